@@ -5,6 +5,7 @@ import (
 	"io"
 	"net"
 	"runtime"
+	"sync/atomic"
 
 	"deedles.dev/wl/internal/debug"
 	"deedles.dev/wl/internal/objstore"
@@ -12,8 +13,6 @@ import (
 	"deedles.dev/xsync"
 )
 
-//go:generate go run deedles.dev/wl/cmd/wlgen -client -out protocol.go -xml ../protocol/wayland.xml
-
 // Client tracks the connection state, including objects and the event
 // queue. It is the primary interface to a Wayland server.
 type Client struct {
@@ -21,6 +20,8 @@ type Client struct {
 	stop  xsync.Stopper
 	queue xsync.Queue[func() error]
 	store *objstore.Store
+
+	tracer atomic.Pointer[wire.Tracer]
 }
 
 // Dial opens a connection to the Wayland display based on the
@@ -40,8 +41,9 @@ func Dial() (*Client, error) {
 func NewClient(conn *wire.Conn) *Client {
 	client := Client{
 		conn:  conn,
-		store: objstore.New(1),
+		store: objstore.New(1, true),
 	}
+	client.SetTracer(debug.Tracer("client"))
 	client.Add(NewDisplay(&client))
 	go client.listen()
 
@@ -58,6 +60,19 @@ func (client *Client) listen() {
 				return
 			}
 
+			var framingErr wire.FramingError
+			if errors.As(err, &framingErr) || errors.Is(err, wire.ErrControlTruncated) {
+				// The rest of the stream can't be interpreted, so close
+				// the connection once everything before the error in the
+				// queue has been handled.
+				select {
+				case <-client.stop.Done():
+				case client.queue.Push() <- func() error { client.Close(); return err }:
+					<-client.stop.Done()
+				}
+				return
+			}
+
 			select {
 			case <-client.stop.Done():
 				return
@@ -116,15 +131,42 @@ func (client *Client) DeleteAll() {
 }
 
 func (client *Client) dispatch(msg *wire.MessageBuffer) error {
-	return client.store.Dispatch(msg)
+	return client.store.Dispatch(msg, client.Tracer())
+}
+
+// SetTracer sets a function to be called with a description of every
+// message sent or received by client. If trace is nil, tracing is
+// disabled. By default, tracing is enabled based on the WAYLAND_DEBUG
+// environment variable in the same way as libwayland. This method is
+// safe to call concurrently and may be called at any time.
+func (client *Client) SetTracer(trace wire.Tracer) {
+	if trace == nil {
+		client.tracer.Store(nil)
+		return
+	}
+	client.tracer.Store(&trace)
+}
+
+// Tracer returns the function that is currently being used to trace
+// messages, or nil if tracing is disabled.
+func (client *Client) Tracer() wire.Tracer {
+	trace := client.tracer.Load()
+	if trace == nil {
+		return nil
+	}
+	return *trace
 }
 
-// Enqueue adds msg to the event queue.
+// Enqueue adds msg to the event queue. If the client has already
+// stopped, msg is discarded and its file descriptors are closed.
 func (client *Client) Enqueue(msg *wire.MessageBuilder) {
 	select {
 	case <-client.stop.Done():
+		msg.Close()
 	case client.queue.Push() <- func() error {
-		debug.Printf(" -> %v", msg)
+		if trace := client.Tracer(); trace != nil {
+			trace(msg.Trace())
+		}
 		return msg.Build(client.conn)
 	}:
 	}
@@ -0,0 +1,199 @@
+package wl
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"testing"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+	"golang.org/x/sys/unix"
+)
+
+func socketPair(t testing.TB) (*net.UnixConn, *net.UnixConn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		file := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	return conns[0], conns[1]
+}
+
+func message(sender uint32, op uint16, args ...uint32) []byte {
+	size := 8 + 4*len(args)
+	buf := binary.NativeEndian.AppendUint32(nil, sender)
+	buf = binary.NativeEndian.AppendUint32(buf, uint32(size)<<16|uint32(op))
+	for _, arg := range args {
+		buf = binary.NativeEndian.AppendUint32(buf, arg)
+	}
+	return buf
+}
+
+// maxFuzzInput is the largest fuzz input that is used. It is small
+// enough to be written to a socket without blocking.
+const maxFuzzInput = 4096
+
+func ctor[T wire.Object](f func(wire.State) T) func(wire.State) wire.Object {
+	return func(state wire.State) wire.Object { return f(state) }
+}
+
+// fuzzObjects holds a constructor for every interface other than
+// wl_display, which every client already has as object 1. Object i is
+// given the ID i+2.
+var fuzzObjects = []func(wire.State) wire.Object{
+	ctor(NewRegistry),
+	ctor(NewCallback),
+	ctor(NewCompositor),
+	ctor(NewShmPool),
+	ctor(NewShm),
+	ctor(NewBuffer),
+	ctor(NewDataOffer),
+	ctor(NewDataSource),
+	ctor(NewDataDevice),
+	ctor(NewDataDeviceManager),
+	ctor(NewShell),
+	ctor(NewShellSurface),
+	ctor(NewSurface),
+	ctor(NewSeat),
+	ctor(NewPointer),
+	ctor(NewKeyboard),
+	ctor(NewTouch),
+	ctor(NewOutput),
+	ctor(NewRegion),
+	ctor(NewSubcompositor),
+	ctor(NewSubsurface),
+	ctor(NewFixes),
+}
+
+func TestFuzzObjects(t *testing.T) {
+	ifaces := []string{DisplayInterface}
+	for _, newObj := range fuzzObjects {
+		ifaces = append(ifaces, newObj(nil).(interface{ Interface() string }).Interface())
+	}
+
+	var expected []string
+	for _, i := range protocol.Wayland().Interfaces {
+		expected = append(expected, i.Name)
+	}
+	if !slices.Equal(ifaces, expected) {
+		t.Fatalf("fuzzed interfaces %v do not match the protocol's %v", ifaces, expected)
+	}
+}
+
+func FuzzDispatch(f *testing.F) {
+	for id := range uint32(len(fuzzObjects) + 1) {
+		for op := range uint16(16) {
+			f.Add(message(id+1, op, 1, 2, 3, 4), uint8(0))
+		}
+	}
+
+	// wl_registry.global followed by wl_registry.global_remove.
+	f.Add(append(message(2, 0, 1, 4, 0x0070_6f74, 1), message(2, 1, 1)...), uint8(0))
+	// wl_display.delete_id for the display and then for the registry.
+	f.Add(append(message(1, 1, 1), message(1, 1, 2)...), uint8(0))
+	// wl_data_offer.offer with a null mime type.
+	f.Add(message(8, 0, 0), uint8(0))
+	// wl_keyboard.keymap with file descriptors.
+	f.Add(message(17, 0, 1, 4096), uint8(1))
+	f.Add(message(17, 0, 1, 4096), uint8(3))
+
+	f.Fuzz(func(t *testing.T, data []byte, nfds uint8) {
+		if len(data) > maxFuzzInput {
+			t.Skip()
+		}
+
+		local, remote := socketPair(t)
+		defer remote.Close()
+		client := NewClient(wire.NewConn(local))
+		client.SetTracer(wire.TraceWriter(io.Discard))
+		for i, newObj := range fuzzObjects {
+			obj := newObj(client)
+			obj.SetID(uint32(i + 2))
+			client.Add(obj)
+		}
+		display := client.Display()
+
+		var oob []byte
+		if nfds%4 != 0 {
+			fds := make([]int, nfds%4)
+			for i := range fds {
+				fds[i] = int(os.Stderr.Fd())
+			}
+			oob = unix.UnixRights(fds...)
+		}
+		_, _, err := remote.WriteMsgUnix(data, oob, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		remote.CloseWrite()
+		go io.Copy(io.Discard, remote)
+
+		// Unlike a server, a client carries on after errors, so every
+		// message is dispatched until the end of the input is reached.
+		for ev := range client.Events() {
+			ev()
+			if client.Get(1) != display {
+				t.Fatalf("object 1 replaced with %v", client.Get(1))
+			}
+		}
+		client.DeleteAll()
+	})
+}
+
+func TestDispatchUnknownOpFDs(t *testing.T) {
+	// A newer version of wl_callback with an event that carries a file
+	// descriptor.
+	desc := *CallbackDesc
+	desc.Events = append(slices.Clip(desc.Events), wire.MessageDesc{
+		Name: "fd",
+		Args: []wire.ArgDesc{{Name: "fd", Type: wire.ArgFD}},
+	})
+	wire.RegisterInterface(&desc, true)
+	defer wire.RegisterInterface(CallbackDesc, true)
+
+	local, remote := socketPair(t)
+	defer remote.Close()
+	conn := wire.NewConn(local)
+	defer conn.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	_, _, err = remote.WriteMsgUnix(message(2, 1), unix.UnixRights(int(r.Fd())), nil)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := wire.ReadMessage(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback := NewCallback(nil)
+	err = callback.Dispatch(msg)
+	if !errors.As(err, new(wire.UnknownOpError)) {
+		t.Fatalf("expected UnknownOpError but got %v", err)
+	}
+	msg.Close()
+
+	// Writing to a pipe fails once every read end has been closed.
+	if _, err := w.Write([]byte{0}); !errors.Is(err, unix.EPIPE) {
+		t.Fatalf("file descriptor of the message was not closed: %v", err)
+	}
+}
@@ -3,16 +3,103 @@
 package wl
 
 import (
+	shared "deedles.dev/wl"
+	"deedles.dev/wl/pointer"
 	"deedles.dev/wl/wire"
 	"fmt"
 	"os"
+	"time"
 )
 
 const (
-	DisplayInterface = "wl_display"
-	DisplayVersion   = 1
+	DisplayInterface = shared.DisplayInterface
+
+	// DisplayVersion is the version of the interface that this
+	// package implements.
+	DisplayVersion = 1
+)
+
+// The versions of the wl_display interface that its messages were
+// added in.
+const (
+	DisplaySyncRequestSince        = 1
+	DisplayGetRegistryRequestSince = 1
+	DisplayErrorEventSince         = 1
+	DisplayDeleteIdEventSince      = 1
 )
 
+// DisplayDesc describes the wl_display interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DisplayDesc = &wire.InterfaceDesc{
+	Name:    DisplayInterface,
+	Version: DisplayVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "sync",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wl_callback",
+				},
+			},
+		},
+		{
+			Name:  "get_registry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "registry",
+					Type:      wire.ArgNewID,
+					Interface: "wl_registry",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "error",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "object_id",
+					Type: wire.ArgObject,
+				},
+				{
+					Name: "code",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "message",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "delete_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_object", Value: 0},
+				{Name: "invalid_method", Value: 1},
+				{Name: "no_memory", Value: 2},
+				{Name: "implementation", Value: 3},
+			},
+		},
+	},
+}
+
 // DisplayListener is a type that can respond to incoming
 // messages for a Display object.
 type DisplayListener interface {
@@ -33,6 +120,50 @@ type DisplayListener interface {
 	DeleteId(id uint32)
 }
 
+// DisplayListenerFuncs implements DisplayListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DisplayListenerFuncs struct {
+	OnError    func(objectId uint32, code uint32, message string)
+	OnDeleteId func(id uint32)
+}
+
+func (lis *DisplayListenerFuncs) Error(objectId uint32, code uint32, message string) {
+	if lis.OnError != nil {
+		lis.OnError(objectId, code, message)
+	}
+}
+
+func (lis *DisplayListenerFuncs) DeleteId(id uint32) {
+	if lis.OnDeleteId != nil {
+		lis.OnDeleteId(id)
+	}
+}
+
+// DisplayEvent is implemented by the message types of all
+// of the events that a Display can receive.
+type DisplayEvent interface {
+	displayEvent()
+}
+
+// DisplayEventFunc implements DisplayListener by calling
+// itself with each incoming message.
+type DisplayEventFunc func(DisplayEvent)
+
+func (f DisplayEventFunc) Error(objectId uint32, code uint32, message string) {
+	f(DisplayErrorEvent{
+		ObjectId: objectId,
+		Code:     code,
+		Message:  message,
+	})
+}
+
+func (f DisplayEventFunc) DeleteId(id uint32) {
+	f(DisplayDeleteIdEvent{
+		Id: id,
+	})
+}
+
 // The core global object.  This is a special singleton object.  It
 // is used for internal Wayland protocol features.
 type Display struct {
@@ -62,14 +193,8 @@ func (obj *Display) State() wire.State {
 func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		objectId := msg.ReadUint()
-
-		code := msg.ReadUint()
-
-		message := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m DisplayErrorEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -77,17 +202,15 @@ func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Error(
-			objectId,
-			code,
-			message,
+			m.ObjectId,
+			m.Code,
+			m.Message,
 		)
 		return nil
 
 	case 1:
-
-		id := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m DisplayDeleteIdEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -95,11 +218,19 @@ func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.DeleteId(
-			id,
+			m.Id,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DisplayInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_display",
 		Type:      "event",
@@ -141,6 +272,38 @@ func (obj *Display) Interface() string {
 	return DisplayInterface
 }
 
+// OnError sets the function that is called when the
+// error event is received. If obj's Listener is not a
+// *DisplayListenerFuncs, it is replaced with one.
+func (obj *Display) OnError(f func(objectId uint32, code uint32, message string)) {
+	lis, ok := obj.Listener.(*DisplayListenerFuncs)
+	if !ok {
+		lis = new(DisplayListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnError = f
+}
+
+// OnDeleteId sets the function that is called when the
+// delete_id event is received. If obj's Listener is not a
+// *DisplayListenerFuncs, it is replaced with one.
+func (obj *Display) OnDeleteId(f func(id uint32)) {
+	lis, ok := obj.Listener.(*DisplayListenerFuncs)
+	if !ok {
+		lis = new(DisplayListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDeleteId = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Display) Subscribe() *wire.Messages[DisplayEvent] {
+	q := new(wire.Messages[DisplayEvent])
+	obj.Listener = DisplayEventFunc(q.Push)
+	return q
+}
+
 func (obj *Display) Version() uint32 {
 	return DisplayVersion
 }
@@ -157,15 +320,11 @@ func (obj *Display) Version() uint32 {
 //
 // The callback_data passed in the callback is undefined and should be ignored.
 func (obj *Display) Sync() (callback *Callback) {
-	builder := wire.NewMessage(obj, 0)
-
 	callback = NewCallback(obj.state)
 	obj.state.Add(callback)
-	builder.WriteObject(callback)
-
-	builder.Method = "sync"
-	builder.Args = []any{callback}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DisplaySyncRequest{
+		Callback: callback,
+	}.Encode(obj))
 	return callback
 }
 
@@ -179,59 +338,232 @@ func (obj *Display) Sync() (callback *Callback) {
 // Therefore, clients should invoke get_registry as infrequently as
 // possible to avoid wasting memory.
 func (obj *Display) GetRegistry() (registry *Registry) {
-	builder := wire.NewMessage(obj, 1)
-
 	registry = NewRegistry(obj.state)
 	obj.state.Add(registry)
-	builder.WriteObject(registry)
-
-	builder.Method = "get_registry"
-	builder.Args = []any{registry}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DisplayGetRegistryRequest{
+		Registry: registry,
+	}.Encode(obj))
 	return registry
 }
 
-// These errors are global and can be emitted in response to any
-// server request.
-type DisplayError int64
+// DisplayErrorEvent holds the arguments of the error event of
+// the wl_display interface.
+type DisplayErrorEvent struct {
+	ObjectId uint32
+	Code     uint32
+	Message  string
+}
 
-const (
-	// server couldn't find object
-	DisplayErrorInvalidObject DisplayError = 0
+func (DisplayErrorEvent) displayEvent() {}
 
-	// method doesn't exist on the specified interface or malformed request
-	DisplayErrorInvalidMethod DisplayError = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DisplayErrorEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ObjectId = msg.ReadUint()
+	m.Code = msg.ReadUint()
+	m.Message = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// server is out of memory
-	DisplayErrorNoMemory DisplayError = 2
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayErrorEvent) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.ObjectId)
+	builder.WriteUint(m.Code)
+	builder.WriteString(m.Message)
 
-	// implementation error in compositor
-	DisplayErrorImplementation DisplayError = 3
-)
+	builder.Method = "error"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ObjectId, m.Code, m.Message}
+	}
+	return builder
+}
 
-func (enum DisplayError) String() string {
-	switch enum {
-	case 0:
-		return "DisplayErrorInvalidObject"
+// DisplayDeleteIdEvent holds the arguments of the delete_id event of
+// the wl_display interface.
+type DisplayDeleteIdEvent struct {
+	Id uint32
+}
 
-	case 1:
-		return "DisplayErrorInvalidMethod"
+func (DisplayDeleteIdEvent) displayEvent() {}
 
-	case 2:
-		return "DisplayErrorNoMemory"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DisplayDeleteIdEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 3:
-		return "DisplayErrorImplementation"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayDeleteIdEvent) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Id)
+
+	builder.Method = "delete_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id}
+	}
+	return builder
+}
+
+// DisplaySyncRequest holds the arguments of the sync request of
+// the wl_display interface.
+type DisplaySyncRequest struct {
+	Callback *Callback
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DisplaySyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Callback = NewCallback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, CallbackInterface, msg.ReadNewObject(CallbackInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplaySyncRequest) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Callback)
+
+	builder.Method = "sync"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CallbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
+
+// DisplayGetRegistryRequest holds the arguments of the get_registry request of
+// the wl_display interface.
+type DisplayGetRegistryRequest struct {
+	Registry *Registry
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DisplayGetRegistryRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Registry = NewRegistry(state)
+	m.Registry.SetID(wire.CheckNewID(msg, state, RegistryInterface, msg.ReadNewObject(RegistryInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	state.Add(m.Registry)
+	return nil
+}
 
-	return "<invalid DisplayError>"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayGetRegistryRequest) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Registry)
+
+	builder.Method = "get_registry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: RegistryInterface, ID: m.Registry.ID()}}
+	}
+	return builder
 }
 
+// DisplayError is an alias for [shared.DisplayError].
+type DisplayError = shared.DisplayError
+
+const (
+	DisplayErrorInvalidObject  = shared.DisplayErrorInvalidObject
+	DisplayErrorInvalidMethod  = shared.DisplayErrorInvalidMethod
+	DisplayErrorNoMemory       = shared.DisplayErrorNoMemory
+	DisplayErrorImplementation = shared.DisplayErrorImplementation
+)
+
 const (
-	RegistryInterface = "wl_registry"
-	RegistryVersion   = 1
+	RegistryInterface = shared.RegistryInterface
+
+	// RegistryVersion is the version of the interface that this
+	// package implements.
+	RegistryVersion = 1
+)
+
+// The versions of the wl_registry interface that its messages were
+// added in.
+const (
+	RegistryBindRequestSince       = 1
+	RegistryGlobalEventSince       = 1
+	RegistryGlobalRemoveEventSince = 1
 )
 
+// RegistryDesc describes the wl_registry interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var RegistryDesc = &wire.InterfaceDesc{
+	Name:    RegistryInterface,
+	Version: RegistryVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "bind",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgNewID,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "global",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "interface",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "version",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "global_remove",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // RegistryListener is a type that can respond to incoming
 // messages for a Registry object.
 type RegistryListener interface {
@@ -255,6 +587,50 @@ type RegistryListener interface {
 	GlobalRemove(name uint32)
 }
 
+// RegistryListenerFuncs implements RegistryListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type RegistryListenerFuncs struct {
+	OnGlobal       func(name uint32, _interface string, version uint32)
+	OnGlobalRemove func(name uint32)
+}
+
+func (lis *RegistryListenerFuncs) Global(name uint32, _interface string, version uint32) {
+	if lis.OnGlobal != nil {
+		lis.OnGlobal(name, _interface, version)
+	}
+}
+
+func (lis *RegistryListenerFuncs) GlobalRemove(name uint32) {
+	if lis.OnGlobalRemove != nil {
+		lis.OnGlobalRemove(name)
+	}
+}
+
+// RegistryEvent is implemented by the message types of all
+// of the events that a Registry can receive.
+type RegistryEvent interface {
+	registryEvent()
+}
+
+// RegistryEventFunc implements RegistryListener by calling
+// itself with each incoming message.
+type RegistryEventFunc func(RegistryEvent)
+
+func (f RegistryEventFunc) Global(name uint32, _interface string, version uint32) {
+	f(RegistryGlobalEvent{
+		Name:      name,
+		Interface: _interface,
+		Version:   version,
+	})
+}
+
+func (f RegistryEventFunc) GlobalRemove(name uint32) {
+	f(RegistryGlobalRemoveEvent{
+		Name: name,
+	})
+}
+
 // The singleton global registry object.  The server has a number of
 // global objects that are available to all clients.  These objects
 // typically represent an actual object in the server (for example,
@@ -302,14 +678,8 @@ func (obj *Registry) State() wire.State {
 func (obj *Registry) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		name := msg.ReadUint()
-
-		_interface := msg.ReadString()
-
-		version := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m RegistryGlobalEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -317,17 +687,15 @@ func (obj *Registry) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Global(
-			name,
-			_interface,
-			version,
+			m.Name,
+			m.Interface,
+			m.Version,
 		)
 		return nil
 
 	case 1:
-
-		name := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m RegistryGlobalRemoveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -335,11 +703,19 @@ func (obj *Registry) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GlobalRemove(
-			name,
+			m.Name,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(RegistryInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_registry",
 		Type:      "event",
@@ -381,6 +757,38 @@ func (obj *Registry) Interface() string {
 	return RegistryInterface
 }
 
+// OnGlobal sets the function that is called when the
+// global event is received. If obj's Listener is not a
+// *RegistryListenerFuncs, it is replaced with one.
+func (obj *Registry) OnGlobal(f func(name uint32, _interface string, version uint32)) {
+	lis, ok := obj.Listener.(*RegistryListenerFuncs)
+	if !ok {
+		lis = new(RegistryListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGlobal = f
+}
+
+// OnGlobalRemove sets the function that is called when the
+// global_remove event is received. If obj's Listener is not a
+// *RegistryListenerFuncs, it is replaced with one.
+func (obj *Registry) OnGlobalRemove(f func(name uint32)) {
+	lis, ok := obj.Listener.(*RegistryListenerFuncs)
+	if !ok {
+		lis = new(RegistryListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGlobalRemove = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Registry) Subscribe() *wire.Messages[RegistryEvent] {
+	q := new(wire.Messages[RegistryEvent])
+	obj.Listener = RegistryEventFunc(q.Push)
+	return q
+}
+
 func (obj *Registry) Version() uint32 {
 	return RegistryVersion
 }
@@ -388,22 +796,155 @@ func (obj *Registry) Version() uint32 {
 // Binds a new, client-created object to the server using the
 // specified name as the identifier.
 func (obj *Registry) Bind(name uint32, id wire.NewID) {
+	obj.state.Enqueue(RegistryBindRequest{
+		Name: name,
+		Id:   id,
+	}.Encode(obj))
+	return
+}
+
+// RegistryGlobalEvent holds the arguments of the global event of
+// the wl_registry interface.
+type RegistryGlobalEvent struct {
+	Name      uint32
+	Interface string
+	Version   uint32
+}
+
+func (RegistryGlobalEvent) registryEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegistryGlobalEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	m.Interface = msg.ReadString()
+	m.Version = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryGlobalEvent) Encode(obj *Registry) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Name)
+	builder.WriteString(m.Interface)
+	builder.WriteUint(m.Version)
+
+	builder.Method = "global"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name, m.Interface, m.Version}
+	}
+	return builder
+}
+
+// RegistryGlobalRemoveEvent holds the arguments of the global_remove event of
+// the wl_registry interface.
+type RegistryGlobalRemoveEvent struct {
+	Name uint32
+}
+
+func (RegistryGlobalRemoveEvent) registryEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegistryGlobalRemoveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteUint(name)
-	builder.WriteNewID(id)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryGlobalRemoveEvent) Encode(obj *Registry) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Name)
+
+	builder.Method = "global_remove"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
+}
+
+// RegistryBindRequest holds the arguments of the bind request of
+// the wl_registry interface.
+type RegistryBindRequest struct {
+	Name uint32
+	Id   wire.NewID
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegistryBindRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	m.Id = msg.ReadNewID()
+	wire.CheckNewID(msg, state, m.Id.Interface, m.Id.ID, false)
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryBindRequest) Encode(obj *Registry) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Name)
+	builder.WriteNewID(m.Id)
 
 	builder.Method = "bind"
-	builder.Args = []any{name, id}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name, m.Id}
+	}
+	return builder
 }
 
 const (
-	CallbackInterface = "wl_callback"
-	CallbackVersion   = 1
+	CallbackInterface = shared.CallbackInterface
+
+	// CallbackVersion is the version of the interface that this
+	// package implements.
+	CallbackVersion = 1
+)
+
+// The versions of the wl_callback interface that its messages were
+// added in.
+const (
+	CallbackDoneEventSince = 1
 )
 
+// CallbackDesc describes the wl_callback interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CallbackDesc = &wire.InterfaceDesc{
+	Name:     CallbackInterface,
+	Version:  CallbackVersion,
+	Requests: []wire.MessageDesc{},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "callback_data",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // CallbackListener is a type that can respond to incoming
 // messages for a Callback object.
 type CallbackListener interface {
@@ -411,6 +952,35 @@ type CallbackListener interface {
 	Done(callbackData uint32)
 }
 
+// CallbackListenerFuncs implements CallbackListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type CallbackListenerFuncs struct {
+	OnDone func(callbackData uint32)
+}
+
+func (lis *CallbackListenerFuncs) Done(callbackData uint32) {
+	if lis.OnDone != nil {
+		lis.OnDone(callbackData)
+	}
+}
+
+// CallbackEvent is implemented by the message types of all
+// of the events that a Callback can receive.
+type CallbackEvent interface {
+	callbackEvent()
+}
+
+// CallbackEventFunc implements CallbackListener by calling
+// itself with each incoming message.
+type CallbackEventFunc func(CallbackEvent)
+
+func (f CallbackEventFunc) Done(callbackData uint32) {
+	f(CallbackDoneEvent{
+		CallbackData: callbackData,
+	})
+}
+
 // Clients can handle the 'done' event to get notified when
 // the related request is done.
 //
@@ -443,10 +1013,8 @@ func (obj *Callback) State() wire.State {
 func (obj *Callback) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		callbackData := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m CallbackDoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -454,11 +1022,19 @@ func (obj *Callback) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Done(
-			callbackData,
+			m.CallbackData,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CallbackInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_callback",
 		Type:      "event",
@@ -497,15 +1073,111 @@ func (obj *Callback) Interface() string {
 	return CallbackInterface
 }
 
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *CallbackListenerFuncs, it is replaced with one.
+func (obj *Callback) OnDone(f func(callbackData uint32)) {
+	lis, ok := obj.Listener.(*CallbackListenerFuncs)
+	if !ok {
+		lis = new(CallbackListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Callback) Subscribe() *wire.Messages[CallbackEvent] {
+	q := new(wire.Messages[CallbackEvent])
+	obj.Listener = CallbackEventFunc(q.Push)
+	return q
+}
+
 func (obj *Callback) Version() uint32 {
 	return CallbackVersion
 }
 
+// CallbackDoneEvent holds the arguments of the done event of
+// the wl_callback interface.
+type CallbackDoneEvent struct {
+	CallbackData uint32
+}
+
+func (CallbackDoneEvent) callbackEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CallbackDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.CallbackData = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CallbackDoneEvent) Encode(obj *Callback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.CallbackData)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.CallbackData}
+	}
+	return builder
+}
+
+const (
+	CompositorInterface = shared.CompositorInterface
+
+	// CompositorVersion is the version of the interface that this
+	// package implements.
+	CompositorVersion = 6
+)
+
+// The versions of the wl_compositor interface that its messages were
+// added in.
 const (
-	CompositorInterface = "wl_compositor"
-	CompositorVersion   = 6
+	CompositorCreateSurfaceRequestSince = 1
+	CompositorCreateRegionRequestSince  = 1
 )
 
+// CompositorDesc describes the wl_compositor interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CompositorDesc = &wire.InterfaceDesc{
+	Name:    CompositorInterface,
+	Version: CompositorVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "create_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_region",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // A compositor.  This object is a singleton global.  The
 // compositor is in charge of combining the contents of multiple
 // surfaces into one displayable output.
@@ -538,6 +1210,14 @@ func (obj *Compositor) State() wire.State {
 
 func (obj *Compositor) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CompositorInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_compositor",
 		Type:      "event",
@@ -580,37 +1260,164 @@ func (obj *Compositor) Version() uint32 {
 
 // Ask the compositor to create a new surface.
 func (obj *Compositor) CreateSurface() (id *Surface) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewSurface(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "create_surface"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(CompositorCreateSurfaceRequest{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
 // Ask the compositor to create a new region.
 func (obj *Compositor) CreateRegion() (id *Region) {
-	builder := wire.NewMessage(obj, 1)
-
 	id = NewRegion(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
+	obj.state.Enqueue(CompositorCreateRegionRequest{
+		Id: id,
+	}.Encode(obj))
+	return id
+}
+
+// CompositorCreateSurfaceRequest holds the arguments of the create_surface request of
+// the wl_compositor interface.
+type CompositorCreateSurfaceRequest struct {
+	Id *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CompositorCreateSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewSurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, SurfaceInterface, msg.ReadNewObject(SurfaceInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CompositorCreateSurfaceRequest) Encode(obj *Compositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "create_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: SurfaceInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// CompositorCreateRegionRequest holds the arguments of the create_region request of
+// the wl_compositor interface.
+type CompositorCreateRegionRequest struct {
+	Id *Region
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CompositorCreateRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewRegion(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, RegionInterface, msg.ReadNewObject(RegionInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CompositorCreateRegionRequest) Encode(obj *Compositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
 
 	builder.Method = "create_region"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
-	return id
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: RegionInterface, ID: m.Id.ID()}}
+	}
+	return builder
 }
 
 const (
-	ShmPoolInterface = "wl_shm_pool"
-	ShmPoolVersion   = 2
+	ShmPoolInterface = shared.ShmPoolInterface
+
+	// ShmPoolVersion is the version of the interface that this
+	// package implements.
+	ShmPoolVersion = 2
+)
+
+// The versions of the wl_shm_pool interface that its messages were
+// added in.
+const (
+	ShmPoolCreateBufferRequestSince = 1
+	ShmPoolDestroyRequestSince      = 1
+	ShmPoolResizeRequestSince       = 1
 )
 
+// ShmPoolDesc describes the wl_shm_pool interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShmPoolDesc = &wire.InterfaceDesc{
+	Name:    ShmPoolInterface,
+	Version: ShmPoolVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_buffer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_buffer",
+				},
+				{
+					Name: "offset",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "stride",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "wl_shm.format",
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "size",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // The wl_shm_pool object encapsulates a piece of memory shared
 // between the compositor and client.  Through the wl_shm_pool
 // object, the client can allocate shared memory wl_buffer objects.
@@ -640,6 +1447,14 @@ func (obj *ShmPool) State() wire.State {
 
 func (obj *ShmPool) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShmPoolInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shm_pool",
 		Type:      "event",
@@ -692,20 +1507,16 @@ func (obj *ShmPool) Version() uint32 {
 // so it is valid to destroy the pool immediately after creating
 // a buffer from it.
 func (obj *ShmPool) CreateBuffer(offset int32, width int32, height int32, stride int32, format ShmFormat) (id *Buffer) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewBuffer(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-	builder.WriteInt(offset)
-	builder.WriteInt(width)
-	builder.WriteInt(height)
-	builder.WriteInt(stride)
-	builder.WriteUint(uint32(format))
-
-	builder.Method = "create_buffer"
-	builder.Args = []any{id, offset, width, height, stride, format}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShmPoolCreateBufferRequest{
+		Id:     id,
+		Offset: offset,
+		Width:  width,
+		Height: height,
+		Stride: stride,
+		Format: format,
+	}.Encode(obj))
 	return id
 }
 
@@ -715,11 +1526,7 @@ func (obj *ShmPool) CreateBuffer(offset int32, width int32, height int32, stride
 // buffers that have been created from this pool
 // are gone.
 func (obj *ShmPool) Destroy() {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShmPoolDestroyRequest{}.Encode(obj))
 	return
 }
 
@@ -734,28 +1541,355 @@ func (obj *ShmPool) Destroy() {
 // responsibility to ensure that the file is at least as big as
 // the new pool size.
 func (obj *ShmPool) Resize(size int32) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteInt(size)
-
-	builder.Method = "resize"
-	builder.Args = []any{size}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShmPoolResizeRequest{
+		Size: size,
+	}.Encode(obj))
 	return
 }
 
-const (
-	ShmInterface = "wl_shm"
-	ShmVersion   = 2
-)
-
-// ShmListener is a type that can respond to incoming
-// messages for a Shm object.
-type ShmListener interface {
-	// Informs the client about a valid pixel format that
-	// can be used for buffers. Known formats include
-	// argb8888 and xrgb8888.
-	Format(format ShmFormat)
+// ShmPoolCreateBufferRequest holds the arguments of the create_buffer request of
+// the wl_shm_pool interface.
+type ShmPoolCreateBufferRequest struct {
+	Id     *Buffer
+	Offset int32
+	Width  int32
+	Height int32
+	Stride int32
+	Format ShmFormat
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmPoolCreateBufferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewBuffer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, BufferInterface, msg.ReadNewObject(BufferInterface), false))
+	m.Offset = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Stride = msg.ReadInt()
+	m.Format = ShmFormat(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolCreateBufferRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	builder.WriteInt(m.Offset)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteInt(m.Stride)
+	builder.WriteUint(uint32(m.Format))
+
+	builder.Method = "create_buffer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: BufferInterface, ID: m.Id.ID()}, m.Offset, m.Width, m.Height, m.Stride, m.Format}
+	}
+	return builder
+}
+
+// ShmPoolDestroyRequest holds the arguments of the destroy request of
+// the wl_shm_pool interface.
+type ShmPoolDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmPoolDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolDestroyRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ShmPoolResizeRequest holds the arguments of the resize request of
+// the wl_shm_pool interface.
+type ShmPoolResizeRequest struct {
+	Size int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmPoolResizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Size = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolResizeRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Size)
+
+	builder.Method = "resize"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Size}
+	}
+	return builder
+}
+
+const (
+	ShmInterface = shared.ShmInterface
+
+	// ShmVersion is the version of the interface that this
+	// package implements.
+	ShmVersion = 2
+)
+
+// The versions of the wl_shm interface that its messages were
+// added in.
+const (
+	ShmCreatePoolRequestSince = 1
+	ShmReleaseRequestSince    = 2
+	ShmFormatEventSince       = 1
+)
+
+// ShmDesc describes the wl_shm interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShmDesc = &wire.InterfaceDesc{
+	Name:    ShmInterface,
+	Version: ShmVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_pool",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_shm_pool",
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "size",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "format",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "format",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_format", Value: 0},
+				{Name: "invalid_stride", Value: 1},
+				{Name: "invalid_fd", Value: 2},
+			},
+		},
+		{
+			Name: "format",
+			Entries: []wire.EntryDesc{
+				{Name: "argb8888", Value: 0},
+				{Name: "xrgb8888", Value: 1},
+				{Name: "c8", Value: 538982467},
+				{Name: "rgb332", Value: 943867730},
+				{Name: "bgr233", Value: 944916290},
+				{Name: "xrgb4444", Value: 842093144},
+				{Name: "xbgr4444", Value: 842089048},
+				{Name: "rgbx4444", Value: 842094674},
+				{Name: "bgrx4444", Value: 842094658},
+				{Name: "argb4444", Value: 842093121},
+				{Name: "abgr4444", Value: 842089025},
+				{Name: "rgba4444", Value: 842088786},
+				{Name: "bgra4444", Value: 842088770},
+				{Name: "xrgb1555", Value: 892424792},
+				{Name: "xbgr1555", Value: 892420696},
+				{Name: "rgbx5551", Value: 892426322},
+				{Name: "bgrx5551", Value: 892426306},
+				{Name: "argb1555", Value: 892424769},
+				{Name: "abgr1555", Value: 892420673},
+				{Name: "rgba5551", Value: 892420434},
+				{Name: "bgra5551", Value: 892420418},
+				{Name: "rgb565", Value: 909199186},
+				{Name: "bgr565", Value: 909199170},
+				{Name: "rgb888", Value: 875710290},
+				{Name: "bgr888", Value: 875710274},
+				{Name: "xbgr8888", Value: 875709016},
+				{Name: "rgbx8888", Value: 875714642},
+				{Name: "bgrx8888", Value: 875714626},
+				{Name: "abgr8888", Value: 875708993},
+				{Name: "rgba8888", Value: 875708754},
+				{Name: "bgra8888", Value: 875708738},
+				{Name: "xrgb2101010", Value: 808669784},
+				{Name: "xbgr2101010", Value: 808665688},
+				{Name: "rgbx1010102", Value: 808671314},
+				{Name: "bgrx1010102", Value: 808671298},
+				{Name: "argb2101010", Value: 808669761},
+				{Name: "abgr2101010", Value: 808665665},
+				{Name: "rgba1010102", Value: 808665426},
+				{Name: "bgra1010102", Value: 808665410},
+				{Name: "yuyv", Value: 1448695129},
+				{Name: "yvyu", Value: 1431918169},
+				{Name: "uyvy", Value: 1498831189},
+				{Name: "vyuy", Value: 1498765654},
+				{Name: "ayuv", Value: 1448433985},
+				{Name: "nv12", Value: 842094158},
+				{Name: "nv21", Value: 825382478},
+				{Name: "nv16", Value: 909203022},
+				{Name: "nv61", Value: 825644622},
+				{Name: "yuv410", Value: 961959257},
+				{Name: "yvu410", Value: 961893977},
+				{Name: "yuv411", Value: 825316697},
+				{Name: "yvu411", Value: 825316953},
+				{Name: "yuv420", Value: 842093913},
+				{Name: "yvu420", Value: 842094169},
+				{Name: "yuv422", Value: 909202777},
+				{Name: "yvu422", Value: 909203033},
+				{Name: "yuv444", Value: 875713881},
+				{Name: "yvu444", Value: 875714137},
+				{Name: "r8", Value: 538982482},
+				{Name: "r16", Value: 540422482},
+				{Name: "rg88", Value: 943212370},
+				{Name: "gr88", Value: 943215175},
+				{Name: "rg1616", Value: 842221394},
+				{Name: "gr1616", Value: 842224199},
+				{Name: "xrgb16161616f", Value: 1211388504},
+				{Name: "xbgr16161616f", Value: 1211384408},
+				{Name: "argb16161616f", Value: 1211388481},
+				{Name: "abgr16161616f", Value: 1211384385},
+				{Name: "xyuv8888", Value: 1448434008},
+				{Name: "vuy888", Value: 875713878},
+				{Name: "vuy101010", Value: 808670550},
+				{Name: "y210", Value: 808530521},
+				{Name: "y212", Value: 842084953},
+				{Name: "y216", Value: 909193817},
+				{Name: "y410", Value: 808531033},
+				{Name: "y412", Value: 842085465},
+				{Name: "y416", Value: 909194329},
+				{Name: "xvyu2101010", Value: 808670808},
+				{Name: "xvyu12_16161616", Value: 909334104},
+				{Name: "xvyu16161616", Value: 942954072},
+				{Name: "y0l0", Value: 810299481},
+				{Name: "x0l0", Value: 810299480},
+				{Name: "y0l2", Value: 843853913},
+				{Name: "x0l2", Value: 843853912},
+				{Name: "yuv420_8bit", Value: 942691673},
+				{Name: "yuv420_10bit", Value: 808539481},
+				{Name: "xrgb8888_a8", Value: 943805016},
+				{Name: "xbgr8888_a8", Value: 943800920},
+				{Name: "rgbx8888_a8", Value: 943806546},
+				{Name: "bgrx8888_a8", Value: 943806530},
+				{Name: "rgb888_a8", Value: 943798354},
+				{Name: "bgr888_a8", Value: 943798338},
+				{Name: "rgb565_a8", Value: 943797586},
+				{Name: "bgr565_a8", Value: 943797570},
+				{Name: "nv24", Value: 875714126},
+				{Name: "nv42", Value: 842290766},
+				{Name: "p210", Value: 808530512},
+				{Name: "p010", Value: 808530000},
+				{Name: "p012", Value: 842084432},
+				{Name: "p016", Value: 909193296},
+				{Name: "axbxgxrx106106106106", Value: 808534593},
+				{Name: "nv15", Value: 892425806},
+				{Name: "q410", Value: 808531025},
+				{Name: "q401", Value: 825242705},
+				{Name: "xrgb16161616", Value: 942953048},
+				{Name: "xbgr16161616", Value: 942948952},
+				{Name: "argb16161616", Value: 942953025},
+				{Name: "abgr16161616", Value: 942948929},
+				{Name: "c1", Value: 538980675},
+				{Name: "c2", Value: 538980931},
+				{Name: "c4", Value: 538981443},
+				{Name: "d1", Value: 538980676},
+				{Name: "d2", Value: 538980932},
+				{Name: "d4", Value: 538981444},
+				{Name: "d8", Value: 538982468},
+				{Name: "r1", Value: 538980690},
+				{Name: "r2", Value: 538980946},
+				{Name: "r4", Value: 538981458},
+				{Name: "r10", Value: 540029266},
+				{Name: "r12", Value: 540160338},
+				{Name: "avuy8888", Value: 1498764865},
+				{Name: "xvuy8888", Value: 1498764888},
+				{Name: "p030", Value: 808661072},
+			},
+		},
+	},
+}
+
+// ShmListener is a type that can respond to incoming
+// messages for a Shm object.
+type ShmListener interface {
+	// Informs the client about a valid pixel format that
+	// can be used for buffers. Known formats include
+	// argb8888 and xrgb8888.
+	Format(format ShmFormat)
+}
+
+// ShmListenerFuncs implements ShmListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShmListenerFuncs struct {
+	OnFormat func(format ShmFormat)
+}
+
+func (lis *ShmListenerFuncs) Format(format ShmFormat) {
+	if lis.OnFormat != nil {
+		lis.OnFormat(format)
+	}
+}
+
+// ShmEvent is implemented by the message types of all
+// of the events that a Shm can receive.
+type ShmEvent interface {
+	shmEvent()
+}
+
+// ShmEventFunc implements ShmListener by calling
+// itself with each incoming message.
+type ShmEventFunc func(ShmEvent)
+
+func (f ShmEventFunc) Format(format ShmFormat) {
+	f(ShmFormatEvent{
+		Format: format,
+	})
 }
 
 // A singleton global object that provides support for shared
@@ -801,10 +1935,8 @@ func (obj *Shm) State() wire.State {
 func (obj *Shm) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		format := ShmFormat(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m ShmFormatEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -812,11 +1944,19 @@ func (obj *Shm) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Format(
-			format,
+			m.Format,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShmInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shm",
 		Type:      "event",
@@ -855,6 +1995,26 @@ func (obj *Shm) Interface() string {
 	return ShmInterface
 }
 
+// OnFormat sets the function that is called when the
+// format event is received. If obj's Listener is not a
+// *ShmListenerFuncs, it is replaced with one.
+func (obj *Shm) OnFormat(f func(format ShmFormat)) {
+	lis, ok := obj.Listener.(*ShmListenerFuncs)
+	if !ok {
+		lis = new(ShmListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFormat = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Shm) Subscribe() *wire.Messages[ShmEvent] {
+	q := new(wire.Messages[ShmEvent])
+	obj.Listener = ShmEventFunc(q.Push)
+	return q
+}
+
 func (obj *Shm) Version() uint32 {
 	return ShmVersion
 }
@@ -865,17 +2025,28 @@ func (obj *Shm) Version() uint32 {
 // objects.  The server will mmap size bytes of the passed file
 // descriptor, to use as backing memory for the pool.
 func (obj *Shm) CreatePool(fd *os.File, size int32) (id *ShmPool) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewShmPool(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-	builder.WriteFile(fd)
-	builder.WriteInt(size)
+	obj.state.Enqueue(ShmCreatePoolRequest{
+		Id:   id,
+		Fd:   fd,
+		Size: size,
+	}.Encode(obj))
+	return id
+}
 
-	builder.Method = "create_pool"
-	builder.Args = []any{id, fd, size}
-	obj.state.Enqueue(builder)
+// CreatePoolTransfer is like CreatePool, but ownership of its files is
+// transferred to the message instead of their file descriptors
+// being duplicated. They are closed once the message has been sent
+// or has failed to be and must not be used afterwards.
+func (obj *Shm) CreatePoolTransfer(fd *os.File, size int32) (id *ShmPool) {
+	id = NewShmPool(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(ShmCreatePoolRequest{
+		Id:   id,
+		Fd:   fd,
+		Size: size,
+	}.EncodeTransfer(obj))
 	return id
 }
 
@@ -883,797 +2054,309 @@ func (obj *Shm) CreatePool(fd *os.File, size int32) (id *ShmPool) {
 // use the shm object anymore.
 //
 // Objects created via this interface remain unaffected.
+//
+// Available since version 2.
 func (obj *Shm) Release() {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShmReleaseRequest{}.Encode(obj))
 	return
 }
 
-// These errors can be emitted in response to wl_shm requests.
-type ShmError int64
-
-const (
-	// buffer format is not known
-	ShmErrorInvalidFormat ShmError = 0
-
-	// invalid size or stride during pool or buffer creation
-	ShmErrorInvalidStride ShmError = 1
-
-	// mmapping the file descriptor failed
-	ShmErrorInvalidFd ShmError = 2
-)
-
-func (enum ShmError) String() string {
-	switch enum {
-	case 0:
-		return "ShmErrorInvalidFormat"
+// ShmFormatEvent holds the arguments of the format event of
+// the wl_shm interface.
+type ShmFormatEvent struct {
+	Format ShmFormat
+}
 
-	case 1:
-		return "ShmErrorInvalidStride"
+func (ShmFormatEvent) shmEvent() {}
 
-	case 2:
-		return "ShmErrorInvalidFd"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmFormatEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = ShmFormat(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
 	}
-
-	return "<invalid ShmError>"
+	return nil
 }
 
-// This describes the memory layout of an individual pixel.
-//
-// All renderers should support argb8888 and xrgb8888 but any other
-// formats are optional and may not be supported by the particular
-// renderer in use.
-//
-// The drm format codes match the macros defined in drm_fourcc.h, except
-// argb8888 and xrgb8888. The formats actually supported by the compositor
-// will be reported by the format event.
-//
-// For all wl_shm formats and unless specified in another protocol
-// extension, pre-multiplied alpha is used for pixel values.
-type ShmFormat int64
-
-const (
-	// 32-bit ARGB format, [31:0] A:R:G:B 8:8:8:8 little endian
-	ShmFormatArgb8888 ShmFormat = 0
-
-	// 32-bit RGB format, [31:0] x:R:G:B 8:8:8:8 little endian
-	ShmFormatXrgb8888 ShmFormat = 1
-
-	// 8-bit color index format, [7:0] C
-	ShmFormatC8 ShmFormat = 538982467
-
-	// 8-bit RGB format, [7:0] R:G:B 3:3:2
-	ShmFormatRgb332 ShmFormat = 943867730
-
-	// 8-bit BGR format, [7:0] B:G:R 2:3:3
-	ShmFormatBgr233 ShmFormat = 944916290
-
-	// 16-bit xRGB format, [15:0] x:R:G:B 4:4:4:4 little endian
-	ShmFormatXrgb4444 ShmFormat = 842093144
-
-	// 16-bit xBGR format, [15:0] x:B:G:R 4:4:4:4 little endian
-	ShmFormatXbgr4444 ShmFormat = 842089048
-
-	// 16-bit RGBx format, [15:0] R:G:B:x 4:4:4:4 little endian
-	ShmFormatRgbx4444 ShmFormat = 842094674
-
-	// 16-bit BGRx format, [15:0] B:G:R:x 4:4:4:4 little endian
-	ShmFormatBgrx4444 ShmFormat = 842094658
-
-	// 16-bit ARGB format, [15:0] A:R:G:B 4:4:4:4 little endian
-	ShmFormatArgb4444 ShmFormat = 842093121
-
-	// 16-bit ABGR format, [15:0] A:B:G:R 4:4:4:4 little endian
-	ShmFormatAbgr4444 ShmFormat = 842089025
-
-	// 16-bit RBGA format, [15:0] R:G:B:A 4:4:4:4 little endian
-	ShmFormatRgba4444 ShmFormat = 842088786
-
-	// 16-bit BGRA format, [15:0] B:G:R:A 4:4:4:4 little endian
-	ShmFormatBgra4444 ShmFormat = 842088770
-
-	// 16-bit xRGB format, [15:0] x:R:G:B 1:5:5:5 little endian
-	ShmFormatXrgb1555 ShmFormat = 892424792
-
-	// 16-bit xBGR 1555 format, [15:0] x:B:G:R 1:5:5:5 little endian
-	ShmFormatXbgr1555 ShmFormat = 892420696
-
-	// 16-bit RGBx 5551 format, [15:0] R:G:B:x 5:5:5:1 little endian
-	ShmFormatRgbx5551 ShmFormat = 892426322
-
-	// 16-bit BGRx 5551 format, [15:0] B:G:R:x 5:5:5:1 little endian
-	ShmFormatBgrx5551 ShmFormat = 892426306
-
-	// 16-bit ARGB 1555 format, [15:0] A:R:G:B 1:5:5:5 little endian
-	ShmFormatArgb1555 ShmFormat = 892424769
-
-	// 16-bit ABGR 1555 format, [15:0] A:B:G:R 1:5:5:5 little endian
-	ShmFormatAbgr1555 ShmFormat = 892420673
-
-	// 16-bit RGBA 5551 format, [15:0] R:G:B:A 5:5:5:1 little endian
-	ShmFormatRgba5551 ShmFormat = 892420434
-
-	// 16-bit BGRA 5551 format, [15:0] B:G:R:A 5:5:5:1 little endian
-	ShmFormatBgra5551 ShmFormat = 892420418
-
-	// 16-bit RGB 565 format, [15:0] R:G:B 5:6:5 little endian
-	ShmFormatRgb565 ShmFormat = 909199186
-
-	// 16-bit BGR 565 format, [15:0] B:G:R 5:6:5 little endian
-	ShmFormatBgr565 ShmFormat = 909199170
-
-	// 24-bit RGB format, [23:0] R:G:B little endian
-	ShmFormatRgb888 ShmFormat = 875710290
-
-	// 24-bit BGR format, [23:0] B:G:R little endian
-	ShmFormatBgr888 ShmFormat = 875710274
-
-	// 32-bit xBGR format, [31:0] x:B:G:R 8:8:8:8 little endian
-	ShmFormatXbgr8888 ShmFormat = 875709016
-
-	// 32-bit RGBx format, [31:0] R:G:B:x 8:8:8:8 little endian
-	ShmFormatRgbx8888 ShmFormat = 875714642
-
-	// 32-bit BGRx format, [31:0] B:G:R:x 8:8:8:8 little endian
-	ShmFormatBgrx8888 ShmFormat = 875714626
-
-	// 32-bit ABGR format, [31:0] A:B:G:R 8:8:8:8 little endian
-	ShmFormatAbgr8888 ShmFormat = 875708993
-
-	// 32-bit RGBA format, [31:0] R:G:B:A 8:8:8:8 little endian
-	ShmFormatRgba8888 ShmFormat = 875708754
-
-	// 32-bit BGRA format, [31:0] B:G:R:A 8:8:8:8 little endian
-	ShmFormatBgra8888 ShmFormat = 875708738
-
-	// 32-bit xRGB format, [31:0] x:R:G:B 2:10:10:10 little endian
-	ShmFormatXrgb2101010 ShmFormat = 808669784
-
-	// 32-bit xBGR format, [31:0] x:B:G:R 2:10:10:10 little endian
-	ShmFormatXbgr2101010 ShmFormat = 808665688
-
-	// 32-bit RGBx format, [31:0] R:G:B:x 10:10:10:2 little endian
-	ShmFormatRgbx1010102 ShmFormat = 808671314
-
-	// 32-bit BGRx format, [31:0] B:G:R:x 10:10:10:2 little endian
-	ShmFormatBgrx1010102 ShmFormat = 808671298
-
-	// 32-bit ARGB format, [31:0] A:R:G:B 2:10:10:10 little endian
-	ShmFormatArgb2101010 ShmFormat = 808669761
-
-	// 32-bit ABGR format, [31:0] A:B:G:R 2:10:10:10 little endian
-	ShmFormatAbgr2101010 ShmFormat = 808665665
-
-	// 32-bit RGBA format, [31:0] R:G:B:A 10:10:10:2 little endian
-	ShmFormatRgba1010102 ShmFormat = 808665426
-
-	// 32-bit BGRA format, [31:0] B:G:R:A 10:10:10:2 little endian
-	ShmFormatBgra1010102 ShmFormat = 808665410
-
-	// packed YCbCr format, [31:0] Cr0:Y1:Cb0:Y0 8:8:8:8 little endian
-	ShmFormatYuyv ShmFormat = 1448695129
-
-	// packed YCbCr format, [31:0] Cb0:Y1:Cr0:Y0 8:8:8:8 little endian
-	ShmFormatYvyu ShmFormat = 1431918169
-
-	// packed YCbCr format, [31:0] Y1:Cr0:Y0:Cb0 8:8:8:8 little endian
-	ShmFormatUyvy ShmFormat = 1498831189
-
-	// packed YCbCr format, [31:0] Y1:Cb0:Y0:Cr0 8:8:8:8 little endian
-	ShmFormatVyuy ShmFormat = 1498765654
-
-	// packed AYCbCr format, [31:0] A:Y:Cb:Cr 8:8:8:8 little endian
-	ShmFormatAyuv ShmFormat = 1448433985
-
-	// 2 plane YCbCr Cr:Cb format, 2x2 subsampled Cr:Cb plane
-	ShmFormatNv12 ShmFormat = 842094158
-
-	// 2 plane YCbCr Cb:Cr format, 2x2 subsampled Cb:Cr plane
-	ShmFormatNv21 ShmFormat = 825382478
-
-	// 2 plane YCbCr Cr:Cb format, 2x1 subsampled Cr:Cb plane
-	ShmFormatNv16 ShmFormat = 909203022
-
-	// 2 plane YCbCr Cb:Cr format, 2x1 subsampled Cb:Cr plane
-	ShmFormatNv61 ShmFormat = 825644622
-
-	// 3 plane YCbCr format, 4x4 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv410 ShmFormat = 961959257
-
-	// 3 plane YCbCr format, 4x4 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu410 ShmFormat = 961893977
-
-	// 3 plane YCbCr format, 4x1 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv411 ShmFormat = 825316697
-
-	// 3 plane YCbCr format, 4x1 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu411 ShmFormat = 825316953
-
-	// 3 plane YCbCr format, 2x2 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv420 ShmFormat = 842093913
-
-	// 3 plane YCbCr format, 2x2 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu420 ShmFormat = 842094169
-
-	// 3 plane YCbCr format, 2x1 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv422 ShmFormat = 909202777
-
-	// 3 plane YCbCr format, 2x1 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu422 ShmFormat = 909203033
-
-	// 3 plane YCbCr format, non-subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv444 ShmFormat = 875713881
-
-	// 3 plane YCbCr format, non-subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu444 ShmFormat = 875714137
-
-	// [7:0] R
-	ShmFormatR8 ShmFormat = 538982482
-
-	// [15:0] R little endian
-	ShmFormatR16 ShmFormat = 540422482
-
-	// [15:0] R:G 8:8 little endian
-	ShmFormatRg88 ShmFormat = 943212370
-
-	// [15:0] G:R 8:8 little endian
-	ShmFormatGr88 ShmFormat = 943215175
-
-	// [31:0] R:G 16:16 little endian
-	ShmFormatRg1616 ShmFormat = 842221394
-
-	// [31:0] G:R 16:16 little endian
-	ShmFormatGr1616 ShmFormat = 842224199
-
-	// [63:0] x:R:G:B 16:16:16:16 little endian
-	ShmFormatXrgb16161616f ShmFormat = 1211388504
-
-	// [63:0] x:B:G:R 16:16:16:16 little endian
-	ShmFormatXbgr16161616f ShmFormat = 1211384408
-
-	// [63:0] A:R:G:B 16:16:16:16 little endian
-	ShmFormatArgb16161616f ShmFormat = 1211388481
-
-	// [63:0] A:B:G:R 16:16:16:16 little endian
-	ShmFormatAbgr16161616f ShmFormat = 1211384385
-
-	// [31:0] X:Y:Cb:Cr 8:8:8:8 little endian
-	ShmFormatXyuv8888 ShmFormat = 1448434008
-
-	// [23:0] Cr:Cb:Y 8:8:8 little endian
-	ShmFormatVuy888 ShmFormat = 875713878
-
-	// Y followed by U then V, 10:10:10. Non-linear modifier only
-	ShmFormatVuy101010 ShmFormat = 808670550
-
-	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 10:6:10:6:10:6:10:6 little endian per 2 Y pixels
-	ShmFormatY210 ShmFormat = 808530521
-
-	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 12:4:12:4:12:4:12:4 little endian per 2 Y pixels
-	ShmFormatY212 ShmFormat = 842084953
-
-	// [63:0] Cr0:Y1:Cb0:Y0 16:16:16:16 little endian per 2 Y pixels
-	ShmFormatY216 ShmFormat = 909193817
-
-	// [31:0] A:Cr:Y:Cb 2:10:10:10 little endian
-	ShmFormatY410 ShmFormat = 808531033
-
-	// [63:0] A:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
-	ShmFormatY412 ShmFormat = 842085465
-
-	// [63:0] A:Cr:Y:Cb 16:16:16:16 little endian
-	ShmFormatY416 ShmFormat = 909194329
-
-	// [31:0] X:Cr:Y:Cb 2:10:10:10 little endian
-	ShmFormatXvyu2101010 ShmFormat = 808670808
-
-	// [63:0] X:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
-	ShmFormatXvyu1216161616 ShmFormat = 909334104
-
-	// [63:0] X:Cr:Y:Cb 16:16:16:16 little endian
-	ShmFormatXvyu16161616 ShmFormat = 942954072
-
-	// [63:0]   A3:A2:Y3:0:Cr0:0:Y2:0:A1:A0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
-	ShmFormatY0l0 ShmFormat = 810299481
-
-	// [63:0]   X3:X2:Y3:0:Cr0:0:Y2:0:X1:X0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
-	ShmFormatX0l0 ShmFormat = 810299480
-
-	// [63:0]   A3:A2:Y3:Cr0:Y2:A1:A0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
-	ShmFormatY0l2 ShmFormat = 843853913
-
-	// [63:0]   X3:X2:Y3:Cr0:Y2:X1:X0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
-	ShmFormatX0l2 ShmFormat = 843853912
-
-	ShmFormatYuv4208bit ShmFormat = 942691673
-
-	ShmFormatYuv42010bit ShmFormat = 808539481
-
-	ShmFormatXrgb8888A8 ShmFormat = 943805016
-
-	ShmFormatXbgr8888A8 ShmFormat = 943800920
-
-	ShmFormatRgbx8888A8 ShmFormat = 943806546
-
-	ShmFormatBgrx8888A8 ShmFormat = 943806530
-
-	ShmFormatRgb888A8 ShmFormat = 943798354
-
-	ShmFormatBgr888A8 ShmFormat = 943798338
-
-	ShmFormatRgb565A8 ShmFormat = 943797586
-
-	ShmFormatBgr565A8 ShmFormat = 943797570
-
-	// non-subsampled Cr:Cb plane
-	ShmFormatNv24 ShmFormat = 875714126
-
-	// non-subsampled Cb:Cr plane
-	ShmFormatNv42 ShmFormat = 842290766
-
-	// 2x1 subsampled Cr:Cb plane, 10 bit per channel
-	ShmFormatP210 ShmFormat = 808530512
-
-	// 2x2 subsampled Cr:Cb plane 10 bits per channel
-	ShmFormatP010 ShmFormat = 808530000
-
-	// 2x2 subsampled Cr:Cb plane 12 bits per channel
-	ShmFormatP012 ShmFormat = 842084432
-
-	// 2x2 subsampled Cr:Cb plane 16 bits per channel
-	ShmFormatP016 ShmFormat = 909193296
-
-	// [63:0] A:x:B:x:G:x:R:x 10:6:10:6:10:6:10:6 little endian
-	ShmFormatAxbxgxrx106106106106 ShmFormat = 808534593
-
-	// 2x2 subsampled Cr:Cb plane
-	ShmFormatNv15 ShmFormat = 892425806
-
-	ShmFormatQ410 ShmFormat = 808531025
-
-	ShmFormatQ401 ShmFormat = 825242705
-
-	// [63:0] x:R:G:B 16:16:16:16 little endian
-	ShmFormatXrgb16161616 ShmFormat = 942953048
-
-	// [63:0] x:B:G:R 16:16:16:16 little endian
-	ShmFormatXbgr16161616 ShmFormat = 942948952
-
-	// [63:0] A:R:G:B 16:16:16:16 little endian
-	ShmFormatArgb16161616 ShmFormat = 942953025
-
-	// [63:0] A:B:G:R 16:16:16:16 little endian
-	ShmFormatAbgr16161616 ShmFormat = 942948929
-
-	// [7:0] C0:C1:C2:C3:C4:C5:C6:C7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatC1 ShmFormat = 538980675
-
-	// [7:0] C0:C1:C2:C3 2:2:2:2 four pixels/byte
-	ShmFormatC2 ShmFormat = 538980931
-
-	// [7:0] C0:C1 4:4 two pixels/byte
-	ShmFormatC4 ShmFormat = 538981443
-
-	// [7:0] D0:D1:D2:D3:D4:D5:D6:D7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatD1 ShmFormat = 538980676
-
-	// [7:0] D0:D1:D2:D3 2:2:2:2 four pixels/byte
-	ShmFormatD2 ShmFormat = 538980932
-
-	// [7:0] D0:D1 4:4 two pixels/byte
-	ShmFormatD4 ShmFormat = 538981444
-
-	// [7:0] D
-	ShmFormatD8 ShmFormat = 538982468
-
-	// [7:0] R0:R1:R2:R3:R4:R5:R6:R7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatR1 ShmFormat = 538980690
-
-	// [7:0] R0:R1:R2:R3 2:2:2:2 four pixels/byte
-	ShmFormatR2 ShmFormat = 538980946
-
-	// [7:0] R0:R1 4:4 two pixels/byte
-	ShmFormatR4 ShmFormat = 538981458
-
-	// [15:0] x:R 6:10 little endian
-	ShmFormatR10 ShmFormat = 540029266
-
-	// [15:0] x:R 4:12 little endian
-	ShmFormatR12 ShmFormat = 540160338
-
-	// [31:0] A:Cr:Cb:Y 8:8:8:8 little endian
-	ShmFormatAvuy8888 ShmFormat = 1498764865
-
-	// [31:0] X:Cr:Cb:Y 8:8:8:8 little endian
-	ShmFormatXvuy8888 ShmFormat = 1498764888
-
-	// 2x2 subsampled Cr:Cb plane 10 bits per channel packed
-	ShmFormatP030 ShmFormat = 808661072
-)
-
-func (enum ShmFormat) String() string {
-	switch enum {
-	case 0:
-		return "ShmFormatArgb8888"
-
-	case 1:
-		return "ShmFormatXrgb8888"
-
-	case 538982467:
-		return "ShmFormatC8"
-
-	case 943867730:
-		return "ShmFormatRgb332"
-
-	case 944916290:
-		return "ShmFormatBgr233"
-
-	case 842093144:
-		return "ShmFormatXrgb4444"
-
-	case 842089048:
-		return "ShmFormatXbgr4444"
-
-	case 842094674:
-		return "ShmFormatRgbx4444"
-
-	case 842094658:
-		return "ShmFormatBgrx4444"
-
-	case 842093121:
-		return "ShmFormatArgb4444"
-
-	case 842089025:
-		return "ShmFormatAbgr4444"
-
-	case 842088786:
-		return "ShmFormatRgba4444"
-
-	case 842088770:
-		return "ShmFormatBgra4444"
-
-	case 892424792:
-		return "ShmFormatXrgb1555"
-
-	case 892420696:
-		return "ShmFormatXbgr1555"
-
-	case 892426322:
-		return "ShmFormatRgbx5551"
-
-	case 892426306:
-		return "ShmFormatBgrx5551"
-
-	case 892424769:
-		return "ShmFormatArgb1555"
-
-	case 892420673:
-		return "ShmFormatAbgr1555"
-
-	case 892420434:
-		return "ShmFormatRgba5551"
-
-	case 892420418:
-		return "ShmFormatBgra5551"
-
-	case 909199186:
-		return "ShmFormatRgb565"
-
-	case 909199170:
-		return "ShmFormatBgr565"
-
-	case 875710290:
-		return "ShmFormatRgb888"
-
-	case 875710274:
-		return "ShmFormatBgr888"
-
-	case 875709016:
-		return "ShmFormatXbgr8888"
-
-	case 875714642:
-		return "ShmFormatRgbx8888"
-
-	case 875714626:
-		return "ShmFormatBgrx8888"
-
-	case 875708993:
-		return "ShmFormatAbgr8888"
-
-	case 875708754:
-		return "ShmFormatRgba8888"
-
-	case 875708738:
-		return "ShmFormatBgra8888"
-
-	case 808669784:
-		return "ShmFormatXrgb2101010"
-
-	case 808665688:
-		return "ShmFormatXbgr2101010"
-
-	case 808671314:
-		return "ShmFormatRgbx1010102"
-
-	case 808671298:
-		return "ShmFormatBgrx1010102"
-
-	case 808669761:
-		return "ShmFormatArgb2101010"
-
-	case 808665665:
-		return "ShmFormatAbgr2101010"
-
-	case 808665426:
-		return "ShmFormatRgba1010102"
-
-	case 808665410:
-		return "ShmFormatBgra1010102"
-
-	case 1448695129:
-		return "ShmFormatYuyv"
-
-	case 1431918169:
-		return "ShmFormatYvyu"
-
-	case 1498831189:
-		return "ShmFormatUyvy"
-
-	case 1498765654:
-		return "ShmFormatVyuy"
-
-	case 1448433985:
-		return "ShmFormatAyuv"
-
-	case 842094158:
-		return "ShmFormatNv12"
-
-	case 825382478:
-		return "ShmFormatNv21"
-
-	case 909203022:
-		return "ShmFormatNv16"
-
-	case 825644622:
-		return "ShmFormatNv61"
-
-	case 961959257:
-		return "ShmFormatYuv410"
-
-	case 961893977:
-		return "ShmFormatYvu410"
-
-	case 825316697:
-		return "ShmFormatYuv411"
-
-	case 825316953:
-		return "ShmFormatYvu411"
-
-	case 842093913:
-		return "ShmFormatYuv420"
-
-	case 842094169:
-		return "ShmFormatYvu420"
-
-	case 909202777:
-		return "ShmFormatYuv422"
-
-	case 909203033:
-		return "ShmFormatYvu422"
-
-	case 875713881:
-		return "ShmFormatYuv444"
-
-	case 875714137:
-		return "ShmFormatYvu444"
-
-	case 538982482:
-		return "ShmFormatR8"
-
-	case 540422482:
-		return "ShmFormatR16"
-
-	case 943212370:
-		return "ShmFormatRg88"
-
-	case 943215175:
-		return "ShmFormatGr88"
-
-	case 842221394:
-		return "ShmFormatRg1616"
-
-	case 842224199:
-		return "ShmFormatGr1616"
-
-	case 1211388504:
-		return "ShmFormatXrgb16161616f"
-
-	case 1211384408:
-		return "ShmFormatXbgr16161616f"
-
-	case 1211388481:
-		return "ShmFormatArgb16161616f"
-
-	case 1211384385:
-		return "ShmFormatAbgr16161616f"
-
-	case 1448434008:
-		return "ShmFormatXyuv8888"
-
-	case 875713878:
-		return "ShmFormatVuy888"
-
-	case 808670550:
-		return "ShmFormatVuy101010"
-
-	case 808530521:
-		return "ShmFormatY210"
-
-	case 842084953:
-		return "ShmFormatY212"
-
-	case 909193817:
-		return "ShmFormatY216"
-
-	case 808531033:
-		return "ShmFormatY410"
-
-	case 842085465:
-		return "ShmFormatY412"
-
-	case 909194329:
-		return "ShmFormatY416"
-
-	case 808670808:
-		return "ShmFormatXvyu2101010"
-
-	case 909334104:
-		return "ShmFormatXvyu1216161616"
-
-	case 942954072:
-		return "ShmFormatXvyu16161616"
-
-	case 810299481:
-		return "ShmFormatY0l0"
-
-	case 810299480:
-		return "ShmFormatX0l0"
-
-	case 843853913:
-		return "ShmFormatY0l2"
-
-	case 843853912:
-		return "ShmFormatX0l2"
-
-	case 942691673:
-		return "ShmFormatYuv4208bit"
-
-	case 808539481:
-		return "ShmFormatYuv42010bit"
-
-	case 943805016:
-		return "ShmFormatXrgb8888A8"
-
-	case 943800920:
-		return "ShmFormatXbgr8888A8"
-
-	case 943806546:
-		return "ShmFormatRgbx8888A8"
-
-	case 943806530:
-		return "ShmFormatBgrx8888A8"
-
-	case 943798354:
-		return "ShmFormatRgb888A8"
-
-	case 943798338:
-		return "ShmFormatBgr888A8"
-
-	case 943797586:
-		return "ShmFormatRgb565A8"
-
-	case 943797570:
-		return "ShmFormatBgr565A8"
-
-	case 875714126:
-		return "ShmFormatNv24"
-
-	case 842290766:
-		return "ShmFormatNv42"
-
-	case 808530512:
-		return "ShmFormatP210"
-
-	case 808530000:
-		return "ShmFormatP010"
-
-	case 842084432:
-		return "ShmFormatP012"
-
-	case 909193296:
-		return "ShmFormatP016"
-
-	case 808534593:
-		return "ShmFormatAxbxgxrx106106106106"
-
-	case 892425806:
-		return "ShmFormatNv15"
-
-	case 808531025:
-		return "ShmFormatQ410"
-
-	case 825242705:
-		return "ShmFormatQ401"
-
-	case 942953048:
-		return "ShmFormatXrgb16161616"
-
-	case 942948952:
-		return "ShmFormatXbgr16161616"
-
-	case 942953025:
-		return "ShmFormatArgb16161616"
-
-	case 942948929:
-		return "ShmFormatAbgr16161616"
-
-	case 538980675:
-		return "ShmFormatC1"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmFormatEvent) Encode(obj *Shm) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Format))
 
-	case 538980931:
-		return "ShmFormatC2"
+	builder.Method = "format"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format}
+	}
+	return builder
+}
+
+// ShmCreatePoolRequest holds the arguments of the create_pool request of
+// the wl_shm interface.
+type ShmCreatePoolRequest struct {
+	Id   *ShmPool
+	Fd   *os.File
+	Size int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmCreatePoolRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewShmPool(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ShmPoolInterface, msg.ReadNewObject(ShmPoolInterface), false))
+	m.Fd = msg.ReadFile()
+	m.Size = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-	case 538981443:
-		return "ShmFormatC4"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m ShmCreatePoolRequest) Encode(obj *Shm) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
 
-	case 538980676:
-		return "ShmFormatD1"
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m ShmCreatePoolRequest) EncodeTransfer(obj *Shm) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
 
-	case 538980932:
-		return "ShmFormatD2"
+func (m ShmCreatePoolRequest) encode(obj *Shm, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+	builder.WriteInt(m.Size)
 
-	case 538981444:
-		return "ShmFormatD4"
+	builder.Method = "create_pool"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ShmPoolInterface, ID: m.Id.ID()}, m.Fd, m.Size}
+	}
+	return builder
+}
 
-	case 538982468:
-		return "ShmFormatD8"
+// ShmReleaseRequest holds the arguments of the release request of
+// the wl_shm interface.
+//
+// Available since version 2.
+type ShmReleaseRequest struct {
+}
 
-	case 538980690:
-		return "ShmFormatR1"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShmReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 538980946:
-		return "ShmFormatR2"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmReleaseRequest) Encode(obj *Shm) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
 
-	case 538981458:
-		return "ShmFormatR4"
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	case 540029266:
-		return "ShmFormatR10"
+// ShmError is an alias for [shared.ShmError].
+type ShmError = shared.ShmError
 
-	case 540160338:
-		return "ShmFormatR12"
+const (
+	ShmErrorInvalidFormat = shared.ShmErrorInvalidFormat
+	ShmErrorInvalidStride = shared.ShmErrorInvalidStride
+	ShmErrorInvalidFd     = shared.ShmErrorInvalidFd
+)
 
-	case 1498764865:
-		return "ShmFormatAvuy8888"
+// ShmFormat is an alias for [shared.ShmFormat].
+type ShmFormat = shared.ShmFormat
 
-	case 1498764888:
-		return "ShmFormatXvuy8888"
+const (
+	ShmFormatArgb8888             = shared.ShmFormatArgb8888
+	ShmFormatXrgb8888             = shared.ShmFormatXrgb8888
+	ShmFormatC8                   = shared.ShmFormatC8
+	ShmFormatRgb332               = shared.ShmFormatRgb332
+	ShmFormatBgr233               = shared.ShmFormatBgr233
+	ShmFormatXrgb4444             = shared.ShmFormatXrgb4444
+	ShmFormatXbgr4444             = shared.ShmFormatXbgr4444
+	ShmFormatRgbx4444             = shared.ShmFormatRgbx4444
+	ShmFormatBgrx4444             = shared.ShmFormatBgrx4444
+	ShmFormatArgb4444             = shared.ShmFormatArgb4444
+	ShmFormatAbgr4444             = shared.ShmFormatAbgr4444
+	ShmFormatRgba4444             = shared.ShmFormatRgba4444
+	ShmFormatBgra4444             = shared.ShmFormatBgra4444
+	ShmFormatXrgb1555             = shared.ShmFormatXrgb1555
+	ShmFormatXbgr1555             = shared.ShmFormatXbgr1555
+	ShmFormatRgbx5551             = shared.ShmFormatRgbx5551
+	ShmFormatBgrx5551             = shared.ShmFormatBgrx5551
+	ShmFormatArgb1555             = shared.ShmFormatArgb1555
+	ShmFormatAbgr1555             = shared.ShmFormatAbgr1555
+	ShmFormatRgba5551             = shared.ShmFormatRgba5551
+	ShmFormatBgra5551             = shared.ShmFormatBgra5551
+	ShmFormatRgb565               = shared.ShmFormatRgb565
+	ShmFormatBgr565               = shared.ShmFormatBgr565
+	ShmFormatRgb888               = shared.ShmFormatRgb888
+	ShmFormatBgr888               = shared.ShmFormatBgr888
+	ShmFormatXbgr8888             = shared.ShmFormatXbgr8888
+	ShmFormatRgbx8888             = shared.ShmFormatRgbx8888
+	ShmFormatBgrx8888             = shared.ShmFormatBgrx8888
+	ShmFormatAbgr8888             = shared.ShmFormatAbgr8888
+	ShmFormatRgba8888             = shared.ShmFormatRgba8888
+	ShmFormatBgra8888             = shared.ShmFormatBgra8888
+	ShmFormatXrgb2101010          = shared.ShmFormatXrgb2101010
+	ShmFormatXbgr2101010          = shared.ShmFormatXbgr2101010
+	ShmFormatRgbx1010102          = shared.ShmFormatRgbx1010102
+	ShmFormatBgrx1010102          = shared.ShmFormatBgrx1010102
+	ShmFormatArgb2101010          = shared.ShmFormatArgb2101010
+	ShmFormatAbgr2101010          = shared.ShmFormatAbgr2101010
+	ShmFormatRgba1010102          = shared.ShmFormatRgba1010102
+	ShmFormatBgra1010102          = shared.ShmFormatBgra1010102
+	ShmFormatYuyv                 = shared.ShmFormatYuyv
+	ShmFormatYvyu                 = shared.ShmFormatYvyu
+	ShmFormatUyvy                 = shared.ShmFormatUyvy
+	ShmFormatVyuy                 = shared.ShmFormatVyuy
+	ShmFormatAyuv                 = shared.ShmFormatAyuv
+	ShmFormatNv12                 = shared.ShmFormatNv12
+	ShmFormatNv21                 = shared.ShmFormatNv21
+	ShmFormatNv16                 = shared.ShmFormatNv16
+	ShmFormatNv61                 = shared.ShmFormatNv61
+	ShmFormatYuv410               = shared.ShmFormatYuv410
+	ShmFormatYvu410               = shared.ShmFormatYvu410
+	ShmFormatYuv411               = shared.ShmFormatYuv411
+	ShmFormatYvu411               = shared.ShmFormatYvu411
+	ShmFormatYuv420               = shared.ShmFormatYuv420
+	ShmFormatYvu420               = shared.ShmFormatYvu420
+	ShmFormatYuv422               = shared.ShmFormatYuv422
+	ShmFormatYvu422               = shared.ShmFormatYvu422
+	ShmFormatYuv444               = shared.ShmFormatYuv444
+	ShmFormatYvu444               = shared.ShmFormatYvu444
+	ShmFormatR8                   = shared.ShmFormatR8
+	ShmFormatR16                  = shared.ShmFormatR16
+	ShmFormatRg88                 = shared.ShmFormatRg88
+	ShmFormatGr88                 = shared.ShmFormatGr88
+	ShmFormatRg1616               = shared.ShmFormatRg1616
+	ShmFormatGr1616               = shared.ShmFormatGr1616
+	ShmFormatXrgb16161616f        = shared.ShmFormatXrgb16161616f
+	ShmFormatXbgr16161616f        = shared.ShmFormatXbgr16161616f
+	ShmFormatArgb16161616f        = shared.ShmFormatArgb16161616f
+	ShmFormatAbgr16161616f        = shared.ShmFormatAbgr16161616f
+	ShmFormatXyuv8888             = shared.ShmFormatXyuv8888
+	ShmFormatVuy888               = shared.ShmFormatVuy888
+	ShmFormatVuy101010            = shared.ShmFormatVuy101010
+	ShmFormatY210                 = shared.ShmFormatY210
+	ShmFormatY212                 = shared.ShmFormatY212
+	ShmFormatY216                 = shared.ShmFormatY216
+	ShmFormatY410                 = shared.ShmFormatY410
+	ShmFormatY412                 = shared.ShmFormatY412
+	ShmFormatY416                 = shared.ShmFormatY416
+	ShmFormatXvyu2101010          = shared.ShmFormatXvyu2101010
+	ShmFormatXvyu1216161616       = shared.ShmFormatXvyu1216161616
+	ShmFormatXvyu16161616         = shared.ShmFormatXvyu16161616
+	ShmFormatY0l0                 = shared.ShmFormatY0l0
+	ShmFormatX0l0                 = shared.ShmFormatX0l0
+	ShmFormatY0l2                 = shared.ShmFormatY0l2
+	ShmFormatX0l2                 = shared.ShmFormatX0l2
+	ShmFormatYuv4208bit           = shared.ShmFormatYuv4208bit
+	ShmFormatYuv42010bit          = shared.ShmFormatYuv42010bit
+	ShmFormatXrgb8888A8           = shared.ShmFormatXrgb8888A8
+	ShmFormatXbgr8888A8           = shared.ShmFormatXbgr8888A8
+	ShmFormatRgbx8888A8           = shared.ShmFormatRgbx8888A8
+	ShmFormatBgrx8888A8           = shared.ShmFormatBgrx8888A8
+	ShmFormatRgb888A8             = shared.ShmFormatRgb888A8
+	ShmFormatBgr888A8             = shared.ShmFormatBgr888A8
+	ShmFormatRgb565A8             = shared.ShmFormatRgb565A8
+	ShmFormatBgr565A8             = shared.ShmFormatBgr565A8
+	ShmFormatNv24                 = shared.ShmFormatNv24
+	ShmFormatNv42                 = shared.ShmFormatNv42
+	ShmFormatP210                 = shared.ShmFormatP210
+	ShmFormatP010                 = shared.ShmFormatP010
+	ShmFormatP012                 = shared.ShmFormatP012
+	ShmFormatP016                 = shared.ShmFormatP016
+	ShmFormatAxbxgxrx106106106106 = shared.ShmFormatAxbxgxrx106106106106
+	ShmFormatNv15                 = shared.ShmFormatNv15
+	ShmFormatQ410                 = shared.ShmFormatQ410
+	ShmFormatQ401                 = shared.ShmFormatQ401
+	ShmFormatXrgb16161616         = shared.ShmFormatXrgb16161616
+	ShmFormatXbgr16161616         = shared.ShmFormatXbgr16161616
+	ShmFormatArgb16161616         = shared.ShmFormatArgb16161616
+	ShmFormatAbgr16161616         = shared.ShmFormatAbgr16161616
+	ShmFormatC1                   = shared.ShmFormatC1
+	ShmFormatC2                   = shared.ShmFormatC2
+	ShmFormatC4                   = shared.ShmFormatC4
+	ShmFormatD1                   = shared.ShmFormatD1
+	ShmFormatD2                   = shared.ShmFormatD2
+	ShmFormatD4                   = shared.ShmFormatD4
+	ShmFormatD8                   = shared.ShmFormatD8
+	ShmFormatR1                   = shared.ShmFormatR1
+	ShmFormatR2                   = shared.ShmFormatR2
+	ShmFormatR4                   = shared.ShmFormatR4
+	ShmFormatR10                  = shared.ShmFormatR10
+	ShmFormatR12                  = shared.ShmFormatR12
+	ShmFormatAvuy8888             = shared.ShmFormatAvuy8888
+	ShmFormatXvuy8888             = shared.ShmFormatXvuy8888
+	ShmFormatP030                 = shared.ShmFormatP030
+)
 
-	case 808661072:
-		return "ShmFormatP030"
-	}
+const (
+	BufferInterface = shared.BufferInterface
 
-	return "<invalid ShmFormat>"
-}
+	// BufferVersion is the version of the interface that this
+	// package implements.
+	BufferVersion = 1
+)
 
+// The versions of the wl_buffer interface that its messages were
+// added in.
 const (
-	BufferInterface = "wl_buffer"
-	BufferVersion   = 1
+	BufferDestroyRequestSince = 1
+	BufferReleaseEventSince   = 1
 )
 
+// BufferDesc describes the wl_buffer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var BufferDesc = &wire.InterfaceDesc{
+	Name:    BufferInterface,
+	Version: BufferVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // BufferListener is a type that can respond to incoming
 // messages for a Buffer object.
 type BufferListener interface {
@@ -1694,6 +2377,33 @@ type BufferListener interface {
 	Release()
 }
 
+// BufferListenerFuncs implements BufferListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type BufferListenerFuncs struct {
+	OnRelease func()
+}
+
+func (lis *BufferListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// BufferEvent is implemented by the message types of all
+// of the events that a Buffer can receive.
+type BufferEvent interface {
+	bufferEvent()
+}
+
+// BufferEventFunc implements BufferListener by calling
+// itself with each incoming message.
+type BufferEventFunc func(BufferEvent)
+
+func (f BufferEventFunc) Release() {
+	f(BufferReleaseEvent{})
+}
+
 // A buffer provides the content for a wl_surface. Buffers are
 // created through factory interfaces such as wl_shm, wp_linux_buffer_params
 // (from the linux-dmabuf protocol extension) or similar. It has a width and
@@ -1736,7 +2446,8 @@ func (obj *Buffer) State() wire.State {
 func (obj *Buffer) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m BufferReleaseEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -1747,6 +2458,14 @@ func (obj *Buffer) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(BufferInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_buffer",
 		Type:      "event",
@@ -1785,6 +2504,26 @@ func (obj *Buffer) Interface() string {
 	return BufferInterface
 }
 
+// OnRelease sets the function that is called when the
+// release event is received. If obj's Listener is not a
+// *BufferListenerFuncs, it is replaced with one.
+func (obj *Buffer) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*BufferListenerFuncs)
+	if !ok {
+		lis = new(BufferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Buffer) Subscribe() *wire.Messages[BufferEvent] {
+	q := new(wire.Messages[BufferEvent])
+	obj.Listener = BufferEventFunc(q.Push)
+	return q
+}
+
 func (obj *Buffer) Version() uint32 {
 	return BufferVersion
 }
@@ -1794,19 +2533,198 @@ func (obj *Buffer) Version() uint32 {
 //
 // For possible side-effects to a surface, see wl_surface.attach.
 func (obj *Buffer) Destroy() {
+	obj.state.Enqueue(BufferDestroyRequest{}.Encode(obj))
+	return
+}
+
+// BufferReleaseEvent holds the arguments of the release event of
+// the wl_buffer interface.
+type BufferReleaseEvent struct {
+}
+
+func (BufferReleaseEvent) bufferEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *BufferReleaseEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m BufferReleaseEvent) Encode(obj *Buffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// BufferDestroyRequest holds the arguments of the destroy request of
+// the wl_buffer interface.
+type BufferDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *BufferDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m BufferDestroyRequest) Encode(obj *Buffer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
 
 	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
 const (
-	DataOfferInterface = "wl_data_offer"
-	DataOfferVersion   = 3
+	DataOfferInterface = shared.DataOfferInterface
+
+	// DataOfferVersion is the version of the interface that this
+	// package implements.
+	DataOfferVersion = 3
+)
+
+// The versions of the wl_data_offer interface that its messages were
+// added in.
+const (
+	DataOfferAcceptRequestSince      = 1
+	DataOfferReceiveRequestSince     = 1
+	DataOfferDestroyRequestSince     = 1
+	DataOfferFinishRequestSince      = 3
+	DataOfferSetActionsRequestSince  = 3
+	DataOfferOfferEventSince         = 1
+	DataOfferSourceActionsEventSince = 3
+	DataOfferActionEventSince        = 3
 )
 
+// DataOfferDesc describes the wl_data_offer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataOfferDesc = &wire.InterfaceDesc{
+	Name:    DataOfferInterface,
+	Version: DataOfferVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "accept",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "mime_type",
+					Type:      wire.ArgString,
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "receive",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "finish",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+				{
+					Name: "preferred_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "source_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "source_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_finish", Value: 0},
+				{Name: "invalid_action_mask", Value: 1},
+				{Name: "invalid_action", Value: 2},
+				{Name: "invalid_offer", Value: 3},
+			},
+		},
+	},
+}
+
 // DataOfferListener is a type that can respond to incoming
 // messages for a DataOffer object.
 type DataOfferListener interface {
@@ -1818,6 +2736,8 @@ type DataOfferListener interface {
 	// will be sent immediately after creating the wl_data_offer object,
 	// or anytime the source side changes its offered actions through
 	// wl_data_source.set_actions.
+	//
+	// Available since version 3.
 	SourceActions(sourceActions DataDeviceManagerDndAction)
 
 	// This event indicates the action selected by the compositor after
@@ -1855,9 +2775,72 @@ type DataOfferListener interface {
 	// user (e.g. popping up a menu with the available options). The
 	// final wl_data_offer.set_actions and wl_data_offer.accept requests
 	// must happen before the call to wl_data_offer.finish.
+	//
+	// Available since version 3.
 	Action(dndAction DataDeviceManagerDndAction)
 }
 
+// DataOfferListenerFuncs implements DataOfferListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataOfferListenerFuncs struct {
+	OnOffer func(mimeType string)
+	// Available since version 3.
+	OnSourceActions func(sourceActions DataDeviceManagerDndAction)
+	// Available since version 3.
+	OnAction func(dndAction DataDeviceManagerDndAction)
+}
+
+func (lis *DataOfferListenerFuncs) Offer(mimeType string) {
+	if lis.OnOffer != nil {
+		lis.OnOffer(mimeType)
+	}
+}
+
+// Available since version 3.
+func (lis *DataOfferListenerFuncs) SourceActions(sourceActions DataDeviceManagerDndAction) {
+	if lis.OnSourceActions != nil {
+		lis.OnSourceActions(sourceActions)
+	}
+}
+
+// Available since version 3.
+func (lis *DataOfferListenerFuncs) Action(dndAction DataDeviceManagerDndAction) {
+	if lis.OnAction != nil {
+		lis.OnAction(dndAction)
+	}
+}
+
+// DataOfferEvent is implemented by the message types of all
+// of the events that a DataOffer can receive.
+type DataOfferEvent interface {
+	dataOfferEvent()
+}
+
+// DataOfferEventFunc implements DataOfferListener by calling
+// itself with each incoming message.
+type DataOfferEventFunc func(DataOfferEvent)
+
+func (f DataOfferEventFunc) Offer(mimeType string) {
+	f(DataOfferOfferEvent{
+		MimeType: mimeType,
+	})
+}
+
+// Available since version 3.
+func (f DataOfferEventFunc) SourceActions(sourceActions DataDeviceManagerDndAction) {
+	f(DataOfferSourceActionsEvent{
+		SourceActions: sourceActions,
+	})
+}
+
+// Available since version 3.
+func (f DataOfferEventFunc) Action(dndAction DataDeviceManagerDndAction) {
+	f(DataOfferActionEvent{
+		DndAction: dndAction,
+	})
+}
+
 // A wl_data_offer represents a piece of data offered for transfer
 // by another client (the source client).  It is used by the
 // copy-and-paste and drag-and-drop mechanisms.  The offer
@@ -1891,10 +2874,8 @@ func (obj *DataOffer) State() wire.State {
 func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		mimeType := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m DataOfferOfferEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -1902,15 +2883,13 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Offer(
-			mimeType,
+			m.MimeType,
 		)
 		return nil
 
 	case 1:
-
-		sourceActions := DataDeviceManagerDndAction(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m DataOfferSourceActionsEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -1918,15 +2897,13 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SourceActions(
-			sourceActions,
+			m.SourceActions,
 		)
 		return nil
 
 	case 2:
-
-		dndAction := DataDeviceManagerDndAction(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m DataOfferActionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -1934,11 +2911,19 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Action(
-			dndAction,
+			m.DndAction,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataOfferInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_offer",
 		Type:      "event",
@@ -1983,6 +2968,54 @@ func (obj *DataOffer) Interface() string {
 	return DataOfferInterface
 }
 
+// OnOffer sets the function that is called when the
+// offer event is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+func (obj *DataOffer) OnOffer(f func(mimeType string)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnOffer = f
+}
+
+// OnSourceActions sets the function that is called when the
+// source_actions event is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataOffer) OnSourceActions(f func(sourceActions DataDeviceManagerDndAction)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSourceActions = f
+}
+
+// OnAction sets the function that is called when the
+// action event is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataOffer) OnAction(f func(dndAction DataDeviceManagerDndAction)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAction = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataOffer) Subscribe() *wire.Messages[DataOfferEvent] {
+	q := new(wire.Messages[DataOfferEvent])
+	obj.Listener = DataOfferEventFunc(q.Push)
+	return q
+}
+
 func (obj *DataOffer) Version() uint32 {
 	return DataOfferVersion
 }
@@ -2001,15 +3034,11 @@ func (obj *DataOffer) Version() uint32 {
 // will be cancelled and the corresponding drag source will receive
 // wl_data_source.cancelled. Clients may still use this event in
 // conjunction with wl_data_source.action for feedback.
-func (obj *DataOffer) Accept(serial uint32, mimeType string) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-	builder.WriteString(mimeType)
-
-	builder.Method = "accept"
-	builder.Args = []any{serial, mimeType}
-	obj.state.Enqueue(builder)
+func (obj *DataOffer) Accept(serial uint32, mimeType *string) {
+	obj.state.Enqueue(DataOfferAcceptRequest{
+		Serial:   serial,
+		MimeType: mimeType,
+	}.Encode(obj))
 	return
 }
 
@@ -2029,132 +3058,506 @@ func (obj *DataOffer) Accept(serial uint32, mimeType string) {
 // clients may preemptively fetch data or examine it more closely to
 // determine acceptance.
 func (obj *DataOffer) Receive(mimeType string, fd *os.File) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteString(mimeType)
-	builder.WriteFile(fd)
+	obj.state.Enqueue(DataOfferReceiveRequest{
+		MimeType: mimeType,
+		Fd:       fd,
+	}.Encode(obj))
+	return
+}
 
-	builder.Method = "receive"
-	builder.Args = []any{mimeType, fd}
-	obj.state.Enqueue(builder)
+// ReceiveTransfer is like Receive, but ownership of its files is
+// transferred to the message instead of their file descriptors
+// being duplicated. They are closed once the message has been sent
+// or has failed to be and must not be used afterwards.
+func (obj *DataOffer) ReceiveTransfer(mimeType string, fd *os.File) {
+	obj.state.Enqueue(DataOfferReceiveRequest{
+		MimeType: mimeType,
+		Fd:       fd,
+	}.EncodeTransfer(obj))
 	return
 }
 
 // Destroy the data offer.
 func (obj *DataOffer) Destroy() {
+	obj.state.Enqueue(DataOfferDestroyRequest{}.Encode(obj))
+	return
+}
+
+// Notifies the compositor that the drag destination successfully
+// finished the drag-and-drop operation.
+//
+// Upon receiving this request, the compositor will emit
+// wl_data_source.dnd_finished on the drag source client.
+//
+// It is a client error to perform other requests than
+// wl_data_offer.destroy after this one. It is also an error to perform
+// this request after a NULL mime type has been set in
+// wl_data_offer.accept or no action was received through
+// wl_data_offer.action.
+//
+// If wl_data_offer.finish request is received for a non drag and drop
+// operation, the invalid_finish protocol error is raised.
+//
+// Available since version 3.
+func (obj *DataOffer) Finish() {
+	obj.state.Enqueue(DataOfferFinishRequest{}.Encode(obj))
+	return
+}
+
+// Sets the actions that the destination side client supports for
+// this operation. This request may trigger the emission of
+// wl_data_source.action and wl_data_offer.action events if the compositor
+// needs to change the selected action.
+//
+// This request can be called multiple times throughout the
+// drag-and-drop operation, typically in response to wl_data_device.enter
+// or wl_data_device.motion events.
+//
+// This request determines the final result of the drag-and-drop
+// operation. If the end result is that no action is accepted,
+// the drag source will receive wl_data_source.cancelled.
+//
+// The dnd_actions argument must contain only values expressed in the
+// wl_data_device_manager.dnd_actions enum, and the preferred_action
+// argument must only contain one of those values set, otherwise it
+// will result in a protocol error.
+//
+// While managing an "ask" action, the destination drag-and-drop client
+// may perform further wl_data_offer.receive requests, and is expected
+// to perform one last wl_data_offer.set_actions request with a preferred
+// action other than "ask" (and optionally wl_data_offer.accept) before
+// requesting wl_data_offer.finish, in order to convey the action selected
+// by the user. If the preferred action is not in the
+// wl_data_offer.source_actions mask, an error will be raised.
+//
+// If the "ask" action is dismissed (e.g. user cancellation), the client
+// is expected to perform wl_data_offer.destroy right away.
+//
+// This request can only be made on drag-and-drop offers, a protocol error
+// will be raised otherwise.
+//
+// Available since version 3.
+func (obj *DataOffer) SetActions(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction) {
+	obj.state.Enqueue(DataOfferSetActionsRequest{
+		DndActions:      dndActions,
+		PreferredAction: preferredAction,
+	}.Encode(obj))
+	return
+}
+
+// DataOfferOfferEvent holds the arguments of the offer event of
+// the wl_data_offer interface.
+type DataOfferOfferEvent struct {
+	MimeType string
+}
+
+func (DataOfferOfferEvent) dataOfferEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferOfferEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferOfferEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.MimeType)
+
+	builder.Method = "offer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
+
+// DataOfferSourceActionsEvent holds the arguments of the source_actions event of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferSourceActionsEvent struct {
+	SourceActions DataDeviceManagerDndAction
+}
+
+func (DataOfferSourceActionsEvent) dataOfferEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferSourceActionsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.SourceActions = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferSourceActionsEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.SourceActions))
+
+	builder.Method = "source_actions"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.SourceActions}
+	}
+	return builder
+}
+
+// DataOfferActionEvent holds the arguments of the action event of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferActionEvent struct {
+	DndAction DataDeviceManagerDndAction
+}
+
+func (DataOfferActionEvent) dataOfferEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferActionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferActionEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(uint32(m.DndAction))
+
+	builder.Method = "action"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndAction}
+	}
+	return builder
+}
+
+// DataOfferAcceptRequest holds the arguments of the accept request of
+// the wl_data_offer interface.
+type DataOfferAcceptRequest struct {
+	Serial   uint32
+	MimeType *string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferAcceptRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.MimeType = msg.ReadNullableString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferAcceptRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteNullableString(m.MimeType)
+
+	builder.Method = "accept"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.MimeType}
+	}
+	return builder
+}
+
+// DataOfferReceiveRequest holds the arguments of the receive request of
+// the wl_data_offer interface.
+type DataOfferReceiveRequest struct {
+	MimeType string
+	Fd       *os.File
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferReceiveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	m.Fd = msg.ReadFile()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m DataOfferReceiveRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m DataOfferReceiveRequest) EncodeTransfer(obj *DataOffer) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m DataOfferReceiveRequest) encode(obj *DataOffer, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.MimeType)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+
+	builder.Method = "receive"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType, m.Fd}
+	}
+	return builder
+}
+
+// DataOfferDestroyRequest holds the arguments of the destroy request of
+// the wl_data_offer interface.
+type DataOfferDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferDestroyRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 2)
 
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataOfferFinishRequest holds the arguments of the finish request of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferFinishRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferFinishRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Notifies the compositor that the drag destination successfully
-// finished the drag-and-drop operation.
-//
-// Upon receiving this request, the compositor will emit
-// wl_data_source.dnd_finished on the drag source client.
-//
-// It is a client error to perform other requests than
-// wl_data_offer.destroy after this one. It is also an error to perform
-// this request after a NULL mime type has been set in
-// wl_data_offer.accept or no action was received through
-// wl_data_offer.action.
-//
-// If wl_data_offer.finish request is received for a non drag and drop
-// operation, the invalid_finish protocol error is raised.
-func (obj *DataOffer) Finish() {
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferFinishRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 3)
 
 	builder.Method = "finish"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// Sets the actions that the destination side client supports for
-// this operation. This request may trigger the emission of
-// wl_data_source.action and wl_data_offer.action events if the compositor
-// needs to change the selected action.
-//
-// This request can be called multiple times throughout the
-// drag-and-drop operation, typically in response to wl_data_device.enter
-// or wl_data_device.motion events.
-//
-// This request determines the final result of the drag-and-drop
-// operation. If the end result is that no action is accepted,
-// the drag source will receive wl_data_source.cancelled.
+// DataOfferSetActionsRequest holds the arguments of the set_actions request of
+// the wl_data_offer interface.
 //
-// The dnd_actions argument must contain only values expressed in the
-// wl_data_device_manager.dnd_actions enum, and the preferred_action
-// argument must only contain one of those values set, otherwise it
-// will result in a protocol error.
-//
-// While managing an "ask" action, the destination drag-and-drop client
-// may perform further wl_data_offer.receive requests, and is expected
-// to perform one last wl_data_offer.set_actions request with a preferred
-// action other than "ask" (and optionally wl_data_offer.accept) before
-// requesting wl_data_offer.finish, in order to convey the action selected
-// by the user. If the preferred action is not in the
-// wl_data_offer.source_actions mask, an error will be raised.
-//
-// If the "ask" action is dismissed (e.g. user cancellation), the client
-// is expected to perform wl_data_offer.destroy right away.
-//
-// This request can only be made on drag-and-drop offers, a protocol error
-// will be raised otherwise.
-func (obj *DataOffer) SetActions(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction) {
-	builder := wire.NewMessage(obj, 4)
+// Available since version 3.
+type DataOfferSetActionsRequest struct {
+	DndActions      DataDeviceManagerDndAction
+	PreferredAction DataDeviceManagerDndAction
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataOfferSetActionsRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndActions = DataDeviceManagerDndAction(msg.ReadUint())
+	m.PreferredAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteUint(uint32(dndActions))
-	builder.WriteUint(uint32(preferredAction))
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferSetActionsRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(uint32(m.DndActions))
+	builder.WriteUint(uint32(m.PreferredAction))
 
 	builder.Method = "set_actions"
-	builder.Args = []any{dndActions, preferredAction}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndActions, m.PreferredAction}
+	}
+	return builder
 }
 
-type DataOfferError int64
+// DataOfferError is an alias for [shared.DataOfferError].
+type DataOfferError = shared.DataOfferError
 
 const (
-	// finish request was called untimely
-	DataOfferErrorInvalidFinish DataOfferError = 0
-
-	// action mask contains invalid values
-	DataOfferErrorInvalidActionMask DataOfferError = 1
-
-	// action argument has an invalid value
-	DataOfferErrorInvalidAction DataOfferError = 2
-
-	// offer doesn't accept this request
-	DataOfferErrorInvalidOffer DataOfferError = 3
+	DataOfferErrorInvalidFinish     = shared.DataOfferErrorInvalidFinish
+	DataOfferErrorInvalidActionMask = shared.DataOfferErrorInvalidActionMask
+	DataOfferErrorInvalidAction     = shared.DataOfferErrorInvalidAction
+	DataOfferErrorInvalidOffer      = shared.DataOfferErrorInvalidOffer
 )
 
-func (enum DataOfferError) String() string {
-	switch enum {
-	case 0:
-		return "DataOfferErrorInvalidFinish"
-
-	case 1:
-		return "DataOfferErrorInvalidActionMask"
-
-	case 2:
-		return "DataOfferErrorInvalidAction"
-
-	case 3:
-		return "DataOfferErrorInvalidOffer"
-	}
+const (
+	DataSourceInterface = shared.DataSourceInterface
 
-	return "<invalid DataOfferError>"
-}
+	// DataSourceVersion is the version of the interface that this
+	// package implements.
+	DataSourceVersion = 3
+)
 
+// The versions of the wl_data_source interface that its messages were
+// added in.
 const (
-	DataSourceInterface = "wl_data_source"
-	DataSourceVersion   = 3
+	DataSourceOfferRequestSince          = 1
+	DataSourceDestroyRequestSince        = 1
+	DataSourceSetActionsRequestSince     = 3
+	DataSourceTargetEventSince           = 1
+	DataSourceSendEventSince             = 1
+	DataSourceCancelledEventSince        = 1
+	DataSourceDndDropPerformedEventSince = 3
+	DataSourceDndFinishedEventSince      = 3
+	DataSourceActionEventSince           = 3
 )
 
+// DataSourceDesc describes the wl_data_source interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataSourceDesc = &wire.InterfaceDesc{
+	Name:    DataSourceInterface,
+	Version: DataSourceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "target",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "mime_type",
+					Type:      wire.ArgString,
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "send",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+			},
+		},
+		{
+			Name:  "cancelled",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "dnd_drop_performed",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "dnd_finished",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_action_mask", Value: 0},
+				{Name: "invalid_source", Value: 1},
+			},
+		},
+	},
+}
+
 // DataSourceListener is a type that can respond to incoming
 // messages for a DataSource object.
 type DataSourceListener interface {
@@ -2162,7 +3565,7 @@ type DataSourceListener interface {
 	// a target does not accept any of the offered types, type is NULL.
 	//
 	// Used for feedback during drag-and-drop.
-	Target(mimeType string)
+	Target(mimeType *string)
 
 	// Request for data from the client.  Send the data as the
 	// specified mime type over the passed file descriptor, then
@@ -2200,6 +3603,8 @@ type DataSourceListener interface {
 	//
 	// Note that the data_source may still be used in the future and should
 	// not be destroyed here.
+	//
+	// Available since version 3.
 	DndDropPerformed()
 
 	// The drop destination finished interoperating with this data
@@ -2208,6 +3613,8 @@ type DataSourceListener interface {
 	//
 	// If the action used to perform the operation was "move", the
 	// source can now delete the transferred data.
+	//
+	// Available since version 3.
 	DndFinished()
 
 	// This event indicates the action selected by the compositor after
@@ -2235,9 +3642,109 @@ type DataSourceListener interface {
 	//
 	// Clients can trigger cursor surface changes from this point, so
 	// they reflect the current action.
+	//
+	// Available since version 3.
 	Action(dndAction DataDeviceManagerDndAction)
 }
 
+// DataSourceListenerFuncs implements DataSourceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataSourceListenerFuncs struct {
+	OnTarget    func(mimeType *string)
+	OnSend      func(mimeType string, fd *os.File)
+	OnCancelled func()
+	// Available since version 3.
+	OnDndDropPerformed func()
+	// Available since version 3.
+	OnDndFinished func()
+	// Available since version 3.
+	OnAction func(dndAction DataDeviceManagerDndAction)
+}
+
+func (lis *DataSourceListenerFuncs) Target(mimeType *string) {
+	if lis.OnTarget != nil {
+		lis.OnTarget(mimeType)
+	}
+}
+
+func (lis *DataSourceListenerFuncs) Send(mimeType string, fd *os.File) {
+	if lis.OnSend != nil {
+		lis.OnSend(mimeType, fd)
+	}
+}
+
+func (lis *DataSourceListenerFuncs) Cancelled() {
+	if lis.OnCancelled != nil {
+		lis.OnCancelled()
+	}
+}
+
+// Available since version 3.
+func (lis *DataSourceListenerFuncs) DndDropPerformed() {
+	if lis.OnDndDropPerformed != nil {
+		lis.OnDndDropPerformed()
+	}
+}
+
+// Available since version 3.
+func (lis *DataSourceListenerFuncs) DndFinished() {
+	if lis.OnDndFinished != nil {
+		lis.OnDndFinished()
+	}
+}
+
+// Available since version 3.
+func (lis *DataSourceListenerFuncs) Action(dndAction DataDeviceManagerDndAction) {
+	if lis.OnAction != nil {
+		lis.OnAction(dndAction)
+	}
+}
+
+// DataSourceEvent is implemented by the message types of all
+// of the events that a DataSource can receive.
+type DataSourceEvent interface {
+	dataSourceEvent()
+}
+
+// DataSourceEventFunc implements DataSourceListener by calling
+// itself with each incoming message.
+type DataSourceEventFunc func(DataSourceEvent)
+
+func (f DataSourceEventFunc) Target(mimeType *string) {
+	f(DataSourceTargetEvent{
+		MimeType: mimeType,
+	})
+}
+
+func (f DataSourceEventFunc) Send(mimeType string, fd *os.File) {
+	f(DataSourceSendEvent{
+		MimeType: mimeType,
+		Fd:       fd,
+	})
+}
+
+func (f DataSourceEventFunc) Cancelled() {
+	f(DataSourceCancelledEvent{})
+}
+
+// Available since version 3.
+func (f DataSourceEventFunc) DndDropPerformed() {
+	f(DataSourceDndDropPerformedEvent{})
+}
+
+// Available since version 3.
+func (f DataSourceEventFunc) DndFinished() {
+	f(DataSourceDndFinishedEvent{})
+}
+
+// Available since version 3.
+func (f DataSourceEventFunc) Action(dndAction DataDeviceManagerDndAction) {
+	f(DataSourceActionEvent{
+		DndAction: dndAction,
+	})
+}
+
 // The wl_data_source object is the source side of a wl_data_offer.
 // It is created by the source client in a data transfer and
 // provides a way to describe the offered data and a way to respond
@@ -2269,10 +3776,8 @@ func (obj *DataSource) State() wire.State {
 func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		mimeType := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m DataSourceTargetEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2280,31 +3785,32 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Target(
-			mimeType,
+			m.MimeType,
 		)
 		return nil
 
 	case 1:
-
-		mimeType := msg.ReadString()
-
-		fd := msg.ReadFile()
-
-		if err := msg.Err(); err != nil {
+		if err := msg.ClaimFDs(1); err != nil {
+			return err
+		}
+		var m DataSourceSendEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
 		}
+		msg.HandOffFiles()
 		obj.Listener.Send(
-			mimeType,
-			fd,
+			m.MimeType,
+			m.Fd,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m DataSourceCancelledEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2315,7 +3821,8 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 3:
-		if err := msg.Err(); err != nil {
+		var m DataSourceDndDropPerformedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2326,7 +3833,8 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 4:
-		if err := msg.Err(); err != nil {
+		var m DataSourceDndFinishedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2337,10 +3845,8 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 5:
-
-		dndAction := DataDeviceManagerDndAction(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m DataSourceActionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2348,11 +3854,19 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Action(
-			dndAction,
+			m.DndAction,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataSourceInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_source",
 		Type:      "event",
@@ -2406,6 +3920,92 @@ func (obj *DataSource) Interface() string {
 	return DataSourceInterface
 }
 
+// OnTarget sets the function that is called when the
+// target event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+func (obj *DataSource) OnTarget(f func(mimeType *string)) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTarget = f
+}
+
+// OnSend sets the function that is called when the
+// send event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+func (obj *DataSource) OnSend(f func(mimeType string, fd *os.File)) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSend = f
+}
+
+// OnCancelled sets the function that is called when the
+// cancelled event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+func (obj *DataSource) OnCancelled(f func()) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCancelled = f
+}
+
+// OnDndDropPerformed sets the function that is called when the
+// dnd_drop_performed event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataSource) OnDndDropPerformed(f func()) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDndDropPerformed = f
+}
+
+// OnDndFinished sets the function that is called when the
+// dnd_finished event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataSource) OnDndFinished(f func()) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDndFinished = f
+}
+
+// OnAction sets the function that is called when the
+// action event is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataSource) OnAction(f func(dndAction DataDeviceManagerDndAction)) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAction = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataSource) Subscribe() *wire.Messages[DataSourceEvent] {
+	q := new(wire.Messages[DataSourceEvent])
+	obj.Listener = DataSourceEventFunc(q.Push)
+	return q
+}
+
 func (obj *DataSource) Version() uint32 {
 	return DataSourceVersion
 }
@@ -2414,77 +4014,527 @@ func (obj *DataSource) Version() uint32 {
 // advertised to targets.  Can be called several times to offer
 // multiple types.
 func (obj *DataSource) Offer(mimeType string) {
+	obj.state.Enqueue(DataSourceOfferRequest{
+		MimeType: mimeType,
+	}.Encode(obj))
+	return
+}
+
+// Destroy the data source.
+func (obj *DataSource) Destroy() {
+	obj.state.Enqueue(DataSourceDestroyRequest{}.Encode(obj))
+	return
+}
+
+// Sets the actions that the source side client supports for this
+// operation. This request may trigger wl_data_source.action and
+// wl_data_offer.action events if the compositor needs to change the
+// selected action.
+//
+// The dnd_actions argument must contain only values expressed in the
+// wl_data_device_manager.dnd_actions enum, otherwise it will result
+// in a protocol error.
+//
+// This request must be made once only, and can only be made on sources
+// used in drag-and-drop, so it must be performed before
+// wl_data_device.start_drag. Attempting to use the source other than
+// for drag-and-drop will raise a protocol error.
+//
+// Available since version 3.
+func (obj *DataSource) SetActions(dndActions DataDeviceManagerDndAction) {
+	obj.state.Enqueue(DataSourceSetActionsRequest{
+		DndActions: dndActions,
+	}.Encode(obj))
+	return
+}
+
+// DataSourceTargetEvent holds the arguments of the target event of
+// the wl_data_source interface.
+type DataSourceTargetEvent struct {
+	MimeType *string
+}
+
+func (DataSourceTargetEvent) dataSourceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceTargetEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadNullableString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceTargetEvent) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
+	builder.WriteNullableString(m.MimeType)
+
+	builder.Method = "target"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
+
+// DataSourceSendEvent holds the arguments of the send event of
+// the wl_data_source interface.
+type DataSourceSendEvent struct {
+	MimeType string
+	Fd       *os.File
+}
+
+func (DataSourceSendEvent) dataSourceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceSendEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	m.Fd = msg.ReadFile()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m DataSourceSendEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m DataSourceSendEvent) EncodeTransfer(obj *DataSource) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m DataSourceSendEvent) encode(obj *DataSource, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.MimeType)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+
+	builder.Method = "send"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType, m.Fd}
+	}
+	return builder
+}
+
+// DataSourceCancelledEvent holds the arguments of the cancelled event of
+// the wl_data_source interface.
+type DataSourceCancelledEvent struct {
+}
+
+func (DataSourceCancelledEvent) dataSourceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceCancelledEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceCancelledEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "cancelled"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataSourceDndDropPerformedEvent holds the arguments of the dnd_drop_performed event of
+// the wl_data_source interface.
+//
+// Available since version 3.
+type DataSourceDndDropPerformedEvent struct {
+}
+
+func (DataSourceDndDropPerformedEvent) dataSourceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceDndDropPerformedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDndDropPerformedEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "dnd_drop_performed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataSourceDndFinishedEvent holds the arguments of the dnd_finished event of
+// the wl_data_source interface.
+//
+// Available since version 3.
+type DataSourceDndFinishedEvent struct {
+}
+
+func (DataSourceDndFinishedEvent) dataSourceEvent() {}
 
-	builder.WriteString(mimeType)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceDndFinishedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDndFinishedEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "dnd_finished"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataSourceActionEvent holds the arguments of the action event of
+// the wl_data_source interface.
+//
+// Available since version 3.
+type DataSourceActionEvent struct {
+	DndAction DataDeviceManagerDndAction
+}
+
+func (DataSourceActionEvent) dataSourceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceActionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceActionEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(uint32(m.DndAction))
+
+	builder.Method = "action"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndAction}
+	}
+	return builder
+}
+
+// DataSourceOfferRequest holds the arguments of the offer request of
+// the wl_data_source interface.
+type DataSourceOfferRequest struct {
+	MimeType string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceOfferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceOfferRequest) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.MimeType)
 
 	builder.Method = "offer"
-	builder.Args = []any{mimeType}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
+
+// DataSourceDestroyRequest holds the arguments of the destroy request of
+// the wl_data_source interface.
+type DataSourceDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Destroy the data source.
-func (obj *DataSource) Destroy() {
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDestroyRequest) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 1)
 
 	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// Sets the actions that the source side client supports for this
-// operation. This request may trigger wl_data_source.action and
-// wl_data_offer.action events if the compositor needs to change the
-// selected action.
-//
-// The dnd_actions argument must contain only values expressed in the
-// wl_data_device_manager.dnd_actions enum, otherwise it will result
-// in a protocol error.
+// DataSourceSetActionsRequest holds the arguments of the set_actions request of
+// the wl_data_source interface.
 //
-// This request must be made once only, and can only be made on sources
-// used in drag-and-drop, so it must be performed before
-// wl_data_device.start_drag. Attempting to use the source other than
-// for drag-and-drop will raise a protocol error.
-func (obj *DataSource) SetActions(dndActions DataDeviceManagerDndAction) {
-	builder := wire.NewMessage(obj, 2)
+// Available since version 3.
+type DataSourceSetActionsRequest struct {
+	DndActions DataDeviceManagerDndAction
+}
 
-	builder.WriteUint(uint32(dndActions))
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataSourceSetActionsRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndActions = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceSetActionsRequest) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(uint32(m.DndActions))
 
 	builder.Method = "set_actions"
-	builder.Args = []any{dndActions}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndActions}
+	}
+	return builder
 }
 
-type DataSourceError int64
+// DataSourceError is an alias for [shared.DataSourceError].
+type DataSourceError = shared.DataSourceError
 
 const (
-	// action mask contains invalid values
-	DataSourceErrorInvalidActionMask DataSourceError = 0
-
-	// source doesn't accept this request
-	DataSourceErrorInvalidSource DataSourceError = 1
+	DataSourceErrorInvalidActionMask = shared.DataSourceErrorInvalidActionMask
+	DataSourceErrorInvalidSource     = shared.DataSourceErrorInvalidSource
 )
 
-func (enum DataSourceError) String() string {
-	switch enum {
-	case 0:
-		return "DataSourceErrorInvalidActionMask"
-
-	case 1:
-		return "DataSourceErrorInvalidSource"
-	}
+const (
+	DataDeviceInterface = shared.DataDeviceInterface
 
-	return "<invalid DataSourceError>"
-}
+	// DataDeviceVersion is the version of the interface that this
+	// package implements.
+	DataDeviceVersion = 3
+)
 
+// The versions of the wl_data_device interface that its messages were
+// added in.
 const (
-	DataDeviceInterface = "wl_data_device"
-	DataDeviceVersion   = 3
+	DataDeviceStartDragRequestSince    = 1
+	DataDeviceSetSelectionRequestSince = 1
+	DataDeviceReleaseRequestSince      = 2
+	DataDeviceDataOfferEventSince      = 1
+	DataDeviceEnterEventSince          = 1
+	DataDeviceLeaveEventSince          = 1
+	DataDeviceMotionEventSince         = 1
+	DataDeviceDropEventSince           = 1
+	DataDeviceSelectionEventSince      = 1
 )
 
+// DataDeviceDesc describes the wl_data_device interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataDeviceDesc = &wire.InterfaceDesc{
+	Name:    DataDeviceInterface,
+	Version: DataDeviceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "start_drag",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "source",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_source",
+					AllowNull: true,
+				},
+				{
+					Name:      "origin",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "icon",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+					AllowNull: true,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "set_selection",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "source",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_source",
+					AllowNull: true,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "data_offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_offer",
+				},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name:      "id",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_offer",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "drop",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "selection",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_offer",
+					AllowNull: true,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+				{Name: "used_source", Value: 1},
+			},
+		},
+	},
+}
+
 // DataDeviceListener is a type that can respond to incoming
 // messages for a DataDevice object.
 type DataDeviceListener interface {
@@ -2512,7 +4562,7 @@ type DataDeviceListener interface {
 	// the currently focused surface. The new position of the pointer
 	// is provided by the x and y arguments, in surface-local
 	// coordinates.
-	Motion(time uint32, x wire.Fixed, y wire.Fixed)
+	Motion(time time.Duration, x wire.Fixed, y wire.Fixed)
 
 	// The event is sent when a drag-and-drop operation is ended
 	// because the implicit grab is removed.
@@ -2544,6 +4594,102 @@ type DataDeviceListener interface {
 	Selection(id *DataOffer)
 }
 
+// DataDeviceListenerFuncs implements DataDeviceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataDeviceListenerFuncs struct {
+	OnDataOffer func(id *DataOffer)
+	OnEnter     func(serial uint32, surface *Surface, x wire.Fixed, y wire.Fixed, id *DataOffer)
+	OnLeave     func()
+	OnMotion    func(time time.Duration, x wire.Fixed, y wire.Fixed)
+	OnDrop      func()
+	OnSelection func(id *DataOffer)
+}
+
+func (lis *DataDeviceListenerFuncs) DataOffer(id *DataOffer) {
+	if lis.OnDataOffer != nil {
+		lis.OnDataOffer(id)
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) Enter(serial uint32, surface *Surface, x wire.Fixed, y wire.Fixed, id *DataOffer) {
+	if lis.OnEnter != nil {
+		lis.OnEnter(serial, surface, x, y, id)
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) Leave() {
+	if lis.OnLeave != nil {
+		lis.OnLeave()
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) Motion(time time.Duration, x wire.Fixed, y wire.Fixed) {
+	if lis.OnMotion != nil {
+		lis.OnMotion(time, x, y)
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) Drop() {
+	if lis.OnDrop != nil {
+		lis.OnDrop()
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) Selection(id *DataOffer) {
+	if lis.OnSelection != nil {
+		lis.OnSelection(id)
+	}
+}
+
+// DataDeviceEvent is implemented by the message types of all
+// of the events that a DataDevice can receive.
+type DataDeviceEvent interface {
+	dataDeviceEvent()
+}
+
+// DataDeviceEventFunc implements DataDeviceListener by calling
+// itself with each incoming message.
+type DataDeviceEventFunc func(DataDeviceEvent)
+
+func (f DataDeviceEventFunc) DataOffer(id *DataOffer) {
+	f(DataDeviceDataOfferEvent{
+		Id: id,
+	})
+}
+
+func (f DataDeviceEventFunc) Enter(serial uint32, surface *Surface, x wire.Fixed, y wire.Fixed, id *DataOffer) {
+	f(DataDeviceEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		X:       x,
+		Y:       y,
+		Id:      id,
+	})
+}
+
+func (f DataDeviceEventFunc) Leave() {
+	f(DataDeviceLeaveEvent{})
+}
+
+func (f DataDeviceEventFunc) Motion(time time.Duration, x wire.Fixed, y wire.Fixed) {
+	f(DataDeviceMotionEvent{
+		Time: time,
+		X:    x,
+		Y:    y,
+	})
+}
+
+func (f DataDeviceEventFunc) Drop() {
+	f(DataDeviceDropEvent{})
+}
+
+func (f DataDeviceEventFunc) Selection(id *DataOffer) {
+	f(DataDeviceSelectionEvent{
+		Id: id,
+	})
+}
+
 // There is one wl_data_device per seat which can be obtained
 // from the global wl_data_device_manager singleton.
 //
@@ -2576,13 +4722,8 @@ func (obj *DataDevice) State() wire.State {
 func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewDataOffer(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceDataOfferEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2590,27 +4731,13 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.DataOffer(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 1:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		x := msg.ReadFixed()
-
-		y := msg.ReadFixed()
-
-		id, _ := obj.state.Get(msg.ReadUint()).(*DataOffer)
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceEnterEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2618,16 +4745,17 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Enter(
-			serial,
-			surface,
-			x,
-			y,
-			id,
+			m.Serial,
+			m.Surface,
+			m.X,
+			m.Y,
+			m.Id,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m DataDeviceLeaveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2638,14 +4766,8 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 3:
-
-		time := msg.ReadUint()
-
-		x := msg.ReadFixed()
-
-		y := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceMotionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2653,14 +4775,15 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Motion(
-			time,
-			x,
-			y,
+			m.Time,
+			m.X,
+			m.Y,
 		)
 		return nil
 
 	case 4:
-		if err := msg.Err(); err != nil {
+		var m DataDeviceDropEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2671,12 +4794,8 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 5:
-
-		id, _ := obj.state.Get(msg.ReadUint()).(*DataOffer)
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceSelectionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2684,11 +4803,19 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Selection(
-			id,
+			m.Id,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataDeviceInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_device",
 		Type:      "event",
@@ -2742,6 +4869,86 @@ func (obj *DataDevice) Interface() string {
 	return DataDeviceInterface
 }
 
+// OnDataOffer sets the function that is called when the
+// data_offer event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnDataOffer(f func(id *DataOffer)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDataOffer = f
+}
+
+// OnEnter sets the function that is called when the
+// enter event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnEnter(f func(serial uint32, surface *Surface, x wire.Fixed, y wire.Fixed, id *DataOffer)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnEnter = f
+}
+
+// OnLeave sets the function that is called when the
+// leave event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnLeave(f func()) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnLeave = f
+}
+
+// OnMotion sets the function that is called when the
+// motion event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnMotion(f func(time time.Duration, x wire.Fixed, y wire.Fixed)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMotion = f
+}
+
+// OnDrop sets the function that is called when the
+// drop event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnDrop(f func()) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDrop = f
+}
+
+// OnSelection sets the function that is called when the
+// selection event is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnSelection(f func(id *DataOffer)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSelection = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataDevice) Subscribe() *wire.Messages[DataDeviceEvent] {
+	q := new(wire.Messages[DataDeviceEvent])
+	obj.Listener = DataDeviceEventFunc(q.Push)
+	return q
+}
+
 func (obj *DataDevice) Version() uint32 {
 	return DataDeviceVersion
 }
@@ -2776,16 +4983,12 @@ func (obj *DataDevice) Version() uint32 {
 // start_drag requests. Attempting to reuse a previously-used source
 // may send a used_source error.
 func (obj *DataDevice) StartDrag(source *DataSource, origin *Surface, icon *Surface, serial uint32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteObject(source)
-	builder.WriteObject(origin)
-	builder.WriteObject(icon)
-	builder.WriteUint(serial)
-
-	builder.Method = "start_drag"
-	builder.Args = []any{source, origin, icon, serial}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceStartDragRequest{
+		Source: source,
+		Origin: origin,
+		Icon:   icon,
+		Serial: serial,
+	}.Encode(obj))
 	return
 }
 
@@ -2798,54 +5001,409 @@ func (obj *DataDevice) StartDrag(source *DataSource, origin *Surface, icon *Surf
 // start_drag requests. Attempting to reuse a previously-used source
 // may send a used_source error.
 func (obj *DataDevice) SetSelection(source *DataSource, serial uint32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteObject(source)
-	builder.WriteUint(serial)
-
-	builder.Method = "set_selection"
-	builder.Args = []any{source, serial}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceSetSelectionRequest{
+		Source: source,
+		Serial: serial,
+	}.Encode(obj))
 	return
 }
 
 // This request destroys the data device.
+//
+// Available since version 2.
 func (obj *DataDevice) Release() {
+	obj.state.Enqueue(DataDeviceReleaseRequest{}.Encode(obj))
+	return
+}
+
+// DataDeviceDataOfferEvent holds the arguments of the data_offer event of
+// the wl_data_device interface.
+type DataDeviceDataOfferEvent struct {
+	Id *DataOffer
+}
+
+func (DataDeviceDataOfferEvent) dataDeviceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceDataOfferEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataOffer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataOfferInterface, msg.ReadNewObject(DataOfferInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceDataOfferEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "data_offer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataOfferInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// DataDeviceEnterEvent holds the arguments of the enter event of
+// the wl_data_device interface.
+type DataDeviceEnterEvent struct {
+	Serial  uint32
+	Surface *Surface
+	X       wire.Fixed
+	Y       wire.Fixed
+	Id      *DataOffer
+}
+
+func (DataDeviceEnterEvent) dataDeviceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	m.Id = wire.ResolveObject[*DataOffer](msg, state, DataOfferInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceEnterEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+	builder.WriteNullableObject(m.Id)
+
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.X, m.Y, m.Id}
+	}
+	return builder
+}
+
+// DataDeviceLeaveEvent holds the arguments of the leave event of
+// the wl_data_device interface.
+type DataDeviceLeaveEvent struct {
+}
+
+func (DataDeviceLeaveEvent) dataDeviceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceLeaveEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 2)
 
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-type DataDeviceError int64
+// DataDeviceMotionEvent holds the arguments of the motion event of
+// the wl_data_device interface.
+type DataDeviceMotionEvent struct {
+	Time time.Duration
+	X    wire.Fixed
+	Y    wire.Fixed
+}
 
-const (
-	// given wl_surface has another role
-	DataDeviceErrorRole DataDeviceError = 0
+func (DataDeviceMotionEvent) dataDeviceEvent() {}
 
-	// source has already been used
-	DataDeviceErrorUsedSource DataDeviceError = 1
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum DataDeviceError) String() string {
-	switch enum {
-	case 0:
-		return "DataDeviceErrorRole"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceMotionEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
 
-	case 1:
-		return "DataDeviceErrorUsedSource"
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.X, m.Y}
+	}
+	return builder
+}
+
+// DataDeviceDropEvent holds the arguments of the drop event of
+// the wl_data_device interface.
+type DataDeviceDropEvent struct {
+}
+
+func (DataDeviceDropEvent) dataDeviceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceDropEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceDropEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "drop"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataDeviceSelectionEvent holds the arguments of the selection event of
+// the wl_data_device interface.
+type DataDeviceSelectionEvent struct {
+	Id *DataOffer
+}
+
+func (DataDeviceSelectionEvent) dataDeviceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceSelectionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = wire.ResolveObject[*DataOffer](msg, state, DataOfferInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceSelectionEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteNullableObject(m.Id)
+
+	builder.Method = "selection"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id}
+	}
+	return builder
+}
+
+// DataDeviceStartDragRequest holds the arguments of the start_drag request of
+// the wl_data_device interface.
+type DataDeviceStartDragRequest struct {
+	Source *DataSource
+	Origin *Surface
+	Icon   *Surface
+	Serial uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceStartDragRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = wire.ResolveObject[*DataSource](msg, state, DataSourceInterface, msg.ReadNullableObject())
+	m.Origin = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Icon = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadNullableObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceStartDragRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteNullableObject(m.Source)
+	builder.WriteObject(m.Origin)
+	builder.WriteNullableObject(m.Icon)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "start_drag"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source, m.Origin, m.Icon, m.Serial}
+	}
+	return builder
+}
+
+// DataDeviceSetSelectionRequest holds the arguments of the set_selection request of
+// the wl_data_device interface.
+type DataDeviceSetSelectionRequest struct {
+	Source *DataSource
+	Serial uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceSetSelectionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = wire.ResolveObject[*DataSource](msg, state, DataSourceInterface, msg.ReadNullableObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceSetSelectionRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteNullableObject(m.Source)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_selection"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source, m.Serial}
+	}
+	return builder
+}
+
+// DataDeviceReleaseRequest holds the arguments of the release request of
+// the wl_data_device interface.
+//
+// Available since version 2.
+type DataDeviceReleaseRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceReleaseRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
 
-	return "<invalid DataDeviceError>"
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
+// DataDeviceError is an alias for [shared.DataDeviceError].
+type DataDeviceError = shared.DataDeviceError
+
+const (
+	DataDeviceErrorRole       = shared.DataDeviceErrorRole
+	DataDeviceErrorUsedSource = shared.DataDeviceErrorUsedSource
+)
+
+const (
+	DataDeviceManagerInterface = shared.DataDeviceManagerInterface
+
+	// DataDeviceManagerVersion is the version of the interface that this
+	// package implements.
+	DataDeviceManagerVersion = 3
+)
+
+// The versions of the wl_data_device_manager interface that its messages were
+// added in.
 const (
-	DataDeviceManagerInterface = "wl_data_device_manager"
-	DataDeviceManagerVersion   = 3
+	DataDeviceManagerCreateDataSourceRequestSince = 1
+	DataDeviceManagerGetDataDeviceRequestSince    = 1
 )
 
+// DataDeviceManagerDesc describes the wl_data_device_manager interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataDeviceManagerDesc = &wire.InterfaceDesc{
+	Name:    DataDeviceManagerInterface,
+	Version: DataDeviceManagerVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_data_source",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_source",
+				},
+			},
+		},
+		{
+			Name:  "get_data_device",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_device",
+				},
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "dnd_action",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "copy", Value: 1},
+				{Name: "move", Value: 2},
+				{Name: "ask", Value: 4},
+			},
+		},
+	},
+}
+
 // The wl_data_device_manager is a singleton global object that
 // provides access to inter-client data transfer mechanisms such as
 // copy-and-paste and drag-and-drop.  These mechanisms are tied to
@@ -2885,6 +5443,14 @@ func (obj *DataDeviceManager) State() wire.State {
 
 func (obj *DataDeviceManager) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataDeviceManagerInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_device_manager",
 		Type:      "event",
@@ -2927,95 +5493,154 @@ func (obj *DataDeviceManager) Version() uint32 {
 
 // Create a new data source.
 func (obj *DataDeviceManager) CreateDataSource() (id *DataSource) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewDataSource(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "create_data_source"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceManagerCreateDataSourceRequest{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
 // Create a new data device for a given seat.
 func (obj *DataDeviceManager) GetDataDevice(seat *Seat) (id *DataDevice) {
-	builder := wire.NewMessage(obj, 1)
-
 	id = NewDataDevice(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-	builder.WriteObject(seat)
-
-	builder.Method = "get_data_device"
-	builder.Args = []any{id, seat}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceManagerGetDataDeviceRequest{
+		Id:   id,
+		Seat: seat,
+	}.Encode(obj))
 	return id
 }
 
-// This is a bitmask of the available/preferred actions in a
-// drag-and-drop operation.
-//
-// In the compositor, the selected action is a result of matching the
-// actions offered by the source and destination sides.  "action" events
-// with a "none" action will be sent to both source and destination if
-// there is no match. All further checks will effectively happen on
-// (source actions ∩ destination actions).
-//
-// In addition, compositors may also pick different actions in
-// reaction to key modifiers being pressed. One common design that
-// is used in major toolkits (and the behavior recommended for
-// compositors) is:
-//
-// - If no modifiers are pressed, the first match (in bit order)
-// will be used.
-// - Pressing Shift selects "move", if enabled in the mask.
-// - Pressing Control selects "copy", if enabled in the mask.
-//
-// Behavior beyond that is considered implementation-dependent.
-// Compositors may for example bind other modifiers (like Alt/Meta)
-// or drags initiated with other buttons than BTN_LEFT to specific
-// actions (e.g. "ask").
-type DataDeviceManagerDndAction int64
+// DataDeviceManagerCreateDataSourceRequest holds the arguments of the create_data_source request of
+// the wl_data_device_manager interface.
+type DataDeviceManagerCreateDataSourceRequest struct {
+	Id *DataSource
+}
 
-const (
-	// no action
-	DataDeviceManagerDndActionNone DataDeviceManagerDndAction = 0
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceManagerCreateDataSourceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataSource(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataSourceInterface, msg.ReadNewObject(DataSourceInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-	// copy action
-	DataDeviceManagerDndActionCopy DataDeviceManagerDndAction = 1
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceManagerCreateDataSourceRequest) Encode(obj *DataDeviceManager) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
 
-	// move action
-	DataDeviceManagerDndActionMove DataDeviceManagerDndAction = 2
+	builder.Method = "create_data_source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataSourceInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// DataDeviceManagerGetDataDeviceRequest holds the arguments of the get_data_device request of
+// the wl_data_device_manager interface.
+type DataDeviceManagerGetDataDeviceRequest struct {
+	Id   *DataDevice
+	Seat *Seat
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *DataDeviceManagerGetDataDeviceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataDevice(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataDeviceInterface, msg.ReadNewObject(DataDeviceInterface), false))
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-	// ask action
-	DataDeviceManagerDndActionAsk DataDeviceManagerDndAction = 4
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceManagerGetDataDeviceRequest) Encode(obj *DataDeviceManager) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Seat)
 
-func (enum DataDeviceManagerDndAction) String() string {
-	switch enum {
-	case 0:
-		return "DataDeviceManagerDndActionNone"
+	builder.Method = "get_data_device"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataDeviceInterface, ID: m.Id.ID()}, m.Seat}
+	}
+	return builder
+}
 
-	case 1:
-		return "DataDeviceManagerDndActionCopy"
+// DataDeviceManagerDndAction is an alias for [shared.DataDeviceManagerDndAction].
+//
+// Available since version 3.
+type DataDeviceManagerDndAction = shared.DataDeviceManagerDndAction
 
-	case 2:
-		return "DataDeviceManagerDndActionMove"
+const (
+	DataDeviceManagerDndActionNone = shared.DataDeviceManagerDndActionNone
+	DataDeviceManagerDndActionCopy = shared.DataDeviceManagerDndActionCopy
+	DataDeviceManagerDndActionMove = shared.DataDeviceManagerDndActionMove
+	DataDeviceManagerDndActionAsk  = shared.DataDeviceManagerDndActionAsk
+)
 
-	case 4:
-		return "DataDeviceManagerDndActionAsk"
-	}
+const (
+	ShellInterface = shared.ShellInterface
 
-	return "<invalid DataDeviceManagerDndAction>"
-}
+	// ShellVersion is the version of the interface that this
+	// package implements.
+	ShellVersion = 1
+)
 
+// The versions of the wl_shell interface that its messages were
+// added in.
 const (
-	ShellInterface = "wl_shell"
-	ShellVersion   = 1
+	ShellGetShellSurfaceRequestSince = 1
 )
 
+// ShellDesc describes the wl_shell interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShellDesc = &wire.InterfaceDesc{
+	Name:    ShellInterface,
+	Version: ShellVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "get_shell_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_shell_surface",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+			},
+		},
+	},
+}
+
 // This interface is implemented by servers that provide
 // desktop-style user interfaces.
 //
@@ -3054,6 +5679,14 @@ func (obj *Shell) State() wire.State {
 
 func (obj *Shell) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShellInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shell",
 		Type:      "event",
@@ -3100,40 +5733,322 @@ func (obj *Shell) Version() uint32 {
 //
 // Only one shell surface can be associated with a given surface.
 func (obj *Shell) GetShellSurface(surface *Surface) (id *ShellSurface) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewShellSurface(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-	builder.WriteObject(surface)
+	obj.state.Enqueue(ShellGetShellSurfaceRequest{
+		Id:      id,
+		Surface: surface,
+	}.Encode(obj))
+	return id
+}
+
+// ShellGetShellSurfaceRequest holds the arguments of the get_shell_surface request of
+// the wl_shell interface.
+type ShellGetShellSurfaceRequest struct {
+	Id      *ShellSurface
+	Surface *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellGetShellSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewShellSurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ShellSurfaceInterface, msg.ReadNewObject(ShellSurfaceInterface), false))
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellGetShellSurfaceRequest) Encode(obj *Shell) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
 
 	builder.Method = "get_shell_surface"
-	builder.Args = []any{id, surface}
-	obj.state.Enqueue(builder)
-	return id
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ShellSurfaceInterface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
 }
 
-type ShellError int64
+// ShellError is an alias for [shared.ShellError].
+type ShellError = shared.ShellError
 
 const (
-	// given wl_surface has another role
-	ShellErrorRole ShellError = 0
+	ShellErrorRole = shared.ShellErrorRole
 )
 
-func (enum ShellError) String() string {
-	switch enum {
-	case 0:
-		return "ShellErrorRole"
-	}
+const (
+	ShellSurfaceInterface = shared.ShellSurfaceInterface
 
-	return "<invalid ShellError>"
-}
+	// ShellSurfaceVersion is the version of the interface that this
+	// package implements.
+	ShellSurfaceVersion = 1
+)
 
+// The versions of the wl_shell_surface interface that its messages were
+// added in.
 const (
-	ShellSurfaceInterface = "wl_shell_surface"
-	ShellSurfaceVersion   = 1
+	ShellSurfacePongRequestSince          = 1
+	ShellSurfaceMoveRequestSince          = 1
+	ShellSurfaceResizeRequestSince        = 1
+	ShellSurfaceSetToplevelRequestSince   = 1
+	ShellSurfaceSetTransientRequestSince  = 1
+	ShellSurfaceSetFullscreenRequestSince = 1
+	ShellSurfaceSetPopupRequestSince      = 1
+	ShellSurfaceSetMaximizedRequestSince  = 1
+	ShellSurfaceSetTitleRequestSince      = 1
+	ShellSurfaceSetClassRequestSince      = 1
+	ShellSurfacePingEventSince            = 1
+	ShellSurfaceConfigureEventSince       = 1
+	ShellSurfacePopupDoneEventSince       = 1
 )
 
+// ShellSurfaceDesc describes the wl_shell_surface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShellSurfaceDesc = &wire.InterfaceDesc{
+	Name:    ShellSurfaceInterface,
+	Version: ShellSurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "pong",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "move",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "edges",
+					Type: wire.ArgUint,
+					Enum: "resize",
+				},
+			},
+		},
+		{
+			Name:  "set_toplevel",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_transient",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "transient",
+				},
+			},
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "method",
+					Type: wire.ArgUint,
+					Enum: "fullscreen_method",
+				},
+				{
+					Name: "framerate",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_popup",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "transient",
+				},
+			},
+		},
+		{
+			Name:  "set_maximized",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_title",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "title",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "set_class",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "class_",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "ping",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "edges",
+					Type: wire.ArgUint,
+					Enum: "resize",
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "popup_done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "resize",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "top", Value: 1},
+				{Name: "bottom", Value: 2},
+				{Name: "left", Value: 4},
+				{Name: "top_left", Value: 5},
+				{Name: "bottom_left", Value: 6},
+				{Name: "right", Value: 8},
+				{Name: "top_right", Value: 9},
+				{Name: "bottom_right", Value: 10},
+			},
+		},
+		{
+			Name:     "transient",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "inactive", Value: 1},
+			},
+		},
+		{
+			Name: "fullscreen_method",
+			Entries: []wire.EntryDesc{
+				{Name: "default", Value: 0},
+				{Name: "scale", Value: 1},
+				{Name: "driver", Value: 2},
+				{Name: "fill", Value: 3},
+			},
+		},
+	},
+}
+
 // ShellSurfaceListener is a type that can respond to incoming
 // messages for a ShellSurface object.
 type ShellSurfaceListener interface {
@@ -3166,6 +6081,61 @@ type ShellSurfaceListener interface {
 	PopupDone()
 }
 
+// ShellSurfaceListenerFuncs implements ShellSurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShellSurfaceListenerFuncs struct {
+	OnPing      func(serial uint32)
+	OnConfigure func(edges ShellSurfaceResize, width int32, height int32)
+	OnPopupDone func()
+}
+
+func (lis *ShellSurfaceListenerFuncs) Ping(serial uint32) {
+	if lis.OnPing != nil {
+		lis.OnPing(serial)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) Configure(edges ShellSurfaceResize, width int32, height int32) {
+	if lis.OnConfigure != nil {
+		lis.OnConfigure(edges, width, height)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) PopupDone() {
+	if lis.OnPopupDone != nil {
+		lis.OnPopupDone()
+	}
+}
+
+// ShellSurfaceEvent is implemented by the message types of all
+// of the events that a ShellSurface can receive.
+type ShellSurfaceEvent interface {
+	shellSurfaceEvent()
+}
+
+// ShellSurfaceEventFunc implements ShellSurfaceListener by calling
+// itself with each incoming message.
+type ShellSurfaceEventFunc func(ShellSurfaceEvent)
+
+func (f ShellSurfaceEventFunc) Ping(serial uint32) {
+	f(ShellSurfacePingEvent{
+		Serial: serial,
+	})
+}
+
+func (f ShellSurfaceEventFunc) Configure(edges ShellSurfaceResize, width int32, height int32) {
+	f(ShellSurfaceConfigureEvent{
+		Edges:  edges,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f ShellSurfaceEventFunc) PopupDone() {
+	f(ShellSurfacePopupDoneEvent{})
+}
+
 // An interface that may be implemented by a wl_surface, for
 // implementations that provide a desktop-style user interface.
 //
@@ -3204,10 +6174,8 @@ func (obj *ShellSurface) State() wire.State {
 func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfacePingEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3215,19 +6183,13 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Ping(
-			serial,
+			m.Serial,
 		)
 		return nil
 
 	case 1:
-
-		edges := ShellSurfaceResize(msg.ReadUint())
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceConfigureEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3235,14 +6197,15 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Configure(
-			edges,
-			width,
-			height,
+			m.Edges,
+			m.Width,
+			m.Height,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m ShellSurfacePopupDoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3253,6 +6216,14 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShellSurfaceInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shell_surface",
 		Type:      "event",
@@ -3297,6 +6268,50 @@ func (obj *ShellSurface) Interface() string {
 	return ShellSurfaceInterface
 }
 
+// OnPing sets the function that is called when the
+// ping event is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnPing(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPing = f
+}
+
+// OnConfigure sets the function that is called when the
+// configure event is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnConfigure(f func(edges ShellSurfaceResize, width int32, height int32)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnConfigure = f
+}
+
+// OnPopupDone sets the function that is called when the
+// popup_done event is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnPopupDone(f func()) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPopupDone = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ShellSurface) Subscribe() *wire.Messages[ShellSurfaceEvent] {
+	q := new(wire.Messages[ShellSurfaceEvent])
+	obj.Listener = ShellSurfaceEventFunc(q.Push)
+	return q
+}
+
 func (obj *ShellSurface) Version() uint32 {
 	return ShellSurfaceVersion
 }
@@ -3304,13 +6319,9 @@ func (obj *ShellSurface) Version() uint32 {
 // A client must respond to a ping event with a pong request or
 // the client may be deemed unresponsive.
 func (obj *ShellSurface) Pong(serial uint32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-
-	builder.Method = "pong"
-	builder.Args = []any{serial}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfacePongRequest{
+		Serial: serial,
+	}.Encode(obj))
 	return
 }
 
@@ -3320,14 +6331,10 @@ func (obj *ShellSurface) Pong(serial uint32) {
 // The server may ignore move requests depending on the state of
 // the surface (e.g. fullscreen or maximized).
 func (obj *ShellSurface) Move(seat *Seat, serial uint32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteObject(seat)
-	builder.WriteUint(serial)
-
-	builder.Method = "move"
-	builder.Args = []any{seat, serial}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceMoveRequest{
+		Seat:   seat,
+		Serial: serial,
+	}.Encode(obj))
 	return
 }
 
@@ -3337,15 +6344,11 @@ func (obj *ShellSurface) Move(seat *Seat, serial uint32) {
 // The server may ignore resize requests depending on the state of
 // the surface (e.g. fullscreen or maximized).
 func (obj *ShellSurface) Resize(seat *Seat, serial uint32, edges ShellSurfaceResize) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteObject(seat)
-	builder.WriteUint(serial)
-	builder.WriteUint(uint32(edges))
-
-	builder.Method = "resize"
-	builder.Args = []any{seat, serial, edges}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceResizeRequest{
+		Seat:   seat,
+		Serial: serial,
+		Edges:  edges,
+	}.Encode(obj))
 	return
 }
 
@@ -3353,11 +6356,7 @@ func (obj *ShellSurface) Resize(seat *Seat, serial uint32, edges ShellSurfaceRes
 //
 // A toplevel surface is not fullscreen, maximized or transient.
 func (obj *ShellSurface) SetToplevel() {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.Method = "set_toplevel"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetToplevelRequest{}.Encode(obj))
 	return
 }
 
@@ -3369,16 +6368,12 @@ func (obj *ShellSurface) SetToplevel() {
 //
 // The flags argument controls details of the transient behaviour.
 func (obj *ShellSurface) SetTransient(parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.WriteObject(parent)
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteUint(uint32(flags))
-
-	builder.Method = "set_transient"
-	builder.Args = []any{parent, x, y, flags}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetTransientRequest{
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	}.Encode(obj))
 	return
 }
 
@@ -3416,15 +6411,11 @@ func (obj *ShellSurface) SetTransient(parent *Surface, x int32, y int32, flags S
 // with the dimensions for the output on which the surface will
 // be made fullscreen.
 func (obj *ShellSurface) SetFullscreen(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output) {
-	builder := wire.NewMessage(obj, 5)
-
-	builder.WriteUint(uint32(method))
-	builder.WriteUint(framerate)
-	builder.WriteObject(output)
-
-	builder.Method = "set_fullscreen"
-	builder.Args = []any{method, framerate, output}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetFullscreenRequest{
+		Method:    method,
+		Framerate: framerate,
+		Output:    output,
+	}.Encode(obj))
 	return
 }
 
@@ -3448,18 +6439,14 @@ func (obj *ShellSurface) SetFullscreen(method ShellSurfaceFullscreenMethod, fram
 // corner of the surface relative to the upper left corner of the
 // parent surface, in surface-local coordinates.
 func (obj *ShellSurface) SetPopup(seat *Seat, serial uint32, parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
-	builder := wire.NewMessage(obj, 6)
-
-	builder.WriteObject(seat)
-	builder.WriteUint(serial)
-	builder.WriteObject(parent)
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteUint(uint32(flags))
-
-	builder.Method = "set_popup"
-	builder.Args = []any{seat, serial, parent, x, y, flags}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetPopupRequest{
+		Seat:   seat,
+		Serial: serial,
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	}.Encode(obj))
 	return
 }
 
@@ -3482,13 +6469,9 @@ func (obj *ShellSurface) SetPopup(seat *Seat, serial uint32, parent *Surface, x
 //
 // The details depend on the compositor implementation.
 func (obj *ShellSurface) SetMaximized(output *Output) {
-	builder := wire.NewMessage(obj, 7)
-
-	builder.WriteObject(output)
-
-	builder.Method = "set_maximized"
-	builder.Args = []any{output}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetMaximizedRequest{
+		Output: output,
+	}.Encode(obj))
 	return
 }
 
@@ -3500,13 +6483,9 @@ func (obj *ShellSurface) SetMaximized(output *Output) {
 //
 // The string must be encoded in UTF-8.
 func (obj *ShellSurface) SetTitle(title string) {
-	builder := wire.NewMessage(obj, 8)
-
-	builder.WriteString(title)
-
-	builder.Method = "set_title"
-	builder.Args = []any{title}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceSetTitleRequest{
+		Title: title,
+	}.Encode(obj))
 	return
 }
 
@@ -3517,144 +6496,730 @@ func (obj *ShellSurface) SetTitle(title string) {
 // file name (or the full path if it is a non-standard location) of
 // the application's .desktop file as the class.
 func (obj *ShellSurface) SetClass(class string) {
-	builder := wire.NewMessage(obj, 9)
+	obj.state.Enqueue(ShellSurfaceSetClassRequest{
+		Class: class,
+	}.Encode(obj))
+	return
+}
 
-	builder.WriteString(class)
+// ShellSurfacePingEvent holds the arguments of the ping event of
+// the wl_shell_surface interface.
+type ShellSurfacePingEvent struct {
+	Serial uint32
+}
 
-	builder.Method = "set_class"
-	builder.Args = []any{class}
-	obj.state.Enqueue(builder)
-	return
+func (ShellSurfacePingEvent) shellSurfaceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfacePingEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// These values are used to indicate which edge of a surface
-// is being dragged in a resize operation. The server may
-// use this information to adapt its behavior, e.g. choose
-// an appropriate cursor image.
-type ShellSurfaceResize int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePingEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
 
-const (
-	// no edge
-	ShellSurfaceResizeNone ShellSurfaceResize = 0
+	builder.Method = "ping"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
 
-	// top edge
-	ShellSurfaceResizeTop ShellSurfaceResize = 1
+// ShellSurfaceConfigureEvent holds the arguments of the configure event of
+// the wl_shell_surface interface.
+type ShellSurfaceConfigureEvent struct {
+	Edges  ShellSurfaceResize
+	Width  int32
+	Height int32
+}
 
-	// bottom edge
-	ShellSurfaceResizeBottom ShellSurfaceResize = 2
+func (ShellSurfaceConfigureEvent) shellSurfaceEvent() {}
 
-	// left edge
-	ShellSurfaceResizeLeft ShellSurfaceResize = 4
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Edges = ShellSurfaceResize(msg.ReadUint())
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// top and left edges
-	ShellSurfaceResizeTopLeft ShellSurfaceResize = 5
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceConfigureEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.Edges))
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
 
-	// bottom and left edges
-	ShellSurfaceResizeBottomLeft ShellSurfaceResize = 6
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Edges, m.Width, m.Height}
+	}
+	return builder
+}
 
-	// right edge
-	ShellSurfaceResizeRight ShellSurfaceResize = 8
+// ShellSurfacePopupDoneEvent holds the arguments of the popup_done event of
+// the wl_shell_surface interface.
+type ShellSurfacePopupDoneEvent struct {
+}
 
-	// top and right edges
-	ShellSurfaceResizeTopRight ShellSurfaceResize = 9
+func (ShellSurfacePopupDoneEvent) shellSurfaceEvent() {}
 
-	// bottom and right edges
-	ShellSurfaceResizeBottomRight ShellSurfaceResize = 10
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfacePopupDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum ShellSurfaceResize) String() string {
-	switch enum {
-	case 0:
-		return "ShellSurfaceResizeNone"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePopupDoneEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
 
-	case 1:
-		return "ShellSurfaceResizeTop"
+	builder.Method = "popup_done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	case 2:
-		return "ShellSurfaceResizeBottom"
+// ShellSurfacePongRequest holds the arguments of the pong request of
+// the wl_shell_surface interface.
+type ShellSurfacePongRequest struct {
+	Serial uint32
+}
 
-	case 4:
-		return "ShellSurfaceResizeLeft"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfacePongRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 5:
-		return "ShellSurfaceResizeTopLeft"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePongRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
 
-	case 6:
-		return "ShellSurfaceResizeBottomLeft"
+	builder.Method = "pong"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
 
-	case 8:
-		return "ShellSurfaceResizeRight"
+// ShellSurfaceMoveRequest holds the arguments of the move request of
+// the wl_shell_surface interface.
+type ShellSurfaceMoveRequest struct {
+	Seat   *Seat
+	Serial uint32
+}
 
-	case 9:
-		return "ShellSurfaceResizeTopRight"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceMoveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 10:
-		return "ShellSurfaceResizeBottomRight"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceMoveRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "move"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial}
+	}
+	return builder
+}
+
+// ShellSurfaceResizeRequest holds the arguments of the resize request of
+// the wl_shell_surface interface.
+type ShellSurfaceResizeRequest struct {
+	Seat   *Seat
+	Serial uint32
+	Edges  ShellSurfaceResize
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceResizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.Edges = ShellSurfaceResize(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceResizeRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(uint32(m.Edges))
+
+	builder.Method = "resize"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.Edges}
 	}
+	return builder
+}
 
-	return "<invalid ShellSurfaceResize>"
+// ShellSurfaceSetToplevelRequest holds the arguments of the set_toplevel request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetToplevelRequest struct {
 }
 
-// These flags specify details of the expected behaviour
-// of transient surfaces. Used in the set_transient request.
-type ShellSurfaceTransient int64
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetToplevelRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-const (
-	// do not set keyboard focus
-	ShellSurfaceTransientInactive ShellSurfaceTransient = 1
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetToplevelRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
 
-func (enum ShellSurfaceTransient) String() string {
-	switch enum {
-	case 1:
-		return "ShellSurfaceTransientInactive"
+	builder.Method = "set_toplevel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
 	}
+	return builder
+}
+
+// ShellSurfaceSetTransientRequest holds the arguments of the set_transient request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetTransientRequest struct {
+	Parent *Surface
+	X      int32
+	Y      int32
+	Flags  ShellSurfaceTransient
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetTransientRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Flags = ShellSurfaceTransient(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetTransientRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteObject(m.Parent)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteUint(uint32(m.Flags))
 
-	return "<invalid ShellSurfaceTransient>"
+	builder.Method = "set_transient"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Parent, m.X, m.Y, m.Flags}
+	}
+	return builder
+}
+
+// ShellSurfaceSetFullscreenRequest holds the arguments of the set_fullscreen request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetFullscreenRequest struct {
+	Method    ShellSurfaceFullscreenMethod
+	Framerate uint32
+	Output    *Output
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetFullscreenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Method = ShellSurfaceFullscreenMethod(msg.ReadUint())
+	m.Framerate = msg.ReadUint()
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Hints to indicate to the compositor how to deal with a conflict
-// between the dimensions of the surface and the dimensions of the
-// output. The compositor is free to ignore this parameter.
-type ShellSurfaceFullscreenMethod int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetFullscreenRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(uint32(m.Method))
+	builder.WriteUint(m.Framerate)
+	builder.WriteNullableObject(m.Output)
+
+	builder.Method = "set_fullscreen"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Method, m.Framerate, m.Output}
+	}
+	return builder
+}
+
+// ShellSurfaceSetPopupRequest holds the arguments of the set_popup request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetPopupRequest struct {
+	Seat   *Seat
+	Serial uint32
+	Parent *Surface
+	X      int32
+	Y      int32
+	Flags  ShellSurfaceTransient
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetPopupRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Flags = ShellSurfaceTransient(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-const (
-	// no preference, apply default policy
-	ShellSurfaceFullscreenMethodDefault ShellSurfaceFullscreenMethod = 0
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetPopupRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Parent)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteUint(uint32(m.Flags))
 
-	// scale, preserve the surface's aspect ratio and center on output
-	ShellSurfaceFullscreenMethodScale ShellSurfaceFullscreenMethod = 1
+	builder.Method = "set_popup"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.Parent, m.X, m.Y, m.Flags}
+	}
+	return builder
+}
 
-	// switch output mode to the smallest mode that can fit the surface, add black borders to compensate size mismatch
-	ShellSurfaceFullscreenMethodDriver ShellSurfaceFullscreenMethod = 2
+// ShellSurfaceSetMaximizedRequest holds the arguments of the set_maximized request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetMaximizedRequest struct {
+	Output *Output
+}
 
-	// no upscaling, center on output and add black borders to compensate size mismatch
-	ShellSurfaceFullscreenMethodFill ShellSurfaceFullscreenMethod = 3
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetMaximizedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum ShellSurfaceFullscreenMethod) String() string {
-	switch enum {
-	case 0:
-		return "ShellSurfaceFullscreenMethodDefault"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetMaximizedRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteNullableObject(m.Output)
 
-	case 1:
-		return "ShellSurfaceFullscreenMethodScale"
+	builder.Method = "set_maximized"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
 
-	case 2:
-		return "ShellSurfaceFullscreenMethodDriver"
+// ShellSurfaceSetTitleRequest holds the arguments of the set_title request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetTitleRequest struct {
+	Title string
+}
 
-	case 3:
-		return "ShellSurfaceFullscreenMethodFill"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetTitleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Title = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetTitleRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteString(m.Title)
+
+	builder.Method = "set_title"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Title}
+	}
+	return builder
+}
+
+// ShellSurfaceSetClassRequest holds the arguments of the set_class request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetClassRequest struct {
+	Class string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ShellSurfaceSetClassRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Class = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetClassRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteString(m.Class)
 
-	return "<invalid ShellSurfaceFullscreenMethod>"
+	builder.Method = "set_class"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Class}
+	}
+	return builder
 }
 
+// ShellSurfaceResize is an alias for [shared.ShellSurfaceResize].
+type ShellSurfaceResize = shared.ShellSurfaceResize
+
 const (
-	SurfaceInterface = "wl_surface"
-	SurfaceVersion   = 6
+	ShellSurfaceResizeNone        = shared.ShellSurfaceResizeNone
+	ShellSurfaceResizeTop         = shared.ShellSurfaceResizeTop
+	ShellSurfaceResizeBottom      = shared.ShellSurfaceResizeBottom
+	ShellSurfaceResizeLeft        = shared.ShellSurfaceResizeLeft
+	ShellSurfaceResizeTopLeft     = shared.ShellSurfaceResizeTopLeft
+	ShellSurfaceResizeBottomLeft  = shared.ShellSurfaceResizeBottomLeft
+	ShellSurfaceResizeRight       = shared.ShellSurfaceResizeRight
+	ShellSurfaceResizeTopRight    = shared.ShellSurfaceResizeTopRight
+	ShellSurfaceResizeBottomRight = shared.ShellSurfaceResizeBottomRight
 )
 
+// ShellSurfaceTransient is an alias for [shared.ShellSurfaceTransient].
+type ShellSurfaceTransient = shared.ShellSurfaceTransient
+
+const (
+	ShellSurfaceTransientInactive = shared.ShellSurfaceTransientInactive
+)
+
+// ShellSurfaceFullscreenMethod is an alias for [shared.ShellSurfaceFullscreenMethod].
+type ShellSurfaceFullscreenMethod = shared.ShellSurfaceFullscreenMethod
+
+const (
+	ShellSurfaceFullscreenMethodDefault = shared.ShellSurfaceFullscreenMethodDefault
+	ShellSurfaceFullscreenMethodScale   = shared.ShellSurfaceFullscreenMethodScale
+	ShellSurfaceFullscreenMethodDriver  = shared.ShellSurfaceFullscreenMethodDriver
+	ShellSurfaceFullscreenMethodFill    = shared.ShellSurfaceFullscreenMethodFill
+)
+
+const (
+	SurfaceInterface = shared.SurfaceInterface
+
+	// SurfaceVersion is the version of the interface that this
+	// package implements.
+	SurfaceVersion = 6
+)
+
+// The versions of the wl_surface interface that its messages were
+// added in.
+const (
+	SurfaceDestroyRequestSince                = 1
+	SurfaceAttachRequestSince                 = 1
+	SurfaceDamageRequestSince                 = 1
+	SurfaceFrameRequestSince                  = 1
+	SurfaceSetOpaqueRegionRequestSince        = 1
+	SurfaceSetInputRegionRequestSince         = 1
+	SurfaceCommitRequestSince                 = 1
+	SurfaceSetBufferTransformRequestSince     = 2
+	SurfaceSetBufferScaleRequestSince         = 3
+	SurfaceDamageBufferRequestSince           = 4
+	SurfaceOffsetRequestSince                 = 5
+	SurfaceEnterEventSince                    = 1
+	SurfaceLeaveEventSince                    = 1
+	SurfacePreferredBufferScaleEventSince     = 6
+	SurfacePreferredBufferTransformEventSince = 6
+)
+
+// SurfaceDesc describes the wl_surface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SurfaceDesc = &wire.InterfaceDesc{
+	Name:    SurfaceInterface,
+	Version: SurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "attach",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "buffer",
+					Type:      wire.ArgObject,
+					Interface: "wl_buffer",
+					AllowNull: true,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "damage",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wl_callback",
+				},
+			},
+		},
+		{
+			Name:  "set_opaque_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "region",
+					Type:      wire.ArgObject,
+					Interface: "wl_region",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_input_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "region",
+					Type:      wire.ArgObject,
+					Interface: "wl_region",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "commit",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_buffer_transform",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "transform",
+					Type: wire.ArgInt,
+					Enum: "wl_output.transform",
+				},
+			},
+		},
+		{
+			Name:  "set_buffer_scale",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "scale",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "damage_buffer",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "offset",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "preferred_buffer_scale",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "factor",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "preferred_buffer_transform",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "transform",
+					Type: wire.ArgUint,
+					Enum: "wl_output.transform",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_scale", Value: 0},
+				{Name: "invalid_transform", Value: 1},
+				{Name: "invalid_size", Value: 2},
+				{Name: "invalid_offset", Value: 3},
+				{Name: "defunct_role_object", Value: 4},
+			},
+		},
+	},
+}
+
 // SurfaceListener is a type that can respond to incoming
 // messages for a Surface object.
 type SurfaceListener interface {
@@ -3688,18 +7253,96 @@ type SurfaceListener interface {
 	// buffer.
 	//
 	// The compositor shall emit a scale value greater than 0.
+	//
+	// Available since version 6.
 	PreferredBufferScale(factor int32)
 
-	// This event indicates the preferred buffer transform for this surface.
-	// It is sent whenever the compositor's preference changes.
-	//
-	// Before receiving this event the preferred buffer transform for this
-	// surface is normal.
-	//
-	// Applying this transformation to the surface buffer contents and using
-	// wl_surface.set_buffer_transform might allow the compositor to use the
-	// surface buffer more efficiently.
-	PreferredBufferTransform(transform OutputTransform)
+	// This event indicates the preferred buffer transform for this surface.
+	// It is sent whenever the compositor's preference changes.
+	//
+	// Before receiving this event the preferred buffer transform for this
+	// surface is normal.
+	//
+	// Applying this transformation to the surface buffer contents and using
+	// wl_surface.set_buffer_transform might allow the compositor to use the
+	// surface buffer more efficiently.
+	//
+	// Available since version 6.
+	PreferredBufferTransform(transform OutputTransform)
+}
+
+// SurfaceListenerFuncs implements SurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SurfaceListenerFuncs struct {
+	OnEnter func(output *Output)
+	OnLeave func(output *Output)
+	// Available since version 6.
+	OnPreferredBufferScale func(factor int32)
+	// Available since version 6.
+	OnPreferredBufferTransform func(transform OutputTransform)
+}
+
+func (lis *SurfaceListenerFuncs) Enter(output *Output) {
+	if lis.OnEnter != nil {
+		lis.OnEnter(output)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) Leave(output *Output) {
+	if lis.OnLeave != nil {
+		lis.OnLeave(output)
+	}
+}
+
+// Available since version 6.
+func (lis *SurfaceListenerFuncs) PreferredBufferScale(factor int32) {
+	if lis.OnPreferredBufferScale != nil {
+		lis.OnPreferredBufferScale(factor)
+	}
+}
+
+// Available since version 6.
+func (lis *SurfaceListenerFuncs) PreferredBufferTransform(transform OutputTransform) {
+	if lis.OnPreferredBufferTransform != nil {
+		lis.OnPreferredBufferTransform(transform)
+	}
+}
+
+// SurfaceEvent is implemented by the message types of all
+// of the events that a Surface can receive.
+type SurfaceEvent interface {
+	surfaceEvent()
+}
+
+// SurfaceEventFunc implements SurfaceListener by calling
+// itself with each incoming message.
+type SurfaceEventFunc func(SurfaceEvent)
+
+func (f SurfaceEventFunc) Enter(output *Output) {
+	f(SurfaceEnterEvent{
+		Output: output,
+	})
+}
+
+func (f SurfaceEventFunc) Leave(output *Output) {
+	f(SurfaceLeaveEvent{
+		Output: output,
+	})
+}
+
+// Available since version 6.
+func (f SurfaceEventFunc) PreferredBufferScale(factor int32) {
+	f(SurfacePreferredBufferScaleEvent{
+		Factor: factor,
+	})
+}
+
+// Available since version 6.
+func (f SurfaceEventFunc) PreferredBufferTransform(transform OutputTransform) {
+	f(SurfacePreferredBufferTransformEvent{
+		Transform: transform,
+	})
 }
 
 // A surface is a rectangular area that may be displayed on zero
@@ -3771,12 +7414,8 @@ func (obj *Surface) State() wire.State {
 func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		output, _ := obj.state.Get(msg.ReadUint()).(*Output)
-
-		obj.state.Add(output)
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceEnterEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3784,17 +7423,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Enter(
-			output,
+			m.Output,
 		)
 		return nil
 
 	case 1:
-
-		output, _ := obj.state.Get(msg.ReadUint()).(*Output)
-
-		obj.state.Add(output)
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceLeaveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3802,15 +7437,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Leave(
-			output,
+			m.Output,
 		)
 		return nil
 
 	case 2:
-
-		factor := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m SurfacePreferredBufferScaleEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3818,15 +7451,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.PreferredBufferScale(
-			factor,
+			m.Factor,
 		)
 		return nil
 
 	case 3:
-
-		transform := OutputTransform(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m SurfacePreferredBufferTransformEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3834,11 +7465,19 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.PreferredBufferTransform(
-			transform,
+			m.Transform,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SurfaceInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_surface",
 		Type:      "event",
@@ -3886,17 +7525,73 @@ func (obj *Surface) Interface() string {
 	return SurfaceInterface
 }
 
+// OnEnter sets the function that is called when the
+// enter event is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnEnter(f func(output *Output)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnEnter = f
+}
+
+// OnLeave sets the function that is called when the
+// leave event is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnLeave(f func(output *Output)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnLeave = f
+}
+
+// OnPreferredBufferScale sets the function that is called when the
+// preferred_buffer_scale event is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 6.
+func (obj *Surface) OnPreferredBufferScale(f func(factor int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPreferredBufferScale = f
+}
+
+// OnPreferredBufferTransform sets the function that is called when the
+// preferred_buffer_transform event is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 6.
+func (obj *Surface) OnPreferredBufferTransform(f func(transform OutputTransform)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPreferredBufferTransform = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Surface) Subscribe() *wire.Messages[SurfaceEvent] {
+	q := new(wire.Messages[SurfaceEvent])
+	obj.Listener = SurfaceEventFunc(q.Push)
+	return q
+}
+
 func (obj *Surface) Version() uint32 {
 	return SurfaceVersion
 }
 
 // Deletes the surface and invalidates its object ID.
 func (obj *Surface) Destroy() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceDestroyRequest{}.Encode(obj))
 	return
 }
 
@@ -3966,15 +7661,11 @@ func (obj *Surface) Destroy() {
 // ensure that they explicitly remove content from surfaces, even after
 // destroying buffers.
 func (obj *Surface) Attach(buffer *Buffer, x int32, y int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteObject(buffer)
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-
-	builder.Method = "attach"
-	builder.Args = []any{buffer, x, y}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceAttachRequest{
+		Buffer: buffer,
+		X:      x,
+		Y:      y,
+	}.Encode(obj))
 	return
 }
 
@@ -4000,16 +7691,12 @@ func (obj *Surface) Attach(buffer *Buffer, x int32, y int32) {
 // posted with wl_surface.damage_buffer which uses buffer coordinates
 // instead of surface coordinates.
 func (obj *Surface) Damage(x int32, y int32, width int32, height int32) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteInt(width)
-	builder.WriteInt(height)
-
-	builder.Method = "damage"
-	builder.Args = []any{x, y, width, height}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceDamageRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
 	return
 }
 
@@ -4046,15 +7733,11 @@ func (obj *Surface) Damage(x int32, y int32, width int32, height int32) {
 // The callback_data passed in the callback is the current time, in
 // milliseconds, with an undefined base.
 func (obj *Surface) Frame() (callback *Callback) {
-	builder := wire.NewMessage(obj, 3)
-
 	callback = NewCallback(obj.state)
 	obj.state.Add(callback)
-	builder.WriteObject(callback)
-
-	builder.Method = "frame"
-	builder.Args = []any{callback}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceFrameRequest{
+		Callback: callback,
+	}.Encode(obj))
 	return callback
 }
 
@@ -4083,13 +7766,9 @@ func (obj *Surface) Frame() (callback *Callback) {
 // destroyed immediately. A NULL wl_region causes the pending opaque
 // region to be set to empty.
 func (obj *Surface) SetOpaqueRegion(region *Region) {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.WriteObject(region)
-
-	builder.Method = "set_opaque_region"
-	builder.Args = []any{region}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceSetOpaqueRegionRequest{
+		Region: region,
+	}.Encode(obj))
 	return
 }
 
@@ -4116,13 +7795,9 @@ func (obj *Surface) SetOpaqueRegion(region *Region) {
 // immediately. A NULL wl_region causes the input region to be set
 // to infinite.
 func (obj *Surface) SetInputRegion(region *Region) {
-	builder := wire.NewMessage(obj, 5)
-
-	builder.WriteObject(region)
-
-	builder.Method = "set_input_region"
-	builder.Args = []any{region}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceSetInputRegionRequest{
+		Region: region,
+	}.Encode(obj))
 	return
 }
 
@@ -4146,11 +7821,7 @@ func (obj *Surface) SetInputRegion(region *Region) {
 //
 // Other interfaces may add further double-buffered surface state.
 func (obj *Surface) Commit() {
-	builder := wire.NewMessage(obj, 6)
-
-	builder.Method = "commit"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SurfaceCommitRequest{}.Encode(obj))
 	return
 }
 
@@ -4185,170 +7856,741 @@ func (obj *Surface) Commit() {
 // If transform is not one of the values from the
 // wl_output.transform enum the invalid_transform protocol error
 // is raised.
+//
+// Available since version 2.
 func (obj *Surface) SetBufferTransform(transform OutputTransform) {
-	builder := wire.NewMessage(obj, 7)
+	obj.state.Enqueue(SurfaceSetBufferTransformRequest{
+		Transform: transform,
+	}.Encode(obj))
+	return
+}
+
+// This request sets an optional scaling factor on how the compositor
+// interprets the contents of the buffer attached to the window.
+//
+// Buffer scale is double-buffered state, see wl_surface.commit.
+//
+// A newly created surface has its buffer scale set to 1.
+//
+// wl_surface.set_buffer_scale changes the pending buffer scale.
+// wl_surface.commit copies the pending buffer scale to the current one.
+// Otherwise, the pending and current values are never changed.
+//
+// The purpose of this request is to allow clients to supply higher
+// resolution buffer data for use on high resolution outputs. It is
+// intended that you pick the same buffer scale as the scale of the
+// output that the surface is displayed on. This means the compositor
+// can avoid scaling when rendering the surface on that output.
+//
+// Note that if the scale is larger than 1, then you have to attach
+// a buffer that is larger (by a factor of scale in each dimension)
+// than the desired surface size.
+//
+// If scale is not greater than 0 the invalid_scale protocol error is
+// raised.
+//
+// Available since version 3.
+func (obj *Surface) SetBufferScale(scale int32) {
+	obj.state.Enqueue(SurfaceSetBufferScaleRequest{
+		Scale: scale,
+	}.Encode(obj))
+	return
+}
+
+// This request is used to describe the regions where the pending
+// buffer is different from the current surface contents, and where
+// the surface therefore needs to be repainted. The compositor
+// ignores the parts of the damage that fall outside of the surface.
+//
+// Damage is double-buffered state, see wl_surface.commit.
+//
+// The damage rectangle is specified in buffer coordinates,
+// where x and y specify the upper left corner of the damage rectangle.
+//
+// The initial value for pending damage is empty: no damage.
+// wl_surface.damage_buffer adds pending damage: the new pending
+// damage is the union of old pending damage and the given rectangle.
+//
+// wl_surface.commit assigns pending damage as the current damage,
+// and clears pending damage. The server will clear the current
+// damage as it repaints the surface.
+//
+// This request differs from wl_surface.damage in only one way - it
+// takes damage in buffer coordinates instead of surface-local
+// coordinates. While this generally is more intuitive than surface
+// coordinates, it is especially desirable when using wp_viewport
+// or when a drawing library (like EGL) is unaware of buffer scale
+// and buffer transform.
+//
+// Note: Because buffer transformation changes and damage requests may
+// be interleaved in the protocol stream, it is impossible to determine
+// the actual mapping between surface and buffer damage until
+// wl_surface.commit time. Therefore, compositors wishing to take both
+// kinds of damage into account will have to accumulate damage from the
+// two requests separately and only transform from one to the other
+// after receiving the wl_surface.commit.
+//
+// Available since version 4.
+func (obj *Surface) DamageBuffer(x int32, y int32, width int32, height int32) {
+	obj.state.Enqueue(SurfaceDamageBufferRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
+	return
+}
+
+// The x and y arguments specify the location of the new pending
+// buffer's upper left corner, relative to the current buffer's upper
+// left corner, in surface-local coordinates. In other words, the
+// x and y, combined with the new surface size define in which
+// directions the surface's size changes.
+//
+// The exact semantics of wl_surface.offset are role-specific. Refer to
+// the documentation of specific roles for more information.
+//
+// Surface location offset is double-buffered state, see
+// wl_surface.commit.
+//
+// This request is semantically equivalent to and the replaces the x and y
+// arguments in the wl_surface.attach request in wl_surface versions prior
+// to 5. See wl_surface.attach for details.
+//
+// Available since version 5.
+func (obj *Surface) Offset(x int32, y int32) {
+	obj.state.Enqueue(SurfaceOffsetRequest{
+		X: x,
+		Y: y,
+	}.Encode(obj))
+	return
+}
+
+// SurfaceEnterEvent holds the arguments of the enter event of
+// the wl_surface interface.
+type SurfaceEnterEvent struct {
+	Output *Output
+}
+
+func (SurfaceEnterEvent) surfaceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceEnterEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Output)
+
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
+
+// SurfaceLeaveEvent holds the arguments of the leave event of
+// the wl_surface interface.
+type SurfaceLeaveEvent struct {
+	Output *Output
+}
+
+func (SurfaceLeaveEvent) surfaceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceLeaveEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Output)
+
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
+
+// SurfacePreferredBufferScaleEvent holds the arguments of the preferred_buffer_scale event of
+// the wl_surface interface.
+//
+// Available since version 6.
+type SurfacePreferredBufferScaleEvent struct {
+	Factor int32
+}
+
+func (SurfacePreferredBufferScaleEvent) surfaceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfacePreferredBufferScaleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Factor = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfacePreferredBufferScaleEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Factor)
+
+	builder.Method = "preferred_buffer_scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Factor}
+	}
+	return builder
+}
+
+// SurfacePreferredBufferTransformEvent holds the arguments of the preferred_buffer_transform event of
+// the wl_surface interface.
+//
+// Available since version 6.
+type SurfacePreferredBufferTransformEvent struct {
+	Transform OutputTransform
+}
+
+func (SurfacePreferredBufferTransformEvent) surfaceEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfacePreferredBufferTransformEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Transform = OutputTransform(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfacePreferredBufferTransformEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(uint32(m.Transform))
+
+	builder.Method = "preferred_buffer_transform"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Transform}
+	}
+	return builder
+}
+
+// SurfaceDestroyRequest holds the arguments of the destroy request of
+// the wl_surface interface.
+type SurfaceDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDestroyRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SurfaceAttachRequest holds the arguments of the attach request of
+// the wl_surface interface.
+type SurfaceAttachRequest struct {
+	Buffer *Buffer
+	X      int32
+	Y      int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceAttachRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Buffer = wire.ResolveObject[*Buffer](msg, state, BufferInterface, msg.ReadNullableObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceAttachRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteNullableObject(m.Buffer)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "attach"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Buffer, m.X, m.Y}
+	}
+	return builder
+}
+
+// SurfaceDamageRequest holds the arguments of the damage request of
+// the wl_surface interface.
+type SurfaceDamageRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceDamageRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDamageRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "damage"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// SurfaceFrameRequest holds the arguments of the frame request of
+// the wl_surface interface.
+type SurfaceFrameRequest struct {
+	Callback *Callback
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceFrameRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Callback = NewCallback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, CallbackInterface, msg.ReadNewObject(CallbackInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceFrameRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.Callback)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CallbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
+
+// SurfaceSetOpaqueRegionRequest holds the arguments of the set_opaque_region request of
+// the wl_surface interface.
+type SurfaceSetOpaqueRegionRequest struct {
+	Region *Region
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceSetOpaqueRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Region = wire.ResolveObject[*Region](msg, state, RegionInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetOpaqueRegionRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteNullableObject(m.Region)
+
+	builder.Method = "set_opaque_region"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Region}
+	}
+	return builder
+}
+
+// SurfaceSetInputRegionRequest holds the arguments of the set_input_region request of
+// the wl_surface interface.
+type SurfaceSetInputRegionRequest struct {
+	Region *Region
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceSetInputRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Region = wire.ResolveObject[*Region](msg, state, RegionInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteInt(int32(transform))
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetInputRegionRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteNullableObject(m.Region)
 
-	builder.Method = "set_buffer_transform"
-	builder.Args = []any{transform}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "set_input_region"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Region}
+	}
+	return builder
 }
 
-// This request sets an optional scaling factor on how the compositor
-// interprets the contents of the buffer attached to the window.
-//
-// Buffer scale is double-buffered state, see wl_surface.commit.
-//
-// A newly created surface has its buffer scale set to 1.
-//
-// wl_surface.set_buffer_scale changes the pending buffer scale.
-// wl_surface.commit copies the pending buffer scale to the current one.
-// Otherwise, the pending and current values are never changed.
-//
-// The purpose of this request is to allow clients to supply higher
-// resolution buffer data for use on high resolution outputs. It is
-// intended that you pick the same buffer scale as the scale of the
-// output that the surface is displayed on. This means the compositor
-// can avoid scaling when rendering the surface on that output.
-//
-// Note that if the scale is larger than 1, then you have to attach
-// a buffer that is larger (by a factor of scale in each dimension)
-// than the desired surface size.
-//
-// If scale is not greater than 0 the invalid_scale protocol error is
-// raised.
-func (obj *Surface) SetBufferScale(scale int32) {
-	builder := wire.NewMessage(obj, 8)
+// SurfaceCommitRequest holds the arguments of the commit request of
+// the wl_surface interface.
+type SurfaceCommitRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceCommitRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteInt(scale)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceCommitRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
 
-	builder.Method = "set_buffer_scale"
-	builder.Args = []any{scale}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "commit"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// This request is used to describe the regions where the pending
-// buffer is different from the current surface contents, and where
-// the surface therefore needs to be repainted. The compositor
-// ignores the parts of the damage that fall outside of the surface.
-//
-// Damage is double-buffered state, see wl_surface.commit.
-//
-// The damage rectangle is specified in buffer coordinates,
-// where x and y specify the upper left corner of the damage rectangle.
+// SurfaceSetBufferTransformRequest holds the arguments of the set_buffer_transform request of
+// the wl_surface interface.
 //
-// The initial value for pending damage is empty: no damage.
-// wl_surface.damage_buffer adds pending damage: the new pending
-// damage is the union of old pending damage and the given rectangle.
-//
-// wl_surface.commit assigns pending damage as the current damage,
-// and clears pending damage. The server will clear the current
-// damage as it repaints the surface.
-//
-// This request differs from wl_surface.damage in only one way - it
-// takes damage in buffer coordinates instead of surface-local
-// coordinates. While this generally is more intuitive than surface
-// coordinates, it is especially desirable when using wp_viewport
-// or when a drawing library (like EGL) is unaware of buffer scale
-// and buffer transform.
-//
-// Note: Because buffer transformation changes and damage requests may
-// be interleaved in the protocol stream, it is impossible to determine
-// the actual mapping between surface and buffer damage until
-// wl_surface.commit time. Therefore, compositors wishing to take both
-// kinds of damage into account will have to accumulate damage from the
-// two requests separately and only transform from one to the other
-// after receiving the wl_surface.commit.
-func (obj *Surface) DamageBuffer(x int32, y int32, width int32, height int32) {
-	builder := wire.NewMessage(obj, 9)
+// Available since version 2.
+type SurfaceSetBufferTransformRequest struct {
+	Transform OutputTransform
+}
 
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteInt(width)
-	builder.WriteInt(height)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceSetBufferTransformRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Transform = OutputTransform(msg.ReadInt())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.Method = "damage_buffer"
-	builder.Args = []any{x, y, width, height}
-	obj.state.Enqueue(builder)
-	return
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetBufferTransformRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteInt(int32(m.Transform))
+
+	builder.Method = "set_buffer_transform"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Transform}
+	}
+	return builder
 }
 
-// The x and y arguments specify the location of the new pending
-// buffer's upper left corner, relative to the current buffer's upper
-// left corner, in surface-local coordinates. In other words, the
-// x and y, combined with the new surface size define in which
-// directions the surface's size changes.
-//
-// The exact semantics of wl_surface.offset are role-specific. Refer to
-// the documentation of specific roles for more information.
-//
-// Surface location offset is double-buffered state, see
-// wl_surface.commit.
+// SurfaceSetBufferScaleRequest holds the arguments of the set_buffer_scale request of
+// the wl_surface interface.
 //
-// This request is semantically equivalent to and the replaces the x and y
-// arguments in the wl_surface.attach request in wl_surface versions prior
-// to 5. See wl_surface.attach for details.
-func (obj *Surface) Offset(x int32, y int32) {
-	builder := wire.NewMessage(obj, 10)
-
-	builder.WriteInt(x)
-	builder.WriteInt(y)
+// Available since version 3.
+type SurfaceSetBufferScaleRequest struct {
+	Scale int32
+}
 
-	builder.Method = "offset"
-	builder.Args = []any{x, y}
-	obj.state.Enqueue(builder)
-	return
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceSetBufferScaleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Scale = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// These errors can be emitted in response to wl_surface requests.
-type SurfaceError int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetBufferScaleRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteInt(m.Scale)
 
-const (
-	// buffer scale value is invalid
-	SurfaceErrorInvalidScale SurfaceError = 0
+	builder.Method = "set_buffer_scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Scale}
+	}
+	return builder
+}
+
+// SurfaceDamageBufferRequest holds the arguments of the damage_buffer request of
+// the wl_surface interface.
+//
+// Available since version 4.
+type SurfaceDamageBufferRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceDamageBufferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// buffer transform value is invalid
-	SurfaceErrorInvalidTransform SurfaceError = 1
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDamageBufferRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
 
-	// buffer size is invalid
-	SurfaceErrorInvalidSize SurfaceError = 2
+	builder.Method = "damage_buffer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
 
-	// buffer offset is invalid
-	SurfaceErrorInvalidOffset SurfaceError = 3
+// SurfaceOffsetRequest holds the arguments of the offset request of
+// the wl_surface interface.
+//
+// Available since version 5.
+type SurfaceOffsetRequest struct {
+	X int32
+	Y int32
+}
 
-	// surface was destroyed before its role object
-	SurfaceErrorDefunctRoleObject SurfaceError = 4
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SurfaceOffsetRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum SurfaceError) String() string {
-	switch enum {
-	case 0:
-		return "SurfaceErrorInvalidScale"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceOffsetRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
 
-	case 1:
-		return "SurfaceErrorInvalidTransform"
+	builder.Method = "offset"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
+	}
+	return builder
+}
 
-	case 2:
-		return "SurfaceErrorInvalidSize"
+// SurfaceError is an alias for [shared.SurfaceError].
+type SurfaceError = shared.SurfaceError
 
-	case 3:
-		return "SurfaceErrorInvalidOffset"
+const (
+	SurfaceErrorInvalidScale      = shared.SurfaceErrorInvalidScale
+	SurfaceErrorInvalidTransform  = shared.SurfaceErrorInvalidTransform
+	SurfaceErrorInvalidSize       = shared.SurfaceErrorInvalidSize
+	SurfaceErrorInvalidOffset     = shared.SurfaceErrorInvalidOffset
+	SurfaceErrorDefunctRoleObject = shared.SurfaceErrorDefunctRoleObject
+)
 
-	case 4:
-		return "SurfaceErrorDefunctRoleObject"
-	}
+const (
+	SeatInterface = shared.SeatInterface
 
-	return "<invalid SurfaceError>"
-}
+	// SeatVersion is the version of the interface that this
+	// package implements.
+	SeatVersion = 10
+)
 
+// The versions of the wl_seat interface that its messages were
+// added in.
 const (
-	SeatInterface = "wl_seat"
-	SeatVersion   = 10
+	SeatGetPointerRequestSince  = 1
+	SeatGetKeyboardRequestSince = 1
+	SeatGetTouchRequestSince    = 1
+	SeatReleaseRequestSince     = 5
+	SeatCapabilitiesEventSince  = 1
+	SeatNameEventSince          = 2
 )
 
+// SeatDesc describes the wl_seat interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SeatDesc = &wire.InterfaceDesc{
+	Name:    SeatInterface,
+	Version: SeatVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "get_pointer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_pointer",
+				},
+			},
+		},
+		{
+			Name:  "get_keyboard",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_keyboard",
+				},
+			},
+		},
+		{
+			Name:  "get_touch",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_touch",
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 5,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "capabilities",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "capabilities",
+					Type: wire.ArgUint,
+					Enum: "capability",
+				},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "capability",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "pointer", Value: 1},
+				{Name: "keyboard", Value: 2},
+				{Name: "touch", Value: 4},
+			},
+		},
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "missing_capability", Value: 0},
+			},
+		},
+	},
+}
+
 // SeatListener is a type that can respond to incoming
 // messages for a Seat object.
 type SeatListener interface {
@@ -4395,9 +8637,56 @@ type SeatListener interface {
 	//
 	// Compositors may re-use the same seat name if the wl_seat global is
 	// destroyed and re-created later.
+	//
+	// Available since version 2.
 	Name(name string)
 }
 
+// SeatListenerFuncs implements SeatListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SeatListenerFuncs struct {
+	OnCapabilities func(capabilities SeatCapability)
+	// Available since version 2.
+	OnName func(name string)
+}
+
+func (lis *SeatListenerFuncs) Capabilities(capabilities SeatCapability) {
+	if lis.OnCapabilities != nil {
+		lis.OnCapabilities(capabilities)
+	}
+}
+
+// Available since version 2.
+func (lis *SeatListenerFuncs) Name(name string) {
+	if lis.OnName != nil {
+		lis.OnName(name)
+	}
+}
+
+// SeatEvent is implemented by the message types of all
+// of the events that a Seat can receive.
+type SeatEvent interface {
+	seatEvent()
+}
+
+// SeatEventFunc implements SeatListener by calling
+// itself with each incoming message.
+type SeatEventFunc func(SeatEvent)
+
+func (f SeatEventFunc) Capabilities(capabilities SeatCapability) {
+	f(SeatCapabilitiesEvent{
+		Capabilities: capabilities,
+	})
+}
+
+// Available since version 2.
+func (f SeatEventFunc) Name(name string) {
+	f(SeatNameEvent{
+		Name: name,
+	})
+}
+
 // A seat is a group of keyboards, pointer and touch devices. This
 // object is published as a global during start up, or when such a
 // device is hot plugged.  A seat typically has a pointer and
@@ -4436,10 +8725,8 @@ func (obj *Seat) State() wire.State {
 func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		capabilities := SeatCapability(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m SeatCapabilitiesEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4447,15 +8734,13 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Capabilities(
-			capabilities,
+			m.Capabilities,
 		)
 		return nil
 
 	case 1:
-
-		name := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m SeatNameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4463,11 +8748,19 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Name(
-			name,
+			m.Name,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SeatInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_seat",
 		Type:      "event",
@@ -4509,6 +8802,40 @@ func (obj *Seat) Interface() string {
 	return SeatInterface
 }
 
+// OnCapabilities sets the function that is called when the
+// capabilities event is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+func (obj *Seat) OnCapabilities(f func(capabilities SeatCapability)) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCapabilities = f
+}
+
+// OnName sets the function that is called when the
+// name event is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *Seat) OnName(f func(name string)) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnName = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Seat) Subscribe() *wire.Messages[SeatEvent] {
+	q := new(wire.Messages[SeatEvent])
+	obj.Listener = SeatEventFunc(q.Push)
+	return q
+}
+
 func (obj *Seat) Version() uint32 {
 	return SeatVersion
 }
@@ -4522,15 +8849,11 @@ func (obj *Seat) Version() uint32 {
 // never had the pointer capability. The missing_capability error will
 // be sent in this case.
 func (obj *Seat) GetPointer() (id *Pointer) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewPointer(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "get_pointer"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SeatGetPointerRequest{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
@@ -4543,15 +8866,11 @@ func (obj *Seat) GetPointer() (id *Pointer) {
 // never had the keyboard capability. The missing_capability error will
 // be sent in this case.
 func (obj *Seat) GetKeyboard() (id *Keyboard) {
-	builder := wire.NewMessage(obj, 1)
-
 	id = NewKeyboard(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "get_keyboard"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SeatGetKeyboardRequest{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
@@ -4564,81 +8883,515 @@ func (obj *Seat) GetKeyboard() (id *Keyboard) {
 // never had the touch capability. The missing_capability error will
 // be sent in this case.
 func (obj *Seat) GetTouch() (id *Touch) {
-	builder := wire.NewMessage(obj, 2)
-
 	id = NewTouch(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "get_touch"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SeatGetTouchRequest{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
 // Using this request a client can tell the server that it is not going to
 // use the seat object anymore.
+//
+// Available since version 5.
 func (obj *Seat) Release() {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SeatReleaseRequest{}.Encode(obj))
 	return
 }
 
-// This is a bitmask of capabilities this seat has; if a member is
-// set, then it is present on the seat.
-type SeatCapability int64
+// SeatCapabilitiesEvent holds the arguments of the capabilities event of
+// the wl_seat interface.
+type SeatCapabilitiesEvent struct {
+	Capabilities SeatCapability
+}
 
-const (
-	// the seat has pointer devices
-	SeatCapabilityPointer SeatCapability = 1
+func (SeatCapabilitiesEvent) seatEvent() {}
 
-	// the seat has one or more keyboards
-	SeatCapabilityKeyboard SeatCapability = 2
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatCapabilitiesEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Capabilities = SeatCapability(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// the seat has touch devices
-	SeatCapabilityTouch SeatCapability = 4
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatCapabilitiesEvent) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Capabilities))
 
-func (enum SeatCapability) String() string {
-	switch enum {
-	case 1:
-		return "SeatCapabilityPointer"
+	builder.Method = "capabilities"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Capabilities}
+	}
+	return builder
+}
 
-	case 2:
-		return "SeatCapabilityKeyboard"
+// SeatNameEvent holds the arguments of the name event of
+// the wl_seat interface.
+//
+// Available since version 2.
+type SeatNameEvent struct {
+	Name string
+}
 
-	case 4:
-		return "SeatCapabilityTouch"
+func (SeatNameEvent) seatEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatNameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatNameEvent) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.Name)
+
+	builder.Method = "name"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
+}
+
+// SeatGetPointerRequest holds the arguments of the get_pointer request of
+// the wl_seat interface.
+type SeatGetPointerRequest struct {
+	Id *Pointer
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatGetPointerRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewPointer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, PointerInterface, msg.ReadNewObject(PointerInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetPointerRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_pointer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: PointerInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatGetKeyboardRequest holds the arguments of the get_keyboard request of
+// the wl_seat interface.
+type SeatGetKeyboardRequest struct {
+	Id *Keyboard
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatGetKeyboardRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewKeyboard(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, KeyboardInterface, msg.ReadNewObject(KeyboardInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetKeyboardRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_keyboard"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: KeyboardInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatGetTouchRequest holds the arguments of the get_touch request of
+// the wl_seat interface.
+type SeatGetTouchRequest struct {
+	Id *Touch
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatGetTouchRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTouch(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TouchInterface, msg.ReadNewObject(TouchInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetTouchRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_touch"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TouchInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatReleaseRequest holds the arguments of the release request of
+// the wl_seat interface.
+//
+// Available since version 5.
+type SeatReleaseRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SeatReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatReleaseRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
 
-	return "<invalid SeatCapability>"
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// These errors can be emitted in response to wl_seat requests.
-type SeatError int64
+// SeatCapability is an alias for [shared.SeatCapability].
+type SeatCapability = shared.SeatCapability
+
+const (
+	SeatCapabilityPointer  = shared.SeatCapabilityPointer
+	SeatCapabilityKeyboard = shared.SeatCapabilityKeyboard
+	SeatCapabilityTouch    = shared.SeatCapabilityTouch
+)
+
+// SeatError is an alias for [shared.SeatError].
+type SeatError = shared.SeatError
+
+const (
+	SeatErrorMissingCapability = shared.SeatErrorMissingCapability
+)
+
+const (
+	PointerInterface = shared.PointerInterface
+
+	// PointerVersion is the version of the interface that this
+	// package implements.
+	PointerVersion = 10
+)
 
+// The versions of the wl_pointer interface that its messages were
+// added in.
 const (
-	// get_pointer, get_keyboard or get_touch called on seat without the matching capability
-	SeatErrorMissingCapability SeatError = 0
+	PointerSetCursorRequestSince           = 1
+	PointerReleaseRequestSince             = 3
+	PointerEnterEventSince                 = 1
+	PointerLeaveEventSince                 = 1
+	PointerMotionEventSince                = 1
+	PointerButtonEventSince                = 1
+	PointerAxisEventSince                  = 1
+	PointerFrameEventSince                 = 5
+	PointerAxisSourceEventSince            = 5
+	PointerAxisStopEventSince              = 5
+	PointerAxisDiscreteEventSince          = 5
+	PointerAxisValue120EventSince          = 8
+	PointerAxisRelativeDirectionEventSince = 9
 )
 
-func (enum SeatError) String() string {
-	switch enum {
-	case 0:
-		return "SeatErrorMissingCapability"
-	}
-
-	return "<invalid SeatError>"
+// PointerDesc describes the wl_pointer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PointerDesc = &wire.InterfaceDesc{
+	Name:    PointerInterface,
+	Version: PointerVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_cursor",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+					AllowNull: true,
+				},
+				{
+					Name: "hotspot_x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "hotspot_y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "surface_x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "surface_y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "surface_x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "surface_y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "button",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "button_state",
+				},
+			},
+		},
+		{
+			Name:  "axis",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "value",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 5,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "axis_source",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis_source",
+					Type: wire.ArgUint,
+					Enum: "axis_source",
+				},
+			},
+		},
+		{
+			Name:  "axis_stop",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+			},
+		},
+		{
+			Name:  "axis_discrete",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "discrete",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "axis_value120",
+			Since: 8,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "value120",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "axis_relative_direction",
+			Since: 9,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "direction",
+					Type: wire.ArgUint,
+					Enum: "axis_relative_direction",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+			},
+		},
+		{
+			Name: "button_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+			},
+		},
+		{
+			Name: "axis",
+			Entries: []wire.EntryDesc{
+				{Name: "vertical_scroll", Value: 0},
+				{Name: "horizontal_scroll", Value: 1},
+			},
+		},
+		{
+			Name: "axis_source",
+			Entries: []wire.EntryDesc{
+				{Name: "wheel", Value: 0},
+				{Name: "finger", Value: 1},
+				{Name: "continuous", Value: 2},
+				{Name: "wheel_tilt", Value: 3},
+			},
+		},
+		{
+			Name: "axis_relative_direction",
+			Entries: []wire.EntryDesc{
+				{Name: "identical", Value: 0},
+				{Name: "inverted", Value: 1},
+			},
+		},
+	},
 }
 
-const (
-	PointerInterface = "wl_pointer"
-	PointerVersion   = 10
-)
-
 // PointerListener is a type that can respond to incoming
 // messages for a Pointer object.
 type PointerListener interface {
@@ -4660,7 +9413,7 @@ type PointerListener interface {
 	// Notification of pointer location change. The arguments
 	// surface_x and surface_y are the location relative to the
 	// focused surface.
-	Motion(time uint32, surfaceX wire.Fixed, surfaceY wire.Fixed)
+	Motion(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed)
 
 	// Mouse button click and release notifications.
 	//
@@ -4676,7 +9429,7 @@ type PointerListener interface {
 	// kernel's event code list. All other button codes above 0xFFFF are
 	// currently undefined but may be used in future versions of this
 	// protocol.
-	Button(serial uint32, time uint32, button uint32, state PointerButtonState)
+	Button(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState)
 
 	// Scroll and other axis notifications.
 	//
@@ -4694,7 +9447,7 @@ type PointerListener interface {
 	//
 	// When applicable, a client can transform its content relative to the
 	// scroll distance.
-	Axis(time uint32, axis PointerAxis, value wire.Fixed)
+	Axis(time time.Duration, axis PointerAxis, value wire.Fixed)
 
 	// Indicates the end of a set of events that logically belong together.
 	// A client is expected to accumulate the data in all events within the
@@ -4730,6 +9483,8 @@ type PointerListener interface {
 	// Compositor-specific policies may require the wl_pointer.leave and
 	// wl_pointer.enter event being split across multiple wl_pointer.frame
 	// groups.
+	//
+	// Available since version 5.
 	Frame()
 
 	// Source information for scroll and other axes.
@@ -4757,6 +9512,8 @@ type PointerListener interface {
 	//
 	// The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 	// not guaranteed.
+	//
+	// Available since version 5.
 	AxisSource(axisSource PointerAxisSource)
 
 	// Stop notification for scroll and other axes.
@@ -4773,7 +9530,9 @@ type PointerListener interface {
 	// The timestamp is to be interpreted identical to the timestamp in the
 	// wl_pointer.axis event. The timestamp value may be the same as a
 	// preceding wl_pointer.axis event.
-	AxisStop(time uint32, axis PointerAxis)
+	//
+	// Available since version 5.
+	AxisStop(time time.Duration, axis PointerAxis)
 
 	// Discrete step information for scroll and other axes.
 	//
@@ -4805,6 +9564,10 @@ type PointerListener interface {
 	//
 	// The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 	// not guaranteed.
+	//
+	// Available since version 5.
+	//
+	// Deprecated: Deprecated since version 8.
 	AxisDiscrete(axis PointerAxis, discrete int32)
 
 	// Discrete high-resolution scroll information.
@@ -4828,6 +9591,8 @@ type PointerListener interface {
 	//
 	// The order of wl_pointer.axis_value120 and wl_pointer.axis_source is
 	// not guaranteed.
+	//
+	// Available since version 8.
 	AxisValue120(axis PointerAxis, value120 int32)
 
 	// Relative directional information of the entity causing the axis
@@ -4865,9 +9630,207 @@ type PointerListener interface {
 	// The order of wl_pointer.axis_relative_direction,
 	// wl_pointer.axis_discrete and wl_pointer.axis_source is not
 	// guaranteed.
+	//
+	// Available since version 9.
 	AxisRelativeDirection(axis PointerAxis, direction PointerAxisRelativeDirection)
 }
 
+// PointerListenerFuncs implements PointerListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PointerListenerFuncs struct {
+	OnEnter  func(serial uint32, surface *Surface, surfaceX wire.Fixed, surfaceY wire.Fixed)
+	OnLeave  func(serial uint32, surface *Surface)
+	OnMotion func(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed)
+	OnButton func(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState)
+	OnAxis   func(time time.Duration, axis PointerAxis, value wire.Fixed)
+	// Available since version 5.
+	OnFrame func()
+	// Available since version 5.
+	OnAxisSource func(axisSource PointerAxisSource)
+	// Available since version 5.
+	OnAxisStop func(time time.Duration, axis PointerAxis)
+	// Available since version 5.
+	//
+	// Deprecated: Deprecated since version 8.
+	OnAxisDiscrete func(axis PointerAxis, discrete int32)
+	// Available since version 8.
+	OnAxisValue120 func(axis PointerAxis, value120 int32)
+	// Available since version 9.
+	OnAxisRelativeDirection func(axis PointerAxis, direction PointerAxisRelativeDirection)
+}
+
+func (lis *PointerListenerFuncs) Enter(serial uint32, surface *Surface, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	if lis.OnEnter != nil {
+		lis.OnEnter(serial, surface, surfaceX, surfaceY)
+	}
+}
+
+func (lis *PointerListenerFuncs) Leave(serial uint32, surface *Surface) {
+	if lis.OnLeave != nil {
+		lis.OnLeave(serial, surface)
+	}
+}
+
+func (lis *PointerListenerFuncs) Motion(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	if lis.OnMotion != nil {
+		lis.OnMotion(time, surfaceX, surfaceY)
+	}
+}
+
+func (lis *PointerListenerFuncs) Button(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState) {
+	if lis.OnButton != nil {
+		lis.OnButton(serial, time, button, state)
+	}
+}
+
+func (lis *PointerListenerFuncs) Axis(time time.Duration, axis PointerAxis, value wire.Fixed) {
+	if lis.OnAxis != nil {
+		lis.OnAxis(time, axis, value)
+	}
+}
+
+// Available since version 5.
+func (lis *PointerListenerFuncs) Frame() {
+	if lis.OnFrame != nil {
+		lis.OnFrame()
+	}
+}
+
+// Available since version 5.
+func (lis *PointerListenerFuncs) AxisSource(axisSource PointerAxisSource) {
+	if lis.OnAxisSource != nil {
+		lis.OnAxisSource(axisSource)
+	}
+}
+
+// Available since version 5.
+func (lis *PointerListenerFuncs) AxisStop(time time.Duration, axis PointerAxis) {
+	if lis.OnAxisStop != nil {
+		lis.OnAxisStop(time, axis)
+	}
+}
+
+// Available since version 5.
+//
+// Deprecated: Deprecated since version 8.
+func (lis *PointerListenerFuncs) AxisDiscrete(axis PointerAxis, discrete int32) {
+	if lis.OnAxisDiscrete != nil {
+		lis.OnAxisDiscrete(axis, discrete)
+	}
+}
+
+// Available since version 8.
+func (lis *PointerListenerFuncs) AxisValue120(axis PointerAxis, value120 int32) {
+	if lis.OnAxisValue120 != nil {
+		lis.OnAxisValue120(axis, value120)
+	}
+}
+
+// Available since version 9.
+func (lis *PointerListenerFuncs) AxisRelativeDirection(axis PointerAxis, direction PointerAxisRelativeDirection) {
+	if lis.OnAxisRelativeDirection != nil {
+		lis.OnAxisRelativeDirection(axis, direction)
+	}
+}
+
+// PointerEvent is implemented by the message types of all
+// of the events that a Pointer can receive.
+type PointerEvent interface {
+	pointerEvent()
+}
+
+// PointerEventFunc implements PointerListener by calling
+// itself with each incoming message.
+type PointerEventFunc func(PointerEvent)
+
+func (f PointerEventFunc) Enter(serial uint32, surface *Surface, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	f(PointerEnterEvent{
+		Serial:   serial,
+		Surface:  surface,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	})
+}
+
+func (f PointerEventFunc) Leave(serial uint32, surface *Surface) {
+	f(PointerLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	})
+}
+
+func (f PointerEventFunc) Motion(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	f(PointerMotionEvent{
+		Time:     time,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	})
+}
+
+func (f PointerEventFunc) Button(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState) {
+	f(PointerButtonEvent{
+		Serial: serial,
+		Time:   time,
+		Button: button,
+		State:  state,
+	})
+}
+
+func (f PointerEventFunc) Axis(time time.Duration, axis PointerAxis, value wire.Fixed) {
+	f(PointerAxisEvent{
+		Time:  time,
+		Axis:  axis,
+		Value: value,
+	})
+}
+
+// Available since version 5.
+func (f PointerEventFunc) Frame() {
+	f(PointerFrameEvent{})
+}
+
+// Available since version 5.
+func (f PointerEventFunc) AxisSource(axisSource PointerAxisSource) {
+	f(PointerAxisSourceEvent{
+		AxisSource: axisSource,
+	})
+}
+
+// Available since version 5.
+func (f PointerEventFunc) AxisStop(time time.Duration, axis PointerAxis) {
+	f(PointerAxisStopEvent{
+		Time: time,
+		Axis: axis,
+	})
+}
+
+// Available since version 5.
+//
+// Deprecated: Deprecated since version 8.
+func (f PointerEventFunc) AxisDiscrete(axis PointerAxis, discrete int32) {
+	f(PointerAxisDiscreteEvent{
+		Axis:     axis,
+		Discrete: discrete,
+	})
+}
+
+// Available since version 8.
+func (f PointerEventFunc) AxisValue120(axis PointerAxis, value120 int32) {
+	f(PointerAxisValue120Event{
+		Axis:     axis,
+		Value120: value120,
+	})
+}
+
+// Available since version 9.
+func (f PointerEventFunc) AxisRelativeDirection(axis PointerAxis, direction PointerAxisRelativeDirection) {
+	f(PointerAxisRelativeDirectionEvent{
+		Axis:      axis,
+		Direction: direction,
+	})
+}
+
 // The wl_pointer interface represents one or more input devices,
 // such as mice, which control the pointer location and pointer_focus
 // of a seat.
@@ -4903,18 +9866,8 @@ func (obj *Pointer) State() wire.State {
 func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		surfaceX := msg.ReadFixed()
-
-		surfaceY := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m PointerEnterEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4922,22 +9875,16 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Enter(
-			serial,
-			surface,
-			surfaceX,
-			surfaceY,
+			m.Serial,
+			m.Surface,
+			m.SurfaceX,
+			m.SurfaceY,
 		)
 		return nil
 
 	case 1:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		if err := msg.Err(); err != nil {
+		var m PointerLeaveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4945,20 +9892,14 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Leave(
-			serial,
-			surface,
+			m.Serial,
+			m.Surface,
 		)
 		return nil
 
 	case 2:
-
-		time := msg.ReadUint()
-
-		surfaceX := msg.ReadFixed()
-
-		surfaceY := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m PointerMotionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4966,23 +9907,15 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Motion(
-			time,
-			surfaceX,
-			surfaceY,
+			m.Time,
+			m.SurfaceX,
+			m.SurfaceY,
 		)
 		return nil
 
 	case 3:
-
-		serial := msg.ReadUint()
-
-		time := msg.ReadUint()
-
-		button := msg.ReadUint()
-
-		state := PointerButtonState(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m PointerButtonEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4990,22 +9923,16 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Button(
-			serial,
-			time,
-			button,
-			state,
+			m.Serial,
+			m.Time,
+			m.Button,
+			m.State,
 		)
 		return nil
 
 	case 4:
-
-		time := msg.ReadUint()
-
-		axis := PointerAxis(msg.ReadUint())
-
-		value := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5013,14 +9940,15 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Axis(
-			time,
-			axis,
-			value,
+			m.Time,
+			m.Axis,
+			m.Value,
 		)
 		return nil
 
 	case 5:
-		if err := msg.Err(); err != nil {
+		var m PointerFrameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5031,10 +9959,8 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 6:
-
-		axisSource := PointerAxisSource(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisSourceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5042,17 +9968,13 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.AxisSource(
-			axisSource,
+			m.AxisSource,
 		)
 		return nil
 
 	case 7:
-
-		time := msg.ReadUint()
-
-		axis := PointerAxis(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisStopEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5060,18 +9982,14 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.AxisStop(
-			time,
-			axis,
+			m.Time,
+			m.Axis,
 		)
 		return nil
 
 	case 8:
-
-		axis := PointerAxis(msg.ReadUint())
-
-		discrete := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisDiscreteEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5079,18 +9997,14 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.AxisDiscrete(
-			axis,
-			discrete,
+			m.Axis,
+			m.Discrete,
 		)
 		return nil
 
 	case 9:
-
-		axis := PointerAxis(msg.ReadUint())
-
-		value120 := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisValue120Event
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5098,18 +10012,14 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.AxisValue120(
-			axis,
-			value120,
+			m.Axis,
+			m.Value120,
 		)
 		return nil
 
 	case 10:
-
-		axis := PointerAxis(msg.ReadUint())
-
-		direction := PointerAxisRelativeDirection(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m PointerAxisRelativeDirectionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5117,12 +10027,20 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.AxisRelativeDirection(
-			axis,
-			direction,
+			m.Axis,
+			m.Direction,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PointerInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_pointer",
 		Type:      "event",
@@ -5191,6 +10109,160 @@ func (obj *Pointer) Interface() string {
 	return PointerInterface
 }
 
+// OnEnter sets the function that is called when the
+// enter event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnEnter(f func(serial uint32, surface *Surface, surfaceX wire.Fixed, surfaceY wire.Fixed)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnEnter = f
+}
+
+// OnLeave sets the function that is called when the
+// leave event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnLeave(f func(serial uint32, surface *Surface)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnLeave = f
+}
+
+// OnMotion sets the function that is called when the
+// motion event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnMotion(f func(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMotion = f
+}
+
+// OnButton sets the function that is called when the
+// button event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnButton(f func(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnButton = f
+}
+
+// OnAxis sets the function that is called when the
+// axis event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnAxis(f func(time time.Duration, axis PointerAxis, value wire.Fixed)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxis = f
+}
+
+// OnFrame sets the function that is called when the
+// frame event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+func (obj *Pointer) OnFrame(f func()) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// OnAxisSource sets the function that is called when the
+// axis_source event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+func (obj *Pointer) OnAxisSource(f func(axisSource PointerAxisSource)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxisSource = f
+}
+
+// OnAxisStop sets the function that is called when the
+// axis_stop event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+func (obj *Pointer) OnAxisStop(f func(time time.Duration, axis PointerAxis)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxisStop = f
+}
+
+// OnAxisDiscrete sets the function that is called when the
+// axis_discrete event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+//
+// Deprecated: Deprecated since version 8.
+func (obj *Pointer) OnAxisDiscrete(f func(axis PointerAxis, discrete int32)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxisDiscrete = f
+}
+
+// OnAxisValue120 sets the function that is called when the
+// axis_value120 event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 8.
+func (obj *Pointer) OnAxisValue120(f func(axis PointerAxis, value120 int32)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxisValue120 = f
+}
+
+// OnAxisRelativeDirection sets the function that is called when the
+// axis_relative_direction event is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 9.
+func (obj *Pointer) OnAxisRelativeDirection(f func(axis PointerAxis, direction PointerAxisRelativeDirection)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAxisRelativeDirection = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Pointer) Subscribe() *wire.Messages[PointerEvent] {
+	q := new(wire.Messages[PointerEvent])
+	obj.Listener = PointerEventFunc(q.Push)
+	return q
+}
+
 func (obj *Pointer) Version() uint32 {
 	return PointerVersion
 }
@@ -5229,16 +10301,12 @@ func (obj *Pointer) Version() uint32 {
 // serial number sent to the client. Otherwise the request will be
 // ignored.
 func (obj *Pointer) SetCursor(serial uint32, surface *Surface, hotspotX int32, hotspotY int32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-	builder.WriteInt(hotspotX)
-	builder.WriteInt(hotspotY)
-
-	builder.Method = "set_cursor"
-	builder.Args = []any{serial, surface, hotspotX, hotspotY}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerSetCursorRequest{
+		Serial:   serial,
+		Surface:  surface,
+		HotspotX: hotspotX,
+		HotspotY: hotspotY,
+	}.Encode(obj))
 	return
 }
 
@@ -5247,157 +10315,713 @@ func (obj *Pointer) SetCursor(serial uint32, surface *Surface, hotspotX int32, h
 //
 // This request destroys the pointer proxy object, so clients must not call
 // wl_pointer_destroy() after using this request.
+//
+// Available since version 3.
 func (obj *Pointer) Release() {
+	obj.state.Enqueue(PointerReleaseRequest{}.Encode(obj))
+	return
+}
+
+// PointerEnterEvent holds the arguments of the enter event of
+// the wl_pointer interface.
+type PointerEnterEvent struct {
+	Serial   uint32
+	Surface  *Surface
+	SurfaceX wire.Fixed
+	SurfaceY wire.Fixed
+}
+
+func (PointerEnterEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.SurfaceX = msg.ReadFixed()
+	m.SurfaceY = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerEnterEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteFixed(m.SurfaceX)
+	builder.WriteFixed(m.SurfaceY)
+
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.SurfaceX, m.SurfaceY}
+	}
+	return builder
+}
+
+// PointerLeaveEvent holds the arguments of the leave event of
+// the wl_pointer interface.
+type PointerLeaveEvent struct {
+	Serial  uint32
+	Surface *Surface
+}
+
+func (PointerLeaveEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerLeaveEvent) Encode(obj *Pointer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
 
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface}
+	}
+	return builder
+}
+
+// PointerMotionEvent holds the arguments of the motion event of
+// the wl_pointer interface.
+type PointerMotionEvent struct {
+	Time     time.Duration
+	SurfaceX wire.Fixed
+	SurfaceY wire.Fixed
+}
+
+func (PointerMotionEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.SurfaceX = msg.ReadFixed()
+	m.SurfaceY = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerMotionEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteFixed(m.SurfaceX)
+	builder.WriteFixed(m.SurfaceY)
+
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.SurfaceX, m.SurfaceY}
+	}
+	return builder
+}
+
+// PointerButtonEvent holds the arguments of the button event of
+// the wl_pointer interface.
+type PointerButtonEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Button pointer.Button
+	State  PointerButtonState
+}
+
+func (PointerButtonEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerButtonEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Button = (pointer.Button)(msg.ReadUint())
+	m.State = PointerButtonState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerButtonEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Button))
+	builder.WriteUint(uint32(m.State))
+
+	builder.Method = "button"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), uint32(m.Button), m.State}
+	}
+	return builder
+}
+
+// PointerAxisEvent holds the arguments of the axis event of
+// the wl_pointer interface.
+type PointerAxisEvent struct {
+	Time  time.Duration
+	Axis  PointerAxis
+	Value wire.Fixed
+}
+
+func (PointerAxisEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Value = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteFixed(m.Value)
+
+	builder.Method = "axis"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Axis, m.Value}
+	}
+	return builder
+}
+
+// PointerFrameEvent holds the arguments of the frame event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerFrameEvent struct {
+}
+
+func (PointerFrameEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerFrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerFrameEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PointerAxisSourceEvent holds the arguments of the axis_source event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerAxisSourceEvent struct {
+	AxisSource PointerAxisSource
+}
+
+func (PointerAxisSourceEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisSourceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.AxisSource = PointerAxisSource(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisSourceEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteUint(uint32(m.AxisSource))
+
+	builder.Method = "axis_source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.AxisSource}
+	}
+	return builder
+}
+
+// PointerAxisStopEvent holds the arguments of the axis_stop event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerAxisStopEvent struct {
+	Time time.Duration
+	Axis PointerAxis
+}
+
+func (PointerAxisStopEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisStopEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Axis = PointerAxis(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisStopEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Axis))
+
+	builder.Method = "axis_stop"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Axis}
+	}
+	return builder
+}
+
+// PointerAxisDiscreteEvent holds the arguments of the axis_discrete event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+//
+// Deprecated: Deprecated since version 8.
+type PointerAxisDiscreteEvent struct {
+	Axis     PointerAxis
+	Discrete int32
+}
+
+func (PointerAxisDiscreteEvent) pointerEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisDiscreteEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Discrete = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisDiscreteEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteInt(m.Discrete)
+
+	builder.Method = "axis_discrete"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Discrete}
+	}
+	return builder
 }
 
-type PointerError int64
+// PointerAxisValue120Event holds the arguments of the axis_value120 event of
+// the wl_pointer interface.
+//
+// Available since version 8.
+type PointerAxisValue120Event struct {
+	Axis     PointerAxis
+	Value120 int32
+}
 
-const (
-	// given wl_surface has another role
-	PointerErrorRole PointerError = 0
-)
+func (PointerAxisValue120Event) pointerEvent() {}
 
-func (enum PointerError) String() string {
-	switch enum {
-	case 0:
-		return "PointerErrorRole"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisValue120Event) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Value120 = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
 	}
-
-	return "<invalid PointerError>"
+	return nil
 }
 
-// Describes the physical state of a button that produced the button
-// event.
-type PointerButtonState int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisValue120Event) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteInt(m.Value120)
 
-const (
-	// the button is not pressed
-	PointerButtonStateReleased PointerButtonState = 0
+	builder.Method = "axis_value120"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Value120}
+	}
+	return builder
+}
 
-	// the button is pressed
-	PointerButtonStatePressed PointerButtonState = 1
-)
+// PointerAxisRelativeDirectionEvent holds the arguments of the axis_relative_direction event of
+// the wl_pointer interface.
+//
+// Available since version 9.
+type PointerAxisRelativeDirectionEvent struct {
+	Axis      PointerAxis
+	Direction PointerAxisRelativeDirection
+}
 
-func (enum PointerButtonState) String() string {
-	switch enum {
-	case 0:
-		return "PointerButtonStateReleased"
+func (PointerAxisRelativeDirectionEvent) pointerEvent() {}
 
-	case 1:
-		return "PointerButtonStatePressed"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerAxisRelativeDirectionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Direction = PointerAxisRelativeDirection(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
 	}
-
-	return "<invalid PointerButtonState>"
+	return nil
 }
 
-// Describes the axis types of scroll events.
-type PointerAxis int64
-
-const (
-	// vertical axis
-	PointerAxisVerticalScroll PointerAxis = 0
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisRelativeDirectionEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteUint(uint32(m.Direction))
 
-	// horizontal axis
-	PointerAxisHorizontalScroll PointerAxis = 1
-)
+	builder.Method = "axis_relative_direction"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Direction}
+	}
+	return builder
+}
+
+// PointerSetCursorRequest holds the arguments of the set_cursor request of
+// the wl_pointer interface.
+type PointerSetCursorRequest struct {
+	Serial   uint32
+	Surface  *Surface
+	HotspotX int32
+	HotspotY int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerSetCursorRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadNullableObject())
+	m.HotspotX = msg.ReadInt()
+	m.HotspotY = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum PointerAxis) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisVerticalScroll"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerSetCursorRequest) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteNullableObject(m.Surface)
+	builder.WriteInt(m.HotspotX)
+	builder.WriteInt(m.HotspotY)
 
-	case 1:
-		return "PointerAxisHorizontalScroll"
+	builder.Method = "set_cursor"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.HotspotX, m.HotspotY}
 	}
-
-	return "<invalid PointerAxis>"
+	return builder
 }
 
-// Describes the source types for axis events. This indicates to the
-// client how an axis event was physically generated; a client may
-// adjust the user interface accordingly. For example, scroll events
-// from a "finger" source may be in a smooth coordinate space with
-// kinetic scrolling whereas a "wheel" source may be in discrete steps
-// of a number of lines.
+// PointerReleaseRequest holds the arguments of the release request of
+// the wl_pointer interface.
 //
-// The "continuous" axis source is a device generating events in a
-// continuous coordinate space, but using something other than a
-// finger. One example for this source is button-based scrolling where
-// the vertical motion of a device is converted to scroll events while
-// a button is held down.
-//
-// The "wheel tilt" axis source indicates that the actual device is a
-// wheel but the scroll event is not caused by a rotation but a
-// (usually sideways) tilt of the wheel.
-type PointerAxisSource int64
+// Available since version 3.
+type PointerReleaseRequest struct {
+}
 
-const (
-	// a physical wheel rotation
-	PointerAxisSourceWheel PointerAxisSource = 0
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PointerReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// finger on a touch surface
-	PointerAxisSourceFinger PointerAxisSource = 1
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerReleaseRequest) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
 
-	// continuous coordinate space
-	PointerAxisSourceContinuous PointerAxisSource = 2
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// a physical wheel tilt
-	PointerAxisSourceWheelTilt PointerAxisSource = 3
-)
+// PointerError is an alias for [shared.PointerError].
+type PointerError = shared.PointerError
 
-func (enum PointerAxisSource) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisSourceWheel"
+const (
+	PointerErrorRole = shared.PointerErrorRole
+)
 
-	case 1:
-		return "PointerAxisSourceFinger"
+// PointerButtonState is an alias for [shared.PointerButtonState].
+type PointerButtonState = shared.PointerButtonState
 
-	case 2:
-		return "PointerAxisSourceContinuous"
+const (
+	PointerButtonStateReleased = shared.PointerButtonStateReleased
+	PointerButtonStatePressed  = shared.PointerButtonStatePressed
+)
 
-	case 3:
-		return "PointerAxisSourceWheelTilt"
-	}
+// PointerAxis is an alias for [shared.PointerAxis].
+type PointerAxis = shared.PointerAxis
 
-	return "<invalid PointerAxisSource>"
-}
+const (
+	PointerAxisVerticalScroll   = shared.PointerAxisVerticalScroll
+	PointerAxisHorizontalScroll = shared.PointerAxisHorizontalScroll
+)
 
-// This specifies the direction of the physical motion that caused a
-// wl_pointer.axis event, relative to the wl_pointer.axis direction.
-type PointerAxisRelativeDirection int64
+// PointerAxisSource is an alias for [shared.PointerAxisSource].
+type PointerAxisSource = shared.PointerAxisSource
 
 const (
-	// physical motion matches axis direction
-	PointerAxisRelativeDirectionIdentical PointerAxisRelativeDirection = 0
-
-	// physical motion is the inverse of the axis direction
-	PointerAxisRelativeDirectionInverted PointerAxisRelativeDirection = 1
+	PointerAxisSourceWheel      = shared.PointerAxisSourceWheel
+	PointerAxisSourceFinger     = shared.PointerAxisSourceFinger
+	PointerAxisSourceContinuous = shared.PointerAxisSourceContinuous
+	// Available since version 6.
+	PointerAxisSourceWheelTilt = shared.PointerAxisSourceWheelTilt
 )
 
-func (enum PointerAxisRelativeDirection) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisRelativeDirectionIdentical"
+// PointerAxisRelativeDirection is an alias for [shared.PointerAxisRelativeDirection].
+type PointerAxisRelativeDirection = shared.PointerAxisRelativeDirection
 
-	case 1:
-		return "PointerAxisRelativeDirectionInverted"
-	}
+const (
+	PointerAxisRelativeDirectionIdentical = shared.PointerAxisRelativeDirectionIdentical
+	PointerAxisRelativeDirectionInverted  = shared.PointerAxisRelativeDirectionInverted
+)
 
-	return "<invalid PointerAxisRelativeDirection>"
-}
+const (
+	KeyboardInterface = shared.KeyboardInterface
+
+	// KeyboardVersion is the version of the interface that this
+	// package implements.
+	KeyboardVersion = 10
+)
 
+// The versions of the wl_keyboard interface that its messages were
+// added in.
 const (
-	KeyboardInterface = "wl_keyboard"
-	KeyboardVersion   = 10
+	KeyboardReleaseRequestSince  = 3
+	KeyboardKeymapEventSince     = 1
+	KeyboardEnterEventSince      = 1
+	KeyboardLeaveEventSince      = 1
+	KeyboardKeyEventSince        = 1
+	KeyboardModifiersEventSince  = 1
+	KeyboardRepeatInfoEventSince = 4
 )
 
+// KeyboardDesc describes the wl_keyboard interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var KeyboardDesc = &wire.InterfaceDesc{
+	Name:    KeyboardInterface,
+	Version: KeyboardVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "keymap",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "keymap_format",
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "size",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "keys",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "key",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "key",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "key_state",
+				},
+			},
+		},
+		{
+			Name:  "modifiers",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_depressed",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_latched",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_locked",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "group",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "repeat_info",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "rate",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "delay",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "keymap_format",
+			Entries: []wire.EntryDesc{
+				{Name: "no_keymap", Value: 0},
+				{Name: "xkb_v1", Value: 1},
+			},
+		},
+		{
+			Name: "key_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+				{Name: "repeated", Value: 2},
+			},
+		},
+	},
+}
+
 // KeyboardListener is a type that can respond to incoming
 // messages for a Keyboard object.
 type KeyboardListener interface {
@@ -5422,7 +11046,7 @@ type KeyboardListener interface {
 	//
 	// Clients should not use the list of pressed keys to emulate key-press
 	// events. The order of keys in the list is unspecified.
-	Enter(serial uint32, surface *Surface, keys []byte)
+	Enter(serial uint32, surface *Surface, keys []uint32)
 
 	// Notification that this seat's keyboard focus is no longer on
 	// a certain surface.
@@ -5459,7 +11083,7 @@ type KeyboardListener interface {
 	// key state when a wl_keyboard.repeat_info event with a rate argument of
 	// 0 has been received. This allows the compositor to take over the
 	// responsibility of key repetition.
-	Key(serial uint32, time uint32, key uint32, state KeyboardKeyState)
+	Key(serial uint32, time time.Duration, key uint32, state KeyboardKeyState)
 
 	// Notifies clients that the modifier and/or group state has
 	// changed, and it should update its local state.
@@ -5488,9 +11112,121 @@ type KeyboardListener interface {
 	// This event can be sent later on as well with a new value if necessary,
 	// so clients should continue listening for the event past the creation
 	// of wl_keyboard.
+	//
+	// Available since version 4.
 	RepeatInfo(rate int32, delay int32)
 }
 
+// KeyboardListenerFuncs implements KeyboardListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type KeyboardListenerFuncs struct {
+	OnKeymap    func(format KeyboardKeymapFormat, fd *os.File, size uint32)
+	OnEnter     func(serial uint32, surface *Surface, keys []uint32)
+	OnLeave     func(serial uint32, surface *Surface)
+	OnKey       func(serial uint32, time time.Duration, key uint32, state KeyboardKeyState)
+	OnModifiers func(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32)
+	// Available since version 4.
+	OnRepeatInfo func(rate int32, delay int32)
+}
+
+func (lis *KeyboardListenerFuncs) Keymap(format KeyboardKeymapFormat, fd *os.File, size uint32) {
+	if lis.OnKeymap != nil {
+		lis.OnKeymap(format, fd, size)
+	}
+}
+
+func (lis *KeyboardListenerFuncs) Enter(serial uint32, surface *Surface, keys []uint32) {
+	if lis.OnEnter != nil {
+		lis.OnEnter(serial, surface, keys)
+	}
+}
+
+func (lis *KeyboardListenerFuncs) Leave(serial uint32, surface *Surface) {
+	if lis.OnLeave != nil {
+		lis.OnLeave(serial, surface)
+	}
+}
+
+func (lis *KeyboardListenerFuncs) Key(serial uint32, time time.Duration, key uint32, state KeyboardKeyState) {
+	if lis.OnKey != nil {
+		lis.OnKey(serial, time, key, state)
+	}
+}
+
+func (lis *KeyboardListenerFuncs) Modifiers(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32) {
+	if lis.OnModifiers != nil {
+		lis.OnModifiers(serial, modsDepressed, modsLatched, modsLocked, group)
+	}
+}
+
+// Available since version 4.
+func (lis *KeyboardListenerFuncs) RepeatInfo(rate int32, delay int32) {
+	if lis.OnRepeatInfo != nil {
+		lis.OnRepeatInfo(rate, delay)
+	}
+}
+
+// KeyboardEvent is implemented by the message types of all
+// of the events that a Keyboard can receive.
+type KeyboardEvent interface {
+	keyboardEvent()
+}
+
+// KeyboardEventFunc implements KeyboardListener by calling
+// itself with each incoming message.
+type KeyboardEventFunc func(KeyboardEvent)
+
+func (f KeyboardEventFunc) Keymap(format KeyboardKeymapFormat, fd *os.File, size uint32) {
+	f(KeyboardKeymapEvent{
+		Format: format,
+		Fd:     fd,
+		Size:   size,
+	})
+}
+
+func (f KeyboardEventFunc) Enter(serial uint32, surface *Surface, keys []uint32) {
+	f(KeyboardEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		Keys:    keys,
+	})
+}
+
+func (f KeyboardEventFunc) Leave(serial uint32, surface *Surface) {
+	f(KeyboardLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	})
+}
+
+func (f KeyboardEventFunc) Key(serial uint32, time time.Duration, key uint32, state KeyboardKeyState) {
+	f(KeyboardKeyEvent{
+		Serial: serial,
+		Time:   time,
+		Key:    key,
+		State:  state,
+	})
+}
+
+func (f KeyboardEventFunc) Modifiers(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32) {
+	f(KeyboardModifiersEvent{
+		Serial:        serial,
+		ModsDepressed: modsDepressed,
+		ModsLatched:   modsLatched,
+		ModsLocked:    modsLocked,
+		Group:         group,
+	})
+}
+
+// Available since version 4.
+func (f KeyboardEventFunc) RepeatInfo(rate int32, delay int32) {
+	f(KeyboardRepeatInfoEvent{
+		Rate:  rate,
+		Delay: delay,
+	})
+}
+
 // The wl_keyboard interface represents one or more keyboards
 // associated with a seat.
 //
@@ -5530,38 +11266,28 @@ func (obj *Keyboard) State() wire.State {
 func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		format := KeyboardKeymapFormat(msg.ReadUint())
-
-		fd := msg.ReadFile()
-
-		size := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		if err := msg.ClaimFDs(1); err != nil {
+			return err
+		}
+		var m KeyboardKeymapEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
 		}
+		msg.HandOffFiles()
 		obj.Listener.Keymap(
-			format,
-			fd,
-			size,
+			m.Format,
+			m.Fd,
+			m.Size,
 		)
 		return nil
 
 	case 1:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		keys := msg.ReadArray()
-
-		if err := msg.Err(); err != nil {
+		var m KeyboardEnterEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5569,21 +11295,15 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Enter(
-			serial,
-			surface,
-			keys,
+			m.Serial,
+			m.Surface,
+			m.Keys,
 		)
 		return nil
 
 	case 2:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		if err := msg.Err(); err != nil {
+		var m KeyboardLeaveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5591,22 +11311,14 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Leave(
-			serial,
-			surface,
+			m.Serial,
+			m.Surface,
 		)
 		return nil
 
 	case 3:
-
-		serial := msg.ReadUint()
-
-		time := msg.ReadUint()
-
-		key := msg.ReadUint()
-
-		state := KeyboardKeyState(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m KeyboardKeyEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5614,26 +11326,16 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Key(
-			serial,
-			time,
-			key,
-			state,
+			m.Serial,
+			m.Time,
+			m.Key,
+			m.State,
 		)
 		return nil
 
 	case 4:
-
-		serial := msg.ReadUint()
-
-		modsDepressed := msg.ReadUint()
-
-		modsLatched := msg.ReadUint()
-
-		modsLocked := msg.ReadUint()
-
-		group := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m KeyboardModifiersEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5641,21 +11343,17 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Modifiers(
-			serial,
-			modsDepressed,
-			modsLatched,
-			modsLocked,
-			group,
+			m.Serial,
+			m.ModsDepressed,
+			m.ModsLatched,
+			m.ModsLocked,
+			m.Group,
 		)
 		return nil
 
 	case 5:
-
-		rate := msg.ReadInt()
-
-		delay := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m KeyboardRepeatInfoEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5663,12 +11361,20 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.RepeatInfo(
-			rate,
-			delay,
+			m.Rate,
+			m.Delay,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(KeyboardInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_keyboard",
 		Type:      "event",
@@ -5722,85 +11428,555 @@ func (obj *Keyboard) Interface() string {
 	return KeyboardInterface
 }
 
+// OnKeymap sets the function that is called when the
+// keymap event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+func (obj *Keyboard) OnKeymap(f func(format KeyboardKeymapFormat, fd *os.File, size uint32)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnKeymap = f
+}
+
+// OnEnter sets the function that is called when the
+// enter event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+func (obj *Keyboard) OnEnter(f func(serial uint32, surface *Surface, keys []uint32)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnEnter = f
+}
+
+// OnLeave sets the function that is called when the
+// leave event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+func (obj *Keyboard) OnLeave(f func(serial uint32, surface *Surface)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnLeave = f
+}
+
+// OnKey sets the function that is called when the
+// key event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+func (obj *Keyboard) OnKey(f func(serial uint32, time time.Duration, key uint32, state KeyboardKeyState)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnKey = f
+}
+
+// OnModifiers sets the function that is called when the
+// modifiers event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+func (obj *Keyboard) OnModifiers(f func(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnModifiers = f
+}
+
+// OnRepeatInfo sets the function that is called when the
+// repeat_info event is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+//
+// Available since version 4.
+func (obj *Keyboard) OnRepeatInfo(f func(rate int32, delay int32)) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRepeatInfo = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Keyboard) Subscribe() *wire.Messages[KeyboardEvent] {
+	q := new(wire.Messages[KeyboardEvent])
+	obj.Listener = KeyboardEventFunc(q.Push)
+	return q
+}
+
 func (obj *Keyboard) Version() uint32 {
 	return KeyboardVersion
 }
 
+// Available since version 3.
 func (obj *Keyboard) Release() {
+	obj.state.Enqueue(KeyboardReleaseRequest{}.Encode(obj))
+	return
+}
+
+// KeyboardKeymapEvent holds the arguments of the keymap event of
+// the wl_keyboard interface.
+type KeyboardKeymapEvent struct {
+	Format KeyboardKeymapFormat
+	Fd     *os.File
+	Size   uint32
+}
+
+func (KeyboardKeymapEvent) keyboardEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardKeymapEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = KeyboardKeymapFormat(msg.ReadUint())
+	m.Fd = msg.ReadFile()
+	m.Size = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m KeyboardKeymapEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m KeyboardKeymapEvent) EncodeTransfer(obj *Keyboard) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m KeyboardKeymapEvent) encode(obj *Keyboard, transfer bool) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Format))
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+	builder.WriteUint(m.Size)
 
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	builder.Method = "keymap"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format, m.Fd, m.Size}
+	}
+	return builder
 }
 
-// This specifies the format of the keymap provided to the
-// client with the wl_keyboard.keymap event.
-type KeyboardKeymapFormat int64
+// KeyboardEnterEvent holds the arguments of the enter event of
+// the wl_keyboard interface.
+type KeyboardEnterEvent struct {
+	Serial  uint32
+	Surface *Surface
+	Keys    []uint32
+}
 
-const (
-	// no keymap; client must understand how to interpret the raw keycode
-	KeyboardKeymapFormatNoKeymap KeyboardKeymapFormat = 0
+func (KeyboardEnterEvent) keyboardEvent() {}
 
-	// libxkbcommon compatible, null-terminated string; to determine the xkb keycode, clients must add 8 to the key event keycode
-	KeyboardKeymapFormatXkbV1 KeyboardKeymapFormat = 1
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Keys = wire.Uint32s(msg.ReadArray())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum KeyboardKeymapFormat) String() string {
-	switch enum {
-	case 0:
-		return "KeyboardKeymapFormatNoKeymap"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardEnterEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteArray(wire.FromUint32s(m.Keys))
 
-	case 1:
-		return "KeyboardKeymapFormatXkbV1"
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, wire.FromUint32s(m.Keys)}
 	}
+	return builder
+}
 
-	return "<invalid KeyboardKeymapFormat>"
+// KeyboardLeaveEvent holds the arguments of the leave event of
+// the wl_keyboard interface.
+type KeyboardLeaveEvent struct {
+	Serial  uint32
+	Surface *Surface
 }
 
-// Describes the physical state of a key that produced the key event.
-//
-// Since version 10, the key can be in a "repeated" pseudo-state which
-// means the same as "pressed", but is used to signal repetition in the
-// key event.
+func (KeyboardLeaveEvent) keyboardEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardLeaveEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface}
+	}
+	return builder
+}
+
+// KeyboardKeyEvent holds the arguments of the key event of
+// the wl_keyboard interface.
+type KeyboardKeyEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Key    uint32
+	State  KeyboardKeyState
+}
+
+func (KeyboardKeyEvent) keyboardEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardKeyEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Key = msg.ReadUint()
+	m.State = KeyboardKeyState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardKeyEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(m.Key)
+	builder.WriteUint(uint32(m.State))
+
+	builder.Method = "key"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Key, m.State}
+	}
+	return builder
+}
+
+// KeyboardModifiersEvent holds the arguments of the modifiers event of
+// the wl_keyboard interface.
+type KeyboardModifiersEvent struct {
+	Serial        uint32
+	ModsDepressed uint32
+	ModsLatched   uint32
+	ModsLocked    uint32
+	Group         uint32
+}
+
+func (KeyboardModifiersEvent) keyboardEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardModifiersEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.ModsDepressed = msg.ReadUint()
+	m.ModsLatched = msg.ReadUint()
+	m.ModsLocked = msg.ReadUint()
+	m.Group = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardModifiersEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(m.ModsDepressed)
+	builder.WriteUint(m.ModsLatched)
+	builder.WriteUint(m.ModsLocked)
+	builder.WriteUint(m.Group)
+
+	builder.Method = "modifiers"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.ModsDepressed, m.ModsLatched, m.ModsLocked, m.Group}
+	}
+	return builder
+}
+
+// KeyboardRepeatInfoEvent holds the arguments of the repeat_info event of
+// the wl_keyboard interface.
 //
-// The key may only enter the repeated state after entering the pressed
-// state and before entering the released state. This event may be
-// generated multiple times while the key is down.
-type KeyboardKeyState int64
+// Available since version 4.
+type KeyboardRepeatInfoEvent struct {
+	Rate  int32
+	Delay int32
+}
 
-const (
-	// key is not pressed
-	KeyboardKeyStateReleased KeyboardKeyState = 0
+func (KeyboardRepeatInfoEvent) keyboardEvent() {}
 
-	// key is pressed
-	KeyboardKeyStatePressed KeyboardKeyState = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardRepeatInfoEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Rate = msg.ReadInt()
+	m.Delay = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// key was repeated
-	KeyboardKeyStateRepeated KeyboardKeyState = 2
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardRepeatInfoEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteInt(m.Rate)
+	builder.WriteInt(m.Delay)
 
-func (enum KeyboardKeyState) String() string {
-	switch enum {
-	case 0:
-		return "KeyboardKeyStateReleased"
+	builder.Method = "repeat_info"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Rate, m.Delay}
+	}
+	return builder
+}
 
-	case 1:
-		return "KeyboardKeyStatePressed"
+// KeyboardReleaseRequest holds the arguments of the release request of
+// the wl_keyboard interface.
+//
+// Available since version 3.
+type KeyboardReleaseRequest struct {
+}
 
-	case 2:
-		return "KeyboardKeyStateRepeated"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *KeyboardReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardReleaseRequest) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	return "<invalid KeyboardKeyState>"
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
+// KeyboardKeymapFormat is an alias for [shared.KeyboardKeymapFormat].
+type KeyboardKeymapFormat = shared.KeyboardKeymapFormat
+
+const (
+	KeyboardKeymapFormatNoKeymap = shared.KeyboardKeymapFormatNoKeymap
+	KeyboardKeymapFormatXkbV1    = shared.KeyboardKeymapFormatXkbV1
+)
+
+// KeyboardKeyState is an alias for [shared.KeyboardKeyState].
+type KeyboardKeyState = shared.KeyboardKeyState
+
+const (
+	KeyboardKeyStateReleased = shared.KeyboardKeyStateReleased
+	KeyboardKeyStatePressed  = shared.KeyboardKeyStatePressed
+	// Available since version 10.
+	KeyboardKeyStateRepeated = shared.KeyboardKeyStateRepeated
+)
+
+const (
+	TouchInterface = shared.TouchInterface
+
+	// TouchVersion is the version of the interface that this
+	// package implements.
+	TouchVersion = 10
+)
+
+// The versions of the wl_touch interface that its messages were
+// added in.
 const (
-	TouchInterface = "wl_touch"
-	TouchVersion   = 10
+	TouchReleaseRequestSince   = 3
+	TouchDownEventSince        = 1
+	TouchUpEventSince          = 1
+	TouchMotionEventSince      = 1
+	TouchFrameEventSince       = 1
+	TouchCancelEventSince      = 1
+	TouchShapeEventSince       = 6
+	TouchOrientationEventSince = 6
 )
 
+// TouchDesc describes the wl_touch interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TouchDesc = &wire.InterfaceDesc{
+	Name:    TouchInterface,
+	Version: TouchVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "down",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "up",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "cancel",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "shape",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "major",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "minor",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "orientation",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "orientation",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // TouchListener is a type that can respond to incoming
 // messages for a Touch object.
 type TouchListener interface {
@@ -5808,15 +11984,15 @@ type TouchListener interface {
 	// assigned a unique ID. Future events from this touch point reference
 	// this ID. The ID ceases to be valid after a touch up event and may be
 	// reused in the future.
-	Down(serial uint32, time uint32, surface *Surface, id int32, x wire.Fixed, y wire.Fixed)
+	Down(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed)
 
 	// The touch point has disappeared. No further events will be sent for
 	// this touch point and the touch point's ID is released and may be
 	// reused in a future touch down event.
-	Up(serial uint32, time uint32, id int32)
+	Up(serial uint32, time time.Duration, id int32)
 
 	// A touch point has changed coordinates.
-	Motion(time uint32, id int32, x wire.Fixed, y wire.Fixed)
+	Motion(time time.Duration, id int32, x wire.Fixed, y wire.Fixed)
 
 	// Indicates the end of a set of events that logically belong together.
 	// A client is expected to accumulate the data in all events within the
@@ -5863,6 +12039,8 @@ type TouchListener interface {
 	// This event is only sent by the compositor if the touch device supports
 	// shape reports. The client has to make reasonable assumptions about the
 	// shape if it did not receive this event.
+	//
+	// Available since version 6.
 	Shape(id int32, major wire.Fixed, minor wire.Fixed)
 
 	// Sent when a touchpoint has changed its orientation.
@@ -5888,9 +12066,133 @@ type TouchListener interface {
 	//
 	// This event is only sent by the compositor if the touch device supports
 	// orientation reports.
+	//
+	// Available since version 6.
 	Orientation(id int32, orientation wire.Fixed)
 }
 
+// TouchListenerFuncs implements TouchListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TouchListenerFuncs struct {
+	OnDown   func(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed)
+	OnUp     func(serial uint32, time time.Duration, id int32)
+	OnMotion func(time time.Duration, id int32, x wire.Fixed, y wire.Fixed)
+	OnFrame  func()
+	OnCancel func()
+	// Available since version 6.
+	OnShape func(id int32, major wire.Fixed, minor wire.Fixed)
+	// Available since version 6.
+	OnOrientation func(id int32, orientation wire.Fixed)
+}
+
+func (lis *TouchListenerFuncs) Down(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed) {
+	if lis.OnDown != nil {
+		lis.OnDown(serial, time, surface, id, x, y)
+	}
+}
+
+func (lis *TouchListenerFuncs) Up(serial uint32, time time.Duration, id int32) {
+	if lis.OnUp != nil {
+		lis.OnUp(serial, time, id)
+	}
+}
+
+func (lis *TouchListenerFuncs) Motion(time time.Duration, id int32, x wire.Fixed, y wire.Fixed) {
+	if lis.OnMotion != nil {
+		lis.OnMotion(time, id, x, y)
+	}
+}
+
+func (lis *TouchListenerFuncs) Frame() {
+	if lis.OnFrame != nil {
+		lis.OnFrame()
+	}
+}
+
+func (lis *TouchListenerFuncs) Cancel() {
+	if lis.OnCancel != nil {
+		lis.OnCancel()
+	}
+}
+
+// Available since version 6.
+func (lis *TouchListenerFuncs) Shape(id int32, major wire.Fixed, minor wire.Fixed) {
+	if lis.OnShape != nil {
+		lis.OnShape(id, major, minor)
+	}
+}
+
+// Available since version 6.
+func (lis *TouchListenerFuncs) Orientation(id int32, orientation wire.Fixed) {
+	if lis.OnOrientation != nil {
+		lis.OnOrientation(id, orientation)
+	}
+}
+
+// TouchEvent is implemented by the message types of all
+// of the events that a Touch can receive.
+type TouchEvent interface {
+	touchEvent()
+}
+
+// TouchEventFunc implements TouchListener by calling
+// itself with each incoming message.
+type TouchEventFunc func(TouchEvent)
+
+func (f TouchEventFunc) Down(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed) {
+	f(TouchDownEvent{
+		Serial:  serial,
+		Time:    time,
+		Surface: surface,
+		Id:      id,
+		X:       x,
+		Y:       y,
+	})
+}
+
+func (f TouchEventFunc) Up(serial uint32, time time.Duration, id int32) {
+	f(TouchUpEvent{
+		Serial: serial,
+		Time:   time,
+		Id:     id,
+	})
+}
+
+func (f TouchEventFunc) Motion(time time.Duration, id int32, x wire.Fixed, y wire.Fixed) {
+	f(TouchMotionEvent{
+		Time: time,
+		Id:   id,
+		X:    x,
+		Y:    y,
+	})
+}
+
+func (f TouchEventFunc) Frame() {
+	f(TouchFrameEvent{})
+}
+
+func (f TouchEventFunc) Cancel() {
+	f(TouchCancelEvent{})
+}
+
+// Available since version 6.
+func (f TouchEventFunc) Shape(id int32, major wire.Fixed, minor wire.Fixed) {
+	f(TouchShapeEvent{
+		Id:    id,
+		Major: major,
+		Minor: minor,
+	})
+}
+
+// Available since version 6.
+func (f TouchEventFunc) Orientation(id int32, orientation wire.Fixed) {
+	f(TouchOrientationEvent{
+		Id:          id,
+		Orientation: orientation,
+	})
+}
+
 // The wl_touch interface represents a touchscreen
 // associated with a seat.
 //
@@ -5926,22 +12228,8 @@ func (obj *Touch) State() wire.State {
 func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		time := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		id := msg.ReadInt()
-
-		x := msg.ReadFixed()
-
-		y := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m TouchDownEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5949,24 +12237,18 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Down(
-			serial,
-			time,
-			surface,
-			id,
-			x,
-			y,
+			m.Serial,
+			m.Time,
+			m.Surface,
+			m.Id,
+			m.X,
+			m.Y,
 		)
 		return nil
 
 	case 1:
-
-		serial := msg.ReadUint()
-
-		time := msg.ReadUint()
-
-		id := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m TouchUpEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5974,23 +12256,15 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Up(
-			serial,
-			time,
-			id,
+			m.Serial,
+			m.Time,
+			m.Id,
 		)
 		return nil
 
 	case 2:
-
-		time := msg.ReadUint()
-
-		id := msg.ReadInt()
-
-		x := msg.ReadFixed()
-
-		y := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m TouchMotionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5998,15 +12272,16 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Motion(
-			time,
-			id,
-			x,
-			y,
+			m.Time,
+			m.Id,
+			m.X,
+			m.Y,
 		)
 		return nil
 
 	case 3:
-		if err := msg.Err(); err != nil {
+		var m TouchFrameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6017,7 +12292,8 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 4:
-		if err := msg.Err(); err != nil {
+		var m TouchCancelEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6028,14 +12304,8 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 5:
-
-		id := msg.ReadInt()
-
-		major := msg.ReadFixed()
-
-		minor := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m TouchShapeEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6043,19 +12313,15 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Shape(
-			id,
-			major,
-			minor,
+			m.Id,
+			m.Major,
+			m.Minor,
 		)
 		return nil
 
 	case 6:
-
-		id := msg.ReadInt()
-
-		orientation := msg.ReadFixed()
-
-		if err := msg.Err(); err != nil {
+		var m TouchOrientationEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6063,12 +12329,20 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Orientation(
-			id,
-			orientation,
+			m.Id,
+			m.Orientation,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TouchInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_touch",
 		Type:      "event",
@@ -6125,24 +12399,578 @@ func (obj *Touch) Interface() string {
 	return TouchInterface
 }
 
+// OnDown sets the function that is called when the
+// down event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+func (obj *Touch) OnDown(f func(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed)) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDown = f
+}
+
+// OnUp sets the function that is called when the
+// up event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+func (obj *Touch) OnUp(f func(serial uint32, time time.Duration, id int32)) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnUp = f
+}
+
+// OnMotion sets the function that is called when the
+// motion event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+func (obj *Touch) OnMotion(f func(time time.Duration, id int32, x wire.Fixed, y wire.Fixed)) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMotion = f
+}
+
+// OnFrame sets the function that is called when the
+// frame event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+func (obj *Touch) OnFrame(f func()) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// OnCancel sets the function that is called when the
+// cancel event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+func (obj *Touch) OnCancel(f func()) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCancel = f
+}
+
+// OnShape sets the function that is called when the
+// shape event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+//
+// Available since version 6.
+func (obj *Touch) OnShape(f func(id int32, major wire.Fixed, minor wire.Fixed)) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnShape = f
+}
+
+// OnOrientation sets the function that is called when the
+// orientation event is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+//
+// Available since version 6.
+func (obj *Touch) OnOrientation(f func(id int32, orientation wire.Fixed)) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnOrientation = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Touch) Subscribe() *wire.Messages[TouchEvent] {
+	q := new(wire.Messages[TouchEvent])
+	obj.Listener = TouchEventFunc(q.Push)
+	return q
+}
+
 func (obj *Touch) Version() uint32 {
 	return TouchVersion
 }
 
+// Available since version 3.
 func (obj *Touch) Release() {
+	obj.state.Enqueue(TouchReleaseRequest{}.Encode(obj))
+	return
+}
+
+// TouchDownEvent holds the arguments of the down event of
+// the wl_touch interface.
+type TouchDownEvent struct {
+	Serial  uint32
+	Time    time.Duration
+	Surface *Surface
+	Id      int32
+	X       wire.Fixed
+	Y       wire.Fixed
+}
+
+func (TouchDownEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchDownEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Id = msg.ReadInt()
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchDownEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteObject(m.Surface)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+
+	builder.Method = "down"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Surface, m.Id, m.X, m.Y}
+	}
+	return builder
+}
+
+// TouchUpEvent holds the arguments of the up event of
+// the wl_touch interface.
+type TouchUpEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Id     int32
+}
+
+func (TouchUpEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchUpEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Id = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchUpEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteInt(m.Id)
+
+	builder.Method = "up"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Id}
+	}
+	return builder
+}
+
+// TouchMotionEvent holds the arguments of the motion event of
+// the wl_touch interface.
+type TouchMotionEvent struct {
+	Time time.Duration
+	Id   int32
+	X    wire.Fixed
+	Y    wire.Fixed
+}
+
+func (TouchMotionEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Id = msg.ReadInt()
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchMotionEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Id, m.X, m.Y}
+	}
+	return builder
+}
+
+// TouchFrameEvent holds the arguments of the frame event of
+// the wl_touch interface.
+type TouchFrameEvent struct {
+}
+
+func (TouchFrameEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchFrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchFrameEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TouchCancelEvent holds the arguments of the cancel event of
+// the wl_touch interface.
+type TouchCancelEvent struct {
+}
+
+func (TouchCancelEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchCancelEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchCancelEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "cancel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TouchShapeEvent holds the arguments of the shape event of
+// the wl_touch interface.
+//
+// Available since version 6.
+type TouchShapeEvent struct {
+	Id    int32
+	Major wire.Fixed
+	Minor wire.Fixed
+}
+
+func (TouchShapeEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchShapeEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadInt()
+	m.Major = msg.ReadFixed()
+	m.Minor = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchShapeEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.Major)
+	builder.WriteFixed(m.Minor)
+
+	builder.Method = "shape"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id, m.Major, m.Minor}
+	}
+	return builder
+}
+
+// TouchOrientationEvent holds the arguments of the orientation event of
+// the wl_touch interface.
+//
+// Available since version 6.
+type TouchOrientationEvent struct {
+	Id          int32
+	Orientation wire.Fixed
+}
+
+func (TouchOrientationEvent) touchEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchOrientationEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadInt()
+	m.Orientation = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchOrientationEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.Orientation)
+
+	builder.Method = "orientation"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id, m.Orientation}
+	}
+	return builder
+}
+
+// TouchReleaseRequest holds the arguments of the release request of
+// the wl_touch interface.
+//
+// Available since version 3.
+type TouchReleaseRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TouchReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchReleaseRequest) Encode(obj *Touch) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
 
 	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
 const (
-	OutputInterface = "wl_output"
-	OutputVersion   = 4
+	OutputInterface = shared.OutputInterface
+
+	// OutputVersion is the version of the interface that this
+	// package implements.
+	OutputVersion = 4
+)
+
+// The versions of the wl_output interface that its messages were
+// added in.
+const (
+	OutputReleaseRequestSince   = 3
+	OutputGeometryEventSince    = 1
+	OutputModeEventSince        = 1
+	OutputDoneEventSince        = 2
+	OutputScaleEventSince       = 2
+	OutputNameEventSince        = 4
+	OutputDescriptionEventSince = 4
 )
 
+// OutputDesc describes the wl_output interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var OutputDesc = &wire.InterfaceDesc{
+	Name:    OutputInterface,
+	Version: OutputVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "geometry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "physical_width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "physical_height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "subpixel",
+					Type: wire.ArgInt,
+					Enum: "subpixel",
+				},
+				{
+					Name: "make",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "model",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "transform",
+					Type: wire.ArgInt,
+					Enum: "transform",
+				},
+			},
+		},
+		{
+			Name:  "mode",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "mode",
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "refresh",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "scale",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "factor",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "description",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "description",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "subpixel",
+			Entries: []wire.EntryDesc{
+				{Name: "unknown", Value: 0},
+				{Name: "none", Value: 1},
+				{Name: "horizontal_rgb", Value: 2},
+				{Name: "horizontal_bgr", Value: 3},
+				{Name: "vertical_rgb", Value: 4},
+				{Name: "vertical_bgr", Value: 5},
+			},
+		},
+		{
+			Name: "transform",
+			Entries: []wire.EntryDesc{
+				{Name: "normal", Value: 0},
+				{Name: "90", Value: 1},
+				{Name: "180", Value: 2},
+				{Name: "270", Value: 3},
+				{Name: "flipped", Value: 4},
+				{Name: "flipped_90", Value: 5},
+				{Name: "flipped_180", Value: 6},
+				{Name: "flipped_270", Value: 7},
+			},
+		},
+		{
+			Name:     "mode",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "current", Value: 1},
+				{Name: "preferred", Value: 2},
+			},
+		},
+	},
+}
+
 // OutputListener is a type that can respond to incoming
 // messages for a Output object.
 type OutputListener interface {
@@ -6208,6 +13036,8 @@ type OutputListener interface {
 	// other property changes done after that. This allows
 	// changes to the output properties to be seen as
 	// atomic, even if they happen via multiple events.
+	//
+	// Available since version 2.
 	Done()
 
 	// This event contains scaling geometry information
@@ -6228,6 +13058,8 @@ type OutputListener interface {
 	// scale to use for a surface.
 	//
 	// The scale event will be followed by a done event.
+	//
+	// Available since version 2.
 	Scale(factor int32)
 
 	// Many compositors will assign user-friendly names to their outputs, show
@@ -6258,6 +13090,8 @@ type OutputListener interface {
 	// same name if possible.
 	//
 	// The name event will be followed by a done event.
+	//
+	// Available since version 4.
 	Name(name string)
 
 	// Many compositors can produce human-readable descriptions of their
@@ -6274,9 +13108,125 @@ type OutputListener interface {
 	// not be sent at all.
 	//
 	// The description event will be followed by a done event.
+	//
+	// Available since version 4.
 	Description(description string)
 }
 
+// OutputListenerFuncs implements OutputListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type OutputListenerFuncs struct {
+	OnGeometry func(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel OutputSubpixel, make string, model string, transform OutputTransform)
+	OnMode     func(flags OutputMode, width int32, height int32, refresh int32)
+	// Available since version 2.
+	OnDone func()
+	// Available since version 2.
+	OnScale func(factor int32)
+	// Available since version 4.
+	OnName func(name string)
+	// Available since version 4.
+	OnDescription func(description string)
+}
+
+func (lis *OutputListenerFuncs) Geometry(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel OutputSubpixel, make string, model string, transform OutputTransform) {
+	if lis.OnGeometry != nil {
+		lis.OnGeometry(x, y, physicalWidth, physicalHeight, subpixel, make, model, transform)
+	}
+}
+
+func (lis *OutputListenerFuncs) Mode(flags OutputMode, width int32, height int32, refresh int32) {
+	if lis.OnMode != nil {
+		lis.OnMode(flags, width, height, refresh)
+	}
+}
+
+// Available since version 2.
+func (lis *OutputListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+// Available since version 2.
+func (lis *OutputListenerFuncs) Scale(factor int32) {
+	if lis.OnScale != nil {
+		lis.OnScale(factor)
+	}
+}
+
+// Available since version 4.
+func (lis *OutputListenerFuncs) Name(name string) {
+	if lis.OnName != nil {
+		lis.OnName(name)
+	}
+}
+
+// Available since version 4.
+func (lis *OutputListenerFuncs) Description(description string) {
+	if lis.OnDescription != nil {
+		lis.OnDescription(description)
+	}
+}
+
+// OutputEvent is implemented by the message types of all
+// of the events that a Output can receive.
+type OutputEvent interface {
+	outputEvent()
+}
+
+// OutputEventFunc implements OutputListener by calling
+// itself with each incoming message.
+type OutputEventFunc func(OutputEvent)
+
+func (f OutputEventFunc) Geometry(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel OutputSubpixel, make string, model string, transform OutputTransform) {
+	f(OutputGeometryEvent{
+		X:              x,
+		Y:              y,
+		PhysicalWidth:  physicalWidth,
+		PhysicalHeight: physicalHeight,
+		Subpixel:       subpixel,
+		Make:           make,
+		Model:          model,
+		Transform:      transform,
+	})
+}
+
+func (f OutputEventFunc) Mode(flags OutputMode, width int32, height int32, refresh int32) {
+	f(OutputModeEvent{
+		Flags:   flags,
+		Width:   width,
+		Height:  height,
+		Refresh: refresh,
+	})
+}
+
+// Available since version 2.
+func (f OutputEventFunc) Done() {
+	f(OutputDoneEvent{})
+}
+
+// Available since version 2.
+func (f OutputEventFunc) Scale(factor int32) {
+	f(OutputScaleEvent{
+		Factor: factor,
+	})
+}
+
+// Available since version 4.
+func (f OutputEventFunc) Name(name string) {
+	f(OutputNameEvent{
+		Name: name,
+	})
+}
+
+// Available since version 4.
+func (f OutputEventFunc) Description(description string) {
+	f(OutputDescriptionEvent{
+		Description: description,
+	})
+}
+
 // An output describes part of the compositor geometry.  The
 // compositor works in the 'compositor coordinate system' and an
 // output corresponds to a rectangular area in that space that is
@@ -6317,24 +13267,8 @@ func (obj *Output) State() wire.State {
 func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		physicalWidth := msg.ReadInt()
-
-		physicalHeight := msg.ReadInt()
-
-		subpixel := OutputSubpixel(msg.ReadInt())
-
-		make := msg.ReadString()
-
-		model := msg.ReadString()
-
-		transform := OutputTransform(msg.ReadInt())
-
-		if err := msg.Err(); err != nil {
+		var m OutputGeometryEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6342,28 +13276,20 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Geometry(
-			x,
-			y,
-			physicalWidth,
-			physicalHeight,
-			subpixel,
-			make,
-			model,
-			transform,
+			m.X,
+			m.Y,
+			m.PhysicalWidth,
+			m.PhysicalHeight,
+			m.Subpixel,
+			m.Make,
+			m.Model,
+			m.Transform,
 		)
 		return nil
 
 	case 1:
-
-		flags := OutputMode(msg.ReadUint())
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		refresh := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m OutputModeEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6371,15 +13297,16 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Mode(
-			flags,
-			width,
-			height,
-			refresh,
+			m.Flags,
+			m.Width,
+			m.Height,
+			m.Refresh,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m OutputDoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6390,10 +13317,8 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 3:
-
-		factor := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m OutputScaleEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6401,15 +13326,13 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Scale(
-			factor,
+			m.Factor,
 		)
 		return nil
 
 	case 4:
-
-		name := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m OutputNameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6417,15 +13340,13 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Name(
-			name,
+			m.Name,
 		)
 		return nil
 
 	case 5:
-
-		description := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m OutputDescriptionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6433,11 +13354,19 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Description(
-			description,
+			m.Description,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(OutputInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_output",
 		Type:      "event",
@@ -6491,166 +13420,480 @@ func (obj *Output) Interface() string {
 	return OutputInterface
 }
 
-func (obj *Output) Version() uint32 {
-	return OutputVersion
+// OnGeometry sets the function that is called when the
+// geometry event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+func (obj *Output) OnGeometry(f func(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel OutputSubpixel, make string, model string, transform OutputTransform)) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGeometry = f
 }
 
-// Using this request a client can tell the server that it is not going to
-// use the output object anymore.
-func (obj *Output) Release() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+// OnMode sets the function that is called when the
+// mode event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+func (obj *Output) OnMode(f func(flags OutputMode, width int32, height int32, refresh int32)) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMode = f
 }
 
-// This enumeration describes how the physical
-// pixels on an output are laid out.
-type OutputSubpixel int64
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *Output) OnDone(f func()) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
 
-const (
-	// unknown geometry
-	OutputSubpixelUnknown OutputSubpixel = 0
+// OnScale sets the function that is called when the
+// scale event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *Output) OnScale(f func(factor int32)) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnScale = f
+}
 
-	// no geometry
-	OutputSubpixelNone OutputSubpixel = 1
+// OnName sets the function that is called when the
+// name event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+//
+// Available since version 4.
+func (obj *Output) OnName(f func(name string)) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnName = f
+}
 
-	// horizontal RGB
-	OutputSubpixelHorizontalRgb OutputSubpixel = 2
+// OnDescription sets the function that is called when the
+// description event is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+//
+// Available since version 4.
+func (obj *Output) OnDescription(f func(description string)) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDescription = f
+}
 
-	// horizontal BGR
-	OutputSubpixelHorizontalBgr OutputSubpixel = 3
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Output) Subscribe() *wire.Messages[OutputEvent] {
+	q := new(wire.Messages[OutputEvent])
+	obj.Listener = OutputEventFunc(q.Push)
+	return q
+}
 
-	// vertical RGB
-	OutputSubpixelVerticalRgb OutputSubpixel = 4
+func (obj *Output) Version() uint32 {
+	return OutputVersion
+}
 
-	// vertical BGR
-	OutputSubpixelVerticalBgr OutputSubpixel = 5
-)
+// Using this request a client can tell the server that it is not going to
+// use the output object anymore.
+//
+// Available since version 3.
+func (obj *Output) Release() {
+	obj.state.Enqueue(OutputReleaseRequest{}.Encode(obj))
+	return
+}
 
-func (enum OutputSubpixel) String() string {
-	switch enum {
-	case 0:
-		return "OutputSubpixelUnknown"
+// OutputGeometryEvent holds the arguments of the geometry event of
+// the wl_output interface.
+type OutputGeometryEvent struct {
+	X              int32
+	Y              int32
+	PhysicalWidth  int32
+	PhysicalHeight int32
+	Subpixel       OutputSubpixel
+	Make           string
+	Model          string
+	Transform      OutputTransform
+}
+
+func (OutputGeometryEvent) outputEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputGeometryEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.PhysicalWidth = msg.ReadInt()
+	m.PhysicalHeight = msg.ReadInt()
+	m.Subpixel = OutputSubpixel(msg.ReadInt())
+	m.Make = msg.ReadString()
+	m.Model = msg.ReadString()
+	m.Transform = OutputTransform(msg.ReadInt())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 1:
-		return "OutputSubpixelNone"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputGeometryEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.PhysicalWidth)
+	builder.WriteInt(m.PhysicalHeight)
+	builder.WriteInt(int32(m.Subpixel))
+	builder.WriteString(m.Make)
+	builder.WriteString(m.Model)
+	builder.WriteInt(int32(m.Transform))
+
+	builder.Method = "geometry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.PhysicalWidth, m.PhysicalHeight, m.Subpixel, m.Make, m.Model, m.Transform}
+	}
+	return builder
+}
+
+// OutputModeEvent holds the arguments of the mode event of
+// the wl_output interface.
+type OutputModeEvent struct {
+	Flags   OutputMode
+	Width   int32
+	Height  int32
+	Refresh int32
+}
+
+func (OutputModeEvent) outputEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputModeEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Flags = OutputMode(msg.ReadUint())
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Refresh = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 2:
-		return "OutputSubpixelHorizontalRgb"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputModeEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.Flags))
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteInt(m.Refresh)
+
+	builder.Method = "mode"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Flags, m.Width, m.Height, m.Refresh}
+	}
+	return builder
+}
 
-	case 3:
-		return "OutputSubpixelHorizontalBgr"
+// OutputDoneEvent holds the arguments of the done event of
+// the wl_output interface.
+//
+// Available since version 2.
+type OutputDoneEvent struct {
+}
 
-	case 4:
-		return "OutputSubpixelVerticalRgb"
+func (OutputDoneEvent) outputEvent() {}
 
-	case 5:
-		return "OutputSubpixelVerticalBgr"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputDoneEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
 
-	return "<invalid OutputSubpixel>"
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// This describes transformations that clients and compositors apply to
-// buffer contents.
+// OutputScaleEvent holds the arguments of the scale event of
+// the wl_output interface.
 //
-// The flipped values correspond to an initial flip around a
-// vertical axis followed by rotation.
-//
-// The purpose is mainly to allow clients to render accordingly and
-// tell the compositor, so that for fullscreen surfaces, the
-// compositor will still be able to scan out directly from client
-// surfaces.
-type OutputTransform int64
+// Available since version 2.
+type OutputScaleEvent struct {
+	Factor int32
+}
 
-const (
-	// no transform
-	OutputTransformNormal OutputTransform = 0
+func (OutputScaleEvent) outputEvent() {}
 
-	// 90 degrees counter-clockwise
-	OutputTransform90 OutputTransform = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputScaleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Factor = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// 180 degrees counter-clockwise
-	OutputTransform180 OutputTransform = 2
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputScaleEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteInt(m.Factor)
 
-	// 270 degrees counter-clockwise
-	OutputTransform270 OutputTransform = 3
+	builder.Method = "scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Factor}
+	}
+	return builder
+}
 
-	// 180 degree flip around a vertical axis
-	OutputTransformFlipped OutputTransform = 4
+// OutputNameEvent holds the arguments of the name event of
+// the wl_output interface.
+//
+// Available since version 4.
+type OutputNameEvent struct {
+	Name string
+}
 
-	// flip and rotate 90 degrees counter-clockwise
-	OutputTransformFlipped90 OutputTransform = 5
+func (OutputNameEvent) outputEvent() {}
 
-	// flip and rotate 180 degrees counter-clockwise
-	OutputTransformFlipped180 OutputTransform = 6
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputNameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// flip and rotate 270 degrees counter-clockwise
-	OutputTransformFlipped270 OutputTransform = 7
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputNameEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteString(m.Name)
 
-func (enum OutputTransform) String() string {
-	switch enum {
-	case 0:
-		return "OutputTransformNormal"
+	builder.Method = "name"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
+}
 
-	case 1:
-		return "OutputTransform90"
+// OutputDescriptionEvent holds the arguments of the description event of
+// the wl_output interface.
+//
+// Available since version 4.
+type OutputDescriptionEvent struct {
+	Description string
+}
 
-	case 2:
-		return "OutputTransform180"
+func (OutputDescriptionEvent) outputEvent() {}
 
-	case 3:
-		return "OutputTransform270"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputDescriptionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Description = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 4:
-		return "OutputTransformFlipped"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputDescriptionEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteString(m.Description)
 
-	case 5:
-		return "OutputTransformFlipped90"
+	builder.Method = "description"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Description}
+	}
+	return builder
+}
 
-	case 6:
-		return "OutputTransformFlipped180"
+// OutputReleaseRequest holds the arguments of the release request of
+// the wl_output interface.
+//
+// Available since version 3.
+type OutputReleaseRequest struct {
+}
 
-	case 7:
-		return "OutputTransformFlipped270"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *OutputReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
 
-	return "<invalid OutputTransform>"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputReleaseRequest) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// These flags describe properties of an output mode.
-// They are used in the flags bitfield of the mode event.
-type OutputMode int64
+// OutputSubpixel is an alias for [shared.OutputSubpixel].
+type OutputSubpixel = shared.OutputSubpixel
 
 const (
-	// indicates this is the current mode
-	OutputModeCurrent OutputMode = 1
+	OutputSubpixelUnknown       = shared.OutputSubpixelUnknown
+	OutputSubpixelNone          = shared.OutputSubpixelNone
+	OutputSubpixelHorizontalRgb = shared.OutputSubpixelHorizontalRgb
+	OutputSubpixelHorizontalBgr = shared.OutputSubpixelHorizontalBgr
+	OutputSubpixelVerticalRgb   = shared.OutputSubpixelVerticalRgb
+	OutputSubpixelVerticalBgr   = shared.OutputSubpixelVerticalBgr
+)
+
+// OutputTransform is an alias for [shared.OutputTransform].
+type OutputTransform = shared.OutputTransform
 
-	// indicates this is the preferred mode
-	OutputModePreferred OutputMode = 2
+const (
+	OutputTransformNormal     = shared.OutputTransformNormal
+	OutputTransform90         = shared.OutputTransform90
+	OutputTransform180        = shared.OutputTransform180
+	OutputTransform270        = shared.OutputTransform270
+	OutputTransformFlipped    = shared.OutputTransformFlipped
+	OutputTransformFlipped90  = shared.OutputTransformFlipped90
+	OutputTransformFlipped180 = shared.OutputTransformFlipped180
+	OutputTransformFlipped270 = shared.OutputTransformFlipped270
 )
 
-func (enum OutputMode) String() string {
-	switch enum {
-	case 1:
-		return "OutputModeCurrent"
+// OutputMode is an alias for [shared.OutputMode].
+type OutputMode = shared.OutputMode
 
-	case 2:
-		return "OutputModePreferred"
-	}
+const (
+	OutputModeCurrent   = shared.OutputModeCurrent
+	OutputModePreferred = shared.OutputModePreferred
+)
 
-	return "<invalid OutputMode>"
-}
+const (
+	RegionInterface = shared.RegionInterface
+
+	// RegionVersion is the version of the interface that this
+	// package implements.
+	RegionVersion = 1
+)
 
+// The versions of the wl_region interface that its messages were
+// added in.
 const (
-	RegionInterface = "wl_region"
-	RegionVersion   = 1
+	RegionDestroyRequestSince  = 1
+	RegionAddRequestSince      = 1
+	RegionSubtractRequestSince = 1
 )
 
+// RegionDesc describes the wl_region interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var RegionDesc = &wire.InterfaceDesc{
+	Name:    RegionInterface,
+	Version: RegionVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "add",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "subtract",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // A region object describes an area.
 //
 // Region objects are used to describe the opaque and input
@@ -6677,6 +13920,14 @@ func (obj *Region) State() wire.State {
 
 func (obj *Region) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(RegionInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_region",
 		Type:      "event",
@@ -6719,49 +13970,200 @@ func (obj *Region) Version() uint32 {
 
 // Destroy the region.  This will invalidate the object ID.
 func (obj *Region) Destroy() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(RegionDestroyRequest{}.Encode(obj))
 	return
 }
 
 // Add the specified rectangle to the region.
 func (obj *Region) Add(x int32, y int32, width int32, height int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteInt(width)
-	builder.WriteInt(height)
-
-	builder.Method = "add"
-	builder.Args = []any{x, y, width, height}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(RegionAddRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
 	return
 }
 
 // Subtract the specified rectangle from the region.
 func (obj *Region) Subtract(x int32, y int32, width int32, height int32) {
-	builder := wire.NewMessage(obj, 2)
+	obj.state.Enqueue(RegionSubtractRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
+	return
+}
+
+// RegionDestroyRequest holds the arguments of the destroy request of
+// the wl_region interface.
+type RegionDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegionDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionDestroyRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// RegionAddRequest holds the arguments of the add request of
+// the wl_region interface.
+type RegionAddRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegionAddRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionAddRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
 
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteInt(width)
-	builder.WriteInt(height)
+	builder.Method = "add"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// RegionSubtractRequest holds the arguments of the subtract request of
+// the wl_region interface.
+type RegionSubtractRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *RegionSubtractRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionSubtractRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
 
 	builder.Method = "subtract"
-	builder.Args = []any{x, y, width, height}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
 }
 
 const (
-	SubcompositorInterface = "wl_subcompositor"
-	SubcompositorVersion   = 1
+	SubcompositorInterface = shared.SubcompositorInterface
+
+	// SubcompositorVersion is the version of the interface that this
+	// package implements.
+	SubcompositorVersion = 1
+)
+
+// The versions of the wl_subcompositor interface that its messages were
+// added in.
+const (
+	SubcompositorDestroyRequestSince       = 1
+	SubcompositorGetSubsurfaceRequestSince = 1
 )
 
+// SubcompositorDesc describes the wl_subcompositor interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SubcompositorDesc = &wire.InterfaceDesc{
+	Name:    SubcompositorInterface,
+	Version: SubcompositorVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_subsurface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_subsurface",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "bad_surface", Value: 0},
+				{Name: "bad_parent", Value: 1},
+			},
+		},
+	},
+}
+
 // The global interface exposing sub-surface compositing capabilities.
 // A wl_surface, that has sub-surfaces associated, is called the
 // parent surface. Sub-surfaces can be arbitrarily nested and create
@@ -6810,6 +14212,14 @@ func (obj *Subcompositor) State() wire.State {
 
 func (obj *Subcompositor) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SubcompositorInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_subcompositor",
 		Type:      "event",
@@ -6854,11 +14264,7 @@ func (obj *Subcompositor) Version() uint32 {
 // protocol object anymore. This does not affect any other
 // objects, wl_subsurface objects included.
 func (obj *Subcompositor) Destroy() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubcompositorDestroyRequest{}.Encode(obj))
 	return
 }
 
@@ -6882,47 +14288,179 @@ func (obj *Subcompositor) Destroy() {
 // This request modifies the behaviour of wl_surface.commit request on
 // the sub-surface, see the documentation on wl_subsurface interface.
 func (obj *Subcompositor) GetSubsurface(surface *Surface, parent *Surface) (id *Subsurface) {
-	builder := wire.NewMessage(obj, 1)
-
 	id = NewSubsurface(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-	builder.WriteObject(surface)
-	builder.WriteObject(parent)
-
-	builder.Method = "get_subsurface"
-	builder.Args = []any{id, surface, parent}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubcompositorGetSubsurfaceRequest{
+		Id:      id,
+		Surface: surface,
+		Parent:  parent,
+	}.Encode(obj))
 	return id
 }
 
-type SubcompositorError int64
-
-const (
-	// the to-be sub-surface is invalid
-	SubcompositorErrorBadSurface SubcompositorError = 0
+// SubcompositorDestroyRequest holds the arguments of the destroy request of
+// the wl_subcompositor interface.
+type SubcompositorDestroyRequest struct {
+}
 
-	// the to-be sub-surface parent is invalid
-	SubcompositorErrorBadParent SubcompositorError = 1
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubcompositorDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum SubcompositorError) String() string {
-	switch enum {
-	case 0:
-		return "SubcompositorErrorBadSurface"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubcompositorDestroyRequest) Encode(obj *Subcompositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	case 1:
-		return "SubcompositorErrorBadParent"
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SubcompositorGetSubsurfaceRequest holds the arguments of the get_subsurface request of
+// the wl_subcompositor interface.
+type SubcompositorGetSubsurfaceRequest struct {
+	Id      *Subsurface
+	Surface *Surface
+	Parent  *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubcompositorGetSubsurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewSubsurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, SubsurfaceInterface, msg.ReadNewObject(SubsurfaceInterface), false))
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubcompositorGetSubsurfaceRequest) Encode(obj *Subcompositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+	builder.WriteObject(m.Parent)
 
-	return "<invalid SubcompositorError>"
+	builder.Method = "get_subsurface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: SubsurfaceInterface, ID: m.Id.ID()}, m.Surface, m.Parent}
+	}
+	return builder
 }
 
+// SubcompositorError is an alias for [shared.SubcompositorError].
+type SubcompositorError = shared.SubcompositorError
+
 const (
-	SubsurfaceInterface = "wl_subsurface"
-	SubsurfaceVersion   = 1
+	SubcompositorErrorBadSurface = shared.SubcompositorErrorBadSurface
+	SubcompositorErrorBadParent  = shared.SubcompositorErrorBadParent
 )
 
+const (
+	SubsurfaceInterface = shared.SubsurfaceInterface
+
+	// SubsurfaceVersion is the version of the interface that this
+	// package implements.
+	SubsurfaceVersion = 1
+)
+
+// The versions of the wl_subsurface interface that its messages were
+// added in.
+const (
+	SubsurfaceDestroyRequestSince     = 1
+	SubsurfaceSetPositionRequestSince = 1
+	SubsurfacePlaceAboveRequestSince  = 1
+	SubsurfacePlaceBelowRequestSince  = 1
+	SubsurfaceSetSyncRequestSince     = 1
+	SubsurfaceSetDesyncRequestSince   = 1
+)
+
+// SubsurfaceDesc describes the wl_subsurface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SubsurfaceDesc = &wire.InterfaceDesc{
+	Name:    SubsurfaceInterface,
+	Version: SubsurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_position",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "place_above",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "sibling",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "place_below",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "sibling",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "set_sync",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_desync",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "bad_surface", Value: 0},
+			},
+		},
+	},
+}
+
 // An additional interface to a wl_surface object, which has been
 // made a sub-surface. A sub-surface has one parent surface. A
 // sub-surface's size and position are not limited to that of the parent.
@@ -6997,6 +14535,14 @@ func (obj *Subsurface) State() wire.State {
 
 func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SubsurfaceInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_subsurface",
 		Type:      "event",
@@ -7042,11 +14588,7 @@ func (obj *Subsurface) Version() uint32 {
 // wl_subcompositor.get_subsurface request. The wl_surface's association
 // to the parent is deleted. The wl_surface is unmapped immediately.
 func (obj *Subsurface) Destroy() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubsurfaceDestroyRequest{}.Encode(obj))
 	return
 }
 
@@ -7065,14 +14607,10 @@ func (obj *Subsurface) Destroy() {
 //
 // The initial position is 0, 0.
 func (obj *Subsurface) SetPosition(x int32, y int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-
-	builder.Method = "set_position"
-	builder.Args = []any{x, y}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubsurfaceSetPositionRequest{
+		X: x,
+		Y: y,
+	}.Encode(obj))
 	return
 }
 
@@ -7090,26 +14628,18 @@ func (obj *Subsurface) SetPosition(x int32, y int32) {
 // A new sub-surface is initially added as the top-most in the stack
 // of its siblings and parent.
 func (obj *Subsurface) PlaceAbove(sibling *Surface) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteObject(sibling)
-
-	builder.Method = "place_above"
-	builder.Args = []any{sibling}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubsurfacePlaceAboveRequest{
+		Sibling: sibling,
+	}.Encode(obj))
 	return
 }
 
 // The sub-surface is placed just below the reference surface.
 // See wl_subsurface.place_above.
 func (obj *Subsurface) PlaceBelow(sibling *Surface) {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.WriteObject(sibling)
-
-	builder.Method = "place_below"
-	builder.Args = []any{sibling}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubsurfacePlaceBelowRequest{
+		Sibling: sibling,
+	}.Encode(obj))
 	return
 }
 
@@ -7127,11 +14657,7 @@ func (obj *Subsurface) PlaceBelow(sibling *Surface) {
 //
 // See wl_subsurface for the recursive effect of this mode.
 func (obj *Subsurface) SetSync() {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.Method = "set_sync"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SubsurfaceSetSyncRequest{}.Encode(obj))
 	return
 }
 
@@ -7155,35 +14681,239 @@ func (obj *Subsurface) SetSync() {
 // If a surface's parent surface behaves as desynchronized, then
 // the cached state is applied on set_desync.
 func (obj *Subsurface) SetDesync() {
+	obj.state.Enqueue(SubsurfaceSetDesyncRequest{}.Encode(obj))
+	return
+}
+
+// SubsurfaceDestroyRequest holds the arguments of the destroy request of
+// the wl_subsurface interface.
+type SubsurfaceDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfaceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceDestroyRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SubsurfaceSetPositionRequest holds the arguments of the set_position request of
+// the wl_subsurface interface.
+type SubsurfaceSetPositionRequest struct {
+	X int32
+	Y int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfaceSetPositionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetPositionRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "set_position"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
+	}
+	return builder
+}
+
+// SubsurfacePlaceAboveRequest holds the arguments of the place_above request of
+// the wl_subsurface interface.
+type SubsurfacePlaceAboveRequest struct {
+	Sibling *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfacePlaceAboveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Sibling = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfacePlaceAboveRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Sibling)
+
+	builder.Method = "place_above"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Sibling}
+	}
+	return builder
+}
+
+// SubsurfacePlaceBelowRequest holds the arguments of the place_below request of
+// the wl_subsurface interface.
+type SubsurfacePlaceBelowRequest struct {
+	Sibling *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfacePlaceBelowRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Sibling = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfacePlaceBelowRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.Sibling)
+
+	builder.Method = "place_below"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Sibling}
+	}
+	return builder
+}
+
+// SubsurfaceSetSyncRequest holds the arguments of the set_sync request of
+// the wl_subsurface interface.
+type SubsurfaceSetSyncRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfaceSetSyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetSyncRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "set_sync"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SubsurfaceSetDesyncRequest holds the arguments of the set_desync request of
+// the wl_subsurface interface.
+type SubsurfaceSetDesyncRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SubsurfaceSetDesyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetDesyncRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 5)
 
 	builder.Method = "set_desync"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-type SubsurfaceError int64
+// SubsurfaceError is an alias for [shared.SubsurfaceError].
+type SubsurfaceError = shared.SubsurfaceError
 
 const (
-	// wl_surface is not a sibling or the parent
-	SubsurfaceErrorBadSurface SubsurfaceError = 0
+	SubsurfaceErrorBadSurface = shared.SubsurfaceErrorBadSurface
 )
 
-func (enum SubsurfaceError) String() string {
-	switch enum {
-	case 0:
-		return "SubsurfaceErrorBadSurface"
-	}
+const (
+	FixesInterface = shared.FixesInterface
 
-	return "<invalid SubsurfaceError>"
-}
+	// FixesVersion is the version of the interface that this
+	// package implements.
+	FixesVersion = 1
+)
 
+// The versions of the wl_fixes interface that its messages were
+// added in.
 const (
-	FixesInterface = "wl_fixes"
-	FixesVersion   = 1
+	FixesDestroyRequestSince         = 1
+	FixesDestroyRegistryRequestSince = 1
 )
 
+// FixesDesc describes the wl_fixes interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var FixesDesc = &wire.InterfaceDesc{
+	Name:    FixesInterface,
+	Version: FixesVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "destroy_registry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "registry",
+					Type:      wire.ArgObject,
+					Interface: "wl_registry",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // This global fixes problems with other core-protocol interfaces that
 // cannot be fixed in these interfaces themselves.
 type Fixes struct {
@@ -7215,6 +14945,14 @@ func (obj *Fixes) State() wire.State {
 
 func (obj *Fixes) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(FixesInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_fixes",
 		Type:      "event",
@@ -7256,11 +14994,7 @@ func (obj *Fixes) Version() uint32 {
 }
 
 func (obj *Fixes) Destroy() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "destroy"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(FixesDestroyRequest{}.Encode(obj))
 	return
 }
 
@@ -7274,12 +15008,93 @@ func (obj *Fixes) Destroy() {
 // client should re-use the object ID once it receives the
 // wl_display.delete_id event.
 func (obj *Fixes) DestroyRegistry(registry *Registry) {
-	builder := wire.NewMessage(obj, 1)
+	obj.state.Enqueue(FixesDestroyRegistryRequest{
+		Registry: registry,
+	}.Encode(obj))
+	return
+}
+
+// FixesDestroyRequest holds the arguments of the destroy request of
+// the wl_fixes interface.
+type FixesDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FixesDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FixesDestroyRequest) Encode(obj *Fixes) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	builder.WriteObject(registry)
+// FixesDestroyRegistryRequest holds the arguments of the destroy_registry request of
+// the wl_fixes interface.
+type FixesDestroyRegistryRequest struct {
+	Registry *Registry
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FixesDestroyRegistryRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Registry = wire.ResolveObject[*Registry](msg, state, RegistryInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FixesDestroyRegistryRequest) Encode(obj *Fixes) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Registry)
 
 	builder.Method = "destroy_registry"
-	builder.Args = []any{registry}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Registry}
+	}
+	return builder
+}
+
+func init() {
+	wire.RegisterInterface(DisplayDesc, true)
+	wire.RegisterInterface(RegistryDesc, true)
+	wire.RegisterInterface(CallbackDesc, true)
+	wire.RegisterInterface(CompositorDesc, true)
+	wire.RegisterInterface(ShmPoolDesc, true)
+	wire.RegisterInterface(ShmDesc, true)
+	wire.RegisterInterface(BufferDesc, true)
+	wire.RegisterInterface(DataOfferDesc, true)
+	wire.RegisterInterface(DataSourceDesc, true)
+	wire.RegisterInterface(DataDeviceDesc, true)
+	wire.RegisterInterface(DataDeviceManagerDesc, true)
+	wire.RegisterInterface(ShellDesc, true)
+	wire.RegisterInterface(ShellSurfaceDesc, true)
+	wire.RegisterInterface(SurfaceDesc, true)
+	wire.RegisterInterface(SeatDesc, true)
+	wire.RegisterInterface(PointerDesc, true)
+	wire.RegisterInterface(KeyboardDesc, true)
+	wire.RegisterInterface(TouchDesc, true)
+	wire.RegisterInterface(OutputDesc, true)
+	wire.RegisterInterface(RegionDesc, true)
+	wire.RegisterInterface(SubcompositorDesc, true)
+	wire.RegisterInterface(SubsurfaceDesc, true)
+	wire.RegisterInterface(FixesDesc, true)
 }
@@ -0,0 +1,544 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+// Package wltest provides mock listeners for testing code that uses
+// the wayland protocol bindings in package wl. The listeners
+// record the messages that they receive so that they can be checked
+// against expectations.
+package wltest
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/pointer"
+	"deedles.dev/wl/wire"
+	"deedles.dev/wl/wire/wiretest"
+	"os"
+	"time"
+)
+
+// DisplayListener is a [wl.DisplayListener] that records the
+// events that it receives.
+type DisplayListener struct {
+	wiretest.Recorder[wl.DisplayEvent]
+}
+
+var _ wl.DisplayListener = (*DisplayListener)(nil)
+
+func (lis *DisplayListener) Error(objectId uint32, code uint32, message string) {
+	lis.Record(wl.DisplayErrorEvent{
+		ObjectId: objectId,
+		Code:     code,
+		Message:  message,
+	})
+}
+
+func (lis *DisplayListener) DeleteId(id uint32) {
+	lis.Record(wl.DisplayDeleteIdEvent{
+		Id: id,
+	})
+}
+
+// RegistryListener is a [wl.RegistryListener] that records the
+// events that it receives.
+type RegistryListener struct {
+	wiretest.Recorder[wl.RegistryEvent]
+}
+
+var _ wl.RegistryListener = (*RegistryListener)(nil)
+
+func (lis *RegistryListener) Global(name uint32, _interface string, version uint32) {
+	lis.Record(wl.RegistryGlobalEvent{
+		Name:      name,
+		Interface: _interface,
+		Version:   version,
+	})
+}
+
+func (lis *RegistryListener) GlobalRemove(name uint32) {
+	lis.Record(wl.RegistryGlobalRemoveEvent{
+		Name: name,
+	})
+}
+
+// CallbackListener is a [wl.CallbackListener] that records the
+// events that it receives.
+type CallbackListener struct {
+	wiretest.Recorder[wl.CallbackEvent]
+}
+
+var _ wl.CallbackListener = (*CallbackListener)(nil)
+
+func (lis *CallbackListener) Done(callbackData uint32) {
+	lis.Record(wl.CallbackDoneEvent{
+		CallbackData: callbackData,
+	})
+}
+
+// ShmListener is a [wl.ShmListener] that records the
+// events that it receives.
+type ShmListener struct {
+	wiretest.Recorder[wl.ShmEvent]
+}
+
+var _ wl.ShmListener = (*ShmListener)(nil)
+
+func (lis *ShmListener) Format(format wl.ShmFormat) {
+	lis.Record(wl.ShmFormatEvent{
+		Format: format,
+	})
+}
+
+// BufferListener is a [wl.BufferListener] that records the
+// events that it receives.
+type BufferListener struct {
+	wiretest.Recorder[wl.BufferEvent]
+}
+
+var _ wl.BufferListener = (*BufferListener)(nil)
+
+func (lis *BufferListener) Release() {
+	lis.Record(wl.BufferReleaseEvent{})
+}
+
+// DataOfferListener is a [wl.DataOfferListener] that records the
+// events that it receives.
+type DataOfferListener struct {
+	wiretest.Recorder[wl.DataOfferEvent]
+}
+
+var _ wl.DataOfferListener = (*DataOfferListener)(nil)
+
+func (lis *DataOfferListener) Offer(mimeType string) {
+	lis.Record(wl.DataOfferOfferEvent{
+		MimeType: mimeType,
+	})
+}
+
+func (lis *DataOfferListener) SourceActions(sourceActions wl.DataDeviceManagerDndAction) {
+	lis.Record(wl.DataOfferSourceActionsEvent{
+		SourceActions: sourceActions,
+	})
+}
+
+func (lis *DataOfferListener) Action(dndAction wl.DataDeviceManagerDndAction) {
+	lis.Record(wl.DataOfferActionEvent{
+		DndAction: dndAction,
+	})
+}
+
+// DataSourceListener is a [wl.DataSourceListener] that records the
+// events that it receives.
+type DataSourceListener struct {
+	wiretest.Recorder[wl.DataSourceEvent]
+}
+
+var _ wl.DataSourceListener = (*DataSourceListener)(nil)
+
+func (lis *DataSourceListener) Target(mimeType *string) {
+	lis.Record(wl.DataSourceTargetEvent{
+		MimeType: mimeType,
+	})
+}
+
+func (lis *DataSourceListener) Send(mimeType string, fd *os.File) {
+	lis.Record(wl.DataSourceSendEvent{
+		MimeType: mimeType,
+		Fd:       fd,
+	})
+}
+
+func (lis *DataSourceListener) Cancelled() {
+	lis.Record(wl.DataSourceCancelledEvent{})
+}
+
+func (lis *DataSourceListener) DndDropPerformed() {
+	lis.Record(wl.DataSourceDndDropPerformedEvent{})
+}
+
+func (lis *DataSourceListener) DndFinished() {
+	lis.Record(wl.DataSourceDndFinishedEvent{})
+}
+
+func (lis *DataSourceListener) Action(dndAction wl.DataDeviceManagerDndAction) {
+	lis.Record(wl.DataSourceActionEvent{
+		DndAction: dndAction,
+	})
+}
+
+// DataDeviceListener is a [wl.DataDeviceListener] that records the
+// events that it receives.
+type DataDeviceListener struct {
+	wiretest.Recorder[wl.DataDeviceEvent]
+}
+
+var _ wl.DataDeviceListener = (*DataDeviceListener)(nil)
+
+func (lis *DataDeviceListener) DataOffer(id *wl.DataOffer) {
+	lis.Record(wl.DataDeviceDataOfferEvent{
+		Id: id,
+	})
+}
+
+func (lis *DataDeviceListener) Enter(serial uint32, surface *wl.Surface, x wire.Fixed, y wire.Fixed, id *wl.DataOffer) {
+	lis.Record(wl.DataDeviceEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		X:       x,
+		Y:       y,
+		Id:      id,
+	})
+}
+
+func (lis *DataDeviceListener) Leave() {
+	lis.Record(wl.DataDeviceLeaveEvent{})
+}
+
+func (lis *DataDeviceListener) Motion(time time.Duration, x wire.Fixed, y wire.Fixed) {
+	lis.Record(wl.DataDeviceMotionEvent{
+		Time: time,
+		X:    x,
+		Y:    y,
+	})
+}
+
+func (lis *DataDeviceListener) Drop() {
+	lis.Record(wl.DataDeviceDropEvent{})
+}
+
+func (lis *DataDeviceListener) Selection(id *wl.DataOffer) {
+	lis.Record(wl.DataDeviceSelectionEvent{
+		Id: id,
+	})
+}
+
+// ShellSurfaceListener is a [wl.ShellSurfaceListener] that records the
+// events that it receives.
+type ShellSurfaceListener struct {
+	wiretest.Recorder[wl.ShellSurfaceEvent]
+}
+
+var _ wl.ShellSurfaceListener = (*ShellSurfaceListener)(nil)
+
+func (lis *ShellSurfaceListener) Ping(serial uint32) {
+	lis.Record(wl.ShellSurfacePingEvent{
+		Serial: serial,
+	})
+}
+
+func (lis *ShellSurfaceListener) Configure(edges wl.ShellSurfaceResize, width int32, height int32) {
+	lis.Record(wl.ShellSurfaceConfigureEvent{
+		Edges:  edges,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *ShellSurfaceListener) PopupDone() {
+	lis.Record(wl.ShellSurfacePopupDoneEvent{})
+}
+
+// SurfaceListener is a [wl.SurfaceListener] that records the
+// events that it receives.
+type SurfaceListener struct {
+	wiretest.Recorder[wl.SurfaceEvent]
+}
+
+var _ wl.SurfaceListener = (*SurfaceListener)(nil)
+
+func (lis *SurfaceListener) Enter(output *wl.Output) {
+	lis.Record(wl.SurfaceEnterEvent{
+		Output: output,
+	})
+}
+
+func (lis *SurfaceListener) Leave(output *wl.Output) {
+	lis.Record(wl.SurfaceLeaveEvent{
+		Output: output,
+	})
+}
+
+func (lis *SurfaceListener) PreferredBufferScale(factor int32) {
+	lis.Record(wl.SurfacePreferredBufferScaleEvent{
+		Factor: factor,
+	})
+}
+
+func (lis *SurfaceListener) PreferredBufferTransform(transform wl.OutputTransform) {
+	lis.Record(wl.SurfacePreferredBufferTransformEvent{
+		Transform: transform,
+	})
+}
+
+// SeatListener is a [wl.SeatListener] that records the
+// events that it receives.
+type SeatListener struct {
+	wiretest.Recorder[wl.SeatEvent]
+}
+
+var _ wl.SeatListener = (*SeatListener)(nil)
+
+func (lis *SeatListener) Capabilities(capabilities wl.SeatCapability) {
+	lis.Record(wl.SeatCapabilitiesEvent{
+		Capabilities: capabilities,
+	})
+}
+
+func (lis *SeatListener) Name(name string) {
+	lis.Record(wl.SeatNameEvent{
+		Name: name,
+	})
+}
+
+// PointerListener is a [wl.PointerListener] that records the
+// events that it receives.
+type PointerListener struct {
+	wiretest.Recorder[wl.PointerEvent]
+}
+
+var _ wl.PointerListener = (*PointerListener)(nil)
+
+func (lis *PointerListener) Enter(serial uint32, surface *wl.Surface, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	lis.Record(wl.PointerEnterEvent{
+		Serial:   serial,
+		Surface:  surface,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	})
+}
+
+func (lis *PointerListener) Leave(serial uint32, surface *wl.Surface) {
+	lis.Record(wl.PointerLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	})
+}
+
+func (lis *PointerListener) Motion(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	lis.Record(wl.PointerMotionEvent{
+		Time:     time,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	})
+}
+
+func (lis *PointerListener) Button(serial uint32, time time.Duration, button pointer.Button, state wl.PointerButtonState) {
+	lis.Record(wl.PointerButtonEvent{
+		Serial: serial,
+		Time:   time,
+		Button: button,
+		State:  state,
+	})
+}
+
+func (lis *PointerListener) Axis(time time.Duration, axis wl.PointerAxis, value wire.Fixed) {
+	lis.Record(wl.PointerAxisEvent{
+		Time:  time,
+		Axis:  axis,
+		Value: value,
+	})
+}
+
+func (lis *PointerListener) Frame() {
+	lis.Record(wl.PointerFrameEvent{})
+}
+
+func (lis *PointerListener) AxisSource(axisSource wl.PointerAxisSource) {
+	lis.Record(wl.PointerAxisSourceEvent{
+		AxisSource: axisSource,
+	})
+}
+
+func (lis *PointerListener) AxisStop(time time.Duration, axis wl.PointerAxis) {
+	lis.Record(wl.PointerAxisStopEvent{
+		Time: time,
+		Axis: axis,
+	})
+}
+
+func (lis *PointerListener) AxisDiscrete(axis wl.PointerAxis, discrete int32) {
+	lis.Record(wl.PointerAxisDiscreteEvent{
+		Axis:     axis,
+		Discrete: discrete,
+	})
+}
+
+func (lis *PointerListener) AxisValue120(axis wl.PointerAxis, value120 int32) {
+	lis.Record(wl.PointerAxisValue120Event{
+		Axis:     axis,
+		Value120: value120,
+	})
+}
+
+func (lis *PointerListener) AxisRelativeDirection(axis wl.PointerAxis, direction wl.PointerAxisRelativeDirection) {
+	lis.Record(wl.PointerAxisRelativeDirectionEvent{
+		Axis:      axis,
+		Direction: direction,
+	})
+}
+
+// KeyboardListener is a [wl.KeyboardListener] that records the
+// events that it receives.
+type KeyboardListener struct {
+	wiretest.Recorder[wl.KeyboardEvent]
+}
+
+var _ wl.KeyboardListener = (*KeyboardListener)(nil)
+
+func (lis *KeyboardListener) Keymap(format wl.KeyboardKeymapFormat, fd *os.File, size uint32) {
+	lis.Record(wl.KeyboardKeymapEvent{
+		Format: format,
+		Fd:     fd,
+		Size:   size,
+	})
+}
+
+func (lis *KeyboardListener) Enter(serial uint32, surface *wl.Surface, keys []uint32) {
+	lis.Record(wl.KeyboardEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		Keys:    keys,
+	})
+}
+
+func (lis *KeyboardListener) Leave(serial uint32, surface *wl.Surface) {
+	lis.Record(wl.KeyboardLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	})
+}
+
+func (lis *KeyboardListener) Key(serial uint32, time time.Duration, key uint32, state wl.KeyboardKeyState) {
+	lis.Record(wl.KeyboardKeyEvent{
+		Serial: serial,
+		Time:   time,
+		Key:    key,
+		State:  state,
+	})
+}
+
+func (lis *KeyboardListener) Modifiers(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32) {
+	lis.Record(wl.KeyboardModifiersEvent{
+		Serial:        serial,
+		ModsDepressed: modsDepressed,
+		ModsLatched:   modsLatched,
+		ModsLocked:    modsLocked,
+		Group:         group,
+	})
+}
+
+func (lis *KeyboardListener) RepeatInfo(rate int32, delay int32) {
+	lis.Record(wl.KeyboardRepeatInfoEvent{
+		Rate:  rate,
+		Delay: delay,
+	})
+}
+
+// TouchListener is a [wl.TouchListener] that records the
+// events that it receives.
+type TouchListener struct {
+	wiretest.Recorder[wl.TouchEvent]
+}
+
+var _ wl.TouchListener = (*TouchListener)(nil)
+
+func (lis *TouchListener) Down(serial uint32, time time.Duration, surface *wl.Surface, id int32, x wire.Fixed, y wire.Fixed) {
+	lis.Record(wl.TouchDownEvent{
+		Serial:  serial,
+		Time:    time,
+		Surface: surface,
+		Id:      id,
+		X:       x,
+		Y:       y,
+	})
+}
+
+func (lis *TouchListener) Up(serial uint32, time time.Duration, id int32) {
+	lis.Record(wl.TouchUpEvent{
+		Serial: serial,
+		Time:   time,
+		Id:     id,
+	})
+}
+
+func (lis *TouchListener) Motion(time time.Duration, id int32, x wire.Fixed, y wire.Fixed) {
+	lis.Record(wl.TouchMotionEvent{
+		Time: time,
+		Id:   id,
+		X:    x,
+		Y:    y,
+	})
+}
+
+func (lis *TouchListener) Frame() {
+	lis.Record(wl.TouchFrameEvent{})
+}
+
+func (lis *TouchListener) Cancel() {
+	lis.Record(wl.TouchCancelEvent{})
+}
+
+func (lis *TouchListener) Shape(id int32, major wire.Fixed, minor wire.Fixed) {
+	lis.Record(wl.TouchShapeEvent{
+		Id:    id,
+		Major: major,
+		Minor: minor,
+	})
+}
+
+func (lis *TouchListener) Orientation(id int32, orientation wire.Fixed) {
+	lis.Record(wl.TouchOrientationEvent{
+		Id:          id,
+		Orientation: orientation,
+	})
+}
+
+// OutputListener is a [wl.OutputListener] that records the
+// events that it receives.
+type OutputListener struct {
+	wiretest.Recorder[wl.OutputEvent]
+}
+
+var _ wl.OutputListener = (*OutputListener)(nil)
+
+func (lis *OutputListener) Geometry(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel wl.OutputSubpixel, make string, model string, transform wl.OutputTransform) {
+	lis.Record(wl.OutputGeometryEvent{
+		X:              x,
+		Y:              y,
+		PhysicalWidth:  physicalWidth,
+		PhysicalHeight: physicalHeight,
+		Subpixel:       subpixel,
+		Make:           make,
+		Model:          model,
+		Transform:      transform,
+	})
+}
+
+func (lis *OutputListener) Mode(flags wl.OutputMode, width int32, height int32, refresh int32) {
+	lis.Record(wl.OutputModeEvent{
+		Flags:   flags,
+		Width:   width,
+		Height:  height,
+		Refresh: refresh,
+	})
+}
+
+func (lis *OutputListener) Done() {
+	lis.Record(wl.OutputDoneEvent{})
+}
+
+func (lis *OutputListener) Scale(factor int32) {
+	lis.Record(wl.OutputScaleEvent{
+		Factor: factor,
+	})
+}
+
+func (lis *OutputListener) Name(name string) {
+	lis.Record(wl.OutputNameEvent{
+		Name: name,
+	})
+}
+
+func (lis *OutputListener) Description(description string) {
+	lis.Record(wl.OutputDescriptionEvent{
+		Description: description,
+	})
+}
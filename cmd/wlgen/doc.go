@@ -0,0 +1,106 @@
+// wlgen generates Go bindings from Wayland protocol specification
+// files.
+//
+// Usage:
+//
+//	wlgen [-lint] [-client] [-shared | -mock] -xml protocol.xml [-xml other.xml ...] [-templates pattern ...]
+//	      [-version interface=version ...] [-interface interface ...]
+//
+// Each XML file is configured by a file of the same name with a .conf
+// extension. See the files in the protocol directory of this module for
+// examples.
+//
+// The version directive, as in "version wl_seat 7", caps the version
+// of an interface. Messages, enums and enum entries that were added in
+// later versions are left out of the generated code. The interfaces
+// directive, as in "interfaces wl_display wl_registry", restricts the
+// interfaces of a protocol that are generated to those listed, and may
+// be repeated. The -version and -interface flags do the same for every
+// protocol being generated, with -interface restricting the interfaces
+// of all of them. Interfaces that are generated may not refer to ones
+// that are left out.
+//
+// With -lint, wlgen checks the XML files instead of generating code and
+// prints a diagnostic with the file and line of each problem that it
+// finds. Along with anything that would prevent generation, such as
+// references to undefined enums, Go names that would be generated more
+// than once, or messages that exceed the maximum message size even
+// with empty strings and arrays, it warns about likely mistakes, such
+// as enum entries that are missing since attributes and argument names
+// that are Go keywords. It exits with a non-zero status if any errors
+// are found.
+//
+// If a protocol's config contains a shared directive, its enums and
+// interface constants are declared only in the package that the
+// directive names, and the client and server packages declare aliases
+// of them. That package is generated by running wlgen with -shared.
+//
+// If a protocol's config contains a mock directive, running wlgen with
+// -mock generates a package of mock listeners at the path that it
+// gives, one for each interface. The mock listeners record the typed
+// messages that they receive using [deedles.dev/wl/wire/wiretest.Recorder],
+// and can be combined with [deedles.dev/wl/wire/wiretest.State] to test
+// code that uses the bindings without a connection.
+//
+// # Templates
+//
+// Code is generated by executing the built-in wlgen.tmpl template.
+// The -templates flag, which may be repeated, gives glob patterns of
+// additional template files that are parsed after the built-in ones.
+// Any template that they define replaces the built-in template with
+// the same name, so output can be customised without forking wlgen.
+// The built-in template defines the following empty blocks
+// specifically so that they can be overridden:
+//
+//	imports    extra import specs, executed with the Context
+//	interface  extra code after each interface, executed with its protocol.Interface
+//	message    extra code after each message type, executed with its Message
+//	footer     extra code at the end of the file, executed with the Context
+//
+// The enums template, which generates the enums of a protocol.Interface,
+// and the messageDescs template, which generates the []wire.MessageDesc
+// for a slice of protocol.Op, may be overridden in the same way, as may
+// shared.tmpl and mock.tmpl, which generate the packages used with
+// -shared and -mock. User templates may also define their own helper
+// templates and call them with the template action or the partial
+// function.
+//
+// # Data Model
+//
+// The top-level template is executed with a Context, which holds the
+// protocol.Protocol being generated, its Config, and whether client or
+// server code is being generated. The Context is also returned by the
+// context function, so it is available from inside of any template no
+// matter what data it was executed with. The protocol package
+// documents the types that the protocol is decoded into.
+//
+// Along with the standard text/template functions, templates may call
+// the following:
+//
+//	context                       the Context
+//	base path                     the last element of an import path
+//	ident name                    Go name of the interface name, qualified if it is imported
+//	camel, snake                  convert between snake_case and CamelCase
+//	export, unexport              change the case of the first letter
+//	unkeyword                     prefix Go keywords with an underscore
+//	trimSpace, trimLines          trim whitespace from a string or each of its lines
+//	comment text                  text as a line comment
+//	doc text since deprecated     like comment, with version notes appended
+//	listeners iface, senders iface    the ops received and sent by the side being generated
+//	messages iface                the Message for each op of iface, incoming first
+//	messageType iface op isEvent  name of the generated message type of op
+//	incoming                      "Event" for clients and "Request" for servers
+//	args op, returns op           arguments of op that are and are not returned new objects
+//	isRet arg                     whether arg is a returned new object
+//	fdCount op                    number of file descriptor arguments of op
+//	uniqueEntries enum            entries of enum, leaving out those with a value already used
+//	goType arg, argType arg       the wire-level Go type and wire.ArgType of arg
+//	typeFuncSuffix arg            the suffix of arg's Read and Write methods
+//	paramType iface op arg        the Go type used for arg in the generated API
+//	decodeArg iface op arg expr   expr converted from arg's wire type to its parameter type
+//	encodeArg iface op arg expr   the inverse of decodeArg
+//	overridden iface op arg       whether arg's type is overridden by the config
+//	enumType iface enum           Go name of an enum referenced from iface
+//	package name, trimPackage name    split a qualified Go name
+//	partial name data             the output of the named template as a string
+package main
@@ -12,12 +12,20 @@ import (
 )
 
 func (ctx Context) ident(v string) string {
+	if src, ok := ctx.Interfaces[v]; ok {
+		// The interface belongs to one of the protocols being generated,
+		// so its package is known exactly.
+		name := ctx.export(ctx.camel(strings.TrimPrefix(v, src.Config.Prefix)))
+		if (src.Protocol.Name == ctx.Protocol.Name) && !ctx.IsMock {
+			return name
+		}
+		return ctx.Config.Imports[src.Config.Path].Name + "." + name
+	}
+
 	var pkg string
 	v, ok := strings.CutPrefix(v, ctx.Config.Prefix)
 	if !ok {
 		for _, i := range ctx.Config.Imports {
-			// TODO: Figure out how to make this work with multiple
-			// protocols with the same prefix.
 			v, ok = strings.CutPrefix(v, i.Prefix)
 			if ok {
 				pkg = i.Name + "."
@@ -116,6 +124,70 @@ func (ctx Context) senders(i protocol.Interface) []protocol.Op {
 	return i.Events
 }
 
+// Message describes a single request or event for the purposes of
+// generating its message type.
+type Message struct {
+	// Interface is the interface that the message belongs to.
+	Interface protocol.Interface
+
+	// Op is the opcode of the message and Msg is its definition.
+	Op  int
+	Msg protocol.Op
+
+	// Type is the name of the generated message type.
+	Type string
+
+	// IsEvent is true if the message is an event and false if it is a
+	// request. Incoming is true if the message is received, rather
+	// than sent, by the side being generated.
+	IsEvent  bool
+	Incoming bool
+}
+
+// messages returns descriptions of all of the requests and events of
+// i, with incoming messages first.
+func (ctx Context) messages(i protocol.Interface) []Message {
+	var msgs []Message
+	for op, msg := range ctx.listeners(i) {
+		msgs = append(msgs, Message{
+			Interface: i,
+			Op:        op,
+			Msg:       msg,
+			Type:      ctx.messageType(i, msg, ctx.IsClient),
+			IsEvent:   ctx.IsClient,
+			Incoming:  true,
+		})
+	}
+	for op, msg := range ctx.senders(i) {
+		msgs = append(msgs, Message{
+			Interface: i,
+			Op:        op,
+			Msg:       msg,
+			Type:      ctx.messageType(i, msg, !ctx.IsClient),
+			IsEvent:   !ctx.IsClient,
+		})
+	}
+	return msgs
+}
+
+// messageType returns the name of the type generated for a message.
+func (ctx Context) messageType(i protocol.Interface, msg protocol.Op, isEvent bool) string {
+	suffix := "Request"
+	if isEvent {
+		suffix = "Event"
+	}
+	return ctx.ident(i.Name) + ctx.export(ctx.camel(msg.Name)) + suffix
+}
+
+// incoming returns the suffix used for types relating to incoming
+// messages.
+func (ctx Context) incoming() string {
+	if ctx.IsClient {
+		return "Event"
+	}
+	return "Request"
+}
+
 func (ctx Context) goType(arg protocol.Arg) (string, error) {
 	switch arg.Type {
 	case "uint":
@@ -135,6 +207,9 @@ func (ctx Context) goType(arg protocol.Arg) (string, error) {
 		}
 		return "*" + ctx.ident(arg.Interface), nil
 	case "string":
+		if arg.AllowNull {
+			return "*string", nil
+		}
 		return "string", nil
 	case "array":
 		return "[]byte", nil
@@ -145,6 +220,29 @@ func (ctx Context) goType(arg protocol.Arg) (string, error) {
 	}
 }
 
+func (ctx Context) argType(arg protocol.Arg) (string, error) {
+	switch arg.Type {
+	case "int":
+		return "wire.ArgInt", nil
+	case "uint":
+		return "wire.ArgUint", nil
+	case "fixed":
+		return "wire.ArgFixed", nil
+	case "string":
+		return "wire.ArgString", nil
+	case "object":
+		return "wire.ArgObject", nil
+	case "new_id":
+		return "wire.ArgNewID", nil
+	case "array":
+		return "wire.ArgArray", nil
+	case "fd":
+		return "wire.ArgFD", nil
+	default:
+		return "", fmt.Errorf("unknown type: %q", arg.Type)
+	}
+}
+
 func (ctx Context) typeFuncSuffix(arg protocol.Arg) (string, error) {
 	switch arg.Type {
 	case "uint":
@@ -154,16 +252,22 @@ func (ctx Context) typeFuncSuffix(arg protocol.Arg) (string, error) {
 	case "fixed":
 		return "Fixed", nil
 	case "object":
-		if arg.Interface != "" {
-			return "Object", nil
+		if arg.Interface == "" {
+			return "Uint", nil
 		}
-		return "Uint", nil
+		if arg.AllowNull {
+			return "NullableObject", nil
+		}
+		return "Object", nil
 	case "new_id":
 		if arg.Interface == "" {
 			return "NewID", nil
 		}
 		return "Uint", nil
 	case "string":
+		if arg.AllowNull {
+			return "NullableString", nil
+		}
 		return "String", nil
 	case "array":
 		return "Array", nil
@@ -195,6 +299,23 @@ func (ctx Context) comment(v string) string {
 	return sb.String()
 }
 
+// doc returns the doc comment for a declaration generated from an
+// element of the protocol, with notes about the versions that it was
+// added and deprecated in appended to its description.
+func (ctx Context) doc(text string, since, deprecated int) string {
+	paras := []string{ctx.trimLines(strings.TrimSpace(text))}
+	if since > 1 {
+		paras = append(paras, fmt.Sprintf("Available since version %v.", since))
+	}
+	if deprecated > 0 {
+		paras = append(paras, fmt.Sprintf("Deprecated: Deprecated since version %v.", deprecated))
+	}
+	if paras[0] == "" {
+		paras = paras[1:]
+	}
+	return ctx.comment(strings.Join(paras, "\n\n"))
+}
+
 func (ctx Context) partial(name string, data any) (string, error) {
 	var sb strings.Builder
 	err := ctx.T.ExecuteTemplate(&sb, name, data)
@@ -213,6 +334,23 @@ func (ctx Context) isRet(arg protocol.Arg) bool {
 	return (arg.Type == "new_id") && (arg.Interface != "")
 }
 
+func (ctx Context) fdCount(op protocol.Op) int {
+	return len(xslices.Filter(op.Args, func(arg protocol.Arg) bool { return arg.Type == "fd" }))
+}
+
+// uniqueEntries returns the entries of enum with the first of the
+// entries that share each value, as a switch may only have one case for
+// each value.
+func (ctx Context) uniqueEntries(enum protocol.Enum) []protocol.Entry {
+	seen := make(map[int]struct{}, len(enum.Entries))
+	return xslices.Filter(enum.Entries, func(entry protocol.Entry) bool {
+		v, _ := entry.Int()
+		_, ok := seen[v]
+		seen[v] = struct{}{}
+		return !ok
+	})
+}
+
 func (ctx Context) pkg(v string) string {
 	before, _, ok := strings.Cut(v, ".")
 	if ok {
@@ -237,3 +375,69 @@ func (ctx Context) enumType(inter, v string) string {
 	}
 	return ctx.ident(inter) + ctx.export(ctx.camel(v))
 }
+
+// override returns the type override for an argument, if there is
+// one.
+func (ctx Context) override(inter protocol.Interface, op protocol.Op, arg protocol.Arg) (TypeOverride, bool, error) {
+	o, ok := ctx.Config.Types[inter.Name+"."+op.Name+"."+arg.Name]
+	if ok && ((arg.Type == "object") || (arg.Type == "new_id")) {
+		return o, ok, fmt.Errorf("%v.%v.%v: the types of %v arguments can not be overridden", inter.Name, op.Name, arg.Name, arg.Type)
+	}
+	return o, ok, nil
+}
+
+func (ctx Context) overridden(inter protocol.Interface, op protocol.Op, arg protocol.Arg) (bool, error) {
+	_, ok, err := ctx.override(inter, op, arg)
+	return ok, err
+}
+
+// paramType returns the Go type used for an argument in generated
+// APIs, taking into account enums and type overrides.
+func (ctx Context) paramType(inter protocol.Interface, op protocol.Op, arg protocol.Arg) (string, error) {
+	o, ok, err := ctx.override(inter, op, arg)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return o.Type, nil
+	}
+
+	if arg.Enum != "" {
+		return ctx.enumType(inter.Name, arg.Enum), nil
+	}
+	return ctx.goType(arg)
+}
+
+// decodeArg returns an expression that converts expr, the value of an
+// argument as read from a message, to the argument's parameter type.
+func (ctx Context) decodeArg(inter protocol.Interface, op protocol.Op, arg protocol.Arg, expr string) (string, error) {
+	o, ok, err := ctx.override(inter, op, arg)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case ok && (o.Decode != ""):
+		return o.Decode + "(" + expr + ")", nil
+	case ok:
+		return "(" + o.Type + ")(" + expr + ")", nil
+	case arg.Enum != "":
+		return ctx.enumType(inter.Name, arg.Enum) + "(" + expr + ")", nil
+	}
+	return expr, nil
+}
+
+// encodeArg is the inverse of decodeArg.
+func (ctx Context) encodeArg(inter protocol.Interface, op protocol.Op, arg protocol.Arg, expr string) (string, error) {
+	o, ok, err := ctx.override(inter, op, arg)
+	if err != nil {
+		return "", err
+	}
+	if ok && (o.Encode != "") {
+		return o.Encode + "(" + expr + ")", nil
+	}
+	if ok || (arg.Enum != "") {
+		t, err := ctx.goType(arg)
+		return t + "(" + expr + ")", err
+	}
+	return expr, nil
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+)
+
+// objectMembers are the names of the fields and methods that every
+// generated object type has regardless of its messages.
+var objectMembers = []string{
+	"Listener", "OnDelete",
+	"State", "Dispatch", "ID", "SetID", "Delete", "String",
+	"MethodName", "Interface", "Subscribe", "Version",
+}
+
+// diagnostic is a problem found by the linter.
+type diagnostic struct {
+	pos      position
+	severity string
+	msg      string
+}
+
+func (d diagnostic) String() string {
+	return fmt.Sprintf("%v: %v: %v", d.pos, d.severity, d.msg)
+}
+
+// position is the location of an element in an XML file.
+type position struct {
+	file      string
+	line, col int
+}
+
+func (p position) String() string {
+	return fmt.Sprintf("%v:%v:%v", p.file, p.line, p.col)
+}
+
+// elementPositions returns the positions of the elements of the
+// protocol in the XML file at path, keyed by their element paths as
+// used by protocol.Error joined with slashes.
+func elementPositions(path string) (map[string]position, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	positions := make(map[string]position)
+	var stack []string
+	var tracked []bool
+
+	d := xml.NewDecoder(file)
+	for {
+		line, col := d.InputPos()
+		tok, err := d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return positions, nil
+			}
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			switch tok.Name.Local {
+			case "protocol":
+				positions[""] = position{file: path, line: line, col: col}
+			case "interface", "request", "event", "enum", "entry", "arg":
+				var name string
+				for _, attr := range tok.Attr {
+					if attr.Name.Local == "name" {
+						name = attr.Value
+					}
+				}
+
+				stack = append(stack, tok.Name.Local+":"+name)
+				tracked = append(tracked, true)
+				key := strings.Join(stack, "/")
+				if _, ok := positions[key]; !ok {
+					positions[key] = position{file: path, line: line, col: col}
+				}
+				continue
+			}
+			tracked = append(tracked, false)
+
+		case xml.EndElement:
+			if len(tracked) == 0 {
+				continue
+			}
+			if tracked[len(tracked)-1] {
+				stack = stack[:len(stack)-1]
+			}
+			tracked = tracked[:len(tracked)-1]
+		}
+	}
+}
+
+// elementName returns the names in an element path joined with dots.
+func elementName(path []string) string {
+	names := make([]string, 0, len(path))
+	for _, e := range path {
+		_, name, _ := strings.Cut(e, ":")
+		names = append(names, name)
+	}
+	return strings.Join(names, ".")
+}
+
+// linter checks a protocol for problems, both ones that prevent code
+// from being generated and ones that are likely to be mistakes.
+type linter struct {
+	ctx       Context
+	positions map[string]position
+	diags     []diagnostic
+}
+
+// report adds a diagnostic for the element at path. If the element's
+// position is not known, that of its closest known parent is used
+// instead.
+func (l *linter) report(severity string, path []string, format string, args ...any) {
+	var pos position
+	for i := len(path); i >= 0; i-- {
+		p, ok := l.positions[strings.Join(path[:i], "/")]
+		if ok {
+			pos = p
+			break
+		}
+	}
+
+	l.diags = append(l.diags, diagnostic{
+		pos:      pos,
+		severity: severity,
+		msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+// lint checks src, which is one of srcs, and returns the problems that
+// it finds sorted by position.
+func lint(xmlfile string, src *Source, srcs []*Source, defs map[string]*Source) ([]diagnostic, error) {
+	positions, err := elementPositions(xmlfile)
+	if err != nil {
+		return nil, err
+	}
+
+	l := linter{
+		ctx:       newContext(src, defs, false, false),
+		positions: positions,
+	}
+	l.validate(src, srcs, defs)
+	l.checkNames()
+	for _, i := range src.Protocol.Interfaces {
+		l.checkSince(i)
+		l.checkMessages(i, "request", i.Requests)
+		l.checkMessages(i, "event", i.Events)
+	}
+
+	slices.SortStableFunc(l.diags, func(d1, d2 diagnostic) int {
+		return cmp.Or(cmp.Compare(d1.pos.line, d2.pos.line), cmp.Compare(d1.pos.col, d2.pos.col))
+	})
+	return l.diags, nil
+}
+
+// validate reports the problems found by protocol.Validate as errors.
+func (l *linter) validate(src *Source, srcs []*Source, defs map[string]*Source) {
+	var deps []protocol.Protocol
+	if _, ok := defs["wl_display"]; !ok {
+		deps = append(deps, protocol.Wayland())
+	}
+	for _, src := range srcs {
+		deps = append(deps, src.Protocol)
+	}
+
+	err := src.Protocol.Validate(deps...)
+	if err == nil {
+		return
+	}
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var perr *protocol.Error
+		if errors.As(err, &perr) {
+			l.report("error", perr.Element, "%v", perr)
+			continue
+		}
+		l.report("error", nil, "%v", err)
+	}
+}
+
+// checkSince reports enum entries that are probably missing since
+// attributes. Messages that are missing them after ones that have them
+// are caught by validation, as they must be ordered by version, but the
+// entries of enums need not be.
+func (l *linter) checkSince(i protocol.Interface) {
+	path := []string{"interface:" + i.Name}
+
+	for _, e := range i.Enums {
+		var since int
+		for _, entry := range e.Entries {
+			if (entry.Since == 0) && (since > e.SinceVersion()) {
+				l.report("warning", append(path, "enum:"+e.Name, "entry:"+entry.Name), "entry follows one added in version %v but has no since attribute", since)
+			}
+			since = max(since, entry.Since)
+		}
+	}
+}
+
+// checkMessages reports problems with the messages of one kind of an
+// interface.
+func (l *linter) checkMessages(i protocol.Interface, kind string, ops []protocol.Op) {
+	for _, op := range ops {
+		path := []string{"interface:" + i.Name, kind + ":" + op.Name}
+
+		// Destructor events, such as wl_callback.done, commonly carry
+		// a final value, but the arguments of a destructor request are
+		// usually a mistake.
+		if (kind == "request") && op.IsDestructor() && (len(op.Args) != 0) {
+			l.report("warning", path, "destructor request has arguments")
+		}
+
+		size := 8
+		fields := make(map[string]string)
+		for _, arg := range op.Args {
+			path := append(slices.Clip(path), "arg:"+arg.Name)
+
+			switch arg.Type {
+			case "string":
+				// A length and at least a padded terminating null byte,
+				// or just a zero length if the string is null.
+				size += 8
+				if arg.AllowNull {
+					size -= 4
+				}
+			case "array":
+				size += 4
+			case "new_id":
+				size += 4
+				if arg.Interface == "" {
+					// An interface name and a version precede the ID.
+					size += 12
+				}
+			case "fd":
+			default:
+				size += 4
+			}
+
+			param := l.ctx.unexport(l.ctx.camel(arg.Name))
+			if p := l.ctx.unkeyword(param); p != param {
+				l.report("warning", path, "argument name %q is a Go keyword, so the generated parameter is named %v", arg.Name, p)
+			}
+
+			field := l.ctx.export(l.ctx.camel(arg.Name))
+			if prev, ok := fields[field]; ok {
+				l.report("error", path, "arguments %q and %q are both generated as %v", prev, arg.Name, field)
+			}
+			fields[field] = arg.Name
+		}
+		if size > wire.MaxMessageSize {
+			l.report("error", path, "message is at least %v bytes, which is more than the maximum of %v", size, wire.MaxMessageSize)
+		}
+	}
+}
+
+// checkNames reports Go identifiers that would be generated more than
+// once, both at the top level of the generated package and for the
+// methods of each generated object type.
+func (l *linter) checkNames() {
+	names := make(map[string][]string)
+	declare := func(name string, path ...string) {
+		if prev, ok := names[name]; ok {
+			l.report("error", path, "generated name %v is also generated for %v", name, elementName(prev))
+			return
+		}
+		names[name] = path
+	}
+
+	for _, i := range l.ctx.Protocol.Interfaces {
+		ipath := []string{"interface:" + i.Name}
+		name := l.ctx.ident(i.Name)
+		suffixes := []string{"", "Interface", "Version", "Desc"}
+		if (len(i.Requests) != 0) || (len(i.Events) != 0) {
+			suffixes = append(suffixes, "Listener", "ListenerFuncs")
+		}
+		if len(i.Requests) != 0 {
+			suffixes = append(suffixes, "Request", "RequestFunc")
+		}
+		if len(i.Events) != 0 {
+			suffixes = append(suffixes, "Event", "EventFunc")
+		}
+		for _, suffix := range suffixes {
+			declare(name+suffix, ipath...)
+		}
+		declare("New"+name, ipath...)
+		declare("Bind"+name, ipath...)
+
+		for _, op := range i.Requests {
+			t := l.ctx.messageType(i, op, false)
+			declare(t, "interface:"+i.Name, "request:"+op.Name)
+			declare(t+"Since", "interface:"+i.Name, "request:"+op.Name)
+		}
+		for _, op := range i.Events {
+			t := l.ctx.messageType(i, op, true)
+			declare(t, "interface:"+i.Name, "event:"+op.Name)
+			declare(t+"Since", "interface:"+i.Name, "event:"+op.Name)
+		}
+
+		for _, e := range i.Enums {
+			epath := append(slices.Clip(ipath), "enum:"+e.Name)
+			t := l.ctx.enumType(i.Name, e.Name)
+			declare(t, epath...)
+			for _, entry := range e.Entries {
+				declare(t+l.ctx.export(l.ctx.camel(entry.Name)), append(slices.Clip(epath), "entry:"+entry.Name)...)
+			}
+		}
+
+		l.checkMethods(i, false)
+		l.checkMethods(i, true)
+	}
+}
+
+// checkMethods reports message names that would produce methods with
+// the same name on the generated object type for one side of the
+// protocol.
+func (l *linter) checkMethods(i protocol.Interface, isClient bool) {
+	side := "server"
+	senders, sendKind := i.Events, "event"
+	listeners, listenKind := i.Requests, "request"
+	if isClient {
+		side = "client"
+		senders, sendKind = i.Requests, "request"
+		listeners, listenKind = i.Events, "event"
+	}
+
+	methods := make(map[string]string)
+	for _, m := range objectMembers {
+		methods[m] = "the built-in field or method"
+	}
+
+	check := func(name, kind string, op protocol.Op) {
+		if prev, ok := methods[name]; ok {
+			l.report("error", []string{"interface:" + i.Name, kind + ":" + op.Name}, "%v method %v collides with %v", side, name, prev)
+			return
+		}
+		methods[name] = fmt.Sprintf("the method for %v %q", kind, op.Name)
+	}
+	for _, op := range senders {
+		name := l.ctx.export(l.ctx.camel(op.Name))
+		check(name, sendKind, op)
+		if l.ctx.fdCount(op) != 0 {
+			check(name+"Transfer", sendKind, op)
+		}
+	}
+	for _, op := range listeners {
+		check("On"+l.ctx.export(l.ctx.camel(op.Name)), listenKind, op)
+	}
+}
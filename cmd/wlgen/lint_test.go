@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// lintFiles lints each of xmlfiles as wlgen -lint does, using the
+// config next to each of them, and returns the messages of the
+// diagnostics that it finds, prefixed with their severities.
+func lintFiles(t *testing.T, xmlfiles ...string) []string {
+	srcs := make([]*Source, 0, len(xmlfiles))
+	defs := make(map[string]*Source)
+	for _, xmlfile := range xmlfiles {
+		src, err := loadSource(xmlfile, xmlfile+".conf", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range src.Protocol.Interfaces {
+			defs[i.Name] = src
+		}
+		srcs = append(srcs, src)
+	}
+
+	var msgs []string
+	for i, src := range srcs {
+		diags, err := lint(xmlfiles[i], src, srcs, defs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range diags {
+			msgs = append(msgs, d.severity+": "+d.msg)
+		}
+	}
+	return msgs
+}
+
+// writeProtocol writes a protocol containing body and a config for it
+// to a temporary directory and returns the path of the XML file.
+func writeProtocol(t *testing.T, body string) string {
+	dir := t.TempDir()
+	xmlfile := filepath.Join(dir, "test.xml")
+	err := os.WriteFile(xmlfile, []byte(`<protocol name="test">`+body+`</protocol>`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(xmlfile+".conf", []byte("package test test_\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xmlfile
+}
+
+func TestLintUpstream(t *testing.T) {
+	msgs := lintFiles(t, "../../protocol/wayland.xml", "../../protocol/xdg-shell.xml")
+	expected := []string{
+		`warning: argument name "interface" is a Go keyword, so the generated parameter is named _interface`,
+	}
+	if !slices.Equal(msgs, expected) {
+		t.Fatalf("expected %q but got %q", expected, msgs)
+	}
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Clean",
+			body: `<interface name="test_object" version="2">
+				<request name="set_title"><arg name="title" type="string"/></request>
+				<request name="set_data"><arg name="data" type="array"/></request>
+				<request name="destroy" type="destructor"/>
+				<event name="done" type="destructor"><arg name="serial" type="uint"/></event>
+			</interface>`,
+		},
+		{
+			name: "DestructorArgs",
+			body: `<interface name="test_object" version="1">
+				<request name="destroy" type="destructor"><arg name="serial" type="uint"/></request>
+			</interface>`,
+			expected: []string{"warning: destructor request has arguments"},
+		},
+		{
+			name: "Keyword",
+			body: `<interface name="test_object" version="1">
+				<request name="set"><arg name="type" type="uint"/></request>
+			</interface>`,
+			expected: []string{`warning: argument name "type" is a Go keyword, so the generated parameter is named _type`},
+		},
+		{
+			name: "DuplicateField",
+			body: `<interface name="test_object" version="1">
+				<request name="set"><arg name="foo_bar" type="uint"/><arg name="fooBar" type="uint"/></request>
+			</interface>`,
+			expected: []string{`error: arguments "foo_bar" and "fooBar" are both generated as FooBar`},
+		},
+		{
+			name: "EntrySince",
+			body: `<interface name="test_object" version="2">
+				<enum name="mode">
+					<entry name="a" value="0"/>
+					<entry name="b" value="1" since="2"/>
+					<entry name="c" value="2"/>
+				</enum>
+			</interface>`,
+			expected: []string{"warning: entry follows one added in version 2 but has no since attribute"},
+		},
+		{
+			name: "TransferCollision",
+			body: `<interface name="test_object" version="1">
+				<request name="attach"><arg name="fd" type="fd"/></request>
+				<request name="attach_transfer"><arg name="fd" type="fd"/></request>
+			</interface>`,
+			expected: []string{`error: client method AttachTransfer collides with the method for request "attach"`},
+		},
+		{
+			name: "ListenerCollision",
+			body: `<interface name="test_object" version="1">
+				<request name="on_done"/>
+				<event name="done"/>
+			</interface>`,
+			expected: []string{`error: client method OnDone collides with the method for request "on_done"`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msgs := lintFiles(t, writeProtocol(t, test.body))
+			if !slices.Equal(msgs, test.expected) {
+				t.Fatalf("expected %q but got %q", test.expected, msgs)
+			}
+		})
+	}
+}
+
+func TestLintMessageSize(t *testing.T) {
+	// Each argument is at least 4 bytes, so this is just over the
+	// maximum even with empty strings.
+	var args strings.Builder
+	for i := range 16382 {
+		fmt.Fprintf(&args, `<arg name="s%v" type="string" allow-null="true"/>`, i)
+	}
+	msgs := lintFiles(t, writeProtocol(t, `<interface name="test_object" version="1">
+		<request name="set">`+args.String()+`</request>
+	</interface>`))
+	expected := []string{"error: message is at least 65536 bytes, which is more than the maximum of 65535"}
+	if !slices.Equal(msgs, expected) {
+		t.Fatalf("expected %q but got %q", expected, msgs)
+	}
+}
@@ -4,30 +4,46 @@ import (
 	"bufio"
 	"bytes"
 	"embed"
-	"encoding/xml"
 	"errors"
 	"flag"
 	"fmt"
+	"go/ast"
 	"go/build"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
 	"log"
+	"maps"
 	"os"
+	pathpkg "path"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"deedles.dev/wl/internal/set"
 	"deedles.dev/wl/protocol"
-	"golang.org/x/exp/maps"
 )
 
-const baseTmpl = "wlgen.tmpl"
+const (
+	baseTmpl   = "wlgen.tmpl"
+	sharedTmpl = "shared.tmpl"
+	mockTmpl   = "mock.tmpl"
+)
 
 var (
 	//go:embed *.tmpl
 	tmplFS embed.FS
 )
 
-func parseTemplates(ctx Context) *template.Template {
+// parseTemplates parses the built-in templates followed by the user
+// templates matched by each of the glob patterns in user. Templates
+// defined by user templates replace built-in templates with the same
+// name. The partial and context functions refer to ctx itself, so ctx.T
+// should be set to the returned template set before it is executed.
+func parseTemplates(ctx *Context, user []string) (*template.Template, error) {
 	tmplFuncs := map[string]any{
 		"ident":          ctx.ident,
 		"camel":          ctx.camel,
@@ -40,30 +56,43 @@ func parseTemplates(ctx Context) *template.Template {
 		"senders":        ctx.senders,
 		"goType":         ctx.goType,
 		"typeFuncSuffix": ctx.typeFuncSuffix,
+		"argType":        ctx.argType,
+		"messages":       ctx.messages,
+		"messageType":    ctx.messageType,
+		"incoming":       ctx.incoming,
 		"unkeyword":      ctx.unkeyword,
 		"comment":        ctx.comment,
-		"partial":        ctx.partial,
+		"doc":            ctx.doc,
+		"partial":        func(name string, data any) (string, error) { return ctx.partial(name, data) },
 		"args":           ctx.args,
 		"returns":        ctx.returns,
 		"isRet":          ctx.isRet,
+		"fdCount":        ctx.fdCount,
+		"uniqueEntries":  ctx.uniqueEntries,
 		"package":        ctx.pkg,
 		"trimPackage":    ctx.trimPackage,
 		"enumType":       ctx.enumType,
+		"paramType":      ctx.paramType,
+		"decodeArg":      ctx.decodeArg,
+		"encodeArg":      ctx.encodeArg,
+		"overridden":     ctx.overridden,
+		"base":           pathpkg.Base,
+		"context":        func() Context { return *ctx },
 	}
 
-	return template.Must(template.New(baseTmpl).Funcs(tmplFuncs).ParseFS(tmplFS, "*.tmpl"))
-}
-
-func loadXML(path string) (proto protocol.Protocol, err error) {
-	file, err := os.Open(path)
+	t, err := template.New(baseTmpl).Funcs(tmplFuncs).ParseFS(tmplFS, "*.tmpl")
 	if err != nil {
-		return proto, err
+		return nil, err
+	}
+
+	for _, pattern := range user {
+		t, err = t.ParseGlob(pattern)
+		if err != nil {
+			return nil, err
+		}
 	}
-	defer file.Close()
 
-	d := xml.NewDecoder(file)
-	err = d.Decode(&proto)
-	return proto, err
+	return t, nil
 }
 
 type Import struct {
@@ -71,10 +100,107 @@ type Import struct {
 	Name   string
 }
 
+// TypeOverride replaces the Go type used for an argument.
+type TypeOverride struct {
+	// Type is the Go type to use for the argument.
+	Type string
+
+	// Decode and Encode are the names of functions that convert values
+	// from and to the argument's usual type. If they are empty, a
+	// simple type conversion is used instead.
+	Decode string
+	Encode string
+
+	// Imports are the import paths needed by Type, Decode and Encode.
+	Imports []string
+}
+
+// parseTypeOverride parses the arguments of a type directive. Types
+// and functions from other packages are given by their full import
+// path, such as deedles.dev/wl/pointer.Button.
+func parseTypeOverride(parts []string) (TypeOverride, error) {
+	if (len(parts) != 1) && (len(parts) != 3) {
+		return TypeOverride{}, errors.New("expected a type and optionally decode and encode functions")
+	}
+
+	var o TypeOverride
+	exprs := []*string{&o.Type, &o.Decode, &o.Encode}
+	for i, part := range parts {
+		expr, path := qualify(part)
+		*exprs[i] = expr
+		if path != "" {
+			o.Imports = append(o.Imports, path)
+		}
+	}
+	return o, nil
+}
+
+// qualify splits a type or function name that may contain an import
+// path into a Go expression that refers to it and the import path, if
+// any.
+func qualify(v string) (expr, path string) {
+	name := strings.TrimLeft(v, "[]*")
+	mods := v[:len(v)-len(name)]
+
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 {
+		return v, ""
+	}
+	path = name[:i]
+	return mods + pathpkg.Base(path) + name[i:], path
+}
+
 type Config struct {
 	Package string
 	Prefix  string
 	Imports map[string]Import
+
+	// Types maps arguments, identified as interface.message.arg, to
+	// the types that should be used for them instead of the default.
+	Types map[string]TypeOverride
+
+	// Path is the import path of the package being generated, if known.
+	// It is set by the path directive, which is required when generating
+	// more than one protocol at once.
+	Path string
+
+	// Shared is the import path of the package that the protocol's enums
+	// and interface constants are generated into, if any. It is set by
+	// the shared directive. If it is set, the client and server packages
+	// declare aliases of the shared declarations instead of their own.
+	Shared string
+
+	// Mock is the import path of the package that mock listeners for the
+	// protocol are generated into, if any. It is set by the mock
+	// directive.
+	Mock string
+
+	// Versions caps the versions of interfaces, by name. Messages, enums
+	// and enum entries that were added in later versions are left out.
+	// It is set by the version directive.
+	Versions map[string]int
+
+	// Include is the set of the protocol's interfaces that are
+	// generated, or nil if all of them are. It is set by the interfaces
+	// directive.
+	Include set.Set[string]
+}
+
+// directives gives the number of arguments that each config directive
+// accepts and a description of them. A maximum of -1 means that there
+// is no maximum.
+var directives = map[string]struct {
+	min, max int
+	usage    string
+}{
+	"package":    {1, 2, "package <name> [prefix]"},
+	"path":       {2, 2, "path <server path> <client path>"},
+	"version":    {2, 2, "version <interface> <version>"},
+	"interfaces": {1, -1, "interfaces <interface>..."},
+	"mock":       {2, 2, "mock <server path> <client path>"},
+	"shared":     {1, 1, "shared <path>"},
+	"type":       {2, 4, "type <interface.message.arg> <type> [<decode> <encode>]"},
+	"import":     {3, 4, "import <server path> <client path> <prefix> [name]"},
 }
 
 func loadConfig(path string, isClient bool) (Config, error) {
@@ -85,26 +211,67 @@ func loadConfig(path string, isClient bool) (Config, error) {
 	defer file.Close()
 
 	conf := Config{
-		Imports: make(map[string]Import),
+		Imports:  make(map[string]Import),
+		Types:    make(map[string]TypeOverride),
+		Versions: make(map[string]int),
 	}
 	var errs []error
 
 	s := bufio.NewScanner(file)
-	for s.Scan() {
+	for lineno := 1; s.Scan(); lineno++ {
 		line := strings.TrimSpace(s.Text())
 		if (len(line) == 0) || (line[0] == '#') {
 			continue
 		}
 
 		parts := strings.Fields(line)
+		if d, ok := directives[parts[0]]; ok {
+			n := len(parts) - 1
+			if (n < d.min) || ((d.max >= 0) && (n > d.max)) {
+				errs = append(errs, fmt.Errorf("%v:%v: expected %q", path, lineno, d.usage))
+				continue
+			}
+		}
+
 		switch parts[0] {
 		case "package":
 			conf.Package = parts[1]
 			if len(parts) == 3 {
 				conf.Prefix = parts[2]
 			}
+		case "path":
+			conf.Path = parts[1]
+			if isClient {
+				conf.Path = parts[2]
+			}
+		case "version":
+			v, err := strconv.Atoi(parts[2])
+			if (err != nil) || (v < 1) {
+				errs = append(errs, fmt.Errorf("version %v: invalid version %q", parts[1], parts[2]))
+				continue
+			}
+			conf.Versions[parts[1]] = v
+		case "interfaces":
+			if conf.Include == nil {
+				conf.Include = make(set.Set[string])
+			}
+			conf.Include.AddAll(parts[1:]...)
+		case "mock":
+			conf.Mock = parts[1]
+			if isClient {
+				conf.Mock = parts[2]
+			}
+		case "shared":
+			conf.Shared = parts[1]
+		case "type":
+			o, err := parseTypeOverride(parts[2:])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("type %v: %w", parts[1], err))
+				continue
+			}
+			conf.Types[parts[1]] = o
 		case "import":
-			path = parts[1]
+			path := parts[1]
 			if isClient {
 				path = parts[2]
 			}
@@ -129,50 +296,138 @@ func loadConfig(path string, isClient bool) (Config, error) {
 	return conf, errors.Join(errs...)
 }
 
-type Context struct {
-	T            *template.Template
-	Protocol     protocol.Protocol
-	Config       Config
-	IsClient     bool
-	Locals       set.Set[string]
-	ExtraImports []string
+// Source is a protocol that is being generated along with its
+// configuration.
+type Source struct {
+	Protocol protocol.Protocol
+	Config   Config
 }
 
-func main() {
-	xmlfile := flag.String("xml", "", "protocol XML file")
-	out := flag.String("out", "", "output file (default <xml file>.go)")
-	config := flag.String("config", "", "config file (default <xnl file>.conf)")
-	client := flag.Bool("client", false, "generate code for client usage instead of server")
-	flag.Parse()
+func loadSource(xmlfile, config string, isClient bool) (*Source, error) {
+	proto, err := protocol.LoadFile(xmlfile)
+	if err != nil {
+		return nil, fmt.Errorf("load XML: %w", err)
+	}
 
-	if *out == "" {
-		*out = *xmlfile + ".go"
+	conf, err := loadConfig(config, isClient)
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	return &Source{Protocol: proto, Config: conf}, nil
+}
+
+// restrict removes the interfaces and the parts of interfaces that are
+// excluded by src's config from its protocol.
+func (src *Source) restrict() error {
+	var errs []error
+	known := make(set.Set[string])
+	for _, i := range src.Protocol.Interfaces {
+		known.Add(i.Name)
+	}
+	for _, name := range slices.Sorted(maps.Keys(src.Config.Versions)) {
+		if !known.Has(name) {
+			errs = append(errs, fmt.Errorf("version %v: unknown interface", name))
+		}
 	}
-	if *config == "" {
-		*config = *xmlfile + ".conf"
+	for _, name := range slices.Sorted(maps.Keys(src.Config.Include)) {
+		if !known.Has(name) {
+			errs = append(errs, fmt.Errorf("interfaces: unknown interface %v", name))
+		}
 	}
 
-	proto, err := loadXML(*xmlfile)
-	if err != nil {
-		log.Fatalf("load XML: %v", err)
+	ifaces := make([]protocol.Interface, 0, len(src.Protocol.Interfaces))
+	for _, i := range src.Protocol.Interfaces {
+		if (src.Config.Include != nil) && !src.Config.Include.Has(i.Name) {
+			continue
+		}
+		if v, ok := src.Config.Versions[i.Name]; ok {
+			i = i.AtVersion(v)
+		}
+		ifaces = append(ifaces, i)
 	}
+	src.Protocol.Interfaces = ifaces
 
-	conf, err := loadConfig(*config, *client)
-	if err != nil {
-		log.Fatalf("load config: %v", err)
+	return errors.Join(errs...)
+}
+
+// references returns the names of all of the interfaces that src's
+// protocol refers to, including those that it defines.
+func (src *Source) references() set.Set[string] {
+	refs := make(set.Set[string])
+	for _, i := range src.Protocol.Interfaces {
+		refs.Add(i.Name)
+		for _, op := range slices.Concat(i.Requests, i.Events) {
+			for _, arg := range op.Args {
+				if arg.Interface != "" {
+					refs.Add(arg.Interface)
+				}
+				if inter, _, ok := strings.Cut(arg.Enum, "."); ok {
+					refs.Add(inter)
+				}
+			}
+		}
 	}
+	return refs
+}
 
+// Context is the data that the templates are executed with. It is
+// also available to every template via the context function.
+type Context struct {
+	// T is the set of templates being executed.
+	T *template.Template
+
+	// Protocol is the protocol being generated and Config is its
+	// configuration.
+	Protocol protocol.Protocol
+	Config   Config
+
+	// IsClient is true if client-side code is being generated.
+	IsClient bool
+
+	// IsShared is true if the package named by the shared directive is
+	// being generated rather than client-side or server-side code.
+	IsShared bool
+
+	// IsMock is true if the package named by the mock directive is being
+	// generated. Interfaces of the protocol itself are then referred to
+	// via the package that they are declared in.
+	IsMock bool
+
+	// Locals is the set of interfaces that are only ever created by
+	// messages, and so have no Bind function.
+	Locals set.Set[string]
+
+	// ExtraImports are the import paths needed by the generated code
+	// beyond those of other protocols' packages.
+	ExtraImports []string
+
+	// Interfaces maps the names of all of the interfaces of every
+	// protocol being generated to the protocol that defines them.
+	Interfaces map[string]*Source
+}
+
+func newContext(src *Source, ifaces map[string]*Source, isClient, isMock bool) Context {
 	ctx := Context{
-		Protocol: proto,
-		Config:   conf,
-		IsClient: *client,
-		Locals:   set.New("wl_display"),
+		Protocol:   src.Protocol,
+		Config:     src.Config,
+		IsClient:   isClient,
+		IsMock:     isMock,
+		Locals:     set.New("wl_display"),
+		Interfaces: ifaces,
+	}
+	ctx.Config.Imports = maps.Clone(src.Config.Imports)
+	if isMock {
+		ctx.Config.Imports[src.Config.Path] = Import{
+			Prefix: src.Config.Prefix,
+			Name:   src.Config.Package,
+		}
 	}
 
 	extraImports := make(set.Set[string])
-	for _, i := range proto.Interfaces {
-		for _, req := range i.Requests {
-			for _, arg := range req.Args {
+	for _, i := range src.Protocol.Interfaces {
+		for _, op := range slices.Concat(i.Requests, i.Events) {
+			for _, arg := range op.Args {
 				switch arg.Type {
 				case "new_id":
 					if arg.Interface != "" {
@@ -183,45 +438,376 @@ func main() {
 				}
 			}
 		}
+	}
+	for _, o := range src.Config.Types {
+		extraImports.AddAll(o.Imports...)
+	}
+	delete(extraImports, "fmt")
+	delete(extraImports, "deedles.dev/wl/wire")
+	ctx.ExtraImports = slices.Sorted(maps.Keys(extraImports))
 
-		for _, ev := range i.Events {
-			for _, arg := range ev.Args {
-				switch arg.Type {
-				case "new_id":
-					if arg.Interface != "" {
-						ctx.Locals.Add(arg.Interface)
-					}
-				case "fd":
-					extraImports.Add("os")
-				}
-			}
+	ctx.addImports(src)
+
+	return ctx
+}
+
+// addImports adds an import for the package of every other protocol
+// being generated that src refers to.
+func (ctx *Context) addImports(src *Source) {
+	names := set.New("shared")
+	for _, i := range ctx.Config.Imports {
+		names.Add(i.Name)
+	}
+
+	for _, ref := range slices.Sorted(maps.Keys(src.references())) {
+		dep, ok := ctx.Interfaces[ref]
+		if !ok || (dep == src) {
+			continue
+		}
+		if _, ok := ctx.Config.Imports[dep.Config.Path]; ok {
+			continue
+		}
+
+		name := dep.Config.Package
+		for n := 2; names.Has(name); n++ {
+			name = fmt.Sprintf("%v%v", dep.Config.Package, n)
+		}
+		names.Add(name)
+
+		ctx.Config.Imports[dep.Config.Path] = Import{
+			Prefix: dep.Config.Prefix,
+			Name:   name,
 		}
 	}
-	ctx.ExtraImports = maps.Keys(extraImports)
+}
 
-	ctx.T = parseTemplates(ctx)
+func (ctx Context) generate(out string, templates []string) error {
+	t, err := parseTemplates(&ctx, templates)
+	if err != nil {
+		return fmt.Errorf("parse templates: %w", err)
+	}
+	ctx.T = t
+
+	name := baseTmpl
+	switch {
+	case ctx.IsShared:
+		name = sharedTmpl
+	case ctx.IsMock:
+		name = mockTmpl
+	}
 
 	var buf bytes.Buffer
-	err = ctx.T.ExecuteTemplate(&buf, baseTmpl, ctx)
+	err = ctx.T.ExecuteTemplate(&buf, name, ctx)
 	if err != nil {
-		log.Fatalf("execute template: %v", err)
+		return fmt.Errorf("execute template: %w", err)
 	}
 
 	unfmt := buf.Bytes()
-	data, err := format.Source(unfmt)
+	data, err := format.Source(pruneImports(unfmt))
 	if err != nil {
 		log.Printf("format output: %v", err)
 		data = unfmt
 	}
 
-	file, err := os.Create(*out)
+	err = os.MkdirAll(filepath.Dir(out), 0755)
 	if err != nil {
-		log.Fatalf("create output file: %v", err)
+		return fmt.Errorf("create output directory: %w", err)
 	}
-	defer file.Close()
 
-	_, err = file.Write(data)
+	err = os.WriteFile(out, data, 0644)
+	if err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+
+	return nil
+}
+
+// pruneImports removes unused imports from the Go source in src. It
+// only considers the names that imports are referred to by, which is
+// sufficient for generated code. If src can not be parsed, it is
+// returned unchanged.
+func pruneImports(src []byte) []byte {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return src
+	}
+
+	used := make(set.Set[string])
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				used.Add(x.Name)
+			}
+		}
+		return true
+	})
+
+	var pruned bool
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.IMPORT) {
+			continue
+		}
+
+		gen.Specs = slices.DeleteFunc(gen.Specs, func(s ast.Spec) bool {
+			spec := s.(*ast.ImportSpec)
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := pathpkg.Base(path)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			if (name == "_") || (name == ".") || used.Has(name) {
+				return false
+			}
+			pruned = true
+			return true
+		})
+	}
+	if !pruned {
+		return src
+	}
+
+	var buf bytes.Buffer
+	err = format.Node(&buf, fset, file)
+	if err != nil {
+		return src
+	}
+	return buf.Bytes()
+}
+
+// findModule searches upwards from the current directory for a go.mod
+// file and returns the path of the directory containing it along with
+// the module path that it declares.
+func findModule() (dir, path string, err error) {
+	dir, err = os.Getwd()
+	if err != nil {
+		return "", "", err
+	}
+
+	for {
+		data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for line := range strings.Lines(string(data)) {
+				path, ok := strings.CutPrefix(strings.TrimSpace(line), "module ")
+				if ok {
+					return dir, strings.Trim(strings.TrimSpace(path), `"`), nil
+				}
+			}
+			return "", "", errors.New("go.mod has no module directive")
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", "", err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", errors.New("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+// outputPath returns the path of the file to generate for a package
+// with the given import path.
+func outputPath(importPath string) (string, error) {
+	dir, mod, err := findModule()
 	if err != nil {
-		log.Fatalf("write output: %v", err)
+		return "", err
+	}
+
+	rel, ok := strings.CutPrefix(importPath, mod)
+	if !ok || ((rel != "") && (rel[0] != '/')) {
+		return "", fmt.Errorf("package %q is not in module %q", importPath, mod)
+	}
+	return filepath.Join(dir, filepath.FromSlash(rel), "protocol.go"), nil
+}
+
+// validate validates the protocols of srcs against each other, exiting
+// if any of them are invalid. The core protocol is a dependency of
+// every protocol so that extension protocols can be generated on their
+// own, unless defs shows that it is one of the protocols in srcs.
+func validate(xmlfiles []string, srcs []*Source, defs map[string]*Source) {
+	var deps []protocol.Protocol
+	if _, ok := defs["wl_display"]; !ok {
+		deps = append(deps, protocol.Wayland())
+	}
+	for _, src := range srcs {
+		deps = append(deps, src.Protocol)
+	}
+
+	for i, src := range srcs {
+		err := src.Protocol.Validate(deps...)
+		if err != nil {
+			log.Fatalf("%v: invalid protocol:\n%v", xmlfiles[i], err)
+		}
+	}
+}
+
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+func main() {
+	var xmlfiles, templates, versions, include listFlag
+	flag.Var(&xmlfiles, "xml", "protocol XML `file` (may be repeated)")
+	flag.Var(&versions, "version", "cap the version of an interface, given as `interface=version` (may be repeated)")
+	flag.Var(&include, "interface", "only generate the named `interface` (may be repeated)")
+	flag.Var(&templates, "templates", "glob `pattern` of template files that override the built-in templates (may be repeated)")
+	out := flag.String("out", "", "output file (default <xml file>.go, or determined by the config's path directive)")
+	config := flag.String("config", "", "config file (default <xml file>.conf)")
+	client := flag.Bool("client", false, "generate code for client usage instead of server")
+	shared := flag.Bool("shared", false, "generate the packages given by the configs' shared directives instead of server code")
+	lintXML := flag.Bool("lint", false, "check the protocol XML files for problems and report them instead of generating code")
+	mock := flag.Bool("mock", false, "generate the packages given by the configs' mock directives instead of bindings")
+	flag.Parse()
+
+	if *client && *shared {
+		log.Fatalf("-client and -shared may not be used together")
+	}
+	if *mock && *shared {
+		log.Fatalf("-mock and -shared may not be used together")
+	}
+
+	if len(xmlfiles) == 0 {
+		log.Fatalf("no protocol XML files specified")
+	}
+	if (len(xmlfiles) > 1) && ((*out != "") || (*config != "")) {
+		log.Fatalf("-out and -config may only be used with a single XML file")
+	}
+
+	srcs := make([]*Source, 0, len(xmlfiles))
+	defs := make(map[string]*Source)
+	for _, xmlfile := range xmlfiles {
+		conf := *config
+		if conf == "" {
+			conf = xmlfile + ".conf"
+		}
+
+		src, err := loadSource(xmlfile, conf, *client)
+		if err != nil {
+			log.Fatalf("%v: %v", xmlfile, err)
+		}
+		if (len(xmlfiles) > 1) && (src.Config.Path == "") {
+			log.Fatalf("%v: config has no path directive", xmlfile)
+		}
+
+		for _, i := range src.Protocol.Interfaces {
+			if prev, ok := defs[i.Name]; ok {
+				log.Fatalf("%v: interface %v is already defined by protocol %v", xmlfile, i.Name, prev.Protocol.Name)
+			}
+			defs[i.Name] = src
+		}
+		srcs = append(srcs, src)
+	}
+
+	if *lintXML {
+		var failed bool
+		for i, src := range srcs {
+			diags, err := lint(xmlfiles[i], src, srcs, defs)
+			if err != nil {
+				log.Fatalf("%v: %v", xmlfiles[i], err)
+			}
+			for _, d := range diags {
+				fmt.Println(d)
+				failed = failed || (d.severity == "error")
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	for _, v := range versions {
+		name, version, _ := strings.Cut(v, "=")
+		n, err := strconv.Atoi(version)
+		if (err != nil) || (n < 1) {
+			log.Fatalf("-version %v: invalid version %q", v, version)
+		}
+		src, ok := defs[name]
+		if !ok {
+			log.Fatalf("-version %v: unknown interface %v", v, name)
+		}
+		src.Config.Versions[name] = n
+	}
+	if len(include) != 0 {
+		for _, src := range srcs {
+			src.Config.Include = make(set.Set[string])
+		}
+		for _, name := range include {
+			src, ok := defs[name]
+			if !ok {
+				log.Fatalf("-interface: unknown interface %v", name)
+			}
+			src.Config.Include.Add(name)
+		}
+	}
+
+	validate(xmlfiles, srcs, defs)
+	for i, src := range srcs {
+		err := src.restrict()
+		if err != nil {
+			log.Fatalf("%v: %v", xmlfiles[i], err)
+		}
+	}
+	validate(xmlfiles, srcs, defs)
+
+	ifaces := make(map[string]*Source)
+	for _, src := range srcs {
+		for _, i := range src.Protocol.Interfaces {
+			ifaces[i.Name] = src
+		}
+	}
+
+	for i, src := range srcs {
+		pkgpath := src.Config.Path
+		switch {
+		case *shared:
+			if src.Config.Shared == "" {
+				if len(srcs) == 1 {
+					log.Fatalf("%v: config has no shared directive", xmlfiles[i])
+				}
+				continue
+			}
+			pkgpath = src.Config.Shared
+		case *mock:
+			if src.Config.Mock == "" {
+				if len(srcs) == 1 {
+					log.Fatalf("%v: config has no mock directive", xmlfiles[i])
+				}
+				continue
+			}
+			if src.Config.Path == "" {
+				log.Fatalf("%v: config has no path directive", xmlfiles[i])
+			}
+			pkgpath = src.Config.Mock
+		}
+
+		path := *out
+		if path == "" {
+			path = xmlfiles[i] + ".go"
+			if pkgpath != "" {
+				p, err := outputPath(pkgpath)
+				if err != nil {
+					log.Fatalf("%v: %v", xmlfiles[i], err)
+				}
+				path = p
+			}
+		}
+
+		ctx := newContext(src, ifaces, *client, *mock)
+		ctx.IsShared = *shared
+		err := ctx.generate(path, templates)
+		if err != nil {
+			log.Fatalf("%v: %v", xmlfiles[i], err)
+		}
 	}
 }
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTemplateOverride(t *testing.T) {
+	src, err := loadSource("../../protocol/wayland.xml", "../../protocol/wayland.xml.conf", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ifaces := make(map[string]*Source)
+	for _, i := range src.Protocol.Interfaces {
+		ifaces[i.Name] = src
+	}
+
+	dir := t.TempDir()
+	tmpl := filepath.Join(dir, "footer.tmpl")
+	err = os.WriteFile(tmpl, []byte(`
+{{define "footer"}}
+// {{partial "greeting" .Protocol.Name}}
+{{- if (context).T}}
+// The template set is available.
+{{- end}}
+{{end}}
+
+{{define "greeting"}}Generated from {{.}}.{{end}}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "protocol.go")
+	ctx := newContext(src, ifaces, false, false)
+	err = ctx.generate(out, []string{tmpl})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"// Generated from wayland.", "// The template set is available."} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("output does not contain %q", want)
+		}
+	}
+}
+
+func TestDuplicateEnumValues(t *testing.T) {
+	dir := t.TempDir()
+	xmlfile := filepath.Join(dir, "test.xml")
+	err := os.WriteFile(xmlfile, []byte(`<protocol name="test">
+  <interface name="test_object" version="1">
+    <enum name="mode">
+      <entry name="none" value="0"/>
+      <entry name="default" value="0"/>
+      <entry name="fast" value="1"/>
+      <entry name="quick" value="0x1"/>
+    </enum>
+  </interface>
+</protocol>
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(xmlfile+".conf", []byte("package test test_\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := loadSource(xmlfile, xmlfile+".conf", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "test.go")
+	ctx := newContext(src, map[string]*Source{"test_object": src}, false, false)
+	err = ctx.generate(out, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), out, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ast.Inspect(file, func(node ast.Node) bool {
+		sw, ok := node.(*ast.SwitchStmt)
+		if !ok {
+			return true
+		}
+		seen := make(map[string]struct{})
+		for _, stmt := range sw.Body.List {
+			for _, expr := range stmt.(*ast.CaseClause).List {
+				lit, ok := expr.(*ast.BasicLit)
+				if !ok {
+					continue
+				}
+				if _, ok := seen[lit.Value]; ok {
+					t.Errorf("switch has more than one case for %v", lit.Value)
+				}
+				seen[lit.Value] = struct{}{}
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+)
+
+// object is an object that the proxy has seen be created. It
+// implements wire.Object so that it can be used to build messages and
+// be formatted in traces.
+type object struct {
+	id    uint32
+	iface *protocol.Interface
+}
+
+func (obj *object) ID() uint32 {
+	return obj.id
+}
+
+func (obj *object) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *object) Dispatch(msg *wire.MessageBuffer) error {
+	return nil
+}
+
+func (obj *object) Delete() {}
+
+// Interface returns the name of the object's interface, or "unknown"
+// if its protocol was not loaded.
+func (obj *object) Interface() string {
+	if obj.iface == nil {
+		return "unknown"
+	}
+	return obj.iface.Name
+}
+
+// proxy forwards messages between a single client and the
+// compositor, keeping track of objects as they are created and
+// destroyed so that it can decode them.
+type proxy struct {
+	num    uint64
+	ifaces interfaces
+	client *wire.Conn
+	server *wire.Conn
+
+	m       sync.Mutex
+	objects map[uint32]*object
+}
+
+func newProxy(num uint64, ifaces interfaces, client, server *wire.Conn) *proxy {
+	return &proxy{
+		num:    num,
+		ifaces: ifaces,
+		client: client,
+		server: server,
+		objects: map[uint32]*object{
+			1: {id: 1, iface: ifaces["wl_display"]},
+		},
+	}
+}
+
+func (p *proxy) run() {
+	log.Printf("client %v: connected", p.num)
+	defer log.Printf("client %v: disconnected", p.num)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	wg.Add(2)
+	go p.forward(&wg, p.client, p.server, true)
+	go p.forward(&wg, p.server, p.client, false)
+}
+
+func (p *proxy) forward(wg *sync.WaitGroup, from, to *wire.Conn, requests bool) {
+	defer wg.Done()
+	defer to.Close()
+	defer from.Close()
+
+	for {
+		err := p.forwardMessage(from, to, requests)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				log.Printf("client %v: %v", p.num, err)
+			}
+			return
+		}
+	}
+}
+
+func (p *proxy) forwardMessage(from, to *wire.Conn, requests bool) error {
+	msg, err := wire.ReadMessage(from)
+	if err != nil {
+		return err
+	}
+
+	// Files that were decoded are closed when they are forwarded, or
+	// by msg if decoding failed.
+	defer msg.Close()
+
+	sender := p.get(msg.Sender())
+	if (sender == nil) || (sender.iface == nil) {
+		return p.forwardRaw(msg, sender, to, requests)
+	}
+
+	ops := sender.iface.Events
+	if requests {
+		ops = sender.iface.Requests
+	}
+	if int(msg.Op()) >= len(ops) {
+		return p.forwardRaw(msg, sender, to, requests)
+	}
+	op := ops[msg.Op()]
+
+	args, err := p.decode(msg, op)
+	if err != nil {
+		return fmt.Errorf("decode %v@%v.%v: %w", sender.Interface(), sender.id, op.Name, err)
+	}
+	msg.HandOffFiles()
+	p.log(sender, op, args, requests)
+
+	if !requests && (sender.id == 1) && (op.Name == "delete_id") {
+		p.delete(args[0].(uint32))
+	}
+
+	builder := wire.NewMessage(sender, msg.Op())
+	for i, arg := range op.Args {
+		encode(builder, arg, args[i])
+	}
+	err = builder.Build(to)
+	if err != nil {
+		return fmt.Errorf("forward %v@%v.%v: %w", sender.Interface(), sender.id, op.Name, err)
+	}
+	return nil
+}
+
+// forwardRaw forwards msg without decoding it, for messages whose
+// signatures are not known. That happens when an object's interface
+// was not loaded or when a message is from a newer version of it than
+// was loaded. As the number of file descriptors that the message
+// carries can't be known either, all of those that have been received
+// and not yet claimed are forwarded along with it.
+func (p *proxy) forwardRaw(msg *wire.MessageBuffer, sender *object, to *wire.Conn, requests bool) error {
+	if sender == nil {
+		sender = &object{id: msg.Sender()}
+	}
+
+	data := msg.ReadRaw()
+	var files []*os.File
+	for {
+		file := msg.ReadFile()
+		if file == nil {
+			break
+		}
+		files = append(files, file)
+	}
+	msg.HandOffFiles()
+
+	dir := "<-"
+	if requests {
+		dir = "->"
+	}
+	log.Printf("client %v: %v %v@%v.%v (undecoded, %v bytes, %v fds)", p.num, dir, sender.Interface(), sender.id, msg.Op(), len(data), len(files))
+
+	builder := wire.NewMessage(sender, msg.Op())
+	builder.WriteRaw(data)
+	for _, file := range files {
+		builder.TransferFile(file)
+	}
+	err := builder.Build(to)
+	if err != nil {
+		return fmt.Errorf("forward %v@%v.%v: %w", sender.Interface(), sender.id, msg.Op(), err)
+	}
+	return nil
+}
+
+// decode reads the arguments of op from msg, registering any newly
+// created objects.
+func (p *proxy) decode(msg *wire.MessageBuffer, op protocol.Op) ([]any, error) {
+	args := make([]any, 0, len(op.Args))
+	for _, arg := range op.Args {
+		switch arg.Type {
+		case "int":
+			args = append(args, msg.ReadInt())
+		case "uint":
+			args = append(args, msg.ReadUint())
+		case "fixed":
+			args = append(args, msg.ReadFixed())
+		case "string":
+			if arg.AllowNull {
+				args = append(args, msg.ReadNullableString())
+				break
+			}
+			args = append(args, msg.ReadString())
+		case "array":
+			args = append(args, msg.ReadArray())
+		case "fd":
+			args = append(args, msg.ReadFile())
+		case "object":
+			id := msg.ReadNullableObject()
+			switch obj := p.get(id); {
+			case id == 0:
+				args = append(args, nil)
+			case obj == nil:
+				args = append(args, wire.UnknownObject(id))
+			default:
+				args = append(args, obj)
+			}
+		case "new_id":
+			id := wire.NewID{Interface: arg.Interface}
+			if arg.Interface == "" {
+				id = msg.ReadNewID()
+			} else {
+				id.ID = msg.ReadNewObject(arg.Interface)
+			}
+			if msg.Err() == nil {
+				p.add(id)
+			}
+			args = append(args, id)
+		default:
+			return nil, fmt.Errorf("unknown argument type %q", arg.Type)
+		}
+	}
+
+	return args, msg.Err()
+}
+
+func encode(builder *wire.MessageBuilder, arg protocol.Arg, v any) {
+	switch arg.Type {
+	case "int":
+		builder.WriteInt(v.(int32))
+	case "uint":
+		builder.WriteUint(v.(uint32))
+	case "fixed":
+		builder.WriteFixed(v.(wire.Fixed))
+	case "string":
+		if arg.AllowNull {
+			builder.WriteNullableString(v.(*string))
+			return
+		}
+		builder.WriteString(v.(string))
+	case "array":
+		builder.WriteArray(v.([]byte))
+	case "fd":
+		// The file was received only to be forwarded, so there is no
+		// need to keep it open once it has been.
+		builder.TransferFile(v.(*os.File))
+	case "object":
+		switch v := v.(type) {
+		case nil:
+			builder.WriteUint(0)
+		case wire.UnknownObject:
+			builder.WriteUint(uint32(v))
+		case *object:
+			builder.WriteUint(v.id)
+		}
+	case "new_id":
+		id := v.(wire.NewID)
+		if arg.Interface == "" {
+			builder.WriteNewID(id)
+			return
+		}
+		builder.WriteUint(id.ID)
+	}
+}
+
+func (p *proxy) log(sender *object, op protocol.Op, args []any, request bool) {
+	dir := "<-"
+	if request {
+		dir = "->"
+	}
+
+	var sb strings.Builder
+	for i, arg := range op.Args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%v: %v", arg.Name, wire.FormatTraceArg(args[i]))
+	}
+
+	log.Printf("client %v: %v %v@%v.%v(%v)", p.num, dir, sender.Interface(), sender.id, op.Name, sb.String())
+}
+
+func (p *proxy) get(id uint32) *object {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.objects[id]
+}
+
+// add records the creation of an object. If its interface is not
+// known, messages for it are forwarded without being decoded.
+func (p *proxy) add(id wire.NewID) {
+	iface, ok := p.ifaces[id.Interface]
+	if !ok {
+		log.Printf("client %v: unknown interface %q (use -xml to provide its protocol)", p.num, id.Interface)
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.objects[id.ID] = &object{id: id.ID, iface: iface}
+}
+
+func (p *proxy) delete(id uint32) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	delete(p.objects, id)
+}
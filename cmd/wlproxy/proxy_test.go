@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"slices"
+	"testing"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+	"golang.org/x/sys/unix"
+)
+
+func socketPair(t *testing.T) (*net.UnixConn, *net.UnixConn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		file := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	return conns[0], conns[1]
+}
+
+func message(sender uint32, op uint16, args ...uint32) []byte {
+	size := 8 + 4*len(args)
+	buf := binary.NativeEndian.AppendUint32(nil, sender)
+	buf = binary.NativeEndian.AppendUint32(buf, uint32(size)<<16|uint32(op))
+	for _, arg := range args {
+		buf = binary.NativeEndian.AppendUint32(buf, arg)
+	}
+	return buf
+}
+
+func newTestProxy(t *testing.T) (p *proxy, client, server *net.UnixConn) {
+	ifaces := make(interfaces)
+	for _, proto := range protocol.Vendored() {
+		ifaces.add(proto)
+	}
+
+	clientConn, client := socketPair(t)
+	serverConn, server := socketPair(t)
+	p = newProxy(1, ifaces, wire.NewConn(clientConn), wire.NewConn(serverConn))
+	t.Cleanup(func() {
+		p.client.Close()
+		p.server.Close()
+		client.Close()
+		server.Close()
+	})
+	return p, client, server
+}
+
+func TestVendoredInterfaces(t *testing.T) {
+	p, client, server := newTestProxy(t)
+
+	// wl_registry.bind(1, "xdg_wm_base", 1, 3) with the registry as 2.
+	p.add(wire.NewID{Interface: "wl_registry", ID: 2})
+	// The name, including its terminator, is 12 bytes, so it needs no
+	// padding.
+	name := append([]byte("xdg_wm_base"), 0)
+	bind := binary.NativeEndian.AppendUint32(nil, 1)
+	bind = binary.NativeEndian.AppendUint32(bind, uint32(len(name)))
+	bind = append(bind, name...)
+	bind = binary.NativeEndian.AppendUint32(bind, 1)
+	bind = binary.NativeEndian.AppendUint32(bind, 3)
+	header := binary.NativeEndian.AppendUint32(nil, 2)
+	header = binary.NativeEndian.AppendUint32(header, uint32(8+len(bind))<<16)
+	_, err := client.Write(append(header, bind...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.forwardMessage(p.client, p.server, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj := p.get(3); (obj == nil) || (obj.Interface() != "xdg_wm_base") {
+		t.Fatalf("expected object 3 to be an xdg_wm_base but got %v", obj)
+	}
+
+	msg, err := wire.ReadMessage(wire.NewConn(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (msg.Sender() != 2) || (msg.Op() != 0) {
+		t.Fatalf("expected wl_registry.bind but got opcode %v for %v", msg.Op(), msg.Sender())
+	}
+}
+
+func TestForwardUnknown(t *testing.T) {
+	p, client, server := newTestProxy(t)
+	p.add(wire.NewID{Interface: "unknown_interface_v1", ID: 5})
+
+	tests := []struct {
+		name   string
+		sender uint32
+		op     uint16
+	}{
+		{"UnknownInterface", 5, 2},
+		{"UnknownObject", 6, 0},
+		{"UnknownOpcode", 1, 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := message(test.sender, test.op, 7, 8)
+			_, _, err := client.WriteMsgUnix(data, unix.UnixRights(int(os.Stderr.Fd())), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = p.forwardMessage(p.client, p.server, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf := make([]byte, 64)
+			oob := make([]byte, unix.CmsgSpace(4*4))
+			n, oobn, _, _, err := server.ReadMsgUnix(buf, oob)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(buf[:n], data) {
+				t.Fatalf("expected %v to be forwarded but got %v", data, buf[:n])
+			}
+
+			cmsgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+			if (err != nil) || (len(cmsgs) != 1) {
+				t.Fatalf("expected one control message but got %v (%v)", len(cmsgs), err)
+			}
+			fds, err := unix.ParseUnixRights(&cmsgs[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, fd := range fds {
+				unix.Close(fd)
+			}
+			if len(fds) != 1 {
+				t.Fatalf("expected 1 file descriptor to be forwarded but got %v", len(fds))
+			}
+		})
+	}
+}
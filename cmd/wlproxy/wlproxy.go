@@ -0,0 +1,99 @@
+// wlproxy is a Wayland protocol sniffer. It listens on a new socket,
+// forwards every connection that it receives to the compositor
+// specified by the environment, and logs every message that passes
+// through it in both directions.
+//
+// Messages are decoded using protocol specification XML files. The
+// protocols that this module provides bindings for are always
+// available, and further protocols can be added with the -xml flag.
+// Messages for objects whose interfaces wlproxy does not know about
+// are logged and forwarded without being decoded.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+)
+
+type xmlFlag []string
+
+func (f *xmlFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *xmlFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+// interfaces maps interface names to their specifications.
+type interfaces map[string]*protocol.Interface
+
+func (ifaces interfaces) add(proto protocol.Protocol) {
+	for i := range proto.Interfaces {
+		ifaces[proto.Interfaces[i].Name] = &proto.Interfaces[i]
+	}
+}
+
+func main() {
+	var xmlfiles xmlFlag
+	flag.Var(&xmlfiles, "xml", "additional protocol XML `file` (may be repeated)")
+	socket := flag.String("socket", "", "`path` of the socket to listen on (default: generated from the environment)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %v [options]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ifaces := make(interfaces)
+	for _, proto := range protocol.Vendored() {
+		ifaces.add(proto)
+	}
+	for _, path := range xmlfiles {
+		proto, err := protocol.LoadFile(path)
+		if err != nil {
+			log.Fatalf("load %q: %v", path, err)
+		}
+		ifaces.add(proto)
+	}
+
+	if *socket == "" {
+		path, err := wire.NewSocketPath()
+		if err != nil {
+			log.Fatalf("generate socket path: %v", err)
+		}
+		*socket = path
+	}
+
+	lis, err := wire.ListenPath(*socket)
+	if err != nil {
+		log.Fatalf("listen: %v", err)
+	}
+	defer lis.Close()
+	log.Printf("listening at %q, forwarding to %q", *socket, wire.SocketPath())
+
+	var nextID atomic.Uint64
+	for {
+		c, err := lis.AcceptUnix()
+		if err != nil {
+			log.Fatalf("accept: %v", err)
+		}
+
+		upstream, err := wire.Dial()
+		if err != nil {
+			log.Printf("dial compositor: %v", err)
+			c.Close()
+			continue
+		}
+
+		p := newProxy(nextID.Add(1), ifaces, wire.NewConn(c), upstream)
+		go p.run()
+	}
+}
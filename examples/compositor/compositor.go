@@ -7,9 +7,9 @@ import (
 	"os/signal"
 	"time"
 
-	xdg "deedles.dev/wl/examples/internal/xdg/server"
 	wl "deedles.dev/wl/server"
 	"deedles.dev/wl/wire"
+	xdg "deedles.dev/wl/xdg/server"
 	"deedles.dev/xsync"
 )
 
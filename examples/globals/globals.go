@@ -29,15 +29,13 @@ func (lis *registryListener) Global(name uint32, inter string, version uint32) {
 	switch inter {
 	case wl.OutputInterface:
 		output := wl.BindOutput(lis.state, lis.registry, name, version)
-		output.Listener = (*outputListener)(lis)
+		output.OnGeometry(outputGeometry)
 	}
 }
 
 func (lis *registryListener) GlobalRemove(name uint32) {}
 
-type outputListener listener
-
-func (lis *outputListener) Geometry(x, y, w, h int32, subpixel wl.OutputSubpixel, make, model string, transform wl.OutputTransform) {
+func outputGeometry(x, y, w, h int32, subpixel wl.OutputSubpixel, make, model string, transform wl.OutputTransform) {
 	log.Printf(
 		"output: x: %v, y: %v, w: %v, h: %v, subpixel: %v, make: %q, model: %q, transform: %v",
 		x,
@@ -51,16 +49,6 @@ func (lis *outputListener) Geometry(x, y, w, h int32, subpixel wl.OutputSubpixel
 	)
 }
 
-func (lis *outputListener) Mode(flags wl.OutputMode, w, h, refresh int32) { /* TODO */ }
-
-func (lis *outputListener) Done() {}
-
-func (lis *outputListener) Scale(factor int32) { /* TODO */ }
-
-func (lis *outputListener) Description(desc string) { /* TODO */ }
-
-func (lis *outputListener) Name(name string) { /* TODO */ }
-
 func main() {
 	s, err := wl.Dial()
 	if err != nil {
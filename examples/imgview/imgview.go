@@ -12,12 +12,13 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"time"
 
 	wl "deedles.dev/wl/client"
-	xdg "deedles.dev/wl/examples/internal/xdg/client"
 	"deedles.dev/wl/pointer"
 	"deedles.dev/wl/shm"
 	"deedles.dev/wl/wire"
+	xdg "deedles.dev/wl/xdg/client"
 	"deedles.dev/ximage/xcursor"
 	"deedles.dev/xsync"
 	_ "golang.org/x/image/bmp"
@@ -277,8 +278,8 @@ func (s *pointerListener) Enter(serial uint32, surface *wl.Surface, surfaceX wir
 
 func (s *pointerListener) Leave(serial uint32, surface *wl.Surface) {}
 
-func (s *pointerListener) Motion(time uint32, x, y wire.Fixed) {
-	s.pointerLoc = image.Pt(x.Int(), y.Int())
+func (s *pointerListener) Motion(t time.Duration, x, y wire.Fixed) {
+	s.pointerLoc = wire.Point(x, y)
 
 	switch {
 	case s.pointerLoc.In(s.closeBounds):
@@ -293,8 +294,8 @@ func (s *pointerListener) Motion(time uint32, x, y wire.Fixed) {
 	//(*state)(s).draw(0, 0)
 }
 
-func (s *pointerListener) Button(serial, time uint32, button uint32, bstate wl.PointerButtonState) {
-	switch pointer.Button(button) {
+func (s *pointerListener) Button(serial uint32, t time.Duration, button pointer.Button, bstate wl.PointerButtonState) {
+	switch button {
 	case pointer.ButtonLeft:
 		switch {
 		case s.pointerLoc.In(s.closeBounds):
@@ -310,13 +311,13 @@ func (s *pointerListener) Button(serial, time uint32, button uint32, bstate wl.P
 	}
 }
 
-func (s *pointerListener) Axis(time uint32, axis wl.PointerAxis, value wire.Fixed) {}
+func (s *pointerListener) Axis(t time.Duration, axis wl.PointerAxis, value wire.Fixed) {}
 
 func (s *pointerListener) Frame() {}
 
 func (s *pointerListener) AxisSource(axisSource wl.PointerAxisSource) {}
 
-func (s *pointerListener) AxisStop(time uint32, axis wl.PointerAxis) {}
+func (s *pointerListener) AxisStop(t time.Duration, axis wl.PointerAxis) {}
 
 func (s *pointerListener) AxisDiscrete(axis wl.PointerAxis, discrete int32) {}
 
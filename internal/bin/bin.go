@@ -14,6 +14,12 @@ func Value[T ~int32 | ~uint32](data [4]byte) T {
 	return *(*T)(unsafe.Pointer(&data))
 }
 
+// Put stores v in the first four bytes of b, which must have at least
+// that many.
+func Put[T ~int32 | ~uint32](b []byte, v T) {
+	*(*[4]byte)(b) = Bytes(v)
+}
+
 func Read[T ~int32 | ~uint32](r io.Reader) (T, error) {
 	var data [4]byte
 	_, err := io.ReadFull(r, data[:])
@@ -1,23 +1,22 @@
+// Package debug determines default debugging behavior from the
+// environment.
 package debug
 
 import (
-	"log"
 	"os"
-	"strconv"
-)
+	"strings"
 
-var debug = func(string, ...any) {}
+	"deedles.dev/wl/wire"
+)
 
-func init() {
-	debugLevel, err := strconv.ParseInt(os.Getenv("WAYLAND_DEBUG"), 10, 0)
-	if err != nil {
-		return
-	}
-	if debugLevel > 0 {
-		debug = func(str string, args ...any) { log.Printf(str, args...) }
+// Tracer returns the default tracer for the given side of the
+// connection, either "client" or "server". As with libwayland, tracing
+// is enabled if the WAYLAND_DEBUG environment variable is set to 1 or
+// contains the name of the side. Otherwise, it returns nil.
+func Tracer(side string) wire.Tracer {
+	v := os.Getenv("WAYLAND_DEBUG")
+	if (v == "1") || strings.Contains(v, side) {
+		return wire.TraceWriter(os.Stderr)
 	}
-}
-
-func Printf(str string, args ...any) {
-	debug(str, args...)
+	return nil
 }
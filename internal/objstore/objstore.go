@@ -1,19 +1,39 @@
 package objstore
 
 import (
-	"deedles.dev/wl/internal/debug"
+	"slices"
+
 	"deedles.dev/wl/wire"
 )
 
+// maxZombies is the number of deleted objects whose file descriptor
+// counts are remembered.
+const maxZombies = 64
+
 type Store struct {
-	objects map[uint32]wire.Object
-	nextID  uint32
+	objects  map[uint32]wire.Object
+	nextID   uint32
+	isClient bool
+
+	// zombies holds the number of file descriptor arguments of each of
+	// the messages that recently deleted objects could receive, so that
+	// those of messages that were sent to them before the other end
+	// learned of their deletion can be claimed. zombieOrder holds their
+	// IDs from oldest to newest. Objects that can't receive file
+	// descriptors are left out.
+	zombies     map[uint32][]int
+	zombieOrder []uint32
 }
 
-func New(start uint32) *Store {
+// New returns a Store that allocates IDs starting from start for the
+// client side of connections if isClient is true and for the server
+// side otherwise.
+func New(start uint32, isClient bool) *Store {
 	return &Store{
-		objects: make(map[uint32]wire.Object),
-		nextID:  start,
+		objects:  make(map[uint32]wire.Object),
+		nextID:   start,
+		isClient: isClient,
+		zombies:  make(map[uint32][]int),
 	}
 }
 
@@ -26,6 +46,7 @@ func (s *Store) Add(obj wire.Object) {
 	}
 
 	s.objects[id] = obj
+	s.removeZombie(id)
 }
 
 func (s *Store) Get(id uint32) wire.Object {
@@ -36,6 +57,7 @@ func (s *Store) Delete(id uint32) {
 	obj := s.objects[id]
 	delete(s.objects, id)
 	if obj != nil {
+		s.addZombie(id, obj)
 		obj.Delete()
 	}
 }
@@ -45,15 +67,85 @@ func (s *Store) Clear() {
 		obj.Delete()
 		delete(s.objects, id)
 	}
+	clear(s.zombies)
+	s.zombieOrder = nil
 }
 
-func (s *Store) Dispatch(msg *wire.MessageBuffer) error {
+// addZombie remembers the file descriptor counts of the messages that
+// obj, which had the given ID, could receive.
+func (s *Store) addZombie(id uint32, obj wire.Object) {
+	desc := objectDesc(obj, s.isClient)
+	if desc == nil {
+		return
+	}
+	msgs := desc.Requests
+	if s.isClient {
+		msgs = desc.Events
+	}
+
+	fds := make([]int, 0, len(msgs))
+	for _, m := range msgs {
+		fds = append(fds, m.FDCount())
+	}
+	if !slices.ContainsFunc(fds, func(n int) bool { return n != 0 }) {
+		return
+	}
+
+	s.removeZombie(id)
+	if len(s.zombieOrder) >= maxZombies {
+		delete(s.zombies, s.zombieOrder[0])
+		s.zombieOrder = s.zombieOrder[1:]
+	}
+	s.zombies[id] = fds
+	s.zombieOrder = append(s.zombieOrder, id)
+}
+
+func (s *Store) removeZombie(id uint32) {
+	if _, ok := s.zombies[id]; !ok {
+		return
+	}
+	delete(s.zombies, id)
+	s.zombieOrder = slices.DeleteFunc(s.zombieOrder, func(z uint32) bool { return z == id })
+}
+
+// objectDesc returns the description of obj's interface for the given
+// side, or nil if it can't be found.
+func objectDesc(obj wire.Object, isClient bool) *wire.InterfaceDesc {
+	if d, ok := obj.(interface{ Desc() *wire.InterfaceDesc }); ok {
+		return d.Desc()
+	}
+	i, ok := obj.(interface{ Interface() string })
+	if !ok {
+		return nil
+	}
+	desc, _ := wire.LookupInterface(i.Interface(), isClient)
+	return desc
+}
+
+// Dispatch dispatches msg to the object that it was sent to. If trace
+// is not nil, it is called with a description of the message after it
+// has been decoded. File descriptors claimed by msg that were not
+// passed on to a listener are closed afterwards. If the object that
+// msg was sent to was deleted recently, the file descriptors that
+// belong to msg are claimed and closed before an UnknownSenderIDError
+// is returned.
+func (s *Store) Dispatch(msg *wire.MessageBuffer, trace wire.Tracer) error {
+	defer msg.Close()
+
 	obj := s.Get(msg.Sender())
 	if obj == nil {
+		if fds := s.zombies[msg.Sender()]; int(msg.Op()) < len(fds) {
+			msg.ClaimFDs(fds[msg.Op()])
+		}
+		if trace != nil {
+			trace(msg.Trace(nil, s.Get))
+		}
 		return wire.UnknownSenderIDError{Msg: msg}
 	}
 
 	err := obj.Dispatch(msg)
-	debug.Printf("%v", msg.Debug(obj))
+	if trace != nil {
+		trace(msg.Trace(obj, s.Get))
+	}
 	return err
 }
@@ -0,0 +1,176 @@
+package objstore_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"testing"
+
+	"deedles.dev/wl/internal/objstore"
+	"deedles.dev/wl/wire"
+	"golang.org/x/sys/unix"
+
+	wlclient "deedles.dev/wl/client"
+	wlserver "deedles.dev/wl/server"
+)
+
+// state is a wire.State that discards outgoing messages.
+type state struct {
+	*objstore.Store
+}
+
+func (state) Enqueue(*wire.MessageBuilder) {}
+
+// message returns a message with the given header fields and
+// arguments, each of which is encoded as a single uint32.
+func message(sender uint32, op uint16, args ...uint32) []byte {
+	size := 8 + 4*len(args)
+	buf := binary.NativeEndian.AppendUint32(nil, sender)
+	buf = binary.NativeEndian.AppendUint32(buf, uint32(size)<<16|uint32(op))
+	for _, arg := range args {
+		buf = binary.NativeEndian.AppendUint32(buf, arg)
+	}
+	return buf
+}
+
+// socketPair returns a connected pair of Unix domain sockets.
+func socketPair(t *testing.T) (*net.UnixConn, *net.UnixConn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		file := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	return conns[0], conns[1]
+}
+
+func TestDispatchNewID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   uint32
+		ok   bool
+	}{
+		{"Zero", 0, false},
+		{"InUse", 1, false},
+		{"ServerRange", wire.MinServerID, false},
+		{"Valid", 100, true},
+		{"MaxClient", wire.MaxClientID, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			local, remote := socketPair(t)
+			defer remote.Close()
+			conn := wire.NewConn(local)
+			defer conn.Close()
+
+			_, err := remote.Write(message(1, 1, test.id))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := state{objstore.New(wire.MinServerID, false)}
+			display := wlserver.NewDisplay(s)
+			display.SetID(1)
+			s.Add(display)
+
+			msg, err := wire.ReadMessage(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Dispatch(msg, nil)
+
+			var newIDErr wire.InvalidNewIDError
+			if test.ok {
+				if err != nil {
+					t.Fatalf("dispatch: %v", err)
+				}
+				if _, ok := s.Get(test.id).(*wlserver.Registry); !ok {
+					t.Fatalf("object %v is %v, not a registry", test.id, s.Get(test.id))
+				}
+				return
+			}
+			if !errors.As(err, &newIDErr) || (newIDErr.ID != test.id) {
+				t.Fatalf("expected InvalidNewIDError for %v but got %v", test.id, err)
+			}
+			if s.Get(1) != display {
+				t.Fatalf("object 1 replaced with %v", s.Get(1))
+			}
+		})
+	}
+}
+
+func TestDispatchDeleted(t *testing.T) {
+	local, remote := socketPair(t)
+	defer remote.Close()
+	conn := wire.NewConn(local)
+	defer conn.Close()
+
+	s := state{objstore.New(1, true)}
+	deleted := wlclient.NewKeyboard(s)
+	s.Add(deleted)
+	keyboard := wlclient.NewKeyboard(s)
+	s.Add(keyboard)
+	s.Delete(deleted.ID())
+
+	var keymap *os.File
+	keyboard.OnKeymap(func(format wlclient.KeyboardKeymapFormat, fd *os.File, size uint32) { keymap = fd })
+
+	// wl_keyboard.keymap for each keyboard with a different pipe.
+	var pipes [2][2]*os.File
+	for i, id := range []uint32{deleted.ID(), keyboard.ID()} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer w.Close()
+		pipes[i] = [2]*os.File{r, w}
+
+		_, _, err = remote.WriteMsgUnix(message(id, 0, 1, 4096), unix.UnixRights(int(r.Fd())), nil)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range 2 {
+		msg, err := wire.ReadMessage(conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.Dispatch(msg, nil)
+		if (i == 0) && !errors.As(err, new(wire.UnknownSenderIDError)) {
+			t.Fatalf("expected UnknownSenderIDError for the deleted keyboard but got %v", err)
+		}
+		if (i == 1) && (err != nil) {
+			t.Fatal(err)
+		}
+	}
+
+	// Writing to a pipe fails once every read end has been closed.
+	if _, err := pipes[0][1].Write([]byte{0}); !errors.Is(err, unix.EPIPE) {
+		t.Fatalf("file descriptor of the message for the deleted keyboard was not closed: %v", err)
+	}
+	if keymap == nil {
+		t.Fatal("keymap was not received")
+	}
+	defer keymap.Close()
+	fi1, err1 := keymap.Stat()
+	fi2, err2 := pipes[1][1].Stat()
+	if err := errors.Join(err1, err2); err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(fi1, fi2) {
+		t.Fatal("keymap is not the file descriptor that was sent with it")
+	}
+}
@@ -0,0 +1,2059 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package wl
+
+import "fmt"
+
+const (
+	DisplayInterface = "wl_display"
+	DisplayVersion   = 1
+)
+
+// These errors are global and can be emitted in response to any
+// server request.
+type DisplayError int64
+
+const (
+	// server couldn't find object
+	DisplayErrorInvalidObject DisplayError = 0
+
+	// method doesn't exist on the specified interface or malformed request
+	DisplayErrorInvalidMethod DisplayError = 1
+
+	// server is out of memory
+	DisplayErrorNoMemory DisplayError = 2
+
+	// implementation error in compositor
+	DisplayErrorImplementation DisplayError = 3
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum DisplayError) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3:
+		return true
+	}
+
+	return false
+}
+
+func (enum DisplayError) String() string {
+	switch enum {
+	case 0:
+		return "DisplayErrorInvalidObject"
+
+	case 1:
+		return "DisplayErrorInvalidMethod"
+
+	case 2:
+		return "DisplayErrorNoMemory"
+
+	case 3:
+		return "DisplayErrorImplementation"
+	}
+
+	return "<invalid DisplayError>"
+}
+
+const (
+	RegistryInterface = "wl_registry"
+	RegistryVersion   = 1
+)
+
+const (
+	CallbackInterface = "wl_callback"
+	CallbackVersion   = 1
+)
+
+const (
+	CompositorInterface = "wl_compositor"
+	CompositorVersion   = 6
+)
+
+const (
+	ShmPoolInterface = "wl_shm_pool"
+	ShmPoolVersion   = 2
+)
+
+const (
+	ShmInterface = "wl_shm"
+	ShmVersion   = 2
+)
+
+// These errors can be emitted in response to wl_shm requests.
+type ShmError int64
+
+const (
+	// buffer format is not known
+	ShmErrorInvalidFormat ShmError = 0
+
+	// invalid size or stride during pool or buffer creation
+	ShmErrorInvalidStride ShmError = 1
+
+	// mmapping the file descriptor failed
+	ShmErrorInvalidFd ShmError = 2
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ShmError) Valid() bool {
+	switch enum {
+	case 0, 1, 2:
+		return true
+	}
+
+	return false
+}
+
+func (enum ShmError) String() string {
+	switch enum {
+	case 0:
+		return "ShmErrorInvalidFormat"
+
+	case 1:
+		return "ShmErrorInvalidStride"
+
+	case 2:
+		return "ShmErrorInvalidFd"
+	}
+
+	return "<invalid ShmError>"
+}
+
+// This describes the memory layout of an individual pixel.
+//
+// All renderers should support argb8888 and xrgb8888 but any other
+// formats are optional and may not be supported by the particular
+// renderer in use.
+//
+// The drm format codes match the macros defined in drm_fourcc.h, except
+// argb8888 and xrgb8888. The formats actually supported by the compositor
+// will be reported by the format event.
+//
+// For all wl_shm formats and unless specified in another protocol
+// extension, pre-multiplied alpha is used for pixel values.
+type ShmFormat int64
+
+const (
+	// 32-bit ARGB format, [31:0] A:R:G:B 8:8:8:8 little endian
+	ShmFormatArgb8888 ShmFormat = 0
+
+	// 32-bit RGB format, [31:0] x:R:G:B 8:8:8:8 little endian
+	ShmFormatXrgb8888 ShmFormat = 1
+
+	// 8-bit color index format, [7:0] C
+	ShmFormatC8 ShmFormat = 538982467
+
+	// 8-bit RGB format, [7:0] R:G:B 3:3:2
+	ShmFormatRgb332 ShmFormat = 943867730
+
+	// 8-bit BGR format, [7:0] B:G:R 2:3:3
+	ShmFormatBgr233 ShmFormat = 944916290
+
+	// 16-bit xRGB format, [15:0] x:R:G:B 4:4:4:4 little endian
+	ShmFormatXrgb4444 ShmFormat = 842093144
+
+	// 16-bit xBGR format, [15:0] x:B:G:R 4:4:4:4 little endian
+	ShmFormatXbgr4444 ShmFormat = 842089048
+
+	// 16-bit RGBx format, [15:0] R:G:B:x 4:4:4:4 little endian
+	ShmFormatRgbx4444 ShmFormat = 842094674
+
+	// 16-bit BGRx format, [15:0] B:G:R:x 4:4:4:4 little endian
+	ShmFormatBgrx4444 ShmFormat = 842094658
+
+	// 16-bit ARGB format, [15:0] A:R:G:B 4:4:4:4 little endian
+	ShmFormatArgb4444 ShmFormat = 842093121
+
+	// 16-bit ABGR format, [15:0] A:B:G:R 4:4:4:4 little endian
+	ShmFormatAbgr4444 ShmFormat = 842089025
+
+	// 16-bit RBGA format, [15:0] R:G:B:A 4:4:4:4 little endian
+	ShmFormatRgba4444 ShmFormat = 842088786
+
+	// 16-bit BGRA format, [15:0] B:G:R:A 4:4:4:4 little endian
+	ShmFormatBgra4444 ShmFormat = 842088770
+
+	// 16-bit xRGB format, [15:0] x:R:G:B 1:5:5:5 little endian
+	ShmFormatXrgb1555 ShmFormat = 892424792
+
+	// 16-bit xBGR 1555 format, [15:0] x:B:G:R 1:5:5:5 little endian
+	ShmFormatXbgr1555 ShmFormat = 892420696
+
+	// 16-bit RGBx 5551 format, [15:0] R:G:B:x 5:5:5:1 little endian
+	ShmFormatRgbx5551 ShmFormat = 892426322
+
+	// 16-bit BGRx 5551 format, [15:0] B:G:R:x 5:5:5:1 little endian
+	ShmFormatBgrx5551 ShmFormat = 892426306
+
+	// 16-bit ARGB 1555 format, [15:0] A:R:G:B 1:5:5:5 little endian
+	ShmFormatArgb1555 ShmFormat = 892424769
+
+	// 16-bit ABGR 1555 format, [15:0] A:B:G:R 1:5:5:5 little endian
+	ShmFormatAbgr1555 ShmFormat = 892420673
+
+	// 16-bit RGBA 5551 format, [15:0] R:G:B:A 5:5:5:1 little endian
+	ShmFormatRgba5551 ShmFormat = 892420434
+
+	// 16-bit BGRA 5551 format, [15:0] B:G:R:A 5:5:5:1 little endian
+	ShmFormatBgra5551 ShmFormat = 892420418
+
+	// 16-bit RGB 565 format, [15:0] R:G:B 5:6:5 little endian
+	ShmFormatRgb565 ShmFormat = 909199186
+
+	// 16-bit BGR 565 format, [15:0] B:G:R 5:6:5 little endian
+	ShmFormatBgr565 ShmFormat = 909199170
+
+	// 24-bit RGB format, [23:0] R:G:B little endian
+	ShmFormatRgb888 ShmFormat = 875710290
+
+	// 24-bit BGR format, [23:0] B:G:R little endian
+	ShmFormatBgr888 ShmFormat = 875710274
+
+	// 32-bit xBGR format, [31:0] x:B:G:R 8:8:8:8 little endian
+	ShmFormatXbgr8888 ShmFormat = 875709016
+
+	// 32-bit RGBx format, [31:0] R:G:B:x 8:8:8:8 little endian
+	ShmFormatRgbx8888 ShmFormat = 875714642
+
+	// 32-bit BGRx format, [31:0] B:G:R:x 8:8:8:8 little endian
+	ShmFormatBgrx8888 ShmFormat = 875714626
+
+	// 32-bit ABGR format, [31:0] A:B:G:R 8:8:8:8 little endian
+	ShmFormatAbgr8888 ShmFormat = 875708993
+
+	// 32-bit RGBA format, [31:0] R:G:B:A 8:8:8:8 little endian
+	ShmFormatRgba8888 ShmFormat = 875708754
+
+	// 32-bit BGRA format, [31:0] B:G:R:A 8:8:8:8 little endian
+	ShmFormatBgra8888 ShmFormat = 875708738
+
+	// 32-bit xRGB format, [31:0] x:R:G:B 2:10:10:10 little endian
+	ShmFormatXrgb2101010 ShmFormat = 808669784
+
+	// 32-bit xBGR format, [31:0] x:B:G:R 2:10:10:10 little endian
+	ShmFormatXbgr2101010 ShmFormat = 808665688
+
+	// 32-bit RGBx format, [31:0] R:G:B:x 10:10:10:2 little endian
+	ShmFormatRgbx1010102 ShmFormat = 808671314
+
+	// 32-bit BGRx format, [31:0] B:G:R:x 10:10:10:2 little endian
+	ShmFormatBgrx1010102 ShmFormat = 808671298
+
+	// 32-bit ARGB format, [31:0] A:R:G:B 2:10:10:10 little endian
+	ShmFormatArgb2101010 ShmFormat = 808669761
+
+	// 32-bit ABGR format, [31:0] A:B:G:R 2:10:10:10 little endian
+	ShmFormatAbgr2101010 ShmFormat = 808665665
+
+	// 32-bit RGBA format, [31:0] R:G:B:A 10:10:10:2 little endian
+	ShmFormatRgba1010102 ShmFormat = 808665426
+
+	// 32-bit BGRA format, [31:0] B:G:R:A 10:10:10:2 little endian
+	ShmFormatBgra1010102 ShmFormat = 808665410
+
+	// packed YCbCr format, [31:0] Cr0:Y1:Cb0:Y0 8:8:8:8 little endian
+	ShmFormatYuyv ShmFormat = 1448695129
+
+	// packed YCbCr format, [31:0] Cb0:Y1:Cr0:Y0 8:8:8:8 little endian
+	ShmFormatYvyu ShmFormat = 1431918169
+
+	// packed YCbCr format, [31:0] Y1:Cr0:Y0:Cb0 8:8:8:8 little endian
+	ShmFormatUyvy ShmFormat = 1498831189
+
+	// packed YCbCr format, [31:0] Y1:Cb0:Y0:Cr0 8:8:8:8 little endian
+	ShmFormatVyuy ShmFormat = 1498765654
+
+	// packed AYCbCr format, [31:0] A:Y:Cb:Cr 8:8:8:8 little endian
+	ShmFormatAyuv ShmFormat = 1448433985
+
+	// 2 plane YCbCr Cr:Cb format, 2x2 subsampled Cr:Cb plane
+	ShmFormatNv12 ShmFormat = 842094158
+
+	// 2 plane YCbCr Cb:Cr format, 2x2 subsampled Cb:Cr plane
+	ShmFormatNv21 ShmFormat = 825382478
+
+	// 2 plane YCbCr Cr:Cb format, 2x1 subsampled Cr:Cb plane
+	ShmFormatNv16 ShmFormat = 909203022
+
+	// 2 plane YCbCr Cb:Cr format, 2x1 subsampled Cb:Cr plane
+	ShmFormatNv61 ShmFormat = 825644622
+
+	// 3 plane YCbCr format, 4x4 subsampled Cb (1) and Cr (2) planes
+	ShmFormatYuv410 ShmFormat = 961959257
+
+	// 3 plane YCbCr format, 4x4 subsampled Cr (1) and Cb (2) planes
+	ShmFormatYvu410 ShmFormat = 961893977
+
+	// 3 plane YCbCr format, 4x1 subsampled Cb (1) and Cr (2) planes
+	ShmFormatYuv411 ShmFormat = 825316697
+
+	// 3 plane YCbCr format, 4x1 subsampled Cr (1) and Cb (2) planes
+	ShmFormatYvu411 ShmFormat = 825316953
+
+	// 3 plane YCbCr format, 2x2 subsampled Cb (1) and Cr (2) planes
+	ShmFormatYuv420 ShmFormat = 842093913
+
+	// 3 plane YCbCr format, 2x2 subsampled Cr (1) and Cb (2) planes
+	ShmFormatYvu420 ShmFormat = 842094169
+
+	// 3 plane YCbCr format, 2x1 subsampled Cb (1) and Cr (2) planes
+	ShmFormatYuv422 ShmFormat = 909202777
+
+	// 3 plane YCbCr format, 2x1 subsampled Cr (1) and Cb (2) planes
+	ShmFormatYvu422 ShmFormat = 909203033
+
+	// 3 plane YCbCr format, non-subsampled Cb (1) and Cr (2) planes
+	ShmFormatYuv444 ShmFormat = 875713881
+
+	// 3 plane YCbCr format, non-subsampled Cr (1) and Cb (2) planes
+	ShmFormatYvu444 ShmFormat = 875714137
+
+	// [7:0] R
+	ShmFormatR8 ShmFormat = 538982482
+
+	// [15:0] R little endian
+	ShmFormatR16 ShmFormat = 540422482
+
+	// [15:0] R:G 8:8 little endian
+	ShmFormatRg88 ShmFormat = 943212370
+
+	// [15:0] G:R 8:8 little endian
+	ShmFormatGr88 ShmFormat = 943215175
+
+	// [31:0] R:G 16:16 little endian
+	ShmFormatRg1616 ShmFormat = 842221394
+
+	// [31:0] G:R 16:16 little endian
+	ShmFormatGr1616 ShmFormat = 842224199
+
+	// [63:0] x:R:G:B 16:16:16:16 little endian
+	ShmFormatXrgb16161616f ShmFormat = 1211388504
+
+	// [63:0] x:B:G:R 16:16:16:16 little endian
+	ShmFormatXbgr16161616f ShmFormat = 1211384408
+
+	// [63:0] A:R:G:B 16:16:16:16 little endian
+	ShmFormatArgb16161616f ShmFormat = 1211388481
+
+	// [63:0] A:B:G:R 16:16:16:16 little endian
+	ShmFormatAbgr16161616f ShmFormat = 1211384385
+
+	// [31:0] X:Y:Cb:Cr 8:8:8:8 little endian
+	ShmFormatXyuv8888 ShmFormat = 1448434008
+
+	// [23:0] Cr:Cb:Y 8:8:8 little endian
+	ShmFormatVuy888 ShmFormat = 875713878
+
+	// Y followed by U then V, 10:10:10. Non-linear modifier only
+	ShmFormatVuy101010 ShmFormat = 808670550
+
+	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 10:6:10:6:10:6:10:6 little endian per 2 Y pixels
+	ShmFormatY210 ShmFormat = 808530521
+
+	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 12:4:12:4:12:4:12:4 little endian per 2 Y pixels
+	ShmFormatY212 ShmFormat = 842084953
+
+	// [63:0] Cr0:Y1:Cb0:Y0 16:16:16:16 little endian per 2 Y pixels
+	ShmFormatY216 ShmFormat = 909193817
+
+	// [31:0] A:Cr:Y:Cb 2:10:10:10 little endian
+	ShmFormatY410 ShmFormat = 808531033
+
+	// [63:0] A:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
+	ShmFormatY412 ShmFormat = 842085465
+
+	// [63:0] A:Cr:Y:Cb 16:16:16:16 little endian
+	ShmFormatY416 ShmFormat = 909194329
+
+	// [31:0] X:Cr:Y:Cb 2:10:10:10 little endian
+	ShmFormatXvyu2101010 ShmFormat = 808670808
+
+	// [63:0] X:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
+	ShmFormatXvyu1216161616 ShmFormat = 909334104
+
+	// [63:0] X:Cr:Y:Cb 16:16:16:16 little endian
+	ShmFormatXvyu16161616 ShmFormat = 942954072
+
+	// [63:0]   A3:A2:Y3:0:Cr0:0:Y2:0:A1:A0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
+	ShmFormatY0l0 ShmFormat = 810299481
+
+	// [63:0]   X3:X2:Y3:0:Cr0:0:Y2:0:X1:X0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
+	ShmFormatX0l0 ShmFormat = 810299480
+
+	// [63:0]   A3:A2:Y3:Cr0:Y2:A1:A0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
+	ShmFormatY0l2 ShmFormat = 843853913
+
+	// [63:0]   X3:X2:Y3:Cr0:Y2:X1:X0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
+	ShmFormatX0l2 ShmFormat = 843853912
+
+	ShmFormatYuv4208bit ShmFormat = 942691673
+
+	ShmFormatYuv42010bit ShmFormat = 808539481
+
+	ShmFormatXrgb8888A8 ShmFormat = 943805016
+
+	ShmFormatXbgr8888A8 ShmFormat = 943800920
+
+	ShmFormatRgbx8888A8 ShmFormat = 943806546
+
+	ShmFormatBgrx8888A8 ShmFormat = 943806530
+
+	ShmFormatRgb888A8 ShmFormat = 943798354
+
+	ShmFormatBgr888A8 ShmFormat = 943798338
+
+	ShmFormatRgb565A8 ShmFormat = 943797586
+
+	ShmFormatBgr565A8 ShmFormat = 943797570
+
+	// non-subsampled Cr:Cb plane
+	ShmFormatNv24 ShmFormat = 875714126
+
+	// non-subsampled Cb:Cr plane
+	ShmFormatNv42 ShmFormat = 842290766
+
+	// 2x1 subsampled Cr:Cb plane, 10 bit per channel
+	ShmFormatP210 ShmFormat = 808530512
+
+	// 2x2 subsampled Cr:Cb plane 10 bits per channel
+	ShmFormatP010 ShmFormat = 808530000
+
+	// 2x2 subsampled Cr:Cb plane 12 bits per channel
+	ShmFormatP012 ShmFormat = 842084432
+
+	// 2x2 subsampled Cr:Cb plane 16 bits per channel
+	ShmFormatP016 ShmFormat = 909193296
+
+	// [63:0] A:x:B:x:G:x:R:x 10:6:10:6:10:6:10:6 little endian
+	ShmFormatAxbxgxrx106106106106 ShmFormat = 808534593
+
+	// 2x2 subsampled Cr:Cb plane
+	ShmFormatNv15 ShmFormat = 892425806
+
+	ShmFormatQ410 ShmFormat = 808531025
+
+	ShmFormatQ401 ShmFormat = 825242705
+
+	// [63:0] x:R:G:B 16:16:16:16 little endian
+	ShmFormatXrgb16161616 ShmFormat = 942953048
+
+	// [63:0] x:B:G:R 16:16:16:16 little endian
+	ShmFormatXbgr16161616 ShmFormat = 942948952
+
+	// [63:0] A:R:G:B 16:16:16:16 little endian
+	ShmFormatArgb16161616 ShmFormat = 942953025
+
+	// [63:0] A:B:G:R 16:16:16:16 little endian
+	ShmFormatAbgr16161616 ShmFormat = 942948929
+
+	// [7:0] C0:C1:C2:C3:C4:C5:C6:C7 1:1:1:1:1:1:1:1 eight pixels/byte
+	ShmFormatC1 ShmFormat = 538980675
+
+	// [7:0] C0:C1:C2:C3 2:2:2:2 four pixels/byte
+	ShmFormatC2 ShmFormat = 538980931
+
+	// [7:0] C0:C1 4:4 two pixels/byte
+	ShmFormatC4 ShmFormat = 538981443
+
+	// [7:0] D0:D1:D2:D3:D4:D5:D6:D7 1:1:1:1:1:1:1:1 eight pixels/byte
+	ShmFormatD1 ShmFormat = 538980676
+
+	// [7:0] D0:D1:D2:D3 2:2:2:2 four pixels/byte
+	ShmFormatD2 ShmFormat = 538980932
+
+	// [7:0] D0:D1 4:4 two pixels/byte
+	ShmFormatD4 ShmFormat = 538981444
+
+	// [7:0] D
+	ShmFormatD8 ShmFormat = 538982468
+
+	// [7:0] R0:R1:R2:R3:R4:R5:R6:R7 1:1:1:1:1:1:1:1 eight pixels/byte
+	ShmFormatR1 ShmFormat = 538980690
+
+	// [7:0] R0:R1:R2:R3 2:2:2:2 four pixels/byte
+	ShmFormatR2 ShmFormat = 538980946
+
+	// [7:0] R0:R1 4:4 two pixels/byte
+	ShmFormatR4 ShmFormat = 538981458
+
+	// [15:0] x:R 6:10 little endian
+	ShmFormatR10 ShmFormat = 540029266
+
+	// [15:0] x:R 4:12 little endian
+	ShmFormatR12 ShmFormat = 540160338
+
+	// [31:0] A:Cr:Cb:Y 8:8:8:8 little endian
+	ShmFormatAvuy8888 ShmFormat = 1498764865
+
+	// [31:0] X:Cr:Cb:Y 8:8:8:8 little endian
+	ShmFormatXvuy8888 ShmFormat = 1498764888
+
+	// 2x2 subsampled Cr:Cb plane 10 bits per channel packed
+	ShmFormatP030 ShmFormat = 808661072
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ShmFormat) Valid() bool {
+	switch enum {
+	case 0, 1, 538982467, 943867730, 944916290, 842093144, 842089048, 842094674, 842094658, 842093121, 842089025, 842088786, 842088770, 892424792, 892420696, 892426322, 892426306, 892424769, 892420673, 892420434, 892420418, 909199186, 909199170, 875710290, 875710274, 875709016, 875714642, 875714626, 875708993, 875708754, 875708738, 808669784, 808665688, 808671314, 808671298, 808669761, 808665665, 808665426, 808665410, 1448695129, 1431918169, 1498831189, 1498765654, 1448433985, 842094158, 825382478, 909203022, 825644622, 961959257, 961893977, 825316697, 825316953, 842093913, 842094169, 909202777, 909203033, 875713881, 875714137, 538982482, 540422482, 943212370, 943215175, 842221394, 842224199, 1211388504, 1211384408, 1211388481, 1211384385, 1448434008, 875713878, 808670550, 808530521, 842084953, 909193817, 808531033, 842085465, 909194329, 808670808, 909334104, 942954072, 810299481, 810299480, 843853913, 843853912, 942691673, 808539481, 943805016, 943800920, 943806546, 943806530, 943798354, 943798338, 943797586, 943797570, 875714126, 842290766, 808530512, 808530000, 842084432, 909193296, 808534593, 892425806, 808531025, 825242705, 942953048, 942948952, 942953025, 942948929, 538980675, 538980931, 538981443, 538980676, 538980932, 538981444, 538982468, 538980690, 538980946, 538981458, 540029266, 540160338, 1498764865, 1498764888, 808661072:
+		return true
+	}
+
+	return false
+}
+
+func (enum ShmFormat) String() string {
+	switch enum {
+	case 0:
+		return "ShmFormatArgb8888"
+
+	case 1:
+		return "ShmFormatXrgb8888"
+
+	case 538982467:
+		return "ShmFormatC8"
+
+	case 943867730:
+		return "ShmFormatRgb332"
+
+	case 944916290:
+		return "ShmFormatBgr233"
+
+	case 842093144:
+		return "ShmFormatXrgb4444"
+
+	case 842089048:
+		return "ShmFormatXbgr4444"
+
+	case 842094674:
+		return "ShmFormatRgbx4444"
+
+	case 842094658:
+		return "ShmFormatBgrx4444"
+
+	case 842093121:
+		return "ShmFormatArgb4444"
+
+	case 842089025:
+		return "ShmFormatAbgr4444"
+
+	case 842088786:
+		return "ShmFormatRgba4444"
+
+	case 842088770:
+		return "ShmFormatBgra4444"
+
+	case 892424792:
+		return "ShmFormatXrgb1555"
+
+	case 892420696:
+		return "ShmFormatXbgr1555"
+
+	case 892426322:
+		return "ShmFormatRgbx5551"
+
+	case 892426306:
+		return "ShmFormatBgrx5551"
+
+	case 892424769:
+		return "ShmFormatArgb1555"
+
+	case 892420673:
+		return "ShmFormatAbgr1555"
+
+	case 892420434:
+		return "ShmFormatRgba5551"
+
+	case 892420418:
+		return "ShmFormatBgra5551"
+
+	case 909199186:
+		return "ShmFormatRgb565"
+
+	case 909199170:
+		return "ShmFormatBgr565"
+
+	case 875710290:
+		return "ShmFormatRgb888"
+
+	case 875710274:
+		return "ShmFormatBgr888"
+
+	case 875709016:
+		return "ShmFormatXbgr8888"
+
+	case 875714642:
+		return "ShmFormatRgbx8888"
+
+	case 875714626:
+		return "ShmFormatBgrx8888"
+
+	case 875708993:
+		return "ShmFormatAbgr8888"
+
+	case 875708754:
+		return "ShmFormatRgba8888"
+
+	case 875708738:
+		return "ShmFormatBgra8888"
+
+	case 808669784:
+		return "ShmFormatXrgb2101010"
+
+	case 808665688:
+		return "ShmFormatXbgr2101010"
+
+	case 808671314:
+		return "ShmFormatRgbx1010102"
+
+	case 808671298:
+		return "ShmFormatBgrx1010102"
+
+	case 808669761:
+		return "ShmFormatArgb2101010"
+
+	case 808665665:
+		return "ShmFormatAbgr2101010"
+
+	case 808665426:
+		return "ShmFormatRgba1010102"
+
+	case 808665410:
+		return "ShmFormatBgra1010102"
+
+	case 1448695129:
+		return "ShmFormatYuyv"
+
+	case 1431918169:
+		return "ShmFormatYvyu"
+
+	case 1498831189:
+		return "ShmFormatUyvy"
+
+	case 1498765654:
+		return "ShmFormatVyuy"
+
+	case 1448433985:
+		return "ShmFormatAyuv"
+
+	case 842094158:
+		return "ShmFormatNv12"
+
+	case 825382478:
+		return "ShmFormatNv21"
+
+	case 909203022:
+		return "ShmFormatNv16"
+
+	case 825644622:
+		return "ShmFormatNv61"
+
+	case 961959257:
+		return "ShmFormatYuv410"
+
+	case 961893977:
+		return "ShmFormatYvu410"
+
+	case 825316697:
+		return "ShmFormatYuv411"
+
+	case 825316953:
+		return "ShmFormatYvu411"
+
+	case 842093913:
+		return "ShmFormatYuv420"
+
+	case 842094169:
+		return "ShmFormatYvu420"
+
+	case 909202777:
+		return "ShmFormatYuv422"
+
+	case 909203033:
+		return "ShmFormatYvu422"
+
+	case 875713881:
+		return "ShmFormatYuv444"
+
+	case 875714137:
+		return "ShmFormatYvu444"
+
+	case 538982482:
+		return "ShmFormatR8"
+
+	case 540422482:
+		return "ShmFormatR16"
+
+	case 943212370:
+		return "ShmFormatRg88"
+
+	case 943215175:
+		return "ShmFormatGr88"
+
+	case 842221394:
+		return "ShmFormatRg1616"
+
+	case 842224199:
+		return "ShmFormatGr1616"
+
+	case 1211388504:
+		return "ShmFormatXrgb16161616f"
+
+	case 1211384408:
+		return "ShmFormatXbgr16161616f"
+
+	case 1211388481:
+		return "ShmFormatArgb16161616f"
+
+	case 1211384385:
+		return "ShmFormatAbgr16161616f"
+
+	case 1448434008:
+		return "ShmFormatXyuv8888"
+
+	case 875713878:
+		return "ShmFormatVuy888"
+
+	case 808670550:
+		return "ShmFormatVuy101010"
+
+	case 808530521:
+		return "ShmFormatY210"
+
+	case 842084953:
+		return "ShmFormatY212"
+
+	case 909193817:
+		return "ShmFormatY216"
+
+	case 808531033:
+		return "ShmFormatY410"
+
+	case 842085465:
+		return "ShmFormatY412"
+
+	case 909194329:
+		return "ShmFormatY416"
+
+	case 808670808:
+		return "ShmFormatXvyu2101010"
+
+	case 909334104:
+		return "ShmFormatXvyu1216161616"
+
+	case 942954072:
+		return "ShmFormatXvyu16161616"
+
+	case 810299481:
+		return "ShmFormatY0l0"
+
+	case 810299480:
+		return "ShmFormatX0l0"
+
+	case 843853913:
+		return "ShmFormatY0l2"
+
+	case 843853912:
+		return "ShmFormatX0l2"
+
+	case 942691673:
+		return "ShmFormatYuv4208bit"
+
+	case 808539481:
+		return "ShmFormatYuv42010bit"
+
+	case 943805016:
+		return "ShmFormatXrgb8888A8"
+
+	case 943800920:
+		return "ShmFormatXbgr8888A8"
+
+	case 943806546:
+		return "ShmFormatRgbx8888A8"
+
+	case 943806530:
+		return "ShmFormatBgrx8888A8"
+
+	case 943798354:
+		return "ShmFormatRgb888A8"
+
+	case 943798338:
+		return "ShmFormatBgr888A8"
+
+	case 943797586:
+		return "ShmFormatRgb565A8"
+
+	case 943797570:
+		return "ShmFormatBgr565A8"
+
+	case 875714126:
+		return "ShmFormatNv24"
+
+	case 842290766:
+		return "ShmFormatNv42"
+
+	case 808530512:
+		return "ShmFormatP210"
+
+	case 808530000:
+		return "ShmFormatP010"
+
+	case 842084432:
+		return "ShmFormatP012"
+
+	case 909193296:
+		return "ShmFormatP016"
+
+	case 808534593:
+		return "ShmFormatAxbxgxrx106106106106"
+
+	case 892425806:
+		return "ShmFormatNv15"
+
+	case 808531025:
+		return "ShmFormatQ410"
+
+	case 825242705:
+		return "ShmFormatQ401"
+
+	case 942953048:
+		return "ShmFormatXrgb16161616"
+
+	case 942948952:
+		return "ShmFormatXbgr16161616"
+
+	case 942953025:
+		return "ShmFormatArgb16161616"
+
+	case 942948929:
+		return "ShmFormatAbgr16161616"
+
+	case 538980675:
+		return "ShmFormatC1"
+
+	case 538980931:
+		return "ShmFormatC2"
+
+	case 538981443:
+		return "ShmFormatC4"
+
+	case 538980676:
+		return "ShmFormatD1"
+
+	case 538980932:
+		return "ShmFormatD2"
+
+	case 538981444:
+		return "ShmFormatD4"
+
+	case 538982468:
+		return "ShmFormatD8"
+
+	case 538980690:
+		return "ShmFormatR1"
+
+	case 538980946:
+		return "ShmFormatR2"
+
+	case 538981458:
+		return "ShmFormatR4"
+
+	case 540029266:
+		return "ShmFormatR10"
+
+	case 540160338:
+		return "ShmFormatR12"
+
+	case 1498764865:
+		return "ShmFormatAvuy8888"
+
+	case 1498764888:
+		return "ShmFormatXvuy8888"
+
+	case 808661072:
+		return "ShmFormatP030"
+	}
+
+	return "<invalid ShmFormat>"
+}
+
+const (
+	BufferInterface = "wl_buffer"
+	BufferVersion   = 1
+)
+
+const (
+	DataOfferInterface = "wl_data_offer"
+	DataOfferVersion   = 3
+)
+
+type DataOfferError int64
+
+const (
+	// finish request was called untimely
+	DataOfferErrorInvalidFinish DataOfferError = 0
+
+	// action mask contains invalid values
+	DataOfferErrorInvalidActionMask DataOfferError = 1
+
+	// action argument has an invalid value
+	DataOfferErrorInvalidAction DataOfferError = 2
+
+	// offer doesn't accept this request
+	DataOfferErrorInvalidOffer DataOfferError = 3
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum DataOfferError) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3:
+		return true
+	}
+
+	return false
+}
+
+func (enum DataOfferError) String() string {
+	switch enum {
+	case 0:
+		return "DataOfferErrorInvalidFinish"
+
+	case 1:
+		return "DataOfferErrorInvalidActionMask"
+
+	case 2:
+		return "DataOfferErrorInvalidAction"
+
+	case 3:
+		return "DataOfferErrorInvalidOffer"
+	}
+
+	return "<invalid DataOfferError>"
+}
+
+const (
+	DataSourceInterface = "wl_data_source"
+	DataSourceVersion   = 3
+)
+
+type DataSourceError int64
+
+const (
+	// action mask contains invalid values
+	DataSourceErrorInvalidActionMask DataSourceError = 0
+
+	// source doesn't accept this request
+	DataSourceErrorInvalidSource DataSourceError = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum DataSourceError) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum DataSourceError) String() string {
+	switch enum {
+	case 0:
+		return "DataSourceErrorInvalidActionMask"
+
+	case 1:
+		return "DataSourceErrorInvalidSource"
+	}
+
+	return "<invalid DataSourceError>"
+}
+
+const (
+	DataDeviceInterface = "wl_data_device"
+	DataDeviceVersion   = 3
+)
+
+type DataDeviceError int64
+
+const (
+	// given wl_surface has another role
+	DataDeviceErrorRole DataDeviceError = 0
+
+	// source has already been used
+	DataDeviceErrorUsedSource DataDeviceError = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum DataDeviceError) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum DataDeviceError) String() string {
+	switch enum {
+	case 0:
+		return "DataDeviceErrorRole"
+
+	case 1:
+		return "DataDeviceErrorUsedSource"
+	}
+
+	return "<invalid DataDeviceError>"
+}
+
+const (
+	DataDeviceManagerInterface = "wl_data_device_manager"
+	DataDeviceManagerVersion   = 3
+)
+
+// This is a bitmask of the available/preferred actions in a
+// drag-and-drop operation.
+//
+// In the compositor, the selected action is a result of matching the
+// actions offered by the source and destination sides.  "action" events
+// with a "none" action will be sent to both source and destination if
+// there is no match. All further checks will effectively happen on
+// (source actions ∩ destination actions).
+//
+// In addition, compositors may also pick different actions in
+// reaction to key modifiers being pressed. One common design that
+// is used in major toolkits (and the behavior recommended for
+// compositors) is:
+//
+// - If no modifiers are pressed, the first match (in bit order)
+// will be used.
+// - Pressing Shift selects "move", if enabled in the mask.
+// - Pressing Control selects "copy", if enabled in the mask.
+//
+// Behavior beyond that is considered implementation-dependent.
+// Compositors may for example bind other modifiers (like Alt/Meta)
+// or drags initiated with other buttons than BTN_LEFT to specific
+// actions (e.g. "ask").
+//
+// Available since version 3.
+type DataDeviceManagerDndAction int64
+
+const (
+	// no action
+	DataDeviceManagerDndActionNone DataDeviceManagerDndAction = 0
+
+	// copy action
+	DataDeviceManagerDndActionCopy DataDeviceManagerDndAction = 1
+
+	// move action
+	DataDeviceManagerDndActionMove DataDeviceManagerDndAction = 2
+
+	// ask action
+	DataDeviceManagerDndActionAsk DataDeviceManagerDndAction = 4
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum DataDeviceManagerDndAction) Has(flags DataDeviceManagerDndAction) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum DataDeviceManagerDndAction) With(flags DataDeviceManagerDndAction) DataDeviceManagerDndAction {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum DataDeviceManagerDndAction) Without(flags DataDeviceManagerDndAction) DataDeviceManagerDndAction {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum DataDeviceManagerDndAction) Valid() bool {
+	const mask = 0 | DataDeviceManagerDndActionNone | DataDeviceManagerDndActionCopy | DataDeviceManagerDndActionMove | DataDeviceManagerDndActionAsk
+	return enum.Without(mask) == 0
+}
+
+func (enum DataDeviceManagerDndAction) String() string {
+	if enum == 0 {
+		return "DataDeviceManagerDndActionNone"
+	}
+
+	var str string
+	if enum.Has(DataDeviceManagerDndActionCopy) {
+		str += "|DataDeviceManagerDndActionCopy"
+		enum = enum.Without(DataDeviceManagerDndActionCopy)
+	}
+	if enum.Has(DataDeviceManagerDndActionMove) {
+		str += "|DataDeviceManagerDndActionMove"
+		enum = enum.Without(DataDeviceManagerDndActionMove)
+	}
+	if enum.Has(DataDeviceManagerDndActionAsk) {
+		str += "|DataDeviceManagerDndActionAsk"
+		enum = enum.Without(DataDeviceManagerDndActionAsk)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+const (
+	ShellInterface = "wl_shell"
+	ShellVersion   = 1
+)
+
+type ShellError int64
+
+const (
+	// given wl_surface has another role
+	ShellErrorRole ShellError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ShellError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum ShellError) String() string {
+	switch enum {
+	case 0:
+		return "ShellErrorRole"
+	}
+
+	return "<invalid ShellError>"
+}
+
+const (
+	ShellSurfaceInterface = "wl_shell_surface"
+	ShellSurfaceVersion   = 1
+)
+
+// These values are used to indicate which edge of a surface
+// is being dragged in a resize operation. The server may
+// use this information to adapt its behavior, e.g. choose
+// an appropriate cursor image.
+type ShellSurfaceResize int64
+
+const (
+	// no edge
+	ShellSurfaceResizeNone ShellSurfaceResize = 0
+
+	// top edge
+	ShellSurfaceResizeTop ShellSurfaceResize = 1
+
+	// bottom edge
+	ShellSurfaceResizeBottom ShellSurfaceResize = 2
+
+	// left edge
+	ShellSurfaceResizeLeft ShellSurfaceResize = 4
+
+	// top and left edges
+	ShellSurfaceResizeTopLeft ShellSurfaceResize = 5
+
+	// bottom and left edges
+	ShellSurfaceResizeBottomLeft ShellSurfaceResize = 6
+
+	// right edge
+	ShellSurfaceResizeRight ShellSurfaceResize = 8
+
+	// top and right edges
+	ShellSurfaceResizeTopRight ShellSurfaceResize = 9
+
+	// bottom and right edges
+	ShellSurfaceResizeBottomRight ShellSurfaceResize = 10
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum ShellSurfaceResize) Has(flags ShellSurfaceResize) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum ShellSurfaceResize) With(flags ShellSurfaceResize) ShellSurfaceResize {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum ShellSurfaceResize) Without(flags ShellSurfaceResize) ShellSurfaceResize {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum ShellSurfaceResize) Valid() bool {
+	const mask = 0 | ShellSurfaceResizeNone | ShellSurfaceResizeTop | ShellSurfaceResizeBottom | ShellSurfaceResizeLeft | ShellSurfaceResizeTopLeft | ShellSurfaceResizeBottomLeft | ShellSurfaceResizeRight | ShellSurfaceResizeTopRight | ShellSurfaceResizeBottomRight
+	return enum.Without(mask) == 0
+}
+
+func (enum ShellSurfaceResize) String() string {
+	if enum == 0 {
+		return "ShellSurfaceResizeNone"
+	}
+
+	var str string
+	if enum.Has(ShellSurfaceResizeTop) {
+		str += "|ShellSurfaceResizeTop"
+		enum = enum.Without(ShellSurfaceResizeTop)
+	}
+	if enum.Has(ShellSurfaceResizeBottom) {
+		str += "|ShellSurfaceResizeBottom"
+		enum = enum.Without(ShellSurfaceResizeBottom)
+	}
+	if enum.Has(ShellSurfaceResizeLeft) {
+		str += "|ShellSurfaceResizeLeft"
+		enum = enum.Without(ShellSurfaceResizeLeft)
+	}
+	if enum.Has(ShellSurfaceResizeTopLeft) {
+		str += "|ShellSurfaceResizeTopLeft"
+		enum = enum.Without(ShellSurfaceResizeTopLeft)
+	}
+	if enum.Has(ShellSurfaceResizeBottomLeft) {
+		str += "|ShellSurfaceResizeBottomLeft"
+		enum = enum.Without(ShellSurfaceResizeBottomLeft)
+	}
+	if enum.Has(ShellSurfaceResizeRight) {
+		str += "|ShellSurfaceResizeRight"
+		enum = enum.Without(ShellSurfaceResizeRight)
+	}
+	if enum.Has(ShellSurfaceResizeTopRight) {
+		str += "|ShellSurfaceResizeTopRight"
+		enum = enum.Without(ShellSurfaceResizeTopRight)
+	}
+	if enum.Has(ShellSurfaceResizeBottomRight) {
+		str += "|ShellSurfaceResizeBottomRight"
+		enum = enum.Without(ShellSurfaceResizeBottomRight)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+// These flags specify details of the expected behaviour
+// of transient surfaces. Used in the set_transient request.
+type ShellSurfaceTransient int64
+
+const (
+	// do not set keyboard focus
+	ShellSurfaceTransientInactive ShellSurfaceTransient = 1
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum ShellSurfaceTransient) Has(flags ShellSurfaceTransient) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum ShellSurfaceTransient) With(flags ShellSurfaceTransient) ShellSurfaceTransient {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum ShellSurfaceTransient) Without(flags ShellSurfaceTransient) ShellSurfaceTransient {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum ShellSurfaceTransient) Valid() bool {
+	const mask = 0 | ShellSurfaceTransientInactive
+	return enum.Without(mask) == 0
+}
+
+func (enum ShellSurfaceTransient) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(ShellSurfaceTransientInactive) {
+		str += "|ShellSurfaceTransientInactive"
+		enum = enum.Without(ShellSurfaceTransientInactive)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+// Hints to indicate to the compositor how to deal with a conflict
+// between the dimensions of the surface and the dimensions of the
+// output. The compositor is free to ignore this parameter.
+type ShellSurfaceFullscreenMethod int64
+
+const (
+	// no preference, apply default policy
+	ShellSurfaceFullscreenMethodDefault ShellSurfaceFullscreenMethod = 0
+
+	// scale, preserve the surface's aspect ratio and center on output
+	ShellSurfaceFullscreenMethodScale ShellSurfaceFullscreenMethod = 1
+
+	// switch output mode to the smallest mode that can fit the surface, add black borders to compensate size mismatch
+	ShellSurfaceFullscreenMethodDriver ShellSurfaceFullscreenMethod = 2
+
+	// no upscaling, center on output and add black borders to compensate size mismatch
+	ShellSurfaceFullscreenMethodFill ShellSurfaceFullscreenMethod = 3
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ShellSurfaceFullscreenMethod) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3:
+		return true
+	}
+
+	return false
+}
+
+func (enum ShellSurfaceFullscreenMethod) String() string {
+	switch enum {
+	case 0:
+		return "ShellSurfaceFullscreenMethodDefault"
+
+	case 1:
+		return "ShellSurfaceFullscreenMethodScale"
+
+	case 2:
+		return "ShellSurfaceFullscreenMethodDriver"
+
+	case 3:
+		return "ShellSurfaceFullscreenMethodFill"
+	}
+
+	return "<invalid ShellSurfaceFullscreenMethod>"
+}
+
+const (
+	SurfaceInterface = "wl_surface"
+	SurfaceVersion   = 6
+)
+
+// These errors can be emitted in response to wl_surface requests.
+type SurfaceError int64
+
+const (
+	// buffer scale value is invalid
+	SurfaceErrorInvalidScale SurfaceError = 0
+
+	// buffer transform value is invalid
+	SurfaceErrorInvalidTransform SurfaceError = 1
+
+	// buffer size is invalid
+	SurfaceErrorInvalidSize SurfaceError = 2
+
+	// buffer offset is invalid
+	SurfaceErrorInvalidOffset SurfaceError = 3
+
+	// surface was destroyed before its role object
+	SurfaceErrorDefunctRoleObject SurfaceError = 4
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum SurfaceError) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4:
+		return true
+	}
+
+	return false
+}
+
+func (enum SurfaceError) String() string {
+	switch enum {
+	case 0:
+		return "SurfaceErrorInvalidScale"
+
+	case 1:
+		return "SurfaceErrorInvalidTransform"
+
+	case 2:
+		return "SurfaceErrorInvalidSize"
+
+	case 3:
+		return "SurfaceErrorInvalidOffset"
+
+	case 4:
+		return "SurfaceErrorDefunctRoleObject"
+	}
+
+	return "<invalid SurfaceError>"
+}
+
+const (
+	SeatInterface = "wl_seat"
+	SeatVersion   = 10
+)
+
+// This is a bitmask of capabilities this seat has; if a member is
+// set, then it is present on the seat.
+type SeatCapability int64
+
+const (
+	// the seat has pointer devices
+	SeatCapabilityPointer SeatCapability = 1
+
+	// the seat has one or more keyboards
+	SeatCapabilityKeyboard SeatCapability = 2
+
+	// the seat has touch devices
+	SeatCapabilityTouch SeatCapability = 4
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum SeatCapability) Has(flags SeatCapability) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum SeatCapability) With(flags SeatCapability) SeatCapability {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum SeatCapability) Without(flags SeatCapability) SeatCapability {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum SeatCapability) Valid() bool {
+	const mask = 0 | SeatCapabilityPointer | SeatCapabilityKeyboard | SeatCapabilityTouch
+	return enum.Without(mask) == 0
+}
+
+func (enum SeatCapability) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(SeatCapabilityPointer) {
+		str += "|SeatCapabilityPointer"
+		enum = enum.Without(SeatCapabilityPointer)
+	}
+	if enum.Has(SeatCapabilityKeyboard) {
+		str += "|SeatCapabilityKeyboard"
+		enum = enum.Without(SeatCapabilityKeyboard)
+	}
+	if enum.Has(SeatCapabilityTouch) {
+		str += "|SeatCapabilityTouch"
+		enum = enum.Without(SeatCapabilityTouch)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+// These errors can be emitted in response to wl_seat requests.
+type SeatError int64
+
+const (
+	// get_pointer, get_keyboard or get_touch called on seat without the matching capability
+	SeatErrorMissingCapability SeatError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum SeatError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum SeatError) String() string {
+	switch enum {
+	case 0:
+		return "SeatErrorMissingCapability"
+	}
+
+	return "<invalid SeatError>"
+}
+
+const (
+	PointerInterface = "wl_pointer"
+	PointerVersion   = 10
+)
+
+type PointerError int64
+
+const (
+	// given wl_surface has another role
+	PointerErrorRole PointerError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PointerError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum PointerError) String() string {
+	switch enum {
+	case 0:
+		return "PointerErrorRole"
+	}
+
+	return "<invalid PointerError>"
+}
+
+// Describes the physical state of a button that produced the button
+// event.
+type PointerButtonState int64
+
+const (
+	// the button is not pressed
+	PointerButtonStateReleased PointerButtonState = 0
+
+	// the button is pressed
+	PointerButtonStatePressed PointerButtonState = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PointerButtonState) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum PointerButtonState) String() string {
+	switch enum {
+	case 0:
+		return "PointerButtonStateReleased"
+
+	case 1:
+		return "PointerButtonStatePressed"
+	}
+
+	return "<invalid PointerButtonState>"
+}
+
+// Describes the axis types of scroll events.
+type PointerAxis int64
+
+const (
+	// vertical axis
+	PointerAxisVerticalScroll PointerAxis = 0
+
+	// horizontal axis
+	PointerAxisHorizontalScroll PointerAxis = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PointerAxis) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum PointerAxis) String() string {
+	switch enum {
+	case 0:
+		return "PointerAxisVerticalScroll"
+
+	case 1:
+		return "PointerAxisHorizontalScroll"
+	}
+
+	return "<invalid PointerAxis>"
+}
+
+// Describes the source types for axis events. This indicates to the
+// client how an axis event was physically generated; a client may
+// adjust the user interface accordingly. For example, scroll events
+// from a "finger" source may be in a smooth coordinate space with
+// kinetic scrolling whereas a "wheel" source may be in discrete steps
+// of a number of lines.
+//
+// The "continuous" axis source is a device generating events in a
+// continuous coordinate space, but using something other than a
+// finger. One example for this source is button-based scrolling where
+// the vertical motion of a device is converted to scroll events while
+// a button is held down.
+//
+// The "wheel tilt" axis source indicates that the actual device is a
+// wheel but the scroll event is not caused by a rotation but a
+// (usually sideways) tilt of the wheel.
+type PointerAxisSource int64
+
+const (
+	// a physical wheel rotation
+	PointerAxisSourceWheel PointerAxisSource = 0
+
+	// finger on a touch surface
+	PointerAxisSourceFinger PointerAxisSource = 1
+
+	// continuous coordinate space
+	PointerAxisSourceContinuous PointerAxisSource = 2
+
+	// a physical wheel tilt
+	//
+	// Available since version 6.
+	PointerAxisSourceWheelTilt PointerAxisSource = 3
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PointerAxisSource) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3:
+		return true
+	}
+
+	return false
+}
+
+func (enum PointerAxisSource) String() string {
+	switch enum {
+	case 0:
+		return "PointerAxisSourceWheel"
+
+	case 1:
+		return "PointerAxisSourceFinger"
+
+	case 2:
+		return "PointerAxisSourceContinuous"
+
+	case 3:
+		return "PointerAxisSourceWheelTilt"
+	}
+
+	return "<invalid PointerAxisSource>"
+}
+
+// This specifies the direction of the physical motion that caused a
+// wl_pointer.axis event, relative to the wl_pointer.axis direction.
+type PointerAxisRelativeDirection int64
+
+const (
+	// physical motion matches axis direction
+	PointerAxisRelativeDirectionIdentical PointerAxisRelativeDirection = 0
+
+	// physical motion is the inverse of the axis direction
+	PointerAxisRelativeDirectionInverted PointerAxisRelativeDirection = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PointerAxisRelativeDirection) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum PointerAxisRelativeDirection) String() string {
+	switch enum {
+	case 0:
+		return "PointerAxisRelativeDirectionIdentical"
+
+	case 1:
+		return "PointerAxisRelativeDirectionInverted"
+	}
+
+	return "<invalid PointerAxisRelativeDirection>"
+}
+
+const (
+	KeyboardInterface = "wl_keyboard"
+	KeyboardVersion   = 10
+)
+
+// This specifies the format of the keymap provided to the
+// client with the wl_keyboard.keymap event.
+type KeyboardKeymapFormat int64
+
+const (
+	// no keymap; client must understand how to interpret the raw keycode
+	KeyboardKeymapFormatNoKeymap KeyboardKeymapFormat = 0
+
+	// libxkbcommon compatible, null-terminated string; to determine the xkb keycode, clients must add 8 to the key event keycode
+	KeyboardKeymapFormatXkbV1 KeyboardKeymapFormat = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum KeyboardKeymapFormat) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum KeyboardKeymapFormat) String() string {
+	switch enum {
+	case 0:
+		return "KeyboardKeymapFormatNoKeymap"
+
+	case 1:
+		return "KeyboardKeymapFormatXkbV1"
+	}
+
+	return "<invalid KeyboardKeymapFormat>"
+}
+
+// Describes the physical state of a key that produced the key event.
+//
+// Since version 10, the key can be in a "repeated" pseudo-state which
+// means the same as "pressed", but is used to signal repetition in the
+// key event.
+//
+// The key may only enter the repeated state after entering the pressed
+// state and before entering the released state. This event may be
+// generated multiple times while the key is down.
+type KeyboardKeyState int64
+
+const (
+	// key is not pressed
+	KeyboardKeyStateReleased KeyboardKeyState = 0
+
+	// key is pressed
+	KeyboardKeyStatePressed KeyboardKeyState = 1
+
+	// key was repeated
+	//
+	// Available since version 10.
+	KeyboardKeyStateRepeated KeyboardKeyState = 2
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum KeyboardKeyState) Valid() bool {
+	switch enum {
+	case 0, 1, 2:
+		return true
+	}
+
+	return false
+}
+
+func (enum KeyboardKeyState) String() string {
+	switch enum {
+	case 0:
+		return "KeyboardKeyStateReleased"
+
+	case 1:
+		return "KeyboardKeyStatePressed"
+
+	case 2:
+		return "KeyboardKeyStateRepeated"
+	}
+
+	return "<invalid KeyboardKeyState>"
+}
+
+const (
+	TouchInterface = "wl_touch"
+	TouchVersion   = 10
+)
+
+const (
+	OutputInterface = "wl_output"
+	OutputVersion   = 4
+)
+
+// This enumeration describes how the physical
+// pixels on an output are laid out.
+type OutputSubpixel int64
+
+const (
+	// unknown geometry
+	OutputSubpixelUnknown OutputSubpixel = 0
+
+	// no geometry
+	OutputSubpixelNone OutputSubpixel = 1
+
+	// horizontal RGB
+	OutputSubpixelHorizontalRgb OutputSubpixel = 2
+
+	// horizontal BGR
+	OutputSubpixelHorizontalBgr OutputSubpixel = 3
+
+	// vertical RGB
+	OutputSubpixelVerticalRgb OutputSubpixel = 4
+
+	// vertical BGR
+	OutputSubpixelVerticalBgr OutputSubpixel = 5
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum OutputSubpixel) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5:
+		return true
+	}
+
+	return false
+}
+
+func (enum OutputSubpixel) String() string {
+	switch enum {
+	case 0:
+		return "OutputSubpixelUnknown"
+
+	case 1:
+		return "OutputSubpixelNone"
+
+	case 2:
+		return "OutputSubpixelHorizontalRgb"
+
+	case 3:
+		return "OutputSubpixelHorizontalBgr"
+
+	case 4:
+		return "OutputSubpixelVerticalRgb"
+
+	case 5:
+		return "OutputSubpixelVerticalBgr"
+	}
+
+	return "<invalid OutputSubpixel>"
+}
+
+// This describes transformations that clients and compositors apply to
+// buffer contents.
+//
+// The flipped values correspond to an initial flip around a
+// vertical axis followed by rotation.
+//
+// The purpose is mainly to allow clients to render accordingly and
+// tell the compositor, so that for fullscreen surfaces, the
+// compositor will still be able to scan out directly from client
+// surfaces.
+type OutputTransform int64
+
+const (
+	// no transform
+	OutputTransformNormal OutputTransform = 0
+
+	// 90 degrees counter-clockwise
+	OutputTransform90 OutputTransform = 1
+
+	// 180 degrees counter-clockwise
+	OutputTransform180 OutputTransform = 2
+
+	// 270 degrees counter-clockwise
+	OutputTransform270 OutputTransform = 3
+
+	// 180 degree flip around a vertical axis
+	OutputTransformFlipped OutputTransform = 4
+
+	// flip and rotate 90 degrees counter-clockwise
+	OutputTransformFlipped90 OutputTransform = 5
+
+	// flip and rotate 180 degrees counter-clockwise
+	OutputTransformFlipped180 OutputTransform = 6
+
+	// flip and rotate 270 degrees counter-clockwise
+	OutputTransformFlipped270 OutputTransform = 7
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum OutputTransform) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5, 6, 7:
+		return true
+	}
+
+	return false
+}
+
+func (enum OutputTransform) String() string {
+	switch enum {
+	case 0:
+		return "OutputTransformNormal"
+
+	case 1:
+		return "OutputTransform90"
+
+	case 2:
+		return "OutputTransform180"
+
+	case 3:
+		return "OutputTransform270"
+
+	case 4:
+		return "OutputTransformFlipped"
+
+	case 5:
+		return "OutputTransformFlipped90"
+
+	case 6:
+		return "OutputTransformFlipped180"
+
+	case 7:
+		return "OutputTransformFlipped270"
+	}
+
+	return "<invalid OutputTransform>"
+}
+
+// These flags describe properties of an output mode.
+// They are used in the flags bitfield of the mode event.
+type OutputMode int64
+
+const (
+	// indicates this is the current mode
+	OutputModeCurrent OutputMode = 1
+
+	// indicates this is the preferred mode
+	OutputModePreferred OutputMode = 2
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum OutputMode) Has(flags OutputMode) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum OutputMode) With(flags OutputMode) OutputMode {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum OutputMode) Without(flags OutputMode) OutputMode {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum OutputMode) Valid() bool {
+	const mask = 0 | OutputModeCurrent | OutputModePreferred
+	return enum.Without(mask) == 0
+}
+
+func (enum OutputMode) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(OutputModeCurrent) {
+		str += "|OutputModeCurrent"
+		enum = enum.Without(OutputModeCurrent)
+	}
+	if enum.Has(OutputModePreferred) {
+		str += "|OutputModePreferred"
+		enum = enum.Without(OutputModePreferred)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+const (
+	RegionInterface = "wl_region"
+	RegionVersion   = 1
+)
+
+const (
+	SubcompositorInterface = "wl_subcompositor"
+	SubcompositorVersion   = 1
+)
+
+type SubcompositorError int64
+
+const (
+	// the to-be sub-surface is invalid
+	SubcompositorErrorBadSurface SubcompositorError = 0
+
+	// the to-be sub-surface parent is invalid
+	SubcompositorErrorBadParent SubcompositorError = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum SubcompositorError) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum SubcompositorError) String() string {
+	switch enum {
+	case 0:
+		return "SubcompositorErrorBadSurface"
+
+	case 1:
+		return "SubcompositorErrorBadParent"
+	}
+
+	return "<invalid SubcompositorError>"
+}
+
+const (
+	SubsurfaceInterface = "wl_subsurface"
+	SubsurfaceVersion   = 1
+)
+
+type SubsurfaceError int64
+
+const (
+	// wl_surface is not a sibling or the parent
+	SubsurfaceErrorBadSurface SubsurfaceError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum SubsurfaceError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum SubsurfaceError) String() string {
+	switch enum {
+	case 0:
+		return "SubsurfaceErrorBadSurface"
+	}
+
+	return "<invalid SubsurfaceError>"
+}
+
+const (
+	FixesInterface = "wl_fixes"
+	FixesVersion   = 1
+)
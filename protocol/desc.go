@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"fmt"
+
+	"deedles.dev/wl/wire"
+)
+
+// Desc converts p into a set of runtime interface descriptions that
+// can be used with wire.DynamicObject or registered with
+// wire.RegisterInterface.
+func (p Protocol) Desc() ([]*wire.InterfaceDesc, error) {
+	descs := make([]*wire.InterfaceDesc, 0, len(p.Interfaces))
+	for _, i := range p.Interfaces {
+		desc, err := i.Desc()
+		if err != nil {
+			return nil, err
+		}
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
+
+// Desc converts i into a runtime interface description.
+func (i Interface) Desc() (*wire.InterfaceDesc, error) {
+	requests, err := opDescs(i.Requests)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", i.Name, err)
+	}
+	events, err := opDescs(i.Events)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", i.Name, err)
+	}
+
+	enums := make([]wire.EnumDesc, 0, len(i.Enums))
+	for _, e := range i.Enums {
+		entries := make([]wire.EntryDesc, 0, len(e.Entries))
+		for _, entry := range e.Entries {
+			v, err := entry.Int()
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v.%v: %w", i.Name, e.Name, entry.Name, err)
+			}
+			entries = append(entries, wire.EntryDesc{Name: entry.Name, Value: uint32(v)})
+		}
+		enums = append(enums, wire.EnumDesc{Name: e.Name, Bitfield: e.Bitfield, Entries: entries})
+	}
+
+	return &wire.InterfaceDesc{
+		Name:     i.Name,
+		Version:  uint32(i.Version),
+		Requests: requests,
+		Events:   events,
+		Enums:    enums,
+	}, nil
+}
+
+func opDescs(ops []Op) ([]wire.MessageDesc, error) {
+	descs := make([]wire.MessageDesc, 0, len(ops))
+	for _, op := range ops {
+		args := make([]wire.ArgDesc, 0, len(op.Args))
+		for _, arg := range op.Args {
+			t, err := wire.ParseArgType(arg.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v: %w", op.Name, arg.Name, err)
+			}
+			args = append(args, wire.ArgDesc{
+				Name:      arg.Name,
+				Type:      t,
+				Interface: arg.Interface,
+				AllowNull: arg.AllowNull,
+				Enum:      arg.Enum,
+			})
+		}
+
+		since := uint32(op.Since)
+		if since == 0 {
+			since = 1
+		}
+		descs = append(descs, wire.MessageDesc{
+			Name:  op.Name,
+			Since: since,
+			Args:  args,
+		})
+	}
+	return descs, nil
+}
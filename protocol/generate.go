@@ -0,0 +1,15 @@
+package protocol
+
+// Bindings for all of the vendored protocols are generated in a single
+// run so that references between them can be resolved. The location of
+// each protocol's generated package is given by the path directive in
+// its config file, and the location of the package containing the enums
+// and constants that the client and server packages share is given by
+// the shared directive. Packages of mock listeners are generated for the
+// protocols whose configs have a mock directive.
+
+//go:generate go run deedles.dev/wl/cmd/wlgen -xml wayland.xml -xml xdg-shell.xml -xml xdg-decoration-unstable-v1.xml -xml xdg-activation-v1.xml -xml viewporter.xml -xml presentation-time.xml -xml fractional-scale-v1.xml -xml linux-dmabuf-v1.xml -xml cursor-shape-v1.xml -xml single-pixel-buffer-v1.xml -xml tearing-control-v1.xml -xml tablet-v2.xml
+//go:generate go run deedles.dev/wl/cmd/wlgen -client -xml wayland.xml -xml xdg-shell.xml -xml xdg-decoration-unstable-v1.xml -xml xdg-activation-v1.xml -xml viewporter.xml -xml presentation-time.xml -xml fractional-scale-v1.xml -xml linux-dmabuf-v1.xml -xml cursor-shape-v1.xml -xml single-pixel-buffer-v1.xml -xml tearing-control-v1.xml -xml tablet-v2.xml
+//go:generate go run deedles.dev/wl/cmd/wlgen -shared -xml wayland.xml -xml xdg-shell.xml -xml xdg-decoration-unstable-v1.xml -xml xdg-activation-v1.xml -xml viewporter.xml -xml presentation-time.xml -xml fractional-scale-v1.xml -xml linux-dmabuf-v1.xml -xml cursor-shape-v1.xml -xml single-pixel-buffer-v1.xml -xml tearing-control-v1.xml -xml tablet-v2.xml
+//go:generate go run deedles.dev/wl/cmd/wlgen -mock -xml wayland.xml -xml xdg-shell.xml -xml xdg-decoration-unstable-v1.xml -xml xdg-activation-v1.xml -xml viewporter.xml -xml presentation-time.xml -xml fractional-scale-v1.xml -xml linux-dmabuf-v1.xml -xml cursor-shape-v1.xml -xml single-pixel-buffer-v1.xml -xml tearing-control-v1.xml -xml tablet-v2.xml
+//go:generate go run deedles.dev/wl/cmd/wlgen -mock -client -xml wayland.xml -xml xdg-shell.xml -xml xdg-decoration-unstable-v1.xml -xml xdg-activation-v1.xml -xml viewporter.xml -xml presentation-time.xml -xml fractional-scale-v1.xml -xml linux-dmabuf-v1.xml -xml cursor-shape-v1.xml -xml single-pixel-buffer-v1.xml -xml tearing-control-v1.xml -xml tablet-v2.xml
@@ -0,0 +1,88 @@
+package protocol
+
+import (
+	"bytes"
+	"embed"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"os"
+)
+
+//go:embed wayland.xml
+var waylandXML []byte
+
+//go:embed *.xml
+var vendoredXML embed.FS
+
+// Load decodes a protocol specification from r.
+func Load(r io.Reader) (proto Protocol, err error) {
+	d := xml.NewDecoder(r)
+	err = d.Decode(&proto)
+	return proto, err
+}
+
+// LoadFile decodes the protocol specification in the file at path.
+func LoadFile(path string) (Protocol, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Protocol{}, err
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+// Wayland returns the core Wayland protocol specification that this
+// module was generated from.
+func Wayland() Protocol {
+	proto, err := Load(bytes.NewReader(waylandXML))
+	if err != nil {
+		panic(err)
+	}
+	return proto
+}
+
+// Vendored returns the specifications of all of the protocols that
+// this module was generated from, including the core Wayland protocol.
+func Vendored() []Protocol {
+	paths, err := fs.Glob(vendoredXML, "*.xml")
+	if err != nil {
+		panic(err)
+	}
+
+	protos := make([]Protocol, 0, len(paths))
+	for _, path := range paths {
+		data, err := vendoredXML.ReadFile(path)
+		if err != nil {
+			panic(err)
+		}
+		proto, err := Load(bytes.NewReader(data))
+		if err != nil {
+			panic(err)
+		}
+		protos = append(protos, proto)
+	}
+	return protos
+}
+
+// Save encodes proto as a protocol specification and writes it to w.
+// Requests, events and enums are written grouped by kind rather than
+// in the order in which they originally appeared, which does not
+// change the meaning of the protocol.
+func Save(w io.Writer, proto Protocol) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	err = e.Encode(proto)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
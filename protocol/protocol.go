@@ -1,12 +1,21 @@
 // Package protocol defines the types necessary for unmarshalling a
-// protocol-specification XML file.
+// protocol-specification XML file. The types model the complete
+// wayland.dtd schema, so a Protocol can also be marshalled back into
+// XML.
 package protocol
 
-import "strconv"
+import (
+	"encoding/xml"
+	"slices"
+	"strconv"
+)
 
 type Protocol struct {
-	Name      string `xml:"name,attr"`
-	Copyright string `xml:"copyright"`
+	XMLName xml.Name `xml:"protocol"`
+
+	Name        string      `xml:"name,attr"`
+	Copyright   string      `xml:"copyright,omitempty"`
+	Description Description `xml:"description"`
 
 	Interfaces []Interface `xml:"interface"`
 }
@@ -21,43 +30,170 @@ type Interface struct {
 	Enums    []Enum `xml:"enum"`
 }
 
+// Request returns the request with the given name, if there is one.
+func (i Interface) Request(name string) (Op, bool) {
+	return find(i.Requests, func(op Op) bool { return op.Name == name })
+}
+
+// Event returns the event with the given name, if there is one.
+func (i Interface) Event(name string) (Op, bool) {
+	return find(i.Events, func(op Op) bool { return op.Name == name })
+}
+
+// Enum returns the enum with the given name, if there is one.
+func (i Interface) Enum(name string) (Enum, bool) {
+	return find(i.Enums, func(e Enum) bool { return e.Name == name })
+}
+
+// AtVersion returns a copy of i as it was at the given version of the
+// interface, without any of the messages, enums or enum entries that
+// were added after it. If version is not less than i's version, i is
+// returned unchanged.
+//
+// Messages are assumed to be in the order that they were added to the
+// interface, as Validate requires, so that removing the newer ones
+// does not change the opcodes of the rest.
+func (i Interface) AtVersion(version int) Interface {
+	if version >= i.Version {
+		return i
+	}
+
+	newer := func(op Op) bool { return op.SinceVersion() > version }
+	i.Version = version
+	i.Requests = slices.DeleteFunc(slices.Clone(i.Requests), newer)
+	i.Events = slices.DeleteFunc(slices.Clone(i.Events), newer)
+
+	enums := make([]Enum, 0, len(i.Enums))
+	for _, e := range i.Enums {
+		if e.SinceVersion() > version {
+			continue
+		}
+		e.Entries = slices.DeleteFunc(slices.Clone(e.Entries), func(entry Entry) bool {
+			return entry.SinceVersion() > version
+		})
+		enums = append(enums, e)
+	}
+	i.Enums = enums
+
+	return i
+}
+
 type Description struct {
 	Summary string `xml:"summary,attr"`
 	Full    string `xml:",chardata"`
 }
 
+// MarshalXML implements xml.Marshaler. Empty descriptions are
+// omitted entirely.
+func (d Description) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if d == (Description{}) {
+		return nil
+	}
+
+	type plain Description
+	return e.EncodeElement(plain(d), start)
+}
+
 type Op struct {
-	Name        string      `xml:"name,attr"`
+	Name string `xml:"name,attr"`
+
+	// Type is "destructor" if the message destroys the object that it
+	// is sent to and is empty otherwise.
+	Type string `xml:"type,attr,omitempty"`
+
+	// Since is the version of the interface that the message was added
+	// in. It is zero if the message has existed since version 1.
+	Since int `xml:"since,attr,omitempty"`
+
+	// DeprecatedSince is the version of the interface from which the
+	// message should no longer be used, or zero if it is not
+	// deprecated.
+	DeprecatedSince int `xml:"deprecated-since,attr,omitempty"`
+
 	Description Description `xml:"description"`
 
 	Args []Arg `xml:"arg"`
 }
 
+// IsDestructor reports whether op destroys the object that it is sent
+// to.
+func (op Op) IsDestructor() bool {
+	return op.Type == "destructor"
+}
+
+// SinceVersion returns the version that op was added in, which is 1
+// if it was not specified.
+func (op Op) SinceVersion() int {
+	return max(op.Since, 1)
+}
+
 type Arg struct {
 	Name    string `xml:"name,attr"`
-	Summary string `xml:"summary,attr"`
+	Type    string `xml:"type,attr"`
+	Summary string `xml:"summary,attr,omitempty"`
+
+	Interface string `xml:"interface,attr,omitempty"`
+	AllowNull bool   `xml:"allow-null,attr,omitempty"`
+	Enum      string `xml:"enum,attr,omitempty"`
 
-	Type      string `xml:"type,attr"`
-	AllowNull bool   `xml:"allow-null,attr"`
-	Interface string `xml:"interface,attr"`
-	Version   int    `xml:"version,attr"`
-	Enum      string `xml:"enum,attr"`
+	// Version is not part of the schema, but it is accepted for
+	// compatibility with some older protocol files.
+	Version int `xml:"version,attr,omitempty"`
+
+	Description Description `xml:"description"`
 }
 
 type Enum struct {
-	Name        string      `xml:"name,attr"`
+	Name string `xml:"name,attr"`
+
+	// Since is the version of the interface that the enum was added in.
+	// It is zero if the enum has existed since version 1.
+	Since int `xml:"since,attr,omitempty"`
+
+	Bitfield    bool        `xml:"bitfield,attr,omitempty"`
 	Description Description `xml:"description"`
 
 	Entries []Entry `xml:"entry"`
 }
 
+// SinceVersion returns the version that e was added in, which is 1 if
+// it was not specified.
+func (e Enum) SinceVersion() int {
+	return max(e.Since, 1)
+}
+
 type Entry struct {
 	Name    string `xml:"name,attr"`
-	Summary string `xml:"summary,attr"`
 	Value   string `xml:"value,attr"`
+	Summary string `xml:"summary,attr,omitempty"`
+
+	// Since is the version of the interface that the entry was added
+	// in. It is zero if the entry has existed since version 1.
+	Since int `xml:"since,attr,omitempty"`
+
+	// DeprecatedSince is the version of the interface from which the
+	// entry should no longer be used, or zero if it is not deprecated.
+	DeprecatedSince int `xml:"deprecated-since,attr,omitempty"`
+
+	Description Description `xml:"description"`
 }
 
 func (e Entry) Int() (int, error) {
 	v, err := strconv.ParseInt(e.Value, 0, 0)
 	return int(v), err
 }
+
+// SinceVersion returns the version that e was added in, which is 1 if
+// it was not specified.
+func (e Entry) SinceVersion() int {
+	return max(e.Since, 1)
+}
+
+func find[T any](s []T, f func(T) bool) (v T, ok bool) {
+	for _, v := range s {
+		if f(v) {
+			return v, true
+		}
+	}
+	return v, false
+}
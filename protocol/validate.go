@@ -0,0 +1,264 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validate checks proto for problems that the XML schema alone can not
+// express, such as duplicate names, references to interfaces or enums
+// that do not exist, and inconsistent since attributes. Interfaces and
+// enums referenced by proto may be defined either in proto itself or
+// in one of deps. All problems found are returned joined together,
+// each as an *Error.
+func (proto Protocol) Validate(deps ...Protocol) error {
+	v := validator{
+		proto:      proto,
+		interfaces: make(map[string]Interface),
+	}
+	for _, dep := range deps {
+		for _, i := range dep.Interfaces {
+			v.interfaces[i.Name] = i
+		}
+	}
+	v.validate()
+	return errors.Join(v.errs...)
+}
+
+// Error is a problem with an element of a protocol.
+type Error struct {
+	// Element identifies the element of the protocol that the problem
+	// is with. Each part is the kind of an element followed by a colon
+	// and its name, such as "interface:wl_surface", "request:attach"
+	// and "arg:buffer". It is empty if the problem is with the protocol
+	// as a whole.
+	Element []string
+
+	Err error
+}
+
+func (err *Error) Error() string {
+	if len(err.Element) == 0 {
+		return err.Err.Error()
+	}
+
+	names := make([]string, 0, len(err.Element))
+	for _, e := range err.Element {
+		_, name, _ := strings.Cut(e, ":")
+		names = append(names, name)
+	}
+	return fmt.Sprintf("%v: %v", strings.Join(names, "."), err.Err)
+}
+
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+type validator struct {
+	proto      Protocol
+	interfaces map[string]Interface
+	errs       []error
+}
+
+func (v *validator) errorf(path []string, format string, args ...any) {
+	v.errs = append(v.errs, &Error{
+		Element: slices.Clone(path),
+		Err:     fmt.Errorf(format, args...),
+	})
+}
+
+// elem returns a part of an element path.
+func elem(kind, name string) string {
+	return kind + ":" + name
+}
+
+// unique reports an error for every name that appears more than once.
+func (v *validator) unique(path []string, kind string, names []string) {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			v.errorf(path, "duplicate %v %q", kind, name)
+			continue
+		}
+		seen[name] = struct{}{}
+	}
+}
+
+func (v *validator) validate() {
+	if v.proto.Name == "" {
+		v.errorf(nil, "protocol has no name")
+	}
+
+	names := make([]string, 0, len(v.proto.Interfaces))
+	for _, i := range v.proto.Interfaces {
+		names = append(names, i.Name)
+		v.interfaces[i.Name] = i
+	}
+	v.unique(nil, "interface", names)
+
+	for _, i := range v.proto.Interfaces {
+		v.validateInterface(i)
+	}
+}
+
+func (v *validator) validateInterface(i Interface) {
+	path := []string{elem("interface", i.Name)}
+	if i.Name == "" {
+		v.errorf(path, "interface has no name")
+	}
+	if i.Version < 1 {
+		v.errorf(path, "invalid version %v", i.Version)
+	}
+
+	v.unique(path, "request", opNames(i.Requests))
+	v.unique(path, "event", opNames(i.Events))
+
+	enums := make([]string, 0, len(i.Enums))
+	for _, e := range i.Enums {
+		enums = append(enums, e.Name)
+	}
+	v.unique(path, "enum", enums)
+
+	v.validateOps(i, "request", i.Requests)
+	v.validateOps(i, "event", i.Events)
+	for _, e := range i.Enums {
+		v.validateEnum(i, e)
+	}
+}
+
+func opNames(ops []Op) []string {
+	names := make([]string, 0, len(ops))
+	for _, op := range ops {
+		names = append(names, op.Name)
+	}
+	return names
+}
+
+func (v *validator) validateOps(i Interface, kind string, ops []Op) {
+	var since int
+	for _, op := range ops {
+		path := []string{elem("interface", i.Name), elem(kind, op.Name)}
+		if op.Name == "" {
+			v.errorf(path, "message has no name")
+		}
+		switch op.Type {
+		case "", "destructor":
+		default:
+			v.errorf(path, "unknown message type %q", op.Type)
+		}
+
+		v.validateSince(path, i, op.Since, op.DeprecatedSince)
+		if op.SinceVersion() < since {
+			v.errorf(path, "since %v is lower than that of a previous message", op.SinceVersion())
+		}
+		since = max(since, op.SinceVersion())
+
+		args := make([]string, 0, len(op.Args))
+		for _, arg := range op.Args {
+			args = append(args, arg.Name)
+			v.validateArg(path, i, arg)
+		}
+		v.unique(path, "argument", args)
+	}
+}
+
+func (v *validator) validateSince(path []string, i Interface, since, deprecated int) {
+	if (since < 0) || (since > i.Version) {
+		v.errorf(path, "since %v is outside of interface version %v", since, i.Version)
+	}
+	if deprecated == 0 {
+		return
+	}
+	if deprecated <= max(since, 1) {
+		v.errorf(path, "deprecated-since %v is not greater than since %v", deprecated, max(since, 1))
+	}
+	if deprecated > i.Version {
+		v.errorf(path, "deprecated-since %v is greater than interface version %v", deprecated, i.Version)
+	}
+}
+
+func (v *validator) validateArg(op []string, i Interface, arg Arg) {
+	path := append(slices.Clip(op), elem("arg", arg.Name))
+	if arg.Name == "" {
+		v.errorf(path, "argument has no name")
+	}
+
+	switch arg.Type {
+	case "int", "uint", "fixed", "string", "object", "new_id", "array", "fd":
+	default:
+		v.errorf(path, "unknown type %q", arg.Type)
+	}
+
+	if arg.Interface != "" {
+		if (arg.Type != "object") && (arg.Type != "new_id") {
+			v.errorf(path, "interface given for %v argument", arg.Type)
+		}
+		if _, ok := v.interfaces[arg.Interface]; !ok {
+			v.errorf(path, "unknown interface %q", arg.Interface)
+		}
+	}
+
+	if arg.AllowNull && (arg.Type != "string") && (arg.Type != "object") {
+		v.errorf(path, "allow-null given for %v argument", arg.Type)
+	}
+
+	if arg.Enum != "" {
+		v.validateEnumRef(path, i, arg)
+	}
+}
+
+func (v *validator) validateEnumRef(path []string, i Interface, arg Arg) {
+	if (arg.Type != "int") && (arg.Type != "uint") {
+		v.errorf(path, "enum given for %v argument", arg.Type)
+		return
+	}
+
+	iname, ename, ok := strings.Cut(arg.Enum, ".")
+	if !ok {
+		iname, ename = i.Name, arg.Enum
+	}
+
+	inter, ok := v.interfaces[iname]
+	if !ok {
+		v.errorf(path, "enum %q refers to unknown interface %q", arg.Enum, iname)
+		return
+	}
+	e, ok := inter.Enum(ename)
+	if !ok {
+		v.errorf(path, "unknown enum %q", arg.Enum)
+		return
+	}
+
+	if e.Bitfield && (arg.Type != "uint") {
+		v.errorf(path, "bitfield enum %q used for %v argument", arg.Enum, arg.Type)
+	}
+}
+
+func (v *validator) validateEnum(i Interface, e Enum) {
+	path := []string{elem("interface", i.Name), elem("enum", e.Name)}
+	if e.Name == "" {
+		v.errorf(path, "enum has no name")
+	}
+	v.validateSince(path, i, e.Since, 0)
+
+	names := make([]string, 0, len(e.Entries))
+	for _, entry := range e.Entries {
+		names = append(names, entry.Name)
+
+		path := append(slices.Clip(path), elem("entry", entry.Name))
+		if entry.Name == "" {
+			v.errorf(path, "entry has no name")
+		}
+		_, err := entry.Int()
+		if err != nil {
+			v.errorf(path, "invalid value %q", entry.Value)
+		}
+		v.validateSince(path, i, entry.Since, entry.DeprecatedSince)
+		if (entry.Since != 0) && (entry.Since < e.SinceVersion()) {
+			v.errorf(path, "since %v is lower than that of its enum", entry.Since)
+		}
+	}
+	v.unique(path, "entry", names)
+}
@@ -3,8 +3,10 @@ package wl
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net"
+	"sync/atomic"
 
 	"deedles.dev/wl/internal/debug"
 	"deedles.dev/wl/internal/objstore"
@@ -19,14 +21,17 @@ type Client struct {
 	stop   xsync.Stopper
 	queue  xsync.Queue[func() error]
 	store  *objstore.Store
+
+	tracer atomic.Pointer[wire.Tracer]
 }
 
 func newClient(ctx context.Context, server *Server, conn *wire.Conn) *Client {
 	client := Client{
 		server: server,
 		conn:   conn,
-		store:  objstore.New(1 << 24),
+		store:  objstore.New(wire.MinServerID, false),
 	}
+	client.SetTracer(debug.Tracer("server"))
 
 	display := NewDisplay(&client)
 	display.SetID(1)
@@ -60,6 +65,27 @@ func (client *Client) listen(ctx context.Context) {
 				return
 			}
 
+			var framingErr wire.FramingError
+			if errors.As(err, &framingErr) || errors.Is(err, wire.ErrControlTruncated) {
+				// The rest of the stream can't be interpreted, so report
+				// the error and disconnect the client once everything
+				// before it in the queue has been handled.
+				fatal := func() error {
+					client.fatalError(1, DisplayErrorInvalidMethod, err.Error())
+					return err
+				}
+				select {
+				case <-ctx.Done():
+				case <-client.stop.Done():
+				case client.queue.Push() <- fatal:
+					select {
+					case <-ctx.Done():
+					case <-client.stop.Done():
+					}
+				}
+				return
+			}
+
 			select {
 			case <-ctx.Done():
 				return
@@ -82,7 +108,71 @@ func (client *Client) listen(ctx context.Context) {
 }
 
 func (client *Client) dispatch(msg *wire.MessageBuffer) error {
-	return client.store.Dispatch(msg)
+	// Messages that were queued before a fatal error was posted are
+	// not dispatched.
+	select {
+	case <-client.stop.Done():
+		msg.Close()
+		return net.ErrClosed
+	default:
+	}
+
+	err := client.store.Dispatch(msg, client.Tracer())
+	client.postError(msg, err)
+	return err
+}
+
+// postError reports protocol errors caused by msg to the remote
+// client via wl_display.error. Protocol errors are fatal, so the
+// client is then disconnected.
+func (client *Client) postError(msg *wire.MessageBuffer, err error) {
+	var (
+		senderErr wire.UnknownSenderIDError
+		opErr     wire.UnknownOpError
+		objErr    wire.InvalidObjectError
+		newIDErr  wire.InvalidNewIDError
+		enumErr   wire.InvalidEnumError
+	)
+	switch {
+	case errors.As(err, &senderErr):
+		client.fatalError(1, DisplayErrorInvalidObject, fmt.Sprintf("invalid object %v", msg.Sender()))
+	case errors.As(err, &opErr):
+		client.fatalError(msg.Sender(), DisplayErrorInvalidMethod, opErr.Error())
+	case errors.As(err, &objErr):
+		client.fatalError(msg.Sender(), DisplayErrorInvalidObject, fmt.Sprintf("invalid object %v", objErr.ID))
+	case errors.As(err, &newIDErr):
+		client.fatalError(msg.Sender(), DisplayErrorInvalidObject, fmt.Sprintf("invalid new id %v", newIDErr.ID))
+	case errors.As(err, &enumErr):
+		client.fatalError(msg.Sender(), DisplayErrorInvalidMethod, enumErr.Error())
+	case err != nil:
+		// Anything else is a malformed message, such as one with a null
+		// argument that is not nullable, one that is truncated or one
+		// that is missing file descriptors.
+		client.fatalError(msg.Sender(), DisplayErrorInvalidMethod, fmt.Sprintf("invalid arguments for object %v: %v", msg.Sender(), err))
+	}
+}
+
+// fatalError sends a wl_display.error event and then disconnects the
+// client. It must only be called from a function in the queue, as it
+// sends the event directly instead of queuing it so that it is sent
+// before the connection is closed.
+func (client *Client) fatalError(id uint32, code DisplayError, message string) {
+	defer client.close()
+
+	display, ok := client.Get(1).(*Display)
+	if !ok {
+		return
+	}
+
+	ev := DisplayErrorEvent{
+		ObjectId: id,
+		Code:     uint32(code),
+		Message:  message,
+	}.Encode(display)
+	if trace := client.Tracer(); trace != nil {
+		trace(ev.Trace())
+	}
+	ev.Build(client.conn)
 }
 
 // Add adds obj to client's knowledge. Do not call this method unless
@@ -111,21 +201,50 @@ func (client *Client) DeleteAll() {
 	client.store.Clear()
 }
 
-// Enqueue adds msg to the event queue.
+// SetTracer sets a function to be called with a description of every
+// message sent or received by client. If trace is nil, tracing is
+// disabled. By default, tracing is enabled based on the WAYLAND_DEBUG
+// environment variable in the same way as libwayland. This method is
+// safe to call concurrently and may be called at any time.
+func (client *Client) SetTracer(trace wire.Tracer) {
+	if trace == nil {
+		client.tracer.Store(nil)
+		return
+	}
+	client.tracer.Store(&trace)
+}
+
+// Tracer returns the function that is currently being used to trace
+// messages, or nil if tracing is disabled.
+func (client *Client) Tracer() wire.Tracer {
+	trace := client.tracer.Load()
+	if trace == nil {
+		return nil
+	}
+	return *trace
+}
+
+// Enqueue adds msg to the event queue. If the client has already
+// stopped, msg is discarded and its file descriptors are closed.
 func (client *Client) Enqueue(msg *wire.MessageBuilder) {
 	select {
 	case <-client.stop.Done():
+		msg.Close()
 	case client.queue.Push() <- func() error {
-		debug.Printf(" -> %v", msg)
+		if trace := client.Tracer(); trace != nil {
+			trace(msg.Trace())
+		}
 		return msg.Build(client.conn)
 	}:
 	}
 }
 
 // Display returns the display object that represents the Wayland
-// server to the remote client.
+// server to the remote client. It returns nil if object 1 is not a
+// Display, which can only happen if it has been replaced via Add.
 func (client *Client) Display() *Display {
-	return client.Get(1).(*Display)
+	display, _ := client.Get(1).(*Display)
+	return display
 }
 
 // Events returns a channel that yields functions representing events
@@ -0,0 +1,293 @@
+package wl
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"deedles.dev/wl/protocol"
+	"deedles.dev/wl/wire"
+	"golang.org/x/sys/unix"
+)
+
+func socketPair(t testing.TB) (*net.UnixConn, *net.UnixConn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		file := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	return conns[0], conns[1]
+}
+
+func message(sender uint32, op uint16, args ...uint32) []byte {
+	size := 8 + 4*len(args)
+	buf := binary.NativeEndian.AppendUint32(nil, sender)
+	buf = binary.NativeEndian.AppendUint32(buf, uint32(size)<<16|uint32(op))
+	for _, arg := range args {
+		buf = binary.NativeEndian.AppendUint32(buf, arg)
+	}
+	return buf
+}
+
+func TestProtocolErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  []byte
+		code DisplayError
+	}{
+		// wl_display.get_registry with the ID of the display itself.
+		{"InvalidNewID", message(1, 1, 1), DisplayErrorInvalidObject},
+		{"UnknownObject", message(100, 0), DisplayErrorInvalidObject},
+		{"UnknownOpcode", message(1, 100), DisplayErrorInvalidMethod},
+		// wl_display.get_registry without any arguments.
+		{"Truncated", message(1, 1), DisplayErrorInvalidMethod},
+		// wl_registry.bind with a null interface name.
+		{"Null", message(2, 0, 1, 0, 1, 10), DisplayErrorInvalidMethod},
+		// wl_shm.create_pool without a file descriptor.
+		{"MissingFDs", message(3, 0, 10, 4096), DisplayErrorInvalidMethod},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// If the client is never disconnected, the timeout stops the
+			// loop over its events below.
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			local, remote := socketPair(t)
+			defer remote.Close()
+			client := newClient(ctx, nil, wire.NewConn(local))
+			client.SetTracer(nil)
+
+			registry := NewRegistry(client)
+			registry.SetID(2)
+			client.Add(registry)
+			shm := NewShm(client)
+			shm.SetID(3)
+			client.Add(shm)
+
+			_, err := remote.Write(test.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for ev := range client.Events() {
+				ev()
+			}
+			if client.Display() == nil {
+				t.Fatalf("object 1 is %v, not the display", client.Get(1))
+			}
+
+			ev, err := io.ReadAll(remote)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ev) < 20 {
+				t.Fatalf("expected a wl_display.error event but got %v bytes", len(ev))
+			}
+			sender := binary.NativeEndian.Uint32(ev[0:])
+			op := binary.NativeEndian.Uint32(ev[4:]) & 0xFFFF
+			code := binary.NativeEndian.Uint32(ev[12:])
+			if (sender != 1) || (op != 0) || (code != uint32(test.code)) {
+				t.Fatalf("expected wl_display.error with code %v but got sender %v, opcode %v, code %v", test.code, sender, op, code)
+			}
+		})
+	}
+}
+
+func TestEnqueueStopped(t *testing.T) {
+	local, remote := socketPair(t)
+	defer remote.Close()
+	client := newClient(context.Background(), nil, wire.NewConn(local))
+	client.SetTracer(nil)
+	client.close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	msg := wire.NewMessage(client.Display(), 0)
+	msg.TransferFile(r)
+	client.Enqueue(msg)
+	if err := r.Close(); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("file in a message enqueued after the client stopped was not closed: %v", err)
+	}
+}
+
+// maxFuzzInput is the largest fuzz input that is used. It is small
+// enough to be written to a socket without blocking.
+const maxFuzzInput = 4096
+
+func ctor[T wire.Object](f func(wire.State) T) func(wire.State) wire.Object {
+	return func(state wire.State) wire.Object { return f(state) }
+}
+
+// fuzzObjects holds a constructor for every interface other than
+// wl_display, which every client already has as object 1. Object i is
+// given the ID i+2.
+var fuzzObjects = []func(wire.State) wire.Object{
+	ctor(NewRegistry),
+	ctor(NewCallback),
+	ctor(NewCompositor),
+	ctor(NewShmPool),
+	ctor(NewShm),
+	ctor(NewBuffer),
+	ctor(NewDataOffer),
+	ctor(NewDataSource),
+	ctor(NewDataDevice),
+	ctor(NewDataDeviceManager),
+	ctor(NewShell),
+	ctor(NewShellSurface),
+	ctor(NewSurface),
+	ctor(NewSeat),
+	ctor(NewPointer),
+	ctor(NewKeyboard),
+	ctor(NewTouch),
+	ctor(NewOutput),
+	ctor(NewRegion),
+	ctor(NewSubcompositor),
+	ctor(NewSubsurface),
+	ctor(NewFixes),
+}
+
+func TestFuzzObjects(t *testing.T) {
+	ifaces := []string{DisplayInterface}
+	for _, newObj := range fuzzObjects {
+		ifaces = append(ifaces, newObj(nil).(interface{ Interface() string }).Interface())
+	}
+
+	var expected []string
+	for _, i := range protocol.Wayland().Interfaces {
+		expected = append(expected, i.Name)
+	}
+	if !slices.Equal(ifaces, expected) {
+		t.Fatalf("fuzzed interfaces %v do not match the protocol's %v", ifaces, expected)
+	}
+}
+
+func FuzzDispatch(f *testing.F) {
+	for id := range uint32(len(fuzzObjects) + 1) {
+		for op := range uint16(16) {
+			f.Add(message(id+1, op, 1, 2, 3, 4), uint8(0))
+		}
+	}
+
+	// wl_display.get_registry with the IDs 0, 1 and 2.
+	for id := range uint32(3) {
+		f.Add(message(1, 1, id), uint8(0))
+	}
+	// wl_display.get_registry with server IDs.
+	f.Add(message(1, 1, wire.MinServerID), uint8(0))
+	f.Add(message(1, 1, wire.MaxServerID), uint8(0))
+	// wl_display.sync with a new ID followed by a reuse of it.
+	f.Add(append(message(1, 0, 100), message(1, 0, 100)...), uint8(0))
+	// wl_shm.create_pool with file descriptors.
+	f.Add(message(6, 0, 100, 4096), uint8(1))
+	f.Add(message(6, 0, 100, 4096), uint8(3))
+
+	f.Fuzz(func(t *testing.T, data []byte, nfds uint8) {
+		if len(data) > maxFuzzInput {
+			t.Skip()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		local, remote := socketPair(t)
+		defer remote.Close()
+		client := newClient(ctx, nil, wire.NewConn(local))
+		client.SetTracer(wire.TraceWriter(io.Discard))
+		for i, newObj := range fuzzObjects {
+			obj := newObj(client)
+			obj.SetID(uint32(i + 2))
+			client.Add(obj)
+		}
+		display := client.Display()
+
+		var oob []byte
+		if nfds%4 != 0 {
+			fds := make([]int, nfds%4)
+			for i := range fds {
+				fds[i] = int(os.Stderr.Fd())
+			}
+			oob = unix.UnixRights(fds...)
+		}
+		_, _, err := remote.WriteMsgUnix(data, oob, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		remote.CloseWrite()
+		go io.Copy(io.Discard, remote)
+
+		for ev := range client.Events() {
+			ev()
+			if client.Get(1) != display {
+				t.Fatalf("object 1 replaced with %v", client.Get(1))
+			}
+		}
+		if ctx.Err() != nil {
+			t.Fatal("client was not disconnected")
+		}
+		client.DeleteAll()
+	})
+}
+
+// newTestPointer returns a pointer of a client whose events are read
+// and discarded.
+func newTestPointer(tb testing.TB) (*Client, *Pointer) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+
+	local, remote := socketPair(tb)
+	tb.Cleanup(func() { remote.Close() })
+	go io.Copy(io.Discard, remote)
+
+	client := newClient(ctx, nil, wire.NewConn(local))
+	client.SetTracer(nil)
+
+	pointer := NewPointer(client)
+	client.Add(pointer)
+	return client, pointer
+}
+
+func TestPointerMotionAllocs(t *testing.T) {
+	client, pointer := newTestPointer(t)
+	allocs := testing.AllocsPerRun(100, func() {
+		err := PointerMotionEvent{Time: 1, SurfaceX: wire.FixedInt(2), SurfaceY: wire.FixedInt(3)}.Encode(pointer).Build(client.conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("encoding and building a motion event allocated %v times", allocs)
+	}
+}
+
+func BenchmarkPointerMotion(b *testing.B) {
+	client, pointer := newTestPointer(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		err := PointerMotionEvent{Time: 1, SurfaceX: wire.FixedInt(2), SurfaceY: wire.FixedInt(3)}.Encode(pointer).Build(client.conn)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -3,16 +3,103 @@
 package wl
 
 import (
+	shared "deedles.dev/wl"
+	"deedles.dev/wl/pointer"
 	"deedles.dev/wl/wire"
 	"fmt"
 	"os"
+	"time"
 )
 
 const (
-	DisplayInterface = "wl_display"
-	DisplayVersion   = 1
+	DisplayInterface = shared.DisplayInterface
+
+	// DisplayVersion is the version of the interface that this
+	// package implements.
+	DisplayVersion = 1
+)
+
+// The versions of the wl_display interface that its messages were
+// added in.
+const (
+	DisplaySyncRequestSince        = 1
+	DisplayGetRegistryRequestSince = 1
+	DisplayErrorEventSince         = 1
+	DisplayDeleteIdEventSince      = 1
 )
 
+// DisplayDesc describes the wl_display interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DisplayDesc = &wire.InterfaceDesc{
+	Name:    DisplayInterface,
+	Version: DisplayVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "sync",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wl_callback",
+				},
+			},
+		},
+		{
+			Name:  "get_registry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "registry",
+					Type:      wire.ArgNewID,
+					Interface: "wl_registry",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "error",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "object_id",
+					Type: wire.ArgObject,
+				},
+				{
+					Name: "code",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "message",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "delete_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_object", Value: 0},
+				{Name: "invalid_method", Value: 1},
+				{Name: "no_memory", Value: 2},
+				{Name: "implementation", Value: 3},
+			},
+		},
+	},
+}
+
 // DisplayListener is a type that can respond to incoming
 // messages for a Display object.
 type DisplayListener interface {
@@ -41,6 +128,48 @@ type DisplayListener interface {
 	GetRegistry(registry *Registry)
 }
 
+// DisplayListenerFuncs implements DisplayListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DisplayListenerFuncs struct {
+	OnSync        func(callback *Callback)
+	OnGetRegistry func(registry *Registry)
+}
+
+func (lis *DisplayListenerFuncs) Sync(callback *Callback) {
+	if lis.OnSync != nil {
+		lis.OnSync(callback)
+	}
+}
+
+func (lis *DisplayListenerFuncs) GetRegistry(registry *Registry) {
+	if lis.OnGetRegistry != nil {
+		lis.OnGetRegistry(registry)
+	}
+}
+
+// DisplayRequest is implemented by the message types of all
+// of the requests that a Display can receive.
+type DisplayRequest interface {
+	displayRequest()
+}
+
+// DisplayRequestFunc implements DisplayListener by calling
+// itself with each incoming message.
+type DisplayRequestFunc func(DisplayRequest)
+
+func (f DisplayRequestFunc) Sync(callback *Callback) {
+	f(DisplaySyncRequest{
+		Callback: callback,
+	})
+}
+
+func (f DisplayRequestFunc) GetRegistry(registry *Registry) {
+	f(DisplayGetRegistryRequest{
+		Registry: registry,
+	})
+}
+
 // The core global object.  This is a special singleton object.  It
 // is used for internal Wayland protocol features.
 type Display struct {
@@ -70,13 +199,8 @@ func (obj *Display) State() wire.State {
 func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		callback := NewCallback(obj.state)
-		callback.SetID(msg.ReadUint())
-
-		obj.state.Add(callback)
-
-		if err := msg.Err(); err != nil {
+		var m DisplaySyncRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -84,18 +208,13 @@ func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Sync(
-			callback,
+			m.Callback,
 		)
 		return nil
 
 	case 1:
-
-		registry := NewRegistry(obj.state)
-		registry.SetID(msg.ReadUint())
-
-		obj.state.Add(registry)
-
-		if err := msg.Err(); err != nil {
+		var m DisplayGetRegistryRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -103,11 +222,19 @@ func (obj *Display) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetRegistry(
-			registry,
+			m.Registry,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DisplayInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_display",
 		Type:      "request",
@@ -149,6 +276,38 @@ func (obj *Display) Interface() string {
 	return DisplayInterface
 }
 
+// OnSync sets the function that is called when the
+// sync request is received. If obj's Listener is not a
+// *DisplayListenerFuncs, it is replaced with one.
+func (obj *Display) OnSync(f func(callback *Callback)) {
+	lis, ok := obj.Listener.(*DisplayListenerFuncs)
+	if !ok {
+		lis = new(DisplayListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSync = f
+}
+
+// OnGetRegistry sets the function that is called when the
+// get_registry request is received. If obj's Listener is not a
+// *DisplayListenerFuncs, it is replaced with one.
+func (obj *Display) OnGetRegistry(f func(registry *Registry)) {
+	lis, ok := obj.Listener.(*DisplayListenerFuncs)
+	if !ok {
+		lis = new(DisplayListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetRegistry = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Display) Subscribe() *wire.Messages[DisplayRequest] {
+	q := new(wire.Messages[DisplayRequest])
+	obj.Listener = DisplayRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Display) Version() uint32 {
 	return DisplayVersion
 }
@@ -161,15 +320,11 @@ func (obj *Display) Version() uint32 {
 // own set of error codes.  The message is a brief description
 // of the error, for (debugging) convenience.
 func (obj *Display) Error(objectId uint32, code uint32, message string) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(objectId)
-	builder.WriteUint(code)
-	builder.WriteString(message)
-
-	builder.Method = "error"
-	builder.Args = []any{objectId, code, message}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DisplayErrorEvent{
+		ObjectId: objectId,
+		Code:     code,
+		Message:  message,
+	}.Encode(obj))
 	return
 }
 
@@ -179,57 +334,238 @@ func (obj *Display) Error(objectId uint32, code uint32, message string) {
 // seen the delete request. When the client receives this event,
 // it will know that it can safely reuse the object ID.
 func (obj *Display) DeleteId(id uint32) {
-	builder := wire.NewMessage(obj, 1)
+	obj.state.Enqueue(DisplayDeleteIdEvent{
+		Id: id,
+	}.Encode(obj))
+	return
+}
 
-	builder.WriteUint(id)
+// DisplaySyncRequest holds the arguments of the sync request of
+// the wl_display interface.
+type DisplaySyncRequest struct {
+	Callback *Callback
+}
 
-	builder.Method = "delete_id"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
-	return
+func (DisplaySyncRequest) displayRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DisplaySyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Callback = NewCallback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, CallbackInterface, msg.ReadNewObject(CallbackInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
 }
 
-// These errors are global and can be emitted in response to any
-// server request.
-type DisplayError int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplaySyncRequest) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Callback)
 
-const (
-	// server couldn't find object
-	DisplayErrorInvalidObject DisplayError = 0
+	builder.Method = "sync"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CallbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
 
-	// method doesn't exist on the specified interface or malformed request
-	DisplayErrorInvalidMethod DisplayError = 1
+// DisplayGetRegistryRequest holds the arguments of the get_registry request of
+// the wl_display interface.
+type DisplayGetRegistryRequest struct {
+	Registry *Registry
+}
 
-	// server is out of memory
-	DisplayErrorNoMemory DisplayError = 2
+func (DisplayGetRegistryRequest) displayRequest() {}
 
-	// implementation error in compositor
-	DisplayErrorImplementation DisplayError = 3
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DisplayGetRegistryRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Registry = NewRegistry(state)
+	m.Registry.SetID(wire.CheckNewID(msg, state, RegistryInterface, msg.ReadNewObject(RegistryInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Registry)
+	return nil
+}
 
-func (enum DisplayError) String() string {
-	switch enum {
-	case 0:
-		return "DisplayErrorInvalidObject"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayGetRegistryRequest) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Registry)
 
-	case 1:
-		return "DisplayErrorInvalidMethod"
+	builder.Method = "get_registry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: RegistryInterface, ID: m.Registry.ID()}}
+	}
+	return builder
+}
+
+// DisplayErrorEvent holds the arguments of the error event of
+// the wl_display interface.
+type DisplayErrorEvent struct {
+	ObjectId uint32
+	Code     uint32
+	Message  string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DisplayErrorEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ObjectId = msg.ReadUint()
+	m.Code = msg.ReadUint()
+	m.Message = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 2:
-		return "DisplayErrorNoMemory"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayErrorEvent) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.ObjectId)
+	builder.WriteUint(m.Code)
+	builder.WriteString(m.Message)
 
-	case 3:
-		return "DisplayErrorImplementation"
+	builder.Method = "error"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ObjectId, m.Code, m.Message}
+	}
+	return builder
+}
+
+// DisplayDeleteIdEvent holds the arguments of the delete_id event of
+// the wl_display interface.
+type DisplayDeleteIdEvent struct {
+	Id uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DisplayDeleteIdEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DisplayDeleteIdEvent) Encode(obj *Display) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Id)
 
-	return "<invalid DisplayError>"
+	builder.Method = "delete_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id}
+	}
+	return builder
 }
 
+// DisplayError is an alias for [shared.DisplayError].
+type DisplayError = shared.DisplayError
+
+const (
+	DisplayErrorInvalidObject  = shared.DisplayErrorInvalidObject
+	DisplayErrorInvalidMethod  = shared.DisplayErrorInvalidMethod
+	DisplayErrorNoMemory       = shared.DisplayErrorNoMemory
+	DisplayErrorImplementation = shared.DisplayErrorImplementation
+)
+
+const (
+	RegistryInterface = shared.RegistryInterface
+
+	// RegistryVersion is the version of the interface that this
+	// package implements.
+	RegistryVersion = 1
+)
+
+// The versions of the wl_registry interface that its messages were
+// added in.
 const (
-	RegistryInterface = "wl_registry"
-	RegistryVersion   = 1
+	RegistryBindRequestSince       = 1
+	RegistryGlobalEventSince       = 1
+	RegistryGlobalRemoveEventSince = 1
 )
 
+// RegistryDesc describes the wl_registry interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var RegistryDesc = &wire.InterfaceDesc{
+	Name:    RegistryInterface,
+	Version: RegistryVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "bind",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgNewID,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "global",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "interface",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "version",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "global_remove",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // RegistryListener is a type that can respond to incoming
 // messages for a Registry object.
 type RegistryListener interface {
@@ -238,6 +574,36 @@ type RegistryListener interface {
 	Bind(name uint32, id wire.NewID)
 }
 
+// RegistryListenerFuncs implements RegistryListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type RegistryListenerFuncs struct {
+	OnBind func(name uint32, id wire.NewID)
+}
+
+func (lis *RegistryListenerFuncs) Bind(name uint32, id wire.NewID) {
+	if lis.OnBind != nil {
+		lis.OnBind(name, id)
+	}
+}
+
+// RegistryRequest is implemented by the message types of all
+// of the requests that a Registry can receive.
+type RegistryRequest interface {
+	registryRequest()
+}
+
+// RegistryRequestFunc implements RegistryListener by calling
+// itself with each incoming message.
+type RegistryRequestFunc func(RegistryRequest)
+
+func (f RegistryRequestFunc) Bind(name uint32, id wire.NewID) {
+	f(RegistryBindRequest{
+		Name: name,
+		Id:   id,
+	})
+}
+
 // The singleton global registry object.  The server has a number of
 // global objects that are available to all clients.  These objects
 // typically represent an actual object in the server (for example,
@@ -285,12 +651,8 @@ func (obj *Registry) State() wire.State {
 func (obj *Registry) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		name := msg.ReadUint()
-
-		id := msg.ReadNewID()
-
-		if err := msg.Err(); err != nil {
+		var m RegistryBindRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -298,12 +660,20 @@ func (obj *Registry) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Bind(
-			name,
-			id,
+			m.Name,
+			m.Id,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(RegistryInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_registry",
 		Type:      "request",
@@ -342,6 +712,26 @@ func (obj *Registry) Interface() string {
 	return RegistryInterface
 }
 
+// OnBind sets the function that is called when the
+// bind request is received. If obj's Listener is not a
+// *RegistryListenerFuncs, it is replaced with one.
+func (obj *Registry) OnBind(f func(name uint32, id wire.NewID)) {
+	lis, ok := obj.Listener.(*RegistryListenerFuncs)
+	if !ok {
+		lis = new(RegistryListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnBind = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Registry) Subscribe() *wire.Messages[RegistryRequest] {
+	q := new(wire.Messages[RegistryRequest])
+	obj.Listener = RegistryRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Registry) Version() uint32 {
 	return RegistryVersion
 }
@@ -352,15 +742,11 @@ func (obj *Registry) Version() uint32 {
 // the given name is now available, and it implements the
 // given version of the given interface.
 func (obj *Registry) Global(name uint32, _interface string, version uint32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(name)
-	builder.WriteString(_interface)
-	builder.WriteUint(version)
-
-	builder.Method = "global"
-	builder.Args = []any{name, _interface, version}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(RegistryGlobalEvent{
+		Name:      name,
+		Interface: _interface,
+		Version:   version,
+	}.Encode(obj))
 	return
 }
 
@@ -375,21 +761,158 @@ func (obj *Registry) Global(name uint32, _interface string, version uint32) {
 // ignored until the client destroys it, to avoid races between
 // the global going away and a client sending a request to it.
 func (obj *Registry) GlobalRemove(name uint32) {
-	builder := wire.NewMessage(obj, 1)
+	obj.state.Enqueue(RegistryGlobalRemoveEvent{
+		Name: name,
+	}.Encode(obj))
+	return
+}
+
+// RegistryBindRequest holds the arguments of the bind request of
+// the wl_registry interface.
+type RegistryBindRequest struct {
+	Name uint32
+	Id   wire.NewID
+}
+
+func (RegistryBindRequest) registryRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegistryBindRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	m.Id = msg.ReadNewID()
+	wire.CheckNewID(msg, state, m.Id.Interface, m.Id.ID, true)
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryBindRequest) Encode(obj *Registry) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Name)
+	builder.WriteNewID(m.Id)
+
+	builder.Method = "bind"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name, m.Id}
+	}
+	return builder
+}
+
+// RegistryGlobalEvent holds the arguments of the global event of
+// the wl_registry interface.
+type RegistryGlobalEvent struct {
+	Name      uint32
+	Interface string
+	Version   uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegistryGlobalEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	m.Interface = msg.ReadString()
+	m.Version = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryGlobalEvent) Encode(obj *Registry) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Name)
+	builder.WriteString(m.Interface)
+	builder.WriteUint(m.Version)
 
-	builder.WriteUint(name)
+	builder.Method = "global"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name, m.Interface, m.Version}
+	}
+	return builder
+}
+
+// RegistryGlobalRemoveEvent holds the arguments of the global_remove event of
+// the wl_registry interface.
+type RegistryGlobalRemoveEvent struct {
+	Name uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegistryGlobalRemoveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegistryGlobalRemoveEvent) Encode(obj *Registry) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Name)
 
 	builder.Method = "global_remove"
-	builder.Args = []any{name}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
 }
 
 const (
-	CallbackInterface = "wl_callback"
-	CallbackVersion   = 1
+	CallbackInterface = shared.CallbackInterface
+
+	// CallbackVersion is the version of the interface that this
+	// package implements.
+	CallbackVersion = 1
+)
+
+// The versions of the wl_callback interface that its messages were
+// added in.
+const (
+	CallbackDoneEventSince = 1
 )
 
+// CallbackDesc describes the wl_callback interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CallbackDesc = &wire.InterfaceDesc{
+	Name:     CallbackInterface,
+	Version:  CallbackVersion,
+	Requests: []wire.MessageDesc{},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "callback_data",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // Clients can handle the 'done' event to get notified when
 // the related request is done.
 //
@@ -417,6 +940,14 @@ func (obj *Callback) State() wire.State {
 
 func (obj *Callback) Dispatch(msg *wire.MessageBuffer) error {
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CallbackInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_callback",
 		Type:      "request",
@@ -459,21 +990,93 @@ func (obj *Callback) Version() uint32 {
 
 // Notify the client when the related request is done.
 func (obj *Callback) Done(callbackData uint32) {
-	builder := wire.NewMessage(obj, 0)
+	obj.state.Enqueue(CallbackDoneEvent{
+		CallbackData: callbackData,
+	}.Encode(obj))
+	return
+}
+
+// CallbackDoneEvent holds the arguments of the done event of
+// the wl_callback interface.
+type CallbackDoneEvent struct {
+	CallbackData uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *CallbackDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.CallbackData = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteUint(callbackData)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CallbackDoneEvent) Encode(obj *Callback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.CallbackData)
 
 	builder.Method = "done"
-	builder.Args = []any{callbackData}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.CallbackData}
+	}
+	return builder
 }
 
 const (
-	CompositorInterface = "wl_compositor"
-	CompositorVersion   = 6
+	CompositorInterface = shared.CompositorInterface
+
+	// CompositorVersion is the version of the interface that this
+	// package implements.
+	CompositorVersion = 6
+)
+
+// The versions of the wl_compositor interface that its messages were
+// added in.
+const (
+	CompositorCreateSurfaceRequestSince = 1
+	CompositorCreateRegionRequestSince  = 1
 )
 
+// CompositorDesc describes the wl_compositor interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CompositorDesc = &wire.InterfaceDesc{
+	Name:    CompositorInterface,
+	Version: CompositorVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "create_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_region",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // CompositorListener is a type that can respond to incoming
 // messages for a Compositor object.
 type CompositorListener interface {
@@ -484,6 +1087,48 @@ type CompositorListener interface {
 	CreateRegion(id *Region)
 }
 
+// CompositorListenerFuncs implements CompositorListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type CompositorListenerFuncs struct {
+	OnCreateSurface func(id *Surface)
+	OnCreateRegion  func(id *Region)
+}
+
+func (lis *CompositorListenerFuncs) CreateSurface(id *Surface) {
+	if lis.OnCreateSurface != nil {
+		lis.OnCreateSurface(id)
+	}
+}
+
+func (lis *CompositorListenerFuncs) CreateRegion(id *Region) {
+	if lis.OnCreateRegion != nil {
+		lis.OnCreateRegion(id)
+	}
+}
+
+// CompositorRequest is implemented by the message types of all
+// of the requests that a Compositor can receive.
+type CompositorRequest interface {
+	compositorRequest()
+}
+
+// CompositorRequestFunc implements CompositorListener by calling
+// itself with each incoming message.
+type CompositorRequestFunc func(CompositorRequest)
+
+func (f CompositorRequestFunc) CreateSurface(id *Surface) {
+	f(CompositorCreateSurfaceRequest{
+		Id: id,
+	})
+}
+
+func (f CompositorRequestFunc) CreateRegion(id *Region) {
+	f(CompositorCreateRegionRequest{
+		Id: id,
+	})
+}
+
 // A compositor.  This object is a singleton global.  The
 // compositor is in charge of combining the contents of multiple
 // surfaces into one displayable output.
@@ -521,13 +1166,8 @@ func (obj *Compositor) State() wire.State {
 func (obj *Compositor) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewSurface(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m CompositorCreateSurfaceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -535,18 +1175,13 @@ func (obj *Compositor) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.CreateSurface(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 1:
-
-		id := NewRegion(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m CompositorCreateRegionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -554,11 +1189,19 @@ func (obj *Compositor) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.CreateRegion(
-			id,
+			m.Id,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CompositorInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_compositor",
 		Type:      "request",
@@ -600,15 +1243,190 @@ func (obj *Compositor) Interface() string {
 	return CompositorInterface
 }
 
+// OnCreateSurface sets the function that is called when the
+// create_surface request is received. If obj's Listener is not a
+// *CompositorListenerFuncs, it is replaced with one.
+func (obj *Compositor) OnCreateSurface(f func(id *Surface)) {
+	lis, ok := obj.Listener.(*CompositorListenerFuncs)
+	if !ok {
+		lis = new(CompositorListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreateSurface = f
+}
+
+// OnCreateRegion sets the function that is called when the
+// create_region request is received. If obj's Listener is not a
+// *CompositorListenerFuncs, it is replaced with one.
+func (obj *Compositor) OnCreateRegion(f func(id *Region)) {
+	lis, ok := obj.Listener.(*CompositorListenerFuncs)
+	if !ok {
+		lis = new(CompositorListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreateRegion = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Compositor) Subscribe() *wire.Messages[CompositorRequest] {
+	q := new(wire.Messages[CompositorRequest])
+	obj.Listener = CompositorRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Compositor) Version() uint32 {
 	return CompositorVersion
 }
 
+// CompositorCreateSurfaceRequest holds the arguments of the create_surface request of
+// the wl_compositor interface.
+type CompositorCreateSurfaceRequest struct {
+	Id *Surface
+}
+
+func (CompositorCreateSurfaceRequest) compositorRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *CompositorCreateSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewSurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, SurfaceInterface, msg.ReadNewObject(SurfaceInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CompositorCreateSurfaceRequest) Encode(obj *Compositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "create_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: SurfaceInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// CompositorCreateRegionRequest holds the arguments of the create_region request of
+// the wl_compositor interface.
+type CompositorCreateRegionRequest struct {
+	Id *Region
+}
+
+func (CompositorCreateRegionRequest) compositorRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *CompositorCreateRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewRegion(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, RegionInterface, msg.ReadNewObject(RegionInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CompositorCreateRegionRequest) Encode(obj *Compositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "create_region"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: RegionInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+const (
+	ShmPoolInterface = shared.ShmPoolInterface
+
+	// ShmPoolVersion is the version of the interface that this
+	// package implements.
+	ShmPoolVersion = 2
+)
+
+// The versions of the wl_shm_pool interface that its messages were
+// added in.
 const (
-	ShmPoolInterface = "wl_shm_pool"
-	ShmPoolVersion   = 2
+	ShmPoolCreateBufferRequestSince = 1
+	ShmPoolDestroyRequestSince      = 1
+	ShmPoolResizeRequestSince       = 1
 )
 
+// ShmPoolDesc describes the wl_shm_pool interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShmPoolDesc = &wire.InterfaceDesc{
+	Name:    ShmPoolInterface,
+	Version: ShmPoolVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_buffer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_buffer",
+				},
+				{
+					Name: "offset",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "stride",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "wl_shm.format",
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "size",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // ShmPoolListener is a type that can respond to incoming
 // messages for a ShmPool object.
 type ShmPoolListener interface {
@@ -645,6 +1463,64 @@ type ShmPoolListener interface {
 	Resize(size int32)
 }
 
+// ShmPoolListenerFuncs implements ShmPoolListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShmPoolListenerFuncs struct {
+	OnCreateBuffer func(id *Buffer, offset int32, width int32, height int32, stride int32, format ShmFormat)
+	OnDestroy      func()
+	OnResize       func(size int32)
+}
+
+func (lis *ShmPoolListenerFuncs) CreateBuffer(id *Buffer, offset int32, width int32, height int32, stride int32, format ShmFormat) {
+	if lis.OnCreateBuffer != nil {
+		lis.OnCreateBuffer(id, offset, width, height, stride, format)
+	}
+}
+
+func (lis *ShmPoolListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ShmPoolListenerFuncs) Resize(size int32) {
+	if lis.OnResize != nil {
+		lis.OnResize(size)
+	}
+}
+
+// ShmPoolRequest is implemented by the message types of all
+// of the requests that a ShmPool can receive.
+type ShmPoolRequest interface {
+	shmPoolRequest()
+}
+
+// ShmPoolRequestFunc implements ShmPoolListener by calling
+// itself with each incoming message.
+type ShmPoolRequestFunc func(ShmPoolRequest)
+
+func (f ShmPoolRequestFunc) CreateBuffer(id *Buffer, offset int32, width int32, height int32, stride int32, format ShmFormat) {
+	f(ShmPoolCreateBufferRequest{
+		Id:     id,
+		Offset: offset,
+		Width:  width,
+		Height: height,
+		Stride: stride,
+		Format: format,
+	})
+}
+
+func (f ShmPoolRequestFunc) Destroy() {
+	f(ShmPoolDestroyRequest{})
+}
+
+func (f ShmPoolRequestFunc) Resize(size int32) {
+	f(ShmPoolResizeRequest{
+		Size: size,
+	})
+}
+
 // The wl_shm_pool object encapsulates a piece of memory shared
 // between the compositor and client.  Through the wl_shm_pool
 // object, the client can allocate shared memory wl_buffer objects.
@@ -679,23 +1555,8 @@ func (obj *ShmPool) State() wire.State {
 func (obj *ShmPool) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewBuffer(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		offset := msg.ReadInt()
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		stride := msg.ReadInt()
-
-		format := ShmFormat(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m ShmPoolCreateBufferRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -703,17 +1564,18 @@ func (obj *ShmPool) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.CreateBuffer(
-			id,
-			offset,
-			width,
-			height,
-			stride,
-			format,
+			m.Id,
+			m.Offset,
+			m.Width,
+			m.Height,
+			m.Stride,
+			m.Format,
 		)
 		return nil
 
 	case 1:
-		if err := msg.Err(); err != nil {
+		var m ShmPoolDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -724,10 +1586,8 @@ func (obj *ShmPool) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 2:
-
-		size := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m ShmPoolResizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -735,11 +1595,19 @@ func (obj *ShmPool) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Resize(
-			size,
+			m.Size,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShmPoolInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shm_pool",
 		Type:      "request",
@@ -784,15 +1652,381 @@ func (obj *ShmPool) Interface() string {
 	return ShmPoolInterface
 }
 
+// OnCreateBuffer sets the function that is called when the
+// create_buffer request is received. If obj's Listener is not a
+// *ShmPoolListenerFuncs, it is replaced with one.
+func (obj *ShmPool) OnCreateBuffer(f func(id *Buffer, offset int32, width int32, height int32, stride int32, format ShmFormat)) {
+	lis, ok := obj.Listener.(*ShmPoolListenerFuncs)
+	if !ok {
+		lis = new(ShmPoolListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreateBuffer = f
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ShmPoolListenerFuncs, it is replaced with one.
+func (obj *ShmPool) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ShmPoolListenerFuncs)
+	if !ok {
+		lis = new(ShmPoolListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnResize sets the function that is called when the
+// resize request is received. If obj's Listener is not a
+// *ShmPoolListenerFuncs, it is replaced with one.
+func (obj *ShmPool) OnResize(f func(size int32)) {
+	lis, ok := obj.Listener.(*ShmPoolListenerFuncs)
+	if !ok {
+		lis = new(ShmPoolListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnResize = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ShmPool) Subscribe() *wire.Messages[ShmPoolRequest] {
+	q := new(wire.Messages[ShmPoolRequest])
+	obj.Listener = ShmPoolRequestFunc(q.Push)
+	return q
+}
+
 func (obj *ShmPool) Version() uint32 {
 	return ShmPoolVersion
 }
 
+// ShmPoolCreateBufferRequest holds the arguments of the create_buffer request of
+// the wl_shm_pool interface.
+type ShmPoolCreateBufferRequest struct {
+	Id     *Buffer
+	Offset int32
+	Width  int32
+	Height int32
+	Stride int32
+	Format ShmFormat
+}
+
+func (ShmPoolCreateBufferRequest) shmPoolRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmPoolCreateBufferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewBuffer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, BufferInterface, msg.ReadNewObject(BufferInterface), true))
+	m.Offset = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Stride = msg.ReadInt()
+	m.Format = ShmFormat(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Format.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shm_pool",
+			Method:    "create_buffer",
+			Arg:       "format",
+			Value:     int64(m.Format),
+		}
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolCreateBufferRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	builder.WriteInt(m.Offset)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteInt(m.Stride)
+	builder.WriteUint(uint32(m.Format))
+
+	builder.Method = "create_buffer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: BufferInterface, ID: m.Id.ID()}, m.Offset, m.Width, m.Height, m.Stride, m.Format}
+	}
+	return builder
+}
+
+// ShmPoolDestroyRequest holds the arguments of the destroy request of
+// the wl_shm_pool interface.
+type ShmPoolDestroyRequest struct {
+}
+
+func (ShmPoolDestroyRequest) shmPoolRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmPoolDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolDestroyRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ShmPoolResizeRequest holds the arguments of the resize request of
+// the wl_shm_pool interface.
+type ShmPoolResizeRequest struct {
+	Size int32
+}
+
+func (ShmPoolResizeRequest) shmPoolRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmPoolResizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Size = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmPoolResizeRequest) Encode(obj *ShmPool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Size)
+
+	builder.Method = "resize"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Size}
+	}
+	return builder
+}
+
+const (
+	ShmInterface = shared.ShmInterface
+
+	// ShmVersion is the version of the interface that this
+	// package implements.
+	ShmVersion = 2
+)
+
+// The versions of the wl_shm interface that its messages were
+// added in.
 const (
-	ShmInterface = "wl_shm"
-	ShmVersion   = 2
+	ShmCreatePoolRequestSince = 1
+	ShmReleaseRequestSince    = 2
+	ShmFormatEventSince       = 1
 )
 
+// ShmDesc describes the wl_shm interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShmDesc = &wire.InterfaceDesc{
+	Name:    ShmInterface,
+	Version: ShmVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_pool",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_shm_pool",
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "size",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "format",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "format",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_format", Value: 0},
+				{Name: "invalid_stride", Value: 1},
+				{Name: "invalid_fd", Value: 2},
+			},
+		},
+		{
+			Name: "format",
+			Entries: []wire.EntryDesc{
+				{Name: "argb8888", Value: 0},
+				{Name: "xrgb8888", Value: 1},
+				{Name: "c8", Value: 538982467},
+				{Name: "rgb332", Value: 943867730},
+				{Name: "bgr233", Value: 944916290},
+				{Name: "xrgb4444", Value: 842093144},
+				{Name: "xbgr4444", Value: 842089048},
+				{Name: "rgbx4444", Value: 842094674},
+				{Name: "bgrx4444", Value: 842094658},
+				{Name: "argb4444", Value: 842093121},
+				{Name: "abgr4444", Value: 842089025},
+				{Name: "rgba4444", Value: 842088786},
+				{Name: "bgra4444", Value: 842088770},
+				{Name: "xrgb1555", Value: 892424792},
+				{Name: "xbgr1555", Value: 892420696},
+				{Name: "rgbx5551", Value: 892426322},
+				{Name: "bgrx5551", Value: 892426306},
+				{Name: "argb1555", Value: 892424769},
+				{Name: "abgr1555", Value: 892420673},
+				{Name: "rgba5551", Value: 892420434},
+				{Name: "bgra5551", Value: 892420418},
+				{Name: "rgb565", Value: 909199186},
+				{Name: "bgr565", Value: 909199170},
+				{Name: "rgb888", Value: 875710290},
+				{Name: "bgr888", Value: 875710274},
+				{Name: "xbgr8888", Value: 875709016},
+				{Name: "rgbx8888", Value: 875714642},
+				{Name: "bgrx8888", Value: 875714626},
+				{Name: "abgr8888", Value: 875708993},
+				{Name: "rgba8888", Value: 875708754},
+				{Name: "bgra8888", Value: 875708738},
+				{Name: "xrgb2101010", Value: 808669784},
+				{Name: "xbgr2101010", Value: 808665688},
+				{Name: "rgbx1010102", Value: 808671314},
+				{Name: "bgrx1010102", Value: 808671298},
+				{Name: "argb2101010", Value: 808669761},
+				{Name: "abgr2101010", Value: 808665665},
+				{Name: "rgba1010102", Value: 808665426},
+				{Name: "bgra1010102", Value: 808665410},
+				{Name: "yuyv", Value: 1448695129},
+				{Name: "yvyu", Value: 1431918169},
+				{Name: "uyvy", Value: 1498831189},
+				{Name: "vyuy", Value: 1498765654},
+				{Name: "ayuv", Value: 1448433985},
+				{Name: "nv12", Value: 842094158},
+				{Name: "nv21", Value: 825382478},
+				{Name: "nv16", Value: 909203022},
+				{Name: "nv61", Value: 825644622},
+				{Name: "yuv410", Value: 961959257},
+				{Name: "yvu410", Value: 961893977},
+				{Name: "yuv411", Value: 825316697},
+				{Name: "yvu411", Value: 825316953},
+				{Name: "yuv420", Value: 842093913},
+				{Name: "yvu420", Value: 842094169},
+				{Name: "yuv422", Value: 909202777},
+				{Name: "yvu422", Value: 909203033},
+				{Name: "yuv444", Value: 875713881},
+				{Name: "yvu444", Value: 875714137},
+				{Name: "r8", Value: 538982482},
+				{Name: "r16", Value: 540422482},
+				{Name: "rg88", Value: 943212370},
+				{Name: "gr88", Value: 943215175},
+				{Name: "rg1616", Value: 842221394},
+				{Name: "gr1616", Value: 842224199},
+				{Name: "xrgb16161616f", Value: 1211388504},
+				{Name: "xbgr16161616f", Value: 1211384408},
+				{Name: "argb16161616f", Value: 1211388481},
+				{Name: "abgr16161616f", Value: 1211384385},
+				{Name: "xyuv8888", Value: 1448434008},
+				{Name: "vuy888", Value: 875713878},
+				{Name: "vuy101010", Value: 808670550},
+				{Name: "y210", Value: 808530521},
+				{Name: "y212", Value: 842084953},
+				{Name: "y216", Value: 909193817},
+				{Name: "y410", Value: 808531033},
+				{Name: "y412", Value: 842085465},
+				{Name: "y416", Value: 909194329},
+				{Name: "xvyu2101010", Value: 808670808},
+				{Name: "xvyu12_16161616", Value: 909334104},
+				{Name: "xvyu16161616", Value: 942954072},
+				{Name: "y0l0", Value: 810299481},
+				{Name: "x0l0", Value: 810299480},
+				{Name: "y0l2", Value: 843853913},
+				{Name: "x0l2", Value: 843853912},
+				{Name: "yuv420_8bit", Value: 942691673},
+				{Name: "yuv420_10bit", Value: 808539481},
+				{Name: "xrgb8888_a8", Value: 943805016},
+				{Name: "xbgr8888_a8", Value: 943800920},
+				{Name: "rgbx8888_a8", Value: 943806546},
+				{Name: "bgrx8888_a8", Value: 943806530},
+				{Name: "rgb888_a8", Value: 943798354},
+				{Name: "bgr888_a8", Value: 943798338},
+				{Name: "rgb565_a8", Value: 943797586},
+				{Name: "bgr565_a8", Value: 943797570},
+				{Name: "nv24", Value: 875714126},
+				{Name: "nv42", Value: 842290766},
+				{Name: "p210", Value: 808530512},
+				{Name: "p010", Value: 808530000},
+				{Name: "p012", Value: 842084432},
+				{Name: "p016", Value: 909193296},
+				{Name: "axbxgxrx106106106106", Value: 808534593},
+				{Name: "nv15", Value: 892425806},
+				{Name: "q410", Value: 808531025},
+				{Name: "q401", Value: 825242705},
+				{Name: "xrgb16161616", Value: 942953048},
+				{Name: "xbgr16161616", Value: 942948952},
+				{Name: "argb16161616", Value: 942953025},
+				{Name: "abgr16161616", Value: 942948929},
+				{Name: "c1", Value: 538980675},
+				{Name: "c2", Value: 538980931},
+				{Name: "c4", Value: 538981443},
+				{Name: "d1", Value: 538980676},
+				{Name: "d2", Value: 538980932},
+				{Name: "d4", Value: 538981444},
+				{Name: "d8", Value: 538982468},
+				{Name: "r1", Value: 538980690},
+				{Name: "r2", Value: 538980946},
+				{Name: "r4", Value: 538981458},
+				{Name: "r10", Value: 540029266},
+				{Name: "r12", Value: 540160338},
+				{Name: "avuy8888", Value: 1498764865},
+				{Name: "xvuy8888", Value: 1498764888},
+				{Name: "p030", Value: 808661072},
+			},
+		},
+	},
+}
+
 // ShmListener is a type that can respond to incoming
 // messages for a Shm object.
 type ShmListener interface {
@@ -807,9 +2041,56 @@ type ShmListener interface {
 	// use the shm object anymore.
 	//
 	// Objects created via this interface remain unaffected.
+	//
+	// Available since version 2.
 	Release()
 }
 
+// ShmListenerFuncs implements ShmListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShmListenerFuncs struct {
+	OnCreatePool func(id *ShmPool, fd *os.File, size int32)
+	// Available since version 2.
+	OnRelease func()
+}
+
+func (lis *ShmListenerFuncs) CreatePool(id *ShmPool, fd *os.File, size int32) {
+	if lis.OnCreatePool != nil {
+		lis.OnCreatePool(id, fd, size)
+	}
+}
+
+// Available since version 2.
+func (lis *ShmListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// ShmRequest is implemented by the message types of all
+// of the requests that a Shm can receive.
+type ShmRequest interface {
+	shmRequest()
+}
+
+// ShmRequestFunc implements ShmListener by calling
+// itself with each incoming message.
+type ShmRequestFunc func(ShmRequest)
+
+func (f ShmRequestFunc) CreatePool(id *ShmPool, fd *os.File, size int32) {
+	f(ShmCreatePoolRequest{
+		Id:   id,
+		Fd:   fd,
+		Size: size,
+	})
+}
+
+// Available since version 2.
+func (f ShmRequestFunc) Release() {
+	f(ShmReleaseRequest{})
+}
+
 // A singleton global object that provides support for shared
 // memory.
 //
@@ -853,32 +2134,28 @@ func (obj *Shm) State() wire.State {
 func (obj *Shm) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewShmPool(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		fd := msg.ReadFile()
-
-		size := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		if err := msg.ClaimFDs(1); err != nil {
+			return err
+		}
+		var m ShmCreatePoolRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
 		}
+		msg.HandOffFiles()
 		obj.Listener.CreatePool(
-			id,
-			fd,
-			size,
+			m.Id,
+			m.Fd,
+			m.Size,
 		)
 		return nil
 
 	case 1:
-		if err := msg.Err(); err != nil {
+		var m ShmReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -889,6 +2166,14 @@ func (obj *Shm) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShmInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shm",
 		Type:      "request",
@@ -930,6 +2215,40 @@ func (obj *Shm) Interface() string {
 	return ShmInterface
 }
 
+// OnCreatePool sets the function that is called when the
+// create_pool request is received. If obj's Listener is not a
+// *ShmListenerFuncs, it is replaced with one.
+func (obj *Shm) OnCreatePool(f func(id *ShmPool, fd *os.File, size int32)) {
+	lis, ok := obj.Listener.(*ShmListenerFuncs)
+	if !ok {
+		lis = new(ShmListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreatePool = f
+}
+
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *ShmListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *Shm) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*ShmListenerFuncs)
+	if !ok {
+		lis = new(ShmListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Shm) Subscribe() *wire.Messages[ShmRequest] {
+	q := new(wire.Messages[ShmRequest])
+	obj.Listener = ShmRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Shm) Version() uint32 {
 	return ShmVersion
 }
@@ -938,931 +2257,696 @@ func (obj *Shm) Version() uint32 {
 // can be used for buffers. Known formats include
 // argb8888 and xrgb8888.
 func (obj *Shm) Format(format ShmFormat) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(uint32(format))
-
-	builder.Method = "format"
-	builder.Args = []any{format}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShmFormatEvent{
+		Format: format,
+	}.Encode(obj))
 	return
 }
 
-// These errors can be emitted in response to wl_shm requests.
-type ShmError int64
-
-const (
-	// buffer format is not known
-	ShmErrorInvalidFormat ShmError = 0
-
-	// invalid size or stride during pool or buffer creation
-	ShmErrorInvalidStride ShmError = 1
-
-	// mmapping the file descriptor failed
-	ShmErrorInvalidFd ShmError = 2
-)
+// ShmCreatePoolRequest holds the arguments of the create_pool request of
+// the wl_shm interface.
+type ShmCreatePoolRequest struct {
+	Id   *ShmPool
+	Fd   *os.File
+	Size int32
+}
+
+func (ShmCreatePoolRequest) shmRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmCreatePoolRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewShmPool(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ShmPoolInterface, msg.ReadNewObject(ShmPoolInterface), true))
+	m.Fd = msg.ReadFile()
+	m.Size = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-func (enum ShmError) String() string {
-	switch enum {
-	case 0:
-		return "ShmErrorInvalidFormat"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m ShmCreatePoolRequest) Encode(obj *Shm) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
 
-	case 1:
-		return "ShmErrorInvalidStride"
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m ShmCreatePoolRequest) EncodeTransfer(obj *Shm) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
 
-	case 2:
-		return "ShmErrorInvalidFd"
+func (m ShmCreatePoolRequest) encode(obj *Shm, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
 	}
+	builder.WriteInt(m.Size)
 
-	return "<invalid ShmError>"
+	builder.Method = "create_pool"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ShmPoolInterface, ID: m.Id.ID()}, m.Fd, m.Size}
+	}
+	return builder
 }
 
-// This describes the memory layout of an individual pixel.
+// ShmReleaseRequest holds the arguments of the release request of
+// the wl_shm interface.
 //
-// All renderers should support argb8888 and xrgb8888 but any other
-// formats are optional and may not be supported by the particular
-// renderer in use.
-//
-// The drm format codes match the macros defined in drm_fourcc.h, except
-// argb8888 and xrgb8888. The formats actually supported by the compositor
-// will be reported by the format event.
-//
-// For all wl_shm formats and unless specified in another protocol
-// extension, pre-multiplied alpha is used for pixel values.
-type ShmFormat int64
+// Available since version 2.
+type ShmReleaseRequest struct {
+}
 
-const (
-	// 32-bit ARGB format, [31:0] A:R:G:B 8:8:8:8 little endian
-	ShmFormatArgb8888 ShmFormat = 0
+func (ShmReleaseRequest) shmRequest() {}
 
-	// 32-bit RGB format, [31:0] x:R:G:B 8:8:8:8 little endian
-	ShmFormatXrgb8888 ShmFormat = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// 8-bit color index format, [7:0] C
-	ShmFormatC8 ShmFormat = 538982467
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmReleaseRequest) Encode(obj *Shm) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
 
-	// 8-bit RGB format, [7:0] R:G:B 3:3:2
-	ShmFormatRgb332 ShmFormat = 943867730
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// 8-bit BGR format, [7:0] B:G:R 2:3:3
-	ShmFormatBgr233 ShmFormat = 944916290
+// ShmFormatEvent holds the arguments of the format event of
+// the wl_shm interface.
+type ShmFormatEvent struct {
+	Format ShmFormat
+}
 
-	// 16-bit xRGB format, [15:0] x:R:G:B 4:4:4:4 little endian
-	ShmFormatXrgb4444 ShmFormat = 842093144
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShmFormatEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = ShmFormat(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Format.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shm",
+			Method:    "format",
+			Arg:       "format",
+			Value:     int64(m.Format),
+		}
+	}
+	return nil
+}
 
-	// 16-bit xBGR format, [15:0] x:B:G:R 4:4:4:4 little endian
-	ShmFormatXbgr4444 ShmFormat = 842089048
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShmFormatEvent) Encode(obj *Shm) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Format))
 
-	// 16-bit RGBx format, [15:0] R:G:B:x 4:4:4:4 little endian
-	ShmFormatRgbx4444 ShmFormat = 842094674
+	builder.Method = "format"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format}
+	}
+	return builder
+}
 
-	// 16-bit BGRx format, [15:0] B:G:R:x 4:4:4:4 little endian
-	ShmFormatBgrx4444 ShmFormat = 842094658
+// ShmError is an alias for [shared.ShmError].
+type ShmError = shared.ShmError
 
-	// 16-bit ARGB format, [15:0] A:R:G:B 4:4:4:4 little endian
-	ShmFormatArgb4444 ShmFormat = 842093121
+const (
+	ShmErrorInvalidFormat = shared.ShmErrorInvalidFormat
+	ShmErrorInvalidStride = shared.ShmErrorInvalidStride
+	ShmErrorInvalidFd     = shared.ShmErrorInvalidFd
+)
 
-	// 16-bit ABGR format, [15:0] A:B:G:R 4:4:4:4 little endian
-	ShmFormatAbgr4444 ShmFormat = 842089025
+// ShmFormat is an alias for [shared.ShmFormat].
+type ShmFormat = shared.ShmFormat
 
-	// 16-bit RBGA format, [15:0] R:G:B:A 4:4:4:4 little endian
-	ShmFormatRgba4444 ShmFormat = 842088786
+const (
+	ShmFormatArgb8888             = shared.ShmFormatArgb8888
+	ShmFormatXrgb8888             = shared.ShmFormatXrgb8888
+	ShmFormatC8                   = shared.ShmFormatC8
+	ShmFormatRgb332               = shared.ShmFormatRgb332
+	ShmFormatBgr233               = shared.ShmFormatBgr233
+	ShmFormatXrgb4444             = shared.ShmFormatXrgb4444
+	ShmFormatXbgr4444             = shared.ShmFormatXbgr4444
+	ShmFormatRgbx4444             = shared.ShmFormatRgbx4444
+	ShmFormatBgrx4444             = shared.ShmFormatBgrx4444
+	ShmFormatArgb4444             = shared.ShmFormatArgb4444
+	ShmFormatAbgr4444             = shared.ShmFormatAbgr4444
+	ShmFormatRgba4444             = shared.ShmFormatRgba4444
+	ShmFormatBgra4444             = shared.ShmFormatBgra4444
+	ShmFormatXrgb1555             = shared.ShmFormatXrgb1555
+	ShmFormatXbgr1555             = shared.ShmFormatXbgr1555
+	ShmFormatRgbx5551             = shared.ShmFormatRgbx5551
+	ShmFormatBgrx5551             = shared.ShmFormatBgrx5551
+	ShmFormatArgb1555             = shared.ShmFormatArgb1555
+	ShmFormatAbgr1555             = shared.ShmFormatAbgr1555
+	ShmFormatRgba5551             = shared.ShmFormatRgba5551
+	ShmFormatBgra5551             = shared.ShmFormatBgra5551
+	ShmFormatRgb565               = shared.ShmFormatRgb565
+	ShmFormatBgr565               = shared.ShmFormatBgr565
+	ShmFormatRgb888               = shared.ShmFormatRgb888
+	ShmFormatBgr888               = shared.ShmFormatBgr888
+	ShmFormatXbgr8888             = shared.ShmFormatXbgr8888
+	ShmFormatRgbx8888             = shared.ShmFormatRgbx8888
+	ShmFormatBgrx8888             = shared.ShmFormatBgrx8888
+	ShmFormatAbgr8888             = shared.ShmFormatAbgr8888
+	ShmFormatRgba8888             = shared.ShmFormatRgba8888
+	ShmFormatBgra8888             = shared.ShmFormatBgra8888
+	ShmFormatXrgb2101010          = shared.ShmFormatXrgb2101010
+	ShmFormatXbgr2101010          = shared.ShmFormatXbgr2101010
+	ShmFormatRgbx1010102          = shared.ShmFormatRgbx1010102
+	ShmFormatBgrx1010102          = shared.ShmFormatBgrx1010102
+	ShmFormatArgb2101010          = shared.ShmFormatArgb2101010
+	ShmFormatAbgr2101010          = shared.ShmFormatAbgr2101010
+	ShmFormatRgba1010102          = shared.ShmFormatRgba1010102
+	ShmFormatBgra1010102          = shared.ShmFormatBgra1010102
+	ShmFormatYuyv                 = shared.ShmFormatYuyv
+	ShmFormatYvyu                 = shared.ShmFormatYvyu
+	ShmFormatUyvy                 = shared.ShmFormatUyvy
+	ShmFormatVyuy                 = shared.ShmFormatVyuy
+	ShmFormatAyuv                 = shared.ShmFormatAyuv
+	ShmFormatNv12                 = shared.ShmFormatNv12
+	ShmFormatNv21                 = shared.ShmFormatNv21
+	ShmFormatNv16                 = shared.ShmFormatNv16
+	ShmFormatNv61                 = shared.ShmFormatNv61
+	ShmFormatYuv410               = shared.ShmFormatYuv410
+	ShmFormatYvu410               = shared.ShmFormatYvu410
+	ShmFormatYuv411               = shared.ShmFormatYuv411
+	ShmFormatYvu411               = shared.ShmFormatYvu411
+	ShmFormatYuv420               = shared.ShmFormatYuv420
+	ShmFormatYvu420               = shared.ShmFormatYvu420
+	ShmFormatYuv422               = shared.ShmFormatYuv422
+	ShmFormatYvu422               = shared.ShmFormatYvu422
+	ShmFormatYuv444               = shared.ShmFormatYuv444
+	ShmFormatYvu444               = shared.ShmFormatYvu444
+	ShmFormatR8                   = shared.ShmFormatR8
+	ShmFormatR16                  = shared.ShmFormatR16
+	ShmFormatRg88                 = shared.ShmFormatRg88
+	ShmFormatGr88                 = shared.ShmFormatGr88
+	ShmFormatRg1616               = shared.ShmFormatRg1616
+	ShmFormatGr1616               = shared.ShmFormatGr1616
+	ShmFormatXrgb16161616f        = shared.ShmFormatXrgb16161616f
+	ShmFormatXbgr16161616f        = shared.ShmFormatXbgr16161616f
+	ShmFormatArgb16161616f        = shared.ShmFormatArgb16161616f
+	ShmFormatAbgr16161616f        = shared.ShmFormatAbgr16161616f
+	ShmFormatXyuv8888             = shared.ShmFormatXyuv8888
+	ShmFormatVuy888               = shared.ShmFormatVuy888
+	ShmFormatVuy101010            = shared.ShmFormatVuy101010
+	ShmFormatY210                 = shared.ShmFormatY210
+	ShmFormatY212                 = shared.ShmFormatY212
+	ShmFormatY216                 = shared.ShmFormatY216
+	ShmFormatY410                 = shared.ShmFormatY410
+	ShmFormatY412                 = shared.ShmFormatY412
+	ShmFormatY416                 = shared.ShmFormatY416
+	ShmFormatXvyu2101010          = shared.ShmFormatXvyu2101010
+	ShmFormatXvyu1216161616       = shared.ShmFormatXvyu1216161616
+	ShmFormatXvyu16161616         = shared.ShmFormatXvyu16161616
+	ShmFormatY0l0                 = shared.ShmFormatY0l0
+	ShmFormatX0l0                 = shared.ShmFormatX0l0
+	ShmFormatY0l2                 = shared.ShmFormatY0l2
+	ShmFormatX0l2                 = shared.ShmFormatX0l2
+	ShmFormatYuv4208bit           = shared.ShmFormatYuv4208bit
+	ShmFormatYuv42010bit          = shared.ShmFormatYuv42010bit
+	ShmFormatXrgb8888A8           = shared.ShmFormatXrgb8888A8
+	ShmFormatXbgr8888A8           = shared.ShmFormatXbgr8888A8
+	ShmFormatRgbx8888A8           = shared.ShmFormatRgbx8888A8
+	ShmFormatBgrx8888A8           = shared.ShmFormatBgrx8888A8
+	ShmFormatRgb888A8             = shared.ShmFormatRgb888A8
+	ShmFormatBgr888A8             = shared.ShmFormatBgr888A8
+	ShmFormatRgb565A8             = shared.ShmFormatRgb565A8
+	ShmFormatBgr565A8             = shared.ShmFormatBgr565A8
+	ShmFormatNv24                 = shared.ShmFormatNv24
+	ShmFormatNv42                 = shared.ShmFormatNv42
+	ShmFormatP210                 = shared.ShmFormatP210
+	ShmFormatP010                 = shared.ShmFormatP010
+	ShmFormatP012                 = shared.ShmFormatP012
+	ShmFormatP016                 = shared.ShmFormatP016
+	ShmFormatAxbxgxrx106106106106 = shared.ShmFormatAxbxgxrx106106106106
+	ShmFormatNv15                 = shared.ShmFormatNv15
+	ShmFormatQ410                 = shared.ShmFormatQ410
+	ShmFormatQ401                 = shared.ShmFormatQ401
+	ShmFormatXrgb16161616         = shared.ShmFormatXrgb16161616
+	ShmFormatXbgr16161616         = shared.ShmFormatXbgr16161616
+	ShmFormatArgb16161616         = shared.ShmFormatArgb16161616
+	ShmFormatAbgr16161616         = shared.ShmFormatAbgr16161616
+	ShmFormatC1                   = shared.ShmFormatC1
+	ShmFormatC2                   = shared.ShmFormatC2
+	ShmFormatC4                   = shared.ShmFormatC4
+	ShmFormatD1                   = shared.ShmFormatD1
+	ShmFormatD2                   = shared.ShmFormatD2
+	ShmFormatD4                   = shared.ShmFormatD4
+	ShmFormatD8                   = shared.ShmFormatD8
+	ShmFormatR1                   = shared.ShmFormatR1
+	ShmFormatR2                   = shared.ShmFormatR2
+	ShmFormatR4                   = shared.ShmFormatR4
+	ShmFormatR10                  = shared.ShmFormatR10
+	ShmFormatR12                  = shared.ShmFormatR12
+	ShmFormatAvuy8888             = shared.ShmFormatAvuy8888
+	ShmFormatXvuy8888             = shared.ShmFormatXvuy8888
+	ShmFormatP030                 = shared.ShmFormatP030
+)
 
-	// 16-bit BGRA format, [15:0] B:G:R:A 4:4:4:4 little endian
-	ShmFormatBgra4444 ShmFormat = 842088770
+const (
+	BufferInterface = shared.BufferInterface
 
-	// 16-bit xRGB format, [15:0] x:R:G:B 1:5:5:5 little endian
-	ShmFormatXrgb1555 ShmFormat = 892424792
+	// BufferVersion is the version of the interface that this
+	// package implements.
+	BufferVersion = 1
+)
 
-	// 16-bit xBGR 1555 format, [15:0] x:B:G:R 1:5:5:5 little endian
-	ShmFormatXbgr1555 ShmFormat = 892420696
+// The versions of the wl_buffer interface that its messages were
+// added in.
+const (
+	BufferDestroyRequestSince = 1
+	BufferReleaseEventSince   = 1
+)
 
-	// 16-bit RGBx 5551 format, [15:0] R:G:B:x 5:5:5:1 little endian
-	ShmFormatRgbx5551 ShmFormat = 892426322
+// BufferDesc describes the wl_buffer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var BufferDesc = &wire.InterfaceDesc{
+	Name:    BufferInterface,
+	Version: BufferVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
 
-	// 16-bit BGRx 5551 format, [15:0] B:G:R:x 5:5:5:1 little endian
-	ShmFormatBgrx5551 ShmFormat = 892426306
+// BufferListener is a type that can respond to incoming
+// messages for a Buffer object.
+type BufferListener interface {
+	// Destroy a buffer. If and how you need to release the backing
+	// storage is defined by the buffer factory interface.
+	//
+	// For possible side-effects to a surface, see wl_surface.attach.
+	Destroy()
+}
 
-	// 16-bit ARGB 1555 format, [15:0] A:R:G:B 1:5:5:5 little endian
-	ShmFormatArgb1555 ShmFormat = 892424769
+// BufferListenerFuncs implements BufferListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type BufferListenerFuncs struct {
+	OnDestroy func()
+}
 
-	// 16-bit ABGR 1555 format, [15:0] A:B:G:R 1:5:5:5 little endian
-	ShmFormatAbgr1555 ShmFormat = 892420673
+func (lis *BufferListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
 
-	// 16-bit RGBA 5551 format, [15:0] R:G:B:A 5:5:5:1 little endian
-	ShmFormatRgba5551 ShmFormat = 892420434
+// BufferRequest is implemented by the message types of all
+// of the requests that a Buffer can receive.
+type BufferRequest interface {
+	bufferRequest()
+}
 
-	// 16-bit BGRA 5551 format, [15:0] B:G:R:A 5:5:5:1 little endian
-	ShmFormatBgra5551 ShmFormat = 892420418
+// BufferRequestFunc implements BufferListener by calling
+// itself with each incoming message.
+type BufferRequestFunc func(BufferRequest)
 
-	// 16-bit RGB 565 format, [15:0] R:G:B 5:6:5 little endian
-	ShmFormatRgb565 ShmFormat = 909199186
+func (f BufferRequestFunc) Destroy() {
+	f(BufferDestroyRequest{})
+}
 
-	// 16-bit BGR 565 format, [15:0] B:G:R 5:6:5 little endian
-	ShmFormatBgr565 ShmFormat = 909199170
+// A buffer provides the content for a wl_surface. Buffers are
+// created through factory interfaces such as wl_shm, wp_linux_buffer_params
+// (from the linux-dmabuf protocol extension) or similar. It has a width and
+// a height and can be attached to a wl_surface, but the mechanism by which a
+// client provides and updates the contents is defined by the buffer factory
+// interface.
+//
+// Color channels are assumed to be electrical rather than optical (in other
+// words, encoded with a transfer function) unless otherwise specified. If
+// the buffer uses a format that has an alpha channel, the alpha channel is
+// assumed to be premultiplied into the electrical color channel values
+// (after transfer function encoding) unless otherwise specified.
+//
+// Note, because wl_buffer objects are created from multiple independent
+// factory interfaces, the wl_buffer interface is frozen at version 1.
+type Buffer struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener BufferListener
 
-	// 24-bit RGB format, [23:0] R:G:B little endian
-	ShmFormatRgb888 ShmFormat = 875710290
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
 
-	// 24-bit BGR format, [23:0] B:G:R little endian
-	ShmFormatBgr888 ShmFormat = 875710274
+	state wire.State
+	id    uint32
+}
 
-	// 32-bit xBGR format, [31:0] x:B:G:R 8:8:8:8 little endian
-	ShmFormatXbgr8888 ShmFormat = 875709016
+// NewBuffer returns a newly instantiated Buffer. It is
+// primarily intended for use by generated code.
+func NewBuffer(state wire.State) *Buffer {
+	return &Buffer{state: state}
+}
 
-	// 32-bit RGBx format, [31:0] R:G:B:x 8:8:8:8 little endian
-	ShmFormatRgbx8888 ShmFormat = 875714642
+func (obj *Buffer) State() wire.State {
+	return obj.state
+}
 
-	// 32-bit BGRx format, [31:0] B:G:R:x 8:8:8:8 little endian
-	ShmFormatBgrx8888 ShmFormat = 875714626
+func (obj *Buffer) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m BufferDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
 
-	// 32-bit ABGR format, [31:0] A:B:G:R 8:8:8:8 little endian
-	ShmFormatAbgr8888 ShmFormat = 875708993
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+	}
 
-	// 32-bit RGBA format, [31:0] R:G:B:A 8:8:8:8 little endian
-	ShmFormatRgba8888 ShmFormat = 875708754
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(BufferInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wl_buffer",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
 
-	// 32-bit BGRA format, [31:0] B:G:R:A 8:8:8:8 little endian
-	ShmFormatBgra8888 ShmFormat = 875708738
+func (obj *Buffer) ID() uint32 {
+	return obj.id
+}
 
-	// 32-bit xRGB format, [31:0] x:R:G:B 2:10:10:10 little endian
-	ShmFormatXrgb2101010 ShmFormat = 808669784
+func (obj *Buffer) SetID(id uint32) {
+	obj.id = id
+}
 
-	// 32-bit xBGR format, [31:0] x:B:G:R 2:10:10:10 little endian
-	ShmFormatXbgr2101010 ShmFormat = 808665688
+func (obj *Buffer) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
 
-	// 32-bit RGBx format, [31:0] R:G:B:x 10:10:10:2 little endian
-	ShmFormatRgbx1010102 ShmFormat = 808671314
+func (obj *Buffer) String() string {
+	return fmt.Sprintf("%v(%v)", "wl_buffer", obj.id)
+}
 
-	// 32-bit BGRx format, [31:0] B:G:R:x 10:10:10:2 little endian
-	ShmFormatBgrx1010102 ShmFormat = 808671298
+func (obj *Buffer) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+	}
 
-	// 32-bit ARGB format, [31:0] A:R:G:B 2:10:10:10 little endian
-	ShmFormatArgb2101010 ShmFormat = 808669761
+	return "unknown method"
+}
 
-	// 32-bit ABGR format, [31:0] A:B:G:R 2:10:10:10 little endian
-	ShmFormatAbgr2101010 ShmFormat = 808665665
+func (obj *Buffer) Interface() string {
+	return BufferInterface
+}
 
-	// 32-bit RGBA format, [31:0] R:G:B:A 10:10:10:2 little endian
-	ShmFormatRgba1010102 ShmFormat = 808665426
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *BufferListenerFuncs, it is replaced with one.
+func (obj *Buffer) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*BufferListenerFuncs)
+	if !ok {
+		lis = new(BufferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
 
-	// 32-bit BGRA format, [31:0] B:G:R:A 10:10:10:2 little endian
-	ShmFormatBgra1010102 ShmFormat = 808665410
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Buffer) Subscribe() *wire.Messages[BufferRequest] {
+	q := new(wire.Messages[BufferRequest])
+	obj.Listener = BufferRequestFunc(q.Push)
+	return q
+}
 
-	// packed YCbCr format, [31:0] Cr0:Y1:Cb0:Y0 8:8:8:8 little endian
-	ShmFormatYuyv ShmFormat = 1448695129
+func (obj *Buffer) Version() uint32 {
+	return BufferVersion
+}
 
-	// packed YCbCr format, [31:0] Cb0:Y1:Cr0:Y0 8:8:8:8 little endian
-	ShmFormatYvyu ShmFormat = 1431918169
+// Sent when this wl_buffer is no longer used by the compositor.
+//
+// For more information on when release events may or may not be sent,
+// and what consequences it has, please see the description of
+// wl_surface.attach.
+//
+// If a client receives a release event before the frame callback
+// requested in the same wl_surface.commit that attaches this
+// wl_buffer to a surface, then the client is immediately free to
+// reuse the buffer and its backing storage, and does not need a
+// second buffer for the next surface content update. Typically
+// this is possible, when the compositor maintains a copy of the
+// wl_surface contents, e.g. as a GL texture. This is an important
+// optimization for GL(ES) compositors with wl_shm clients.
+func (obj *Buffer) Release() {
+	obj.state.Enqueue(BufferReleaseEvent{}.Encode(obj))
+	return
+}
 
-	// packed YCbCr format, [31:0] Y1:Cr0:Y0:Cb0 8:8:8:8 little endian
-	ShmFormatUyvy ShmFormat = 1498831189
+// BufferDestroyRequest holds the arguments of the destroy request of
+// the wl_buffer interface.
+type BufferDestroyRequest struct {
+}
 
-	// packed YCbCr format, [31:0] Y1:Cb0:Y0:Cr0 8:8:8:8 little endian
-	ShmFormatVyuy ShmFormat = 1498765654
+func (BufferDestroyRequest) bufferRequest() {}
 
-	// packed AYCbCr format, [31:0] A:Y:Cb:Cr 8:8:8:8 little endian
-	ShmFormatAyuv ShmFormat = 1448433985
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *BufferDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// 2 plane YCbCr Cr:Cb format, 2x2 subsampled Cr:Cb plane
-	ShmFormatNv12 ShmFormat = 842094158
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m BufferDestroyRequest) Encode(obj *Buffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	// 2 plane YCbCr Cb:Cr format, 2x2 subsampled Cb:Cr plane
-	ShmFormatNv21 ShmFormat = 825382478
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// 2 plane YCbCr Cr:Cb format, 2x1 subsampled Cr:Cb plane
-	ShmFormatNv16 ShmFormat = 909203022
+// BufferReleaseEvent holds the arguments of the release event of
+// the wl_buffer interface.
+type BufferReleaseEvent struct {
+}
 
-	// 2 plane YCbCr Cb:Cr format, 2x1 subsampled Cb:Cr plane
-	ShmFormatNv61 ShmFormat = 825644622
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *BufferReleaseEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// 3 plane YCbCr format, 4x4 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv410 ShmFormat = 961959257
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m BufferReleaseEvent) Encode(obj *Buffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	// 3 plane YCbCr format, 4x4 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu410 ShmFormat = 961893977
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// 3 plane YCbCr format, 4x1 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv411 ShmFormat = 825316697
+const (
+	DataOfferInterface = shared.DataOfferInterface
 
-	// 3 plane YCbCr format, 4x1 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu411 ShmFormat = 825316953
-
-	// 3 plane YCbCr format, 2x2 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv420 ShmFormat = 842093913
-
-	// 3 plane YCbCr format, 2x2 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu420 ShmFormat = 842094169
-
-	// 3 plane YCbCr format, 2x1 subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv422 ShmFormat = 909202777
-
-	// 3 plane YCbCr format, 2x1 subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu422 ShmFormat = 909203033
-
-	// 3 plane YCbCr format, non-subsampled Cb (1) and Cr (2) planes
-	ShmFormatYuv444 ShmFormat = 875713881
-
-	// 3 plane YCbCr format, non-subsampled Cr (1) and Cb (2) planes
-	ShmFormatYvu444 ShmFormat = 875714137
-
-	// [7:0] R
-	ShmFormatR8 ShmFormat = 538982482
-
-	// [15:0] R little endian
-	ShmFormatR16 ShmFormat = 540422482
-
-	// [15:0] R:G 8:8 little endian
-	ShmFormatRg88 ShmFormat = 943212370
-
-	// [15:0] G:R 8:8 little endian
-	ShmFormatGr88 ShmFormat = 943215175
-
-	// [31:0] R:G 16:16 little endian
-	ShmFormatRg1616 ShmFormat = 842221394
-
-	// [31:0] G:R 16:16 little endian
-	ShmFormatGr1616 ShmFormat = 842224199
-
-	// [63:0] x:R:G:B 16:16:16:16 little endian
-	ShmFormatXrgb16161616f ShmFormat = 1211388504
-
-	// [63:0] x:B:G:R 16:16:16:16 little endian
-	ShmFormatXbgr16161616f ShmFormat = 1211384408
-
-	// [63:0] A:R:G:B 16:16:16:16 little endian
-	ShmFormatArgb16161616f ShmFormat = 1211388481
-
-	// [63:0] A:B:G:R 16:16:16:16 little endian
-	ShmFormatAbgr16161616f ShmFormat = 1211384385
-
-	// [31:0] X:Y:Cb:Cr 8:8:8:8 little endian
-	ShmFormatXyuv8888 ShmFormat = 1448434008
-
-	// [23:0] Cr:Cb:Y 8:8:8 little endian
-	ShmFormatVuy888 ShmFormat = 875713878
-
-	// Y followed by U then V, 10:10:10. Non-linear modifier only
-	ShmFormatVuy101010 ShmFormat = 808670550
-
-	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 10:6:10:6:10:6:10:6 little endian per 2 Y pixels
-	ShmFormatY210 ShmFormat = 808530521
-
-	// [63:0] Cr0:0:Y1:0:Cb0:0:Y0:0 12:4:12:4:12:4:12:4 little endian per 2 Y pixels
-	ShmFormatY212 ShmFormat = 842084953
-
-	// [63:0] Cr0:Y1:Cb0:Y0 16:16:16:16 little endian per 2 Y pixels
-	ShmFormatY216 ShmFormat = 909193817
-
-	// [31:0] A:Cr:Y:Cb 2:10:10:10 little endian
-	ShmFormatY410 ShmFormat = 808531033
-
-	// [63:0] A:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
-	ShmFormatY412 ShmFormat = 842085465
-
-	// [63:0] A:Cr:Y:Cb 16:16:16:16 little endian
-	ShmFormatY416 ShmFormat = 909194329
-
-	// [31:0] X:Cr:Y:Cb 2:10:10:10 little endian
-	ShmFormatXvyu2101010 ShmFormat = 808670808
-
-	// [63:0] X:0:Cr:0:Y:0:Cb:0 12:4:12:4:12:4:12:4 little endian
-	ShmFormatXvyu1216161616 ShmFormat = 909334104
-
-	// [63:0] X:Cr:Y:Cb 16:16:16:16 little endian
-	ShmFormatXvyu16161616 ShmFormat = 942954072
-
-	// [63:0]   A3:A2:Y3:0:Cr0:0:Y2:0:A1:A0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
-	ShmFormatY0l0 ShmFormat = 810299481
-
-	// [63:0]   X3:X2:Y3:0:Cr0:0:Y2:0:X1:X0:Y1:0:Cb0:0:Y0:0  1:1:8:2:8:2:8:2:1:1:8:2:8:2:8:2 little endian
-	ShmFormatX0l0 ShmFormat = 810299480
-
-	// [63:0]   A3:A2:Y3:Cr0:Y2:A1:A0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
-	ShmFormatY0l2 ShmFormat = 843853913
-
-	// [63:0]   X3:X2:Y3:Cr0:Y2:X1:X0:Y1:Cb0:Y0  1:1:10:10:10:1:1:10:10:10 little endian
-	ShmFormatX0l2 ShmFormat = 843853912
-
-	ShmFormatYuv4208bit ShmFormat = 942691673
-
-	ShmFormatYuv42010bit ShmFormat = 808539481
-
-	ShmFormatXrgb8888A8 ShmFormat = 943805016
-
-	ShmFormatXbgr8888A8 ShmFormat = 943800920
-
-	ShmFormatRgbx8888A8 ShmFormat = 943806546
-
-	ShmFormatBgrx8888A8 ShmFormat = 943806530
-
-	ShmFormatRgb888A8 ShmFormat = 943798354
-
-	ShmFormatBgr888A8 ShmFormat = 943798338
-
-	ShmFormatRgb565A8 ShmFormat = 943797586
-
-	ShmFormatBgr565A8 ShmFormat = 943797570
-
-	// non-subsampled Cr:Cb plane
-	ShmFormatNv24 ShmFormat = 875714126
-
-	// non-subsampled Cb:Cr plane
-	ShmFormatNv42 ShmFormat = 842290766
-
-	// 2x1 subsampled Cr:Cb plane, 10 bit per channel
-	ShmFormatP210 ShmFormat = 808530512
-
-	// 2x2 subsampled Cr:Cb plane 10 bits per channel
-	ShmFormatP010 ShmFormat = 808530000
-
-	// 2x2 subsampled Cr:Cb plane 12 bits per channel
-	ShmFormatP012 ShmFormat = 842084432
-
-	// 2x2 subsampled Cr:Cb plane 16 bits per channel
-	ShmFormatP016 ShmFormat = 909193296
-
-	// [63:0] A:x:B:x:G:x:R:x 10:6:10:6:10:6:10:6 little endian
-	ShmFormatAxbxgxrx106106106106 ShmFormat = 808534593
-
-	// 2x2 subsampled Cr:Cb plane
-	ShmFormatNv15 ShmFormat = 892425806
-
-	ShmFormatQ410 ShmFormat = 808531025
-
-	ShmFormatQ401 ShmFormat = 825242705
-
-	// [63:0] x:R:G:B 16:16:16:16 little endian
-	ShmFormatXrgb16161616 ShmFormat = 942953048
-
-	// [63:0] x:B:G:R 16:16:16:16 little endian
-	ShmFormatXbgr16161616 ShmFormat = 942948952
-
-	// [63:0] A:R:G:B 16:16:16:16 little endian
-	ShmFormatArgb16161616 ShmFormat = 942953025
-
-	// [63:0] A:B:G:R 16:16:16:16 little endian
-	ShmFormatAbgr16161616 ShmFormat = 942948929
-
-	// [7:0] C0:C1:C2:C3:C4:C5:C6:C7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatC1 ShmFormat = 538980675
-
-	// [7:0] C0:C1:C2:C3 2:2:2:2 four pixels/byte
-	ShmFormatC2 ShmFormat = 538980931
-
-	// [7:0] C0:C1 4:4 two pixels/byte
-	ShmFormatC4 ShmFormat = 538981443
-
-	// [7:0] D0:D1:D2:D3:D4:D5:D6:D7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatD1 ShmFormat = 538980676
-
-	// [7:0] D0:D1:D2:D3 2:2:2:2 four pixels/byte
-	ShmFormatD2 ShmFormat = 538980932
-
-	// [7:0] D0:D1 4:4 two pixels/byte
-	ShmFormatD4 ShmFormat = 538981444
-
-	// [7:0] D
-	ShmFormatD8 ShmFormat = 538982468
-
-	// [7:0] R0:R1:R2:R3:R4:R5:R6:R7 1:1:1:1:1:1:1:1 eight pixels/byte
-	ShmFormatR1 ShmFormat = 538980690
-
-	// [7:0] R0:R1:R2:R3 2:2:2:2 four pixels/byte
-	ShmFormatR2 ShmFormat = 538980946
-
-	// [7:0] R0:R1 4:4 two pixels/byte
-	ShmFormatR4 ShmFormat = 538981458
-
-	// [15:0] x:R 6:10 little endian
-	ShmFormatR10 ShmFormat = 540029266
-
-	// [15:0] x:R 4:12 little endian
-	ShmFormatR12 ShmFormat = 540160338
-
-	// [31:0] A:Cr:Cb:Y 8:8:8:8 little endian
-	ShmFormatAvuy8888 ShmFormat = 1498764865
-
-	// [31:0] X:Cr:Cb:Y 8:8:8:8 little endian
-	ShmFormatXvuy8888 ShmFormat = 1498764888
-
-	// 2x2 subsampled Cr:Cb plane 10 bits per channel packed
-	ShmFormatP030 ShmFormat = 808661072
-)
-
-func (enum ShmFormat) String() string {
-	switch enum {
-	case 0:
-		return "ShmFormatArgb8888"
-
-	case 1:
-		return "ShmFormatXrgb8888"
-
-	case 538982467:
-		return "ShmFormatC8"
-
-	case 943867730:
-		return "ShmFormatRgb332"
-
-	case 944916290:
-		return "ShmFormatBgr233"
-
-	case 842093144:
-		return "ShmFormatXrgb4444"
-
-	case 842089048:
-		return "ShmFormatXbgr4444"
-
-	case 842094674:
-		return "ShmFormatRgbx4444"
-
-	case 842094658:
-		return "ShmFormatBgrx4444"
-
-	case 842093121:
-		return "ShmFormatArgb4444"
-
-	case 842089025:
-		return "ShmFormatAbgr4444"
-
-	case 842088786:
-		return "ShmFormatRgba4444"
-
-	case 842088770:
-		return "ShmFormatBgra4444"
-
-	case 892424792:
-		return "ShmFormatXrgb1555"
-
-	case 892420696:
-		return "ShmFormatXbgr1555"
-
-	case 892426322:
-		return "ShmFormatRgbx5551"
-
-	case 892426306:
-		return "ShmFormatBgrx5551"
-
-	case 892424769:
-		return "ShmFormatArgb1555"
-
-	case 892420673:
-		return "ShmFormatAbgr1555"
-
-	case 892420434:
-		return "ShmFormatRgba5551"
-
-	case 892420418:
-		return "ShmFormatBgra5551"
-
-	case 909199186:
-		return "ShmFormatRgb565"
-
-	case 909199170:
-		return "ShmFormatBgr565"
-
-	case 875710290:
-		return "ShmFormatRgb888"
-
-	case 875710274:
-		return "ShmFormatBgr888"
-
-	case 875709016:
-		return "ShmFormatXbgr8888"
-
-	case 875714642:
-		return "ShmFormatRgbx8888"
-
-	case 875714626:
-		return "ShmFormatBgrx8888"
-
-	case 875708993:
-		return "ShmFormatAbgr8888"
-
-	case 875708754:
-		return "ShmFormatRgba8888"
-
-	case 875708738:
-		return "ShmFormatBgra8888"
-
-	case 808669784:
-		return "ShmFormatXrgb2101010"
-
-	case 808665688:
-		return "ShmFormatXbgr2101010"
-
-	case 808671314:
-		return "ShmFormatRgbx1010102"
-
-	case 808671298:
-		return "ShmFormatBgrx1010102"
-
-	case 808669761:
-		return "ShmFormatArgb2101010"
-
-	case 808665665:
-		return "ShmFormatAbgr2101010"
-
-	case 808665426:
-		return "ShmFormatRgba1010102"
-
-	case 808665410:
-		return "ShmFormatBgra1010102"
-
-	case 1448695129:
-		return "ShmFormatYuyv"
-
-	case 1431918169:
-		return "ShmFormatYvyu"
-
-	case 1498831189:
-		return "ShmFormatUyvy"
-
-	case 1498765654:
-		return "ShmFormatVyuy"
-
-	case 1448433985:
-		return "ShmFormatAyuv"
-
-	case 842094158:
-		return "ShmFormatNv12"
-
-	case 825382478:
-		return "ShmFormatNv21"
-
-	case 909203022:
-		return "ShmFormatNv16"
-
-	case 825644622:
-		return "ShmFormatNv61"
-
-	case 961959257:
-		return "ShmFormatYuv410"
-
-	case 961893977:
-		return "ShmFormatYvu410"
-
-	case 825316697:
-		return "ShmFormatYuv411"
-
-	case 825316953:
-		return "ShmFormatYvu411"
-
-	case 842093913:
-		return "ShmFormatYuv420"
-
-	case 842094169:
-		return "ShmFormatYvu420"
-
-	case 909202777:
-		return "ShmFormatYuv422"
-
-	case 909203033:
-		return "ShmFormatYvu422"
-
-	case 875713881:
-		return "ShmFormatYuv444"
-
-	case 875714137:
-		return "ShmFormatYvu444"
-
-	case 538982482:
-		return "ShmFormatR8"
-
-	case 540422482:
-		return "ShmFormatR16"
-
-	case 943212370:
-		return "ShmFormatRg88"
-
-	case 943215175:
-		return "ShmFormatGr88"
-
-	case 842221394:
-		return "ShmFormatRg1616"
-
-	case 842224199:
-		return "ShmFormatGr1616"
-
-	case 1211388504:
-		return "ShmFormatXrgb16161616f"
-
-	case 1211384408:
-		return "ShmFormatXbgr16161616f"
-
-	case 1211388481:
-		return "ShmFormatArgb16161616f"
-
-	case 1211384385:
-		return "ShmFormatAbgr16161616f"
-
-	case 1448434008:
-		return "ShmFormatXyuv8888"
-
-	case 875713878:
-		return "ShmFormatVuy888"
-
-	case 808670550:
-		return "ShmFormatVuy101010"
-
-	case 808530521:
-		return "ShmFormatY210"
-
-	case 842084953:
-		return "ShmFormatY212"
-
-	case 909193817:
-		return "ShmFormatY216"
-
-	case 808531033:
-		return "ShmFormatY410"
-
-	case 842085465:
-		return "ShmFormatY412"
-
-	case 909194329:
-		return "ShmFormatY416"
-
-	case 808670808:
-		return "ShmFormatXvyu2101010"
-
-	case 909334104:
-		return "ShmFormatXvyu1216161616"
-
-	case 942954072:
-		return "ShmFormatXvyu16161616"
-
-	case 810299481:
-		return "ShmFormatY0l0"
-
-	case 810299480:
-		return "ShmFormatX0l0"
-
-	case 843853913:
-		return "ShmFormatY0l2"
-
-	case 843853912:
-		return "ShmFormatX0l2"
-
-	case 942691673:
-		return "ShmFormatYuv4208bit"
-
-	case 808539481:
-		return "ShmFormatYuv42010bit"
-
-	case 943805016:
-		return "ShmFormatXrgb8888A8"
-
-	case 943800920:
-		return "ShmFormatXbgr8888A8"
-
-	case 943806546:
-		return "ShmFormatRgbx8888A8"
-
-	case 943806530:
-		return "ShmFormatBgrx8888A8"
-
-	case 943798354:
-		return "ShmFormatRgb888A8"
-
-	case 943798338:
-		return "ShmFormatBgr888A8"
-
-	case 943797586:
-		return "ShmFormatRgb565A8"
-
-	case 943797570:
-		return "ShmFormatBgr565A8"
-
-	case 875714126:
-		return "ShmFormatNv24"
-
-	case 842290766:
-		return "ShmFormatNv42"
-
-	case 808530512:
-		return "ShmFormatP210"
-
-	case 808530000:
-		return "ShmFormatP010"
-
-	case 842084432:
-		return "ShmFormatP012"
-
-	case 909193296:
-		return "ShmFormatP016"
-
-	case 808534593:
-		return "ShmFormatAxbxgxrx106106106106"
-
-	case 892425806:
-		return "ShmFormatNv15"
-
-	case 808531025:
-		return "ShmFormatQ410"
-
-	case 825242705:
-		return "ShmFormatQ401"
-
-	case 942953048:
-		return "ShmFormatXrgb16161616"
-
-	case 942948952:
-		return "ShmFormatXbgr16161616"
-
-	case 942953025:
-		return "ShmFormatArgb16161616"
-
-	case 942948929:
-		return "ShmFormatAbgr16161616"
-
-	case 538980675:
-		return "ShmFormatC1"
-
-	case 538980931:
-		return "ShmFormatC2"
-
-	case 538981443:
-		return "ShmFormatC4"
-
-	case 538980676:
-		return "ShmFormatD1"
-
-	case 538980932:
-		return "ShmFormatD2"
-
-	case 538981444:
-		return "ShmFormatD4"
-
-	case 538982468:
-		return "ShmFormatD8"
-
-	case 538980690:
-		return "ShmFormatR1"
-
-	case 538980946:
-		return "ShmFormatR2"
-
-	case 538981458:
-		return "ShmFormatR4"
-
-	case 540029266:
-		return "ShmFormatR10"
-
-	case 540160338:
-		return "ShmFormatR12"
-
-	case 1498764865:
-		return "ShmFormatAvuy8888"
-
-	case 1498764888:
-		return "ShmFormatXvuy8888"
-
-	case 808661072:
-		return "ShmFormatP030"
-	}
-
-	return "<invalid ShmFormat>"
-}
-
-const (
-	BufferInterface = "wl_buffer"
-	BufferVersion   = 1
-)
-
-// BufferListener is a type that can respond to incoming
-// messages for a Buffer object.
-type BufferListener interface {
-	// Destroy a buffer. If and how you need to release the backing
-	// storage is defined by the buffer factory interface.
-	//
-	// For possible side-effects to a surface, see wl_surface.attach.
-	Destroy()
-}
-
-// A buffer provides the content for a wl_surface. Buffers are
-// created through factory interfaces such as wl_shm, wp_linux_buffer_params
-// (from the linux-dmabuf protocol extension) or similar. It has a width and
-// a height and can be attached to a wl_surface, but the mechanism by which a
-// client provides and updates the contents is defined by the buffer factory
-// interface.
-//
-// Color channels are assumed to be electrical rather than optical (in other
-// words, encoded with a transfer function) unless otherwise specified. If
-// the buffer uses a format that has an alpha channel, the alpha channel is
-// assumed to be premultiplied into the electrical color channel values
-// (after transfer function encoding) unless otherwise specified.
-//
-// Note, because wl_buffer objects are created from multiple independent
-// factory interfaces, the wl_buffer interface is frozen at version 1.
-type Buffer struct {
-	// Listener's methods are called by incoming messages from the
-	// remote end via Dispatch. If it is nil, messages are silently
-	// ignored.
-	Listener BufferListener
-
-	// OnDelete is called when the object is removed from the tracking
-	// system.
-	OnDelete func()
-
-	state wire.State
-	id    uint32
-}
-
-// NewBuffer returns a newly instantiated Buffer. It is
-// primarily intended for use by generated code.
-func NewBuffer(state wire.State) *Buffer {
-	return &Buffer{state: state}
-}
-
-func (obj *Buffer) State() wire.State {
-	return obj.state
-}
-
-func (obj *Buffer) Dispatch(msg *wire.MessageBuffer) error {
-	switch msg.Op() {
-	case 0:
-		if err := msg.Err(); err != nil {
-			return err
-		}
-
-		if obj.Listener == nil {
-			return nil
-		}
-		obj.Listener.Destroy()
-		return nil
-	}
-
-	return wire.UnknownOpError{
-		Interface: "wl_buffer",
-		Type:      "request",
-		Op:        msg.Op(),
-	}
-}
-
-func (obj *Buffer) ID() uint32 {
-	return obj.id
-}
-
-func (obj *Buffer) SetID(id uint32) {
-	obj.id = id
-}
-
-func (obj *Buffer) Delete() {
-	if obj.OnDelete != nil {
-		obj.OnDelete()
-	}
-}
-
-func (obj *Buffer) String() string {
-	return fmt.Sprintf("%v(%v)", "wl_buffer", obj.id)
-}
-
-func (obj *Buffer) MethodName(op uint16) string {
-	switch op {
-	case 0:
-		return "destroy"
-	}
-
-	return "unknown method"
-}
-
-func (obj *Buffer) Interface() string {
-	return BufferInterface
-}
-
-func (obj *Buffer) Version() uint32 {
-	return BufferVersion
-}
-
-// Sent when this wl_buffer is no longer used by the compositor.
-//
-// For more information on when release events may or may not be sent,
-// and what consequences it has, please see the description of
-// wl_surface.attach.
-//
-// If a client receives a release event before the frame callback
-// requested in the same wl_surface.commit that attaches this
-// wl_buffer to a surface, then the client is immediately free to
-// reuse the buffer and its backing storage, and does not need a
-// second buffer for the next surface content update. Typically
-// this is possible, when the compositor maintains a copy of the
-// wl_surface contents, e.g. as a GL texture. This is an important
-// optimization for GL(ES) compositors with wl_shm clients.
-func (obj *Buffer) Release() {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.Method = "release"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
-}
+	// DataOfferVersion is the version of the interface that this
+	// package implements.
+	DataOfferVersion = 3
+)
 
+// The versions of the wl_data_offer interface that its messages were
+// added in.
 const (
-	DataOfferInterface = "wl_data_offer"
-	DataOfferVersion   = 3
+	DataOfferAcceptRequestSince      = 1
+	DataOfferReceiveRequestSince     = 1
+	DataOfferDestroyRequestSince     = 1
+	DataOfferFinishRequestSince      = 3
+	DataOfferSetActionsRequestSince  = 3
+	DataOfferOfferEventSince         = 1
+	DataOfferSourceActionsEventSince = 3
+	DataOfferActionEventSince        = 3
 )
 
+// DataOfferDesc describes the wl_data_offer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataOfferDesc = &wire.InterfaceDesc{
+	Name:    DataOfferInterface,
+	Version: DataOfferVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "accept",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "mime_type",
+					Type:      wire.ArgString,
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "receive",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "finish",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+				{
+					Name: "preferred_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "source_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "source_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_finish", Value: 0},
+				{Name: "invalid_action_mask", Value: 1},
+				{Name: "invalid_action", Value: 2},
+				{Name: "invalid_offer", Value: 3},
+			},
+		},
+	},
+}
+
 // DataOfferListener is a type that can respond to incoming
 // messages for a DataOffer object.
 type DataOfferListener interface {
@@ -1880,7 +2964,7 @@ type DataOfferListener interface {
 	// will be cancelled and the corresponding drag source will receive
 	// wl_data_source.cancelled. Clients may still use this event in
 	// conjunction with wl_data_source.action for feedback.
-	Accept(serial uint32, mimeType string)
+	Accept(serial uint32, mimeType *string)
 
 	// To transfer the offered data, the client issues this request
 	// and indicates the mime type it wants to receive.  The transfer
@@ -1916,6 +3000,8 @@ type DataOfferListener interface {
 	//
 	// If wl_data_offer.finish request is received for a non drag and drop
 	// operation, the invalid_finish protocol error is raised.
+	//
+	// Available since version 3.
 	Finish()
 
 	// Sets the actions that the destination side client supports for
@@ -1949,9 +3035,97 @@ type DataOfferListener interface {
 	//
 	// This request can only be made on drag-and-drop offers, a protocol error
 	// will be raised otherwise.
+	//
+	// Available since version 3.
 	SetActions(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction)
 }
 
+// DataOfferListenerFuncs implements DataOfferListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataOfferListenerFuncs struct {
+	OnAccept  func(serial uint32, mimeType *string)
+	OnReceive func(mimeType string, fd *os.File)
+	OnDestroy func()
+	// Available since version 3.
+	OnFinish func()
+	// Available since version 3.
+	OnSetActions func(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction)
+}
+
+func (lis *DataOfferListenerFuncs) Accept(serial uint32, mimeType *string) {
+	if lis.OnAccept != nil {
+		lis.OnAccept(serial, mimeType)
+	}
+}
+
+func (lis *DataOfferListenerFuncs) Receive(mimeType string, fd *os.File) {
+	if lis.OnReceive != nil {
+		lis.OnReceive(mimeType, fd)
+	}
+}
+
+func (lis *DataOfferListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+// Available since version 3.
+func (lis *DataOfferListenerFuncs) Finish() {
+	if lis.OnFinish != nil {
+		lis.OnFinish()
+	}
+}
+
+// Available since version 3.
+func (lis *DataOfferListenerFuncs) SetActions(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction) {
+	if lis.OnSetActions != nil {
+		lis.OnSetActions(dndActions, preferredAction)
+	}
+}
+
+// DataOfferRequest is implemented by the message types of all
+// of the requests that a DataOffer can receive.
+type DataOfferRequest interface {
+	dataOfferRequest()
+}
+
+// DataOfferRequestFunc implements DataOfferListener by calling
+// itself with each incoming message.
+type DataOfferRequestFunc func(DataOfferRequest)
+
+func (f DataOfferRequestFunc) Accept(serial uint32, mimeType *string) {
+	f(DataOfferAcceptRequest{
+		Serial:   serial,
+		MimeType: mimeType,
+	})
+}
+
+func (f DataOfferRequestFunc) Receive(mimeType string, fd *os.File) {
+	f(DataOfferReceiveRequest{
+		MimeType: mimeType,
+		Fd:       fd,
+	})
+}
+
+func (f DataOfferRequestFunc) Destroy() {
+	f(DataOfferDestroyRequest{})
+}
+
+// Available since version 3.
+func (f DataOfferRequestFunc) Finish() {
+	f(DataOfferFinishRequest{})
+}
+
+// Available since version 3.
+func (f DataOfferRequestFunc) SetActions(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction) {
+	f(DataOfferSetActionsRequest{
+		DndActions:      dndActions,
+		PreferredAction: preferredAction,
+	})
+}
+
 // A wl_data_offer represents a piece of data offered for transfer
 // by another client (the source client).  It is used by the
 // copy-and-paste and drag-and-drop mechanisms.  The offer
@@ -1985,12 +3159,8 @@ func (obj *DataOffer) State() wire.State {
 func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		mimeType := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m DataOfferAcceptRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -1998,32 +3168,33 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Accept(
-			serial,
-			mimeType,
+			m.Serial,
+			m.MimeType,
 		)
 		return nil
 
 	case 1:
-
-		mimeType := msg.ReadString()
-
-		fd := msg.ReadFile()
-
-		if err := msg.Err(); err != nil {
+		if err := msg.ClaimFDs(1); err != nil {
+			return err
+		}
+		var m DataOfferReceiveRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
 		}
+		msg.HandOffFiles()
 		obj.Listener.Receive(
-			mimeType,
-			fd,
+			m.MimeType,
+			m.Fd,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m DataOfferDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2034,7 +3205,8 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 3:
-		if err := msg.Err(); err != nil {
+		var m DataOfferFinishRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2045,12 +3217,8 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 4:
-
-		dndActions := DataDeviceManagerDndAction(msg.ReadUint())
-
-		preferredAction := DataDeviceManagerDndAction(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m DataOfferSetActionsRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2058,12 +3226,20 @@ func (obj *DataOffer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetActions(
-			dndActions,
-			preferredAction,
+			m.DndActions,
+			m.PreferredAction,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataOfferInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_offer",
 		Type:      "request",
@@ -2114,6 +3290,78 @@ func (obj *DataOffer) Interface() string {
 	return DataOfferInterface
 }
 
+// OnAccept sets the function that is called when the
+// accept request is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+func (obj *DataOffer) OnAccept(f func(serial uint32, mimeType *string)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAccept = f
+}
+
+// OnReceive sets the function that is called when the
+// receive request is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+func (obj *DataOffer) OnReceive(f func(mimeType string, fd *os.File)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnReceive = f
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+func (obj *DataOffer) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnFinish sets the function that is called when the
+// finish request is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataOffer) OnFinish(f func()) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFinish = f
+}
+
+// OnSetActions sets the function that is called when the
+// set_actions request is received. If obj's Listener is not a
+// *DataOfferListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataOffer) OnSetActions(f func(dndActions DataDeviceManagerDndAction, preferredAction DataDeviceManagerDndAction)) {
+	lis, ok := obj.Listener.(*DataOfferListenerFuncs)
+	if !ok {
+		lis = new(DataOfferListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetActions = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataOffer) Subscribe() *wire.Messages[DataOfferRequest] {
+	q := new(wire.Messages[DataOfferRequest])
+	obj.Listener = DataOfferRequestFunc(q.Push)
+	return q
+}
+
 func (obj *DataOffer) Version() uint32 {
 	return DataOfferVersion
 }
@@ -2121,116 +3369,534 @@ func (obj *DataOffer) Version() uint32 {
 // Sent immediately after creating the wl_data_offer object.  One
 // event per offered mime type.
 func (obj *DataOffer) Offer(mimeType string) {
+	obj.state.Enqueue(DataOfferOfferEvent{
+		MimeType: mimeType,
+	}.Encode(obj))
+	return
+}
+
+// This event indicates the actions offered by the data source. It
+// will be sent immediately after creating the wl_data_offer object,
+// or anytime the source side changes its offered actions through
+// wl_data_source.set_actions.
+//
+// Available since version 3.
+func (obj *DataOffer) SourceActions(sourceActions DataDeviceManagerDndAction) {
+	obj.state.Enqueue(DataOfferSourceActionsEvent{
+		SourceActions: sourceActions,
+	}.Encode(obj))
+	return
+}
+
+// This event indicates the action selected by the compositor after
+// matching the source/destination side actions. Only one action (or
+// none) will be offered here.
+//
+// This event can be emitted multiple times during the drag-and-drop
+// operation in response to destination side action changes through
+// wl_data_offer.set_actions.
+//
+// This event will no longer be emitted after wl_data_device.drop
+// happened on the drag-and-drop destination, the client must
+// honor the last action received, or the last preferred one set
+// through wl_data_offer.set_actions when handling an "ask" action.
+//
+// Compositors may also change the selected action on the fly, mainly
+// in response to keyboard modifier changes during the drag-and-drop
+// operation.
+//
+// The most recent action received is always the valid one. Prior to
+// receiving wl_data_device.drop, the chosen action may change (e.g.
+// due to keyboard modifiers being pressed). At the time of receiving
+// wl_data_device.drop the drag-and-drop destination must honor the
+// last action received.
+//
+// Action changes may still happen after wl_data_device.drop,
+// especially on "ask" actions, where the drag-and-drop destination
+// may choose another action afterwards. Action changes happening
+// at this stage are always the result of inter-client negotiation, the
+// compositor shall no longer be able to induce a different action.
+//
+// Upon "ask" actions, it is expected that the drag-and-drop destination
+// may potentially choose a different action and/or mime type,
+// based on wl_data_offer.source_actions and finally chosen by the
+// user (e.g. popping up a menu with the available options). The
+// final wl_data_offer.set_actions and wl_data_offer.accept requests
+// must happen before the call to wl_data_offer.finish.
+//
+// Available since version 3.
+func (obj *DataOffer) Action(dndAction DataDeviceManagerDndAction) {
+	obj.state.Enqueue(DataOfferActionEvent{
+		DndAction: dndAction,
+	}.Encode(obj))
+	return
+}
+
+// DataOfferAcceptRequest holds the arguments of the accept request of
+// the wl_data_offer interface.
+type DataOfferAcceptRequest struct {
+	Serial   uint32
+	MimeType *string
+}
+
+func (DataOfferAcceptRequest) dataOfferRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferAcceptRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.MimeType = msg.ReadNullableString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferAcceptRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteNullableString(m.MimeType)
+
+	builder.Method = "accept"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.MimeType}
+	}
+	return builder
+}
+
+// DataOfferReceiveRequest holds the arguments of the receive request of
+// the wl_data_offer interface.
+type DataOfferReceiveRequest struct {
+	MimeType string
+	Fd       *os.File
+}
+
+func (DataOfferReceiveRequest) dataOfferRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferReceiveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	m.Fd = msg.ReadFile()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m DataOfferReceiveRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m DataOfferReceiveRequest) EncodeTransfer(obj *DataOffer) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m DataOfferReceiveRequest) encode(obj *DataOffer, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.MimeType)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+
+	builder.Method = "receive"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType, m.Fd}
+	}
+	return builder
+}
+
+// DataOfferDestroyRequest holds the arguments of the destroy request of
+// the wl_data_offer interface.
+type DataOfferDestroyRequest struct {
+}
+
+func (DataOfferDestroyRequest) dataOfferRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferDestroyRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataOfferFinishRequest holds the arguments of the finish request of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferFinishRequest struct {
+}
+
+func (DataOfferFinishRequest) dataOfferRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferFinishRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferFinishRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "finish"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataOfferSetActionsRequest holds the arguments of the set_actions request of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferSetActionsRequest struct {
+	DndActions      DataDeviceManagerDndAction
+	PreferredAction DataDeviceManagerDndAction
+}
+
+func (DataOfferSetActionsRequest) dataOfferRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferSetActionsRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndActions = DataDeviceManagerDndAction(msg.ReadUint())
+	m.PreferredAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.DndActions.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_offer",
+			Method:    "set_actions",
+			Arg:       "dnd_actions",
+			Value:     int64(m.DndActions),
+		}
+	}
+	if !m.PreferredAction.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_offer",
+			Method:    "set_actions",
+			Arg:       "preferred_action",
+			Value:     int64(m.PreferredAction),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferSetActionsRequest) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(uint32(m.DndActions))
+	builder.WriteUint(uint32(m.PreferredAction))
+
+	builder.Method = "set_actions"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndActions, m.PreferredAction}
+	}
+	return builder
+}
+
+// DataOfferOfferEvent holds the arguments of the offer event of
+// the wl_data_offer interface.
+type DataOfferOfferEvent struct {
+	MimeType string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferOfferEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferOfferEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.MimeType)
+
+	builder.Method = "offer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
 
-	builder.WriteString(mimeType)
+// DataOfferSourceActionsEvent holds the arguments of the source_actions event of
+// the wl_data_offer interface.
+//
+// Available since version 3.
+type DataOfferSourceActionsEvent struct {
+	SourceActions DataDeviceManagerDndAction
+}
 
-	builder.Method = "offer"
-	builder.Args = []any{mimeType}
-	obj.state.Enqueue(builder)
-	return
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferSourceActionsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.SourceActions = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.SourceActions.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_offer",
+			Method:    "source_actions",
+			Arg:       "source_actions",
+			Value:     int64(m.SourceActions),
+		}
+	}
+	return nil
 }
 
-// This event indicates the actions offered by the data source. It
-// will be sent immediately after creating the wl_data_offer object,
-// or anytime the source side changes its offered actions through
-// wl_data_source.set_actions.
-func (obj *DataOffer) SourceActions(sourceActions DataDeviceManagerDndAction) {
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferSourceActionsEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(uint32(sourceActions))
+	builder.WriteUint(uint32(m.SourceActions))
 
 	builder.Method = "source_actions"
-	builder.Args = []any{sourceActions}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.SourceActions}
+	}
+	return builder
 }
 
-// This event indicates the action selected by the compositor after
-// matching the source/destination side actions. Only one action (or
-// none) will be offered here.
-//
-// This event can be emitted multiple times during the drag-and-drop
-// operation in response to destination side action changes through
-// wl_data_offer.set_actions.
-//
-// This event will no longer be emitted after wl_data_device.drop
-// happened on the drag-and-drop destination, the client must
-// honor the last action received, or the last preferred one set
-// through wl_data_offer.set_actions when handling an "ask" action.
-//
-// Compositors may also change the selected action on the fly, mainly
-// in response to keyboard modifier changes during the drag-and-drop
-// operation.
-//
-// The most recent action received is always the valid one. Prior to
-// receiving wl_data_device.drop, the chosen action may change (e.g.
-// due to keyboard modifiers being pressed). At the time of receiving
-// wl_data_device.drop the drag-and-drop destination must honor the
-// last action received.
-//
-// Action changes may still happen after wl_data_device.drop,
-// especially on "ask" actions, where the drag-and-drop destination
-// may choose another action afterwards. Action changes happening
-// at this stage are always the result of inter-client negotiation, the
-// compositor shall no longer be able to induce a different action.
+// DataOfferActionEvent holds the arguments of the action event of
+// the wl_data_offer interface.
 //
-// Upon "ask" actions, it is expected that the drag-and-drop destination
-// may potentially choose a different action and/or mime type,
-// based on wl_data_offer.source_actions and finally chosen by the
-// user (e.g. popping up a menu with the available options). The
-// final wl_data_offer.set_actions and wl_data_offer.accept requests
-// must happen before the call to wl_data_offer.finish.
-func (obj *DataOffer) Action(dndAction DataDeviceManagerDndAction) {
-	builder := wire.NewMessage(obj, 2)
+// Available since version 3.
+type DataOfferActionEvent struct {
+	DndAction DataDeviceManagerDndAction
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataOfferActionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.DndAction.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_offer",
+			Method:    "action",
+			Arg:       "dnd_action",
+			Value:     int64(m.DndAction),
+		}
+	}
+	return nil
+}
 
-	builder.WriteUint(uint32(dndAction))
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataOfferActionEvent) Encode(obj *DataOffer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(uint32(m.DndAction))
 
 	builder.Method = "action"
-	builder.Args = []any{dndAction}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndAction}
+	}
+	return builder
 }
 
-type DataOfferError int64
+// DataOfferError is an alias for [shared.DataOfferError].
+type DataOfferError = shared.DataOfferError
 
 const (
-	// finish request was called untimely
-	DataOfferErrorInvalidFinish DataOfferError = 0
-
-	// action mask contains invalid values
-	DataOfferErrorInvalidActionMask DataOfferError = 1
-
-	// action argument has an invalid value
-	DataOfferErrorInvalidAction DataOfferError = 2
-
-	// offer doesn't accept this request
-	DataOfferErrorInvalidOffer DataOfferError = 3
+	DataOfferErrorInvalidFinish     = shared.DataOfferErrorInvalidFinish
+	DataOfferErrorInvalidActionMask = shared.DataOfferErrorInvalidActionMask
+	DataOfferErrorInvalidAction     = shared.DataOfferErrorInvalidAction
+	DataOfferErrorInvalidOffer      = shared.DataOfferErrorInvalidOffer
 )
 
-func (enum DataOfferError) String() string {
-	switch enum {
-	case 0:
-		return "DataOfferErrorInvalidFinish"
-
-	case 1:
-		return "DataOfferErrorInvalidActionMask"
-
-	case 2:
-		return "DataOfferErrorInvalidAction"
-
-	case 3:
-		return "DataOfferErrorInvalidOffer"
-	}
+const (
+	DataSourceInterface = shared.DataSourceInterface
 
-	return "<invalid DataOfferError>"
-}
+	// DataSourceVersion is the version of the interface that this
+	// package implements.
+	DataSourceVersion = 3
+)
 
+// The versions of the wl_data_source interface that its messages were
+// added in.
 const (
-	DataSourceInterface = "wl_data_source"
-	DataSourceVersion   = 3
+	DataSourceOfferRequestSince          = 1
+	DataSourceDestroyRequestSince        = 1
+	DataSourceSetActionsRequestSince     = 3
+	DataSourceTargetEventSince           = 1
+	DataSourceSendEventSince             = 1
+	DataSourceCancelledEventSince        = 1
+	DataSourceDndDropPerformedEventSince = 3
+	DataSourceDndFinishedEventSince      = 3
+	DataSourceActionEventSince           = 3
 )
 
+// DataSourceDesc describes the wl_data_source interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataSourceDesc = &wire.InterfaceDesc{
+	Name:    DataSourceInterface,
+	Version: DataSourceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_actions",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "target",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "mime_type",
+					Type:      wire.ArgString,
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "send",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mime_type",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+			},
+		},
+		{
+			Name:  "cancelled",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "dnd_drop_performed",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "dnd_finished",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "dnd_action",
+					Type: wire.ArgUint,
+					Enum: "wl_data_device_manager.dnd_action",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_action_mask", Value: 0},
+				{Name: "invalid_source", Value: 1},
+			},
+		},
+	},
+}
+
 // DataSourceListener is a type that can respond to incoming
 // messages for a DataSource object.
 type DataSourceListener interface {
@@ -2255,9 +3921,67 @@ type DataSourceListener interface {
 	// used in drag-and-drop, so it must be performed before
 	// wl_data_device.start_drag. Attempting to use the source other than
 	// for drag-and-drop will raise a protocol error.
+	//
+	// Available since version 3.
 	SetActions(dndActions DataDeviceManagerDndAction)
 }
 
+// DataSourceListenerFuncs implements DataSourceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataSourceListenerFuncs struct {
+	OnOffer   func(mimeType string)
+	OnDestroy func()
+	// Available since version 3.
+	OnSetActions func(dndActions DataDeviceManagerDndAction)
+}
+
+func (lis *DataSourceListenerFuncs) Offer(mimeType string) {
+	if lis.OnOffer != nil {
+		lis.OnOffer(mimeType)
+	}
+}
+
+func (lis *DataSourceListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+// Available since version 3.
+func (lis *DataSourceListenerFuncs) SetActions(dndActions DataDeviceManagerDndAction) {
+	if lis.OnSetActions != nil {
+		lis.OnSetActions(dndActions)
+	}
+}
+
+// DataSourceRequest is implemented by the message types of all
+// of the requests that a DataSource can receive.
+type DataSourceRequest interface {
+	dataSourceRequest()
+}
+
+// DataSourceRequestFunc implements DataSourceListener by calling
+// itself with each incoming message.
+type DataSourceRequestFunc func(DataSourceRequest)
+
+func (f DataSourceRequestFunc) Offer(mimeType string) {
+	f(DataSourceOfferRequest{
+		MimeType: mimeType,
+	})
+}
+
+func (f DataSourceRequestFunc) Destroy() {
+	f(DataSourceDestroyRequest{})
+}
+
+// Available since version 3.
+func (f DataSourceRequestFunc) SetActions(dndActions DataDeviceManagerDndAction) {
+	f(DataSourceSetActionsRequest{
+		DndActions: dndActions,
+	})
+}
+
 // The wl_data_source object is the source side of a wl_data_offer.
 // It is created by the source client in a data transfer and
 // provides a way to describe the offered data and a way to respond
@@ -2289,10 +4013,8 @@ func (obj *DataSource) State() wire.State {
 func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		mimeType := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m DataSourceOfferRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2300,12 +4022,13 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Offer(
-			mimeType,
+			m.MimeType,
 		)
 		return nil
 
 	case 1:
-		if err := msg.Err(); err != nil {
+		var m DataSourceDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2316,10 +4039,8 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 2:
-
-		dndActions := DataDeviceManagerDndAction(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m DataSourceSetActionsRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2327,11 +4048,19 @@ func (obj *DataSource) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetActions(
-			dndActions,
+			m.DndActions,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataSourceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_source",
 		Type:      "request",
@@ -2376,6 +4105,52 @@ func (obj *DataSource) Interface() string {
 	return DataSourceInterface
 }
 
+// OnOffer sets the function that is called when the
+// offer request is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+func (obj *DataSource) OnOffer(f func(mimeType string)) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnOffer = f
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+func (obj *DataSource) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetActions sets the function that is called when the
+// set_actions request is received. If obj's Listener is not a
+// *DataSourceListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *DataSource) OnSetActions(f func(dndActions DataDeviceManagerDndAction)) {
+	lis, ok := obj.Listener.(*DataSourceListenerFuncs)
+	if !ok {
+		lis = new(DataSourceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetActions = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataSource) Subscribe() *wire.Messages[DataSourceRequest] {
+	q := new(wire.Messages[DataSourceRequest])
+	obj.Listener = DataSourceRequestFunc(q.Push)
+	return q
+}
+
 func (obj *DataSource) Version() uint32 {
 	return DataSourceVersion
 }
@@ -2384,14 +4159,10 @@ func (obj *DataSource) Version() uint32 {
 // a target does not accept any of the offered types, type is NULL.
 //
 // Used for feedback during drag-and-drop.
-func (obj *DataSource) Target(mimeType string) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteString(mimeType)
-
-	builder.Method = "target"
-	builder.Args = []any{mimeType}
-	obj.state.Enqueue(builder)
+func (obj *DataSource) Target(mimeType *string) {
+	obj.state.Enqueue(DataSourceTargetEvent{
+		MimeType: mimeType,
+	}.Encode(obj))
 	return
 }
 
@@ -2399,142 +4170,628 @@ func (obj *DataSource) Target(mimeType string) {
 // specified mime type over the passed file descriptor, then
 // close it.
 func (obj *DataSource) Send(mimeType string, fd *os.File) {
+	obj.state.Enqueue(DataSourceSendEvent{
+		MimeType: mimeType,
+		Fd:       fd,
+	}.Encode(obj))
+	return
+}
+
+// SendTransfer is like Send, but ownership of its files is
+// transferred to the message instead of their file descriptors
+// being duplicated. They are closed once the message has been sent
+// or has failed to be and must not be used afterwards.
+func (obj *DataSource) SendTransfer(mimeType string, fd *os.File) {
+	obj.state.Enqueue(DataSourceSendEvent{
+		MimeType: mimeType,
+		Fd:       fd,
+	}.EncodeTransfer(obj))
+	return
+}
+
+// This data source is no longer valid. There are several reasons why
+// this could happen:
+//
+// - The data source has been replaced by another data source.
+// - The drag-and-drop operation was performed, but the drop destination
+// did not accept any of the mime types offered through
+// wl_data_source.target.
+// - The drag-and-drop operation was performed, but the drop destination
+// did not select any of the actions present in the mask offered through
+// wl_data_source.action.
+// - The drag-and-drop operation was performed but didn't happen over a
+// surface.
+// - The compositor cancelled the drag-and-drop operation (e.g. compositor
+// dependent timeouts to avoid stale drag-and-drop transfers).
+//
+// The client should clean up and destroy this data source.
+//
+// For objects of version 2 or older, wl_data_source.cancelled will
+// only be emitted if the data source was replaced by another data
+// source.
+func (obj *DataSource) Cancelled() {
+	obj.state.Enqueue(DataSourceCancelledEvent{}.Encode(obj))
+	return
+}
+
+// The user performed the drop action. This event does not indicate
+// acceptance, wl_data_source.cancelled may still be emitted afterwards
+// if the drop destination does not accept any mime type.
+//
+// However, this event might however not be received if the compositor
+// cancelled the drag-and-drop operation before this event could happen.
+//
+// Note that the data_source may still be used in the future and should
+// not be destroyed here.
+//
+// Available since version 3.
+func (obj *DataSource) DndDropPerformed() {
+	obj.state.Enqueue(DataSourceDndDropPerformedEvent{}.Encode(obj))
+	return
+}
+
+// The drop destination finished interoperating with this data
+// source, so the client is now free to destroy this data source and
+// free all associated data.
+//
+// If the action used to perform the operation was "move", the
+// source can now delete the transferred data.
+//
+// Available since version 3.
+func (obj *DataSource) DndFinished() {
+	obj.state.Enqueue(DataSourceDndFinishedEvent{}.Encode(obj))
+	return
+}
+
+// This event indicates the action selected by the compositor after
+// matching the source/destination side actions. Only one action (or
+// none) will be offered here.
+//
+// This event can be emitted multiple times during the drag-and-drop
+// operation, mainly in response to destination side changes through
+// wl_data_offer.set_actions, and as the data device enters/leaves
+// surfaces.
+//
+// It is only possible to receive this event after
+// wl_data_source.dnd_drop_performed if the drag-and-drop operation
+// ended in an "ask" action, in which case the final wl_data_source.action
+// event will happen immediately before wl_data_source.dnd_finished.
+//
+// Compositors may also change the selected action on the fly, mainly
+// in response to keyboard modifier changes during the drag-and-drop
+// operation.
+//
+// The most recent action received is always the valid one. The chosen
+// action may change alongside negotiation (e.g. an "ask" action can turn
+// into a "move" operation), so the effects of the final action must
+// always be applied in wl_data_offer.dnd_finished.
+//
+// Clients can trigger cursor surface changes from this point, so
+// they reflect the current action.
+//
+// Available since version 3.
+func (obj *DataSource) Action(dndAction DataDeviceManagerDndAction) {
+	obj.state.Enqueue(DataSourceActionEvent{
+		DndAction: dndAction,
+	}.Encode(obj))
+	return
+}
+
+// DataSourceOfferRequest holds the arguments of the offer request of
+// the wl_data_source interface.
+type DataSourceOfferRequest struct {
+	MimeType string
+}
+
+func (DataSourceOfferRequest) dataSourceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceOfferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceOfferRequest) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.MimeType)
+
+	builder.Method = "offer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
+
+// DataSourceDestroyRequest holds the arguments of the destroy request of
+// the wl_data_source interface.
+type DataSourceDestroyRequest struct {
+}
+
+func (DataSourceDestroyRequest) dataSourceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDestroyRequest) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 1)
 
-	builder.WriteString(mimeType)
-	builder.WriteFile(fd)
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataSourceSetActionsRequest holds the arguments of the set_actions request of
+// the wl_data_source interface.
+//
+// Available since version 3.
+type DataSourceSetActionsRequest struct {
+	DndActions DataDeviceManagerDndAction
+}
+
+func (DataSourceSetActionsRequest) dataSourceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceSetActionsRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndActions = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.DndActions.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_source",
+			Method:    "set_actions",
+			Arg:       "dnd_actions",
+			Value:     int64(m.DndActions),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceSetActionsRequest) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(uint32(m.DndActions))
+
+	builder.Method = "set_actions"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndActions}
+	}
+	return builder
+}
+
+// DataSourceTargetEvent holds the arguments of the target event of
+// the wl_data_source interface.
+type DataSourceTargetEvent struct {
+	MimeType *string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceTargetEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadNullableString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceTargetEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteNullableString(m.MimeType)
+
+	builder.Method = "target"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType}
+	}
+	return builder
+}
+
+// DataSourceSendEvent holds the arguments of the send event of
+// the wl_data_source interface.
+type DataSourceSendEvent struct {
+	MimeType string
+	Fd       *os.File
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceSendEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.MimeType = msg.ReadString()
+	m.Fd = msg.ReadFile()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m DataSourceSendEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m DataSourceSendEvent) EncodeTransfer(obj *DataSource) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m DataSourceSendEvent) encode(obj *DataSource, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.MimeType)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
 
 	builder.Method = "send"
-	builder.Args = []any{mimeType, fd}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.MimeType, m.Fd}
+	}
+	return builder
 }
 
-// This data source is no longer valid. There are several reasons why
-// this could happen:
-//
-// - The data source has been replaced by another data source.
-// - The drag-and-drop operation was performed, but the drop destination
-// did not accept any of the mime types offered through
-// wl_data_source.target.
-// - The drag-and-drop operation was performed, but the drop destination
-// did not select any of the actions present in the mask offered through
-// wl_data_source.action.
-// - The drag-and-drop operation was performed but didn't happen over a
-// surface.
-// - The compositor cancelled the drag-and-drop operation (e.g. compositor
-// dependent timeouts to avoid stale drag-and-drop transfers).
-//
-// The client should clean up and destroy this data source.
-//
-// For objects of version 2 or older, wl_data_source.cancelled will
-// only be emitted if the data source was replaced by another data
-// source.
-func (obj *DataSource) Cancelled() {
+// DataSourceCancelledEvent holds the arguments of the cancelled event of
+// the wl_data_source interface.
+type DataSourceCancelledEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceCancelledEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceCancelledEvent) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 2)
 
 	builder.Method = "cancelled"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// The user performed the drop action. This event does not indicate
-// acceptance, wl_data_source.cancelled may still be emitted afterwards
-// if the drop destination does not accept any mime type.
+// DataSourceDndDropPerformedEvent holds the arguments of the dnd_drop_performed event of
+// the wl_data_source interface.
 //
-// However, this event might however not be received if the compositor
-// cancelled the drag-and-drop operation before this event could happen.
-//
-// Note that the data_source may still be used in the future and should
-// not be destroyed here.
-func (obj *DataSource) DndDropPerformed() {
+// Available since version 3.
+type DataSourceDndDropPerformedEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceDndDropPerformedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDndDropPerformedEvent) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 3)
 
 	builder.Method = "dnd_drop_performed"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// The drop destination finished interoperating with this data
-// source, so the client is now free to destroy this data source and
-// free all associated data.
+// DataSourceDndFinishedEvent holds the arguments of the dnd_finished event of
+// the wl_data_source interface.
 //
-// If the action used to perform the operation was "move", the
-// source can now delete the transferred data.
-func (obj *DataSource) DndFinished() {
+// Available since version 3.
+type DataSourceDndFinishedEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceDndFinishedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceDndFinishedEvent) Encode(obj *DataSource) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 4)
 
 	builder.Method = "dnd_finished"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// This event indicates the action selected by the compositor after
-// matching the source/destination side actions. Only one action (or
-// none) will be offered here.
-//
-// This event can be emitted multiple times during the drag-and-drop
-// operation, mainly in response to destination side changes through
-// wl_data_offer.set_actions, and as the data device enters/leaves
-// surfaces.
-//
-// It is only possible to receive this event after
-// wl_data_source.dnd_drop_performed if the drag-and-drop operation
-// ended in an "ask" action, in which case the final wl_data_source.action
-// event will happen immediately before wl_data_source.dnd_finished.
-//
-// Compositors may also change the selected action on the fly, mainly
-// in response to keyboard modifier changes during the drag-and-drop
-// operation.
-//
-// The most recent action received is always the valid one. The chosen
-// action may change alongside negotiation (e.g. an "ask" action can turn
-// into a "move" operation), so the effects of the final action must
-// always be applied in wl_data_offer.dnd_finished.
+// DataSourceActionEvent holds the arguments of the action event of
+// the wl_data_source interface.
 //
-// Clients can trigger cursor surface changes from this point, so
-// they reflect the current action.
-func (obj *DataSource) Action(dndAction DataDeviceManagerDndAction) {
-	builder := wire.NewMessage(obj, 5)
+// Available since version 3.
+type DataSourceActionEvent struct {
+	DndAction DataDeviceManagerDndAction
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataSourceActionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.DndAction = DataDeviceManagerDndAction(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.DndAction.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_data_source",
+			Method:    "action",
+			Arg:       "dnd_action",
+			Value:     int64(m.DndAction),
+		}
+	}
+	return nil
+}
 
-	builder.WriteUint(uint32(dndAction))
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataSourceActionEvent) Encode(obj *DataSource) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(uint32(m.DndAction))
 
 	builder.Method = "action"
-	builder.Args = []any{dndAction}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.DndAction}
+	}
+	return builder
 }
 
-type DataSourceError int64
+// DataSourceError is an alias for [shared.DataSourceError].
+type DataSourceError = shared.DataSourceError
 
 const (
-	// action mask contains invalid values
-	DataSourceErrorInvalidActionMask DataSourceError = 0
-
-	// source doesn't accept this request
-	DataSourceErrorInvalidSource DataSourceError = 1
+	DataSourceErrorInvalidActionMask = shared.DataSourceErrorInvalidActionMask
+	DataSourceErrorInvalidSource     = shared.DataSourceErrorInvalidSource
 )
 
-func (enum DataSourceError) String() string {
-	switch enum {
-	case 0:
-		return "DataSourceErrorInvalidActionMask"
-
-	case 1:
-		return "DataSourceErrorInvalidSource"
-	}
+const (
+	DataDeviceInterface = shared.DataDeviceInterface
 
-	return "<invalid DataSourceError>"
-}
+	// DataDeviceVersion is the version of the interface that this
+	// package implements.
+	DataDeviceVersion = 3
+)
 
+// The versions of the wl_data_device interface that its messages were
+// added in.
 const (
-	DataDeviceInterface = "wl_data_device"
-	DataDeviceVersion   = 3
+	DataDeviceStartDragRequestSince    = 1
+	DataDeviceSetSelectionRequestSince = 1
+	DataDeviceReleaseRequestSince      = 2
+	DataDeviceDataOfferEventSince      = 1
+	DataDeviceEnterEventSince          = 1
+	DataDeviceLeaveEventSince          = 1
+	DataDeviceMotionEventSince         = 1
+	DataDeviceDropEventSince           = 1
+	DataDeviceSelectionEventSince      = 1
 )
 
+// DataDeviceDesc describes the wl_data_device interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataDeviceDesc = &wire.InterfaceDesc{
+	Name:    DataDeviceInterface,
+	Version: DataDeviceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "start_drag",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "source",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_source",
+					AllowNull: true,
+				},
+				{
+					Name:      "origin",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "icon",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+					AllowNull: true,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "set_selection",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "source",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_source",
+					AllowNull: true,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "data_offer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_offer",
+				},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name:      "id",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_offer",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "drop",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "selection",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgObject,
+					Interface: "wl_data_offer",
+					AllowNull: true,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+				{Name: "used_source", Value: 1},
+			},
+		},
+	},
+}
+
 // DataDeviceListener is a type that can respond to incoming
 // messages for a DataDevice object.
 type DataDeviceListener interface {
@@ -2580,9 +4837,71 @@ type DataDeviceListener interface {
 	SetSelection(source *DataSource, serial uint32)
 
 	// This request destroys the data device.
+	//
+	// Available since version 2.
 	Release()
 }
 
+// DataDeviceListenerFuncs implements DataDeviceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataDeviceListenerFuncs struct {
+	OnStartDrag    func(source *DataSource, origin *Surface, icon *Surface, serial uint32)
+	OnSetSelection func(source *DataSource, serial uint32)
+	// Available since version 2.
+	OnRelease func()
+}
+
+func (lis *DataDeviceListenerFuncs) StartDrag(source *DataSource, origin *Surface, icon *Surface, serial uint32) {
+	if lis.OnStartDrag != nil {
+		lis.OnStartDrag(source, origin, icon, serial)
+	}
+}
+
+func (lis *DataDeviceListenerFuncs) SetSelection(source *DataSource, serial uint32) {
+	if lis.OnSetSelection != nil {
+		lis.OnSetSelection(source, serial)
+	}
+}
+
+// Available since version 2.
+func (lis *DataDeviceListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// DataDeviceRequest is implemented by the message types of all
+// of the requests that a DataDevice can receive.
+type DataDeviceRequest interface {
+	dataDeviceRequest()
+}
+
+// DataDeviceRequestFunc implements DataDeviceListener by calling
+// itself with each incoming message.
+type DataDeviceRequestFunc func(DataDeviceRequest)
+
+func (f DataDeviceRequestFunc) StartDrag(source *DataSource, origin *Surface, icon *Surface, serial uint32) {
+	f(DataDeviceStartDragRequest{
+		Source: source,
+		Origin: origin,
+		Icon:   icon,
+		Serial: serial,
+	})
+}
+
+func (f DataDeviceRequestFunc) SetSelection(source *DataSource, serial uint32) {
+	f(DataDeviceSetSelectionRequest{
+		Source: source,
+		Serial: serial,
+	})
+}
+
+// Available since version 2.
+func (f DataDeviceRequestFunc) Release() {
+	f(DataDeviceReleaseRequest{})
+}
+
 // There is one wl_data_device per seat which can be obtained
 // from the global wl_data_device_manager singleton.
 //
@@ -2615,22 +4934,8 @@ func (obj *DataDevice) State() wire.State {
 func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		source, _ := obj.state.Get(msg.ReadUint()).(*DataSource)
-
-		obj.state.Add(source)
-
-		origin, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(origin)
-
-		icon, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(icon)
-
-		serial := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceStartDragRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2638,22 +4943,16 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.StartDrag(
-			source,
-			origin,
-			icon,
-			serial,
+			m.Source,
+			m.Origin,
+			m.Icon,
+			m.Serial,
 		)
 		return nil
 
 	case 1:
-
-		source, _ := obj.state.Get(msg.ReadUint()).(*DataSource)
-
-		obj.state.Add(source)
-
-		serial := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceSetSelectionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2661,13 +4960,14 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetSelection(
-			source,
-			serial,
+			m.Source,
+			m.Serial,
 		)
 		return nil
 
 	case 2:
-		if err := msg.Err(); err != nil {
+		var m DataDeviceReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2678,6 +4978,14 @@ func (obj *DataDevice) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataDeviceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_device",
 		Type:      "request",
@@ -2722,6 +5030,52 @@ func (obj *DataDevice) Interface() string {
 	return DataDeviceInterface
 }
 
+// OnStartDrag sets the function that is called when the
+// start_drag request is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnStartDrag(f func(source *DataSource, origin *Surface, icon *Surface, serial uint32)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnStartDrag = f
+}
+
+// OnSetSelection sets the function that is called when the
+// set_selection request is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+func (obj *DataDevice) OnSetSelection(f func(source *DataSource, serial uint32)) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSelection = f
+}
+
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *DataDeviceListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *DataDevice) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*DataDeviceListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataDevice) Subscribe() *wire.Messages[DataDeviceRequest] {
+	q := new(wire.Messages[DataDeviceRequest])
+	obj.Listener = DataDeviceRequestFunc(q.Push)
+	return q
+}
+
 func (obj *DataDevice) Version() uint32 {
 	return DataDeviceVersion
 }
@@ -2734,15 +5088,11 @@ func (obj *DataDevice) Version() uint32 {
 // object will send out data_offer.offer events to describe the
 // mime types it offers.
 func (obj *DataDevice) DataOffer() (id *DataOffer) {
-	builder := wire.NewMessage(obj, 0)
-
 	id = NewDataOffer(obj.state)
 	obj.state.Add(id)
-	builder.WriteObject(id)
-
-	builder.Method = "data_offer"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceDataOfferEvent{
+		Id: id,
+	}.Encode(obj))
 	return id
 }
 
@@ -2751,17 +5101,13 @@ func (obj *DataDevice) DataOffer() (id *DataOffer) {
 // enter time is provided by the x and y arguments, in surface-local
 // coordinates.
 func (obj *DataDevice) Enter(serial uint32, surface *Surface, x wire.Fixed, y wire.Fixed, id *DataOffer) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-	builder.WriteFixed(x)
-	builder.WriteFixed(y)
-	builder.WriteObject(id)
-
-	builder.Method = "enter"
-	builder.Args = []any{serial, surface, x, y, id}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(DataDeviceEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		X:       x,
+		Y:       y,
+		Id:      id,
+	}.Encode(obj))
 	return
 }
 
@@ -2769,103 +5115,460 @@ func (obj *DataDevice) Enter(serial uint32, surface *Surface, x wire.Fixed, y wi
 // surface and the session ends.  The client must destroy the
 // wl_data_offer introduced at enter time at this point.
 func (obj *DataDevice) Leave() {
+	obj.state.Enqueue(DataDeviceLeaveEvent{}.Encode(obj))
+	return
+}
+
+// This event is sent when the drag-and-drop pointer moves within
+// the currently focused surface. The new position of the pointer
+// is provided by the x and y arguments, in surface-local
+// coordinates.
+func (obj *DataDevice) Motion(time time.Duration, x wire.Fixed, y wire.Fixed) {
+	obj.state.Enqueue(DataDeviceMotionEvent{
+		Time: time,
+		X:    x,
+		Y:    y,
+	}.Encode(obj))
+	return
+}
+
+// The event is sent when a drag-and-drop operation is ended
+// because the implicit grab is removed.
+//
+// The drag-and-drop destination is expected to honor the last action
+// received through wl_data_offer.action, if the resulting action is
+// "copy" or "move", the destination can still perform
+// wl_data_offer.receive requests, and is expected to end all
+// transfers with a wl_data_offer.finish request.
+//
+// If the resulting action is "ask", the action will not be considered
+// final. The drag-and-drop destination is expected to perform one last
+// wl_data_offer.set_actions request, or wl_data_offer.destroy in order
+// to cancel the operation.
+func (obj *DataDevice) Drop() {
+	obj.state.Enqueue(DataDeviceDropEvent{}.Encode(obj))
+	return
+}
+
+// The selection event is sent out to notify the client of a new
+// wl_data_offer for the selection for this device.  The
+// data_device.data_offer and the data_offer.offer events are
+// sent out immediately before this event to introduce the data
+// offer object.  The selection event is sent to a client
+// immediately before receiving keyboard focus and when a new
+// selection is set while the client has keyboard focus.  The
+// data_offer is valid until a new data_offer or NULL is received
+// or until the client loses keyboard focus.  Switching surface with
+// keyboard focus within the same client doesn't mean a new selection
+// will be sent.  The client must destroy the previous selection
+// data_offer, if any, upon receiving this event.
+func (obj *DataDevice) Selection(id *DataOffer) {
+	obj.state.Enqueue(DataDeviceSelectionEvent{
+		Id: id,
+	}.Encode(obj))
+	return
+}
+
+// DataDeviceStartDragRequest holds the arguments of the start_drag request of
+// the wl_data_device interface.
+type DataDeviceStartDragRequest struct {
+	Source *DataSource
+	Origin *Surface
+	Icon   *Surface
+	Serial uint32
+}
+
+func (DataDeviceStartDragRequest) dataDeviceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceStartDragRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = wire.ResolveObject[*DataSource](msg, state, DataSourceInterface, msg.ReadNullableObject())
+	m.Origin = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Icon = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadNullableObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceStartDragRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteNullableObject(m.Source)
+	builder.WriteObject(m.Origin)
+	builder.WriteNullableObject(m.Icon)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "start_drag"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source, m.Origin, m.Icon, m.Serial}
+	}
+	return builder
+}
+
+// DataDeviceSetSelectionRequest holds the arguments of the set_selection request of
+// the wl_data_device interface.
+type DataDeviceSetSelectionRequest struct {
+	Source *DataSource
+	Serial uint32
+}
+
+func (DataDeviceSetSelectionRequest) dataDeviceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceSetSelectionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = wire.ResolveObject[*DataSource](msg, state, DataSourceInterface, msg.ReadNullableObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceSetSelectionRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteNullableObject(m.Source)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_selection"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source, m.Serial}
+	}
+	return builder
+}
+
+// DataDeviceReleaseRequest holds the arguments of the release request of
+// the wl_data_device interface.
+//
+// Available since version 2.
+type DataDeviceReleaseRequest struct {
+}
+
+func (DataDeviceReleaseRequest) dataDeviceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceReleaseRequest) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataDeviceDataOfferEvent holds the arguments of the data_offer event of
+// the wl_data_device interface.
+type DataDeviceDataOfferEvent struct {
+	Id *DataOffer
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceDataOfferEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataOffer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataOfferInterface, msg.ReadNewObject(DataOfferInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceDataOfferEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "data_offer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataOfferInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// DataDeviceEnterEvent holds the arguments of the enter event of
+// the wl_data_device interface.
+type DataDeviceEnterEvent struct {
+	Serial  uint32
+	Surface *Surface
+	X       wire.Fixed
+	Y       wire.Fixed
+	Id      *DataOffer
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	m.Id = wire.ResolveObject[*DataOffer](msg, state, DataOfferInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceEnterEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+	builder.WriteNullableObject(m.Id)
+
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.X, m.Y, m.Id}
+	}
+	return builder
+}
+
+// DataDeviceLeaveEvent holds the arguments of the leave event of
+// the wl_data_device interface.
+type DataDeviceLeaveEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceLeaveEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 2)
 
 	builder.Method = "leave"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DataDeviceMotionEvent holds the arguments of the motion event of
+// the wl_data_device interface.
+type DataDeviceMotionEvent struct {
+	Time time.Duration
+	X    wire.Fixed
+	Y    wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// This event is sent when the drag-and-drop pointer moves within
-// the currently focused surface. The new position of the pointer
-// is provided by the x and y arguments, in surface-local
-// coordinates.
-func (obj *DataDevice) Motion(time uint32, x wire.Fixed, y wire.Fixed) {
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceMotionEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 3)
-
-	builder.WriteUint(time)
-	builder.WriteFixed(x)
-	builder.WriteFixed(y)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
 
 	builder.Method = "motion"
-	builder.Args = []any{time, x, y}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.X, m.Y}
+	}
+	return builder
 }
 
-// The event is sent when a drag-and-drop operation is ended
-// because the implicit grab is removed.
-//
-// The drag-and-drop destination is expected to honor the last action
-// received through wl_data_offer.action, if the resulting action is
-// "copy" or "move", the destination can still perform
-// wl_data_offer.receive requests, and is expected to end all
-// transfers with a wl_data_offer.finish request.
-//
-// If the resulting action is "ask", the action will not be considered
-// final. The drag-and-drop destination is expected to perform one last
-// wl_data_offer.set_actions request, or wl_data_offer.destroy in order
-// to cancel the operation.
-func (obj *DataDevice) Drop() {
+// DataDeviceDropEvent holds the arguments of the drop event of
+// the wl_data_device interface.
+type DataDeviceDropEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceDropEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceDropEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 4)
 
 	builder.Method = "drop"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
-// The selection event is sent out to notify the client of a new
-// wl_data_offer for the selection for this device.  The
-// data_device.data_offer and the data_offer.offer events are
-// sent out immediately before this event to introduce the data
-// offer object.  The selection event is sent to a client
-// immediately before receiving keyboard focus and when a new
-// selection is set while the client has keyboard focus.  The
-// data_offer is valid until a new data_offer or NULL is received
-// or until the client loses keyboard focus.  Switching surface with
-// keyboard focus within the same client doesn't mean a new selection
-// will be sent.  The client must destroy the previous selection
-// data_offer, if any, upon receiving this event.
-func (obj *DataDevice) Selection(id *DataOffer) {
-	builder := wire.NewMessage(obj, 5)
+// DataDeviceSelectionEvent holds the arguments of the selection event of
+// the wl_data_device interface.
+type DataDeviceSelectionEvent struct {
+	Id *DataOffer
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceSelectionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = wire.ResolveObject[*DataOffer](msg, state, DataOfferInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteObject(id)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceSelectionEvent) Encode(obj *DataDevice) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteNullableObject(m.Id)
 
 	builder.Method = "selection"
-	builder.Args = []any{id}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id}
+	}
+	return builder
 }
 
-type DataDeviceError int64
+// DataDeviceError is an alias for [shared.DataDeviceError].
+type DataDeviceError = shared.DataDeviceError
 
 const (
-	// given wl_surface has another role
-	DataDeviceErrorRole DataDeviceError = 0
-
-	// source has already been used
-	DataDeviceErrorUsedSource DataDeviceError = 1
+	DataDeviceErrorRole       = shared.DataDeviceErrorRole
+	DataDeviceErrorUsedSource = shared.DataDeviceErrorUsedSource
 )
 
-func (enum DataDeviceError) String() string {
-	switch enum {
-	case 0:
-		return "DataDeviceErrorRole"
-
-	case 1:
-		return "DataDeviceErrorUsedSource"
-	}
+const (
+	DataDeviceManagerInterface = shared.DataDeviceManagerInterface
 
-	return "<invalid DataDeviceError>"
-}
+	// DataDeviceManagerVersion is the version of the interface that this
+	// package implements.
+	DataDeviceManagerVersion = 3
+)
 
+// The versions of the wl_data_device_manager interface that its messages were
+// added in.
 const (
-	DataDeviceManagerInterface = "wl_data_device_manager"
-	DataDeviceManagerVersion   = 3
+	DataDeviceManagerCreateDataSourceRequestSince = 1
+	DataDeviceManagerGetDataDeviceRequestSince    = 1
 )
 
+// DataDeviceManagerDesc describes the wl_data_device_manager interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DataDeviceManagerDesc = &wire.InterfaceDesc{
+	Name:    DataDeviceManagerInterface,
+	Version: DataDeviceManagerVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "create_data_source",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_source",
+				},
+			},
+		},
+		{
+			Name:  "get_data_device",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_data_device",
+				},
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "dnd_action",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "copy", Value: 1},
+				{Name: "move", Value: 2},
+				{Name: "ask", Value: 4},
+			},
+		},
+	},
+}
+
 // DataDeviceManagerListener is a type that can respond to incoming
 // messages for a DataDeviceManager object.
 type DataDeviceManagerListener interface {
@@ -2876,6 +5579,49 @@ type DataDeviceManagerListener interface {
 	GetDataDevice(id *DataDevice, seat *Seat)
 }
 
+// DataDeviceManagerListenerFuncs implements DataDeviceManagerListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DataDeviceManagerListenerFuncs struct {
+	OnCreateDataSource func(id *DataSource)
+	OnGetDataDevice    func(id *DataDevice, seat *Seat)
+}
+
+func (lis *DataDeviceManagerListenerFuncs) CreateDataSource(id *DataSource) {
+	if lis.OnCreateDataSource != nil {
+		lis.OnCreateDataSource(id)
+	}
+}
+
+func (lis *DataDeviceManagerListenerFuncs) GetDataDevice(id *DataDevice, seat *Seat) {
+	if lis.OnGetDataDevice != nil {
+		lis.OnGetDataDevice(id, seat)
+	}
+}
+
+// DataDeviceManagerRequest is implemented by the message types of all
+// of the requests that a DataDeviceManager can receive.
+type DataDeviceManagerRequest interface {
+	dataDeviceManagerRequest()
+}
+
+// DataDeviceManagerRequestFunc implements DataDeviceManagerListener by calling
+// itself with each incoming message.
+type DataDeviceManagerRequestFunc func(DataDeviceManagerRequest)
+
+func (f DataDeviceManagerRequestFunc) CreateDataSource(id *DataSource) {
+	f(DataDeviceManagerCreateDataSourceRequest{
+		Id: id,
+	})
+}
+
+func (f DataDeviceManagerRequestFunc) GetDataDevice(id *DataDevice, seat *Seat) {
+	f(DataDeviceManagerGetDataDeviceRequest{
+		Id:   id,
+		Seat: seat,
+	})
+}
+
 // The wl_data_device_manager is a singleton global object that
 // provides access to inter-client data transfer mechanisms such as
 // copy-and-paste and drag-and-drop.  These mechanisms are tied to
@@ -2920,13 +5666,8 @@ func (obj *DataDeviceManager) State() wire.State {
 func (obj *DataDeviceManager) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewDataSource(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceManagerCreateDataSourceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2934,22 +5675,13 @@ func (obj *DataDeviceManager) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.CreateDataSource(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 1:
-
-		id := NewDataDevice(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		seat, _ := obj.state.Get(msg.ReadUint()).(*Seat)
-
-		obj.state.Add(seat)
-
-		if err := msg.Err(); err != nil {
+		var m DataDeviceManagerGetDataDeviceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -2957,12 +5689,20 @@ func (obj *DataDeviceManager) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetDataDevice(
-			id,
-			seat,
+			m.Id,
+			m.Seat,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DataDeviceManagerInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_data_device_manager",
 		Type:      "request",
@@ -3004,72 +5744,179 @@ func (obj *DataDeviceManager) Interface() string {
 	return DataDeviceManagerInterface
 }
 
+// OnCreateDataSource sets the function that is called when the
+// create_data_source request is received. If obj's Listener is not a
+// *DataDeviceManagerListenerFuncs, it is replaced with one.
+func (obj *DataDeviceManager) OnCreateDataSource(f func(id *DataSource)) {
+	lis, ok := obj.Listener.(*DataDeviceManagerListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceManagerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreateDataSource = f
+}
+
+// OnGetDataDevice sets the function that is called when the
+// get_data_device request is received. If obj's Listener is not a
+// *DataDeviceManagerListenerFuncs, it is replaced with one.
+func (obj *DataDeviceManager) OnGetDataDevice(f func(id *DataDevice, seat *Seat)) {
+	lis, ok := obj.Listener.(*DataDeviceManagerListenerFuncs)
+	if !ok {
+		lis = new(DataDeviceManagerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetDataDevice = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DataDeviceManager) Subscribe() *wire.Messages[DataDeviceManagerRequest] {
+	q := new(wire.Messages[DataDeviceManagerRequest])
+	obj.Listener = DataDeviceManagerRequestFunc(q.Push)
+	return q
+}
+
 func (obj *DataDeviceManager) Version() uint32 {
 	return DataDeviceManagerVersion
 }
 
-// This is a bitmask of the available/preferred actions in a
-// drag-and-drop operation.
-//
-// In the compositor, the selected action is a result of matching the
-// actions offered by the source and destination sides.  "action" events
-// with a "none" action will be sent to both source and destination if
-// there is no match. All further checks will effectively happen on
-// (source actions ∩ destination actions).
-//
-// In addition, compositors may also pick different actions in
-// reaction to key modifiers being pressed. One common design that
-// is used in major toolkits (and the behavior recommended for
-// compositors) is:
-//
-// - If no modifiers are pressed, the first match (in bit order)
-// will be used.
-// - Pressing Shift selects "move", if enabled in the mask.
-// - Pressing Control selects "copy", if enabled in the mask.
-//
-// Behavior beyond that is considered implementation-dependent.
-// Compositors may for example bind other modifiers (like Alt/Meta)
-// or drags initiated with other buttons than BTN_LEFT to specific
-// actions (e.g. "ask").
-type DataDeviceManagerDndAction int64
+// DataDeviceManagerCreateDataSourceRequest holds the arguments of the create_data_source request of
+// the wl_data_device_manager interface.
+type DataDeviceManagerCreateDataSourceRequest struct {
+	Id *DataSource
+}
 
-const (
-	// no action
-	DataDeviceManagerDndActionNone DataDeviceManagerDndAction = 0
+func (DataDeviceManagerCreateDataSourceRequest) dataDeviceManagerRequest() {}
 
-	// copy action
-	DataDeviceManagerDndActionCopy DataDeviceManagerDndAction = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceManagerCreateDataSourceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataSource(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataSourceInterface, msg.ReadNewObject(DataSourceInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-	// move action
-	DataDeviceManagerDndActionMove DataDeviceManagerDndAction = 2
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceManagerCreateDataSourceRequest) Encode(obj *DataDeviceManager) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
 
-	// ask action
-	DataDeviceManagerDndActionAsk DataDeviceManagerDndAction = 4
-)
+	builder.Method = "create_data_source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataSourceInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// DataDeviceManagerGetDataDeviceRequest holds the arguments of the get_data_device request of
+// the wl_data_device_manager interface.
+type DataDeviceManagerGetDataDeviceRequest struct {
+	Id   *DataDevice
+	Seat *Seat
+}
+
+func (DataDeviceManagerGetDataDeviceRequest) dataDeviceManagerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DataDeviceManagerGetDataDeviceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewDataDevice(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, DataDeviceInterface, msg.ReadNewObject(DataDeviceInterface), true))
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
 
-func (enum DataDeviceManagerDndAction) String() string {
-	switch enum {
-	case 0:
-		return "DataDeviceManagerDndActionNone"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DataDeviceManagerGetDataDeviceRequest) Encode(obj *DataDeviceManager) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Seat)
 
-	case 1:
-		return "DataDeviceManagerDndActionCopy"
+	builder.Method = "get_data_device"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: DataDeviceInterface, ID: m.Id.ID()}, m.Seat}
+	}
+	return builder
+}
 
-	case 2:
-		return "DataDeviceManagerDndActionMove"
+// DataDeviceManagerDndAction is an alias for [shared.DataDeviceManagerDndAction].
+//
+// Available since version 3.
+type DataDeviceManagerDndAction = shared.DataDeviceManagerDndAction
 
-	case 4:
-		return "DataDeviceManagerDndActionAsk"
-	}
+const (
+	DataDeviceManagerDndActionNone = shared.DataDeviceManagerDndActionNone
+	DataDeviceManagerDndActionCopy = shared.DataDeviceManagerDndActionCopy
+	DataDeviceManagerDndActionMove = shared.DataDeviceManagerDndActionMove
+	DataDeviceManagerDndActionAsk  = shared.DataDeviceManagerDndActionAsk
+)
 
-	return "<invalid DataDeviceManagerDndAction>"
-}
+const (
+	ShellInterface = shared.ShellInterface
 
+	// ShellVersion is the version of the interface that this
+	// package implements.
+	ShellVersion = 1
+)
+
+// The versions of the wl_shell interface that its messages were
+// added in.
 const (
-	ShellInterface = "wl_shell"
-	ShellVersion   = 1
+	ShellGetShellSurfaceRequestSince = 1
 )
 
+// ShellDesc describes the wl_shell interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShellDesc = &wire.InterfaceDesc{
+	Name:    ShellInterface,
+	Version: ShellVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "get_shell_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_shell_surface",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+			},
+		},
+	},
+}
+
 // ShellListener is a type that can respond to incoming
 // messages for a Shell object.
 type ShellListener interface {
@@ -3081,6 +5928,36 @@ type ShellListener interface {
 	GetShellSurface(id *ShellSurface, surface *Surface)
 }
 
+// ShellListenerFuncs implements ShellListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShellListenerFuncs struct {
+	OnGetShellSurface func(id *ShellSurface, surface *Surface)
+}
+
+func (lis *ShellListenerFuncs) GetShellSurface(id *ShellSurface, surface *Surface) {
+	if lis.OnGetShellSurface != nil {
+		lis.OnGetShellSurface(id, surface)
+	}
+}
+
+// ShellRequest is implemented by the message types of all
+// of the requests that a Shell can receive.
+type ShellRequest interface {
+	shellRequest()
+}
+
+// ShellRequestFunc implements ShellListener by calling
+// itself with each incoming message.
+type ShellRequestFunc func(ShellRequest)
+
+func (f ShellRequestFunc) GetShellSurface(id *ShellSurface, surface *Surface) {
+	f(ShellGetShellSurfaceRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
 // This interface is implemented by servers that provide
 // desktop-style user interfaces.
 //
@@ -3124,17 +6001,8 @@ func (obj *Shell) State() wire.State {
 func (obj *Shell) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewShellSurface(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		if err := msg.Err(); err != nil {
+		var m ShellGetShellSurfaceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3142,12 +6010,20 @@ func (obj *Shell) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetShellSurface(
-			id,
-			surface,
+			m.Id,
+			m.Surface,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShellInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shell",
 		Type:      "request",
@@ -3186,31 +6062,341 @@ func (obj *Shell) Interface() string {
 	return ShellInterface
 }
 
+// OnGetShellSurface sets the function that is called when the
+// get_shell_surface request is received. If obj's Listener is not a
+// *ShellListenerFuncs, it is replaced with one.
+func (obj *Shell) OnGetShellSurface(f func(id *ShellSurface, surface *Surface)) {
+	lis, ok := obj.Listener.(*ShellListenerFuncs)
+	if !ok {
+		lis = new(ShellListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetShellSurface = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Shell) Subscribe() *wire.Messages[ShellRequest] {
+	q := new(wire.Messages[ShellRequest])
+	obj.Listener = ShellRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Shell) Version() uint32 {
 	return ShellVersion
 }
 
-type ShellError int64
+// ShellGetShellSurfaceRequest holds the arguments of the get_shell_surface request of
+// the wl_shell interface.
+type ShellGetShellSurfaceRequest struct {
+	Id      *ShellSurface
+	Surface *Surface
+}
 
-const (
-	// given wl_surface has another role
-	ShellErrorRole ShellError = 0
-)
+func (ShellGetShellSurfaceRequest) shellRequest() {}
 
-func (enum ShellError) String() string {
-	switch enum {
-	case 0:
-		return "ShellErrorRole"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellGetShellSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewShellSurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ShellSurfaceInterface, msg.ReadNewObject(ShellSurfaceInterface), true))
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellGetShellSurfaceRequest) Encode(obj *Shell) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
 
-	return "<invalid ShellError>"
+	builder.Method = "get_shell_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ShellSurfaceInterface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
 }
 
+// ShellError is an alias for [shared.ShellError].
+type ShellError = shared.ShellError
+
+const (
+	ShellErrorRole = shared.ShellErrorRole
+)
+
+const (
+	ShellSurfaceInterface = shared.ShellSurfaceInterface
+
+	// ShellSurfaceVersion is the version of the interface that this
+	// package implements.
+	ShellSurfaceVersion = 1
+)
+
+// The versions of the wl_shell_surface interface that its messages were
+// added in.
 const (
-	ShellSurfaceInterface = "wl_shell_surface"
-	ShellSurfaceVersion   = 1
+	ShellSurfacePongRequestSince          = 1
+	ShellSurfaceMoveRequestSince          = 1
+	ShellSurfaceResizeRequestSince        = 1
+	ShellSurfaceSetToplevelRequestSince   = 1
+	ShellSurfaceSetTransientRequestSince  = 1
+	ShellSurfaceSetFullscreenRequestSince = 1
+	ShellSurfaceSetPopupRequestSince      = 1
+	ShellSurfaceSetMaximizedRequestSince  = 1
+	ShellSurfaceSetTitleRequestSince      = 1
+	ShellSurfaceSetClassRequestSince      = 1
+	ShellSurfacePingEventSince            = 1
+	ShellSurfaceConfigureEventSince       = 1
+	ShellSurfacePopupDoneEventSince       = 1
 )
 
+// ShellSurfaceDesc describes the wl_shell_surface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ShellSurfaceDesc = &wire.InterfaceDesc{
+	Name:    ShellSurfaceInterface,
+	Version: ShellSurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "pong",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "move",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "edges",
+					Type: wire.ArgUint,
+					Enum: "resize",
+				},
+			},
+		},
+		{
+			Name:  "set_toplevel",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_transient",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "transient",
+				},
+			},
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "method",
+					Type: wire.ArgUint,
+					Enum: "fullscreen_method",
+				},
+				{
+					Name: "framerate",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_popup",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "transient",
+				},
+			},
+		},
+		{
+			Name:  "set_maximized",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_title",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "title",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "set_class",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "class_",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "ping",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "edges",
+					Type: wire.ArgUint,
+					Enum: "resize",
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "popup_done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "resize",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "top", Value: 1},
+				{Name: "bottom", Value: 2},
+				{Name: "left", Value: 4},
+				{Name: "top_left", Value: 5},
+				{Name: "bottom_left", Value: 6},
+				{Name: "right", Value: 8},
+				{Name: "top_right", Value: 9},
+				{Name: "bottom_right", Value: 10},
+			},
+		},
+		{
+			Name:     "transient",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "inactive", Value: 1},
+			},
+		},
+		{
+			Name: "fullscreen_method",
+			Entries: []wire.EntryDesc{
+				{Name: "default", Value: 0},
+				{Name: "scale", Value: 1},
+				{Name: "driver", Value: 2},
+				{Name: "fill", Value: 3},
+			},
+		},
+	},
+}
+
 // ShellSurfaceListener is a type that can respond to incoming
 // messages for a ShellSurface object.
 type ShellSurfaceListener interface {
@@ -3340,6 +6526,163 @@ type ShellSurfaceListener interface {
 	SetClass(class string)
 }
 
+// ShellSurfaceListenerFuncs implements ShellSurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ShellSurfaceListenerFuncs struct {
+	OnPong          func(serial uint32)
+	OnMove          func(seat *Seat, serial uint32)
+	OnResize        func(seat *Seat, serial uint32, edges ShellSurfaceResize)
+	OnSetToplevel   func()
+	OnSetTransient  func(parent *Surface, x int32, y int32, flags ShellSurfaceTransient)
+	OnSetFullscreen func(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output)
+	OnSetPopup      func(seat *Seat, serial uint32, parent *Surface, x int32, y int32, flags ShellSurfaceTransient)
+	OnSetMaximized  func(output *Output)
+	OnSetTitle      func(title string)
+	OnSetClass      func(class string)
+}
+
+func (lis *ShellSurfaceListenerFuncs) Pong(serial uint32) {
+	if lis.OnPong != nil {
+		lis.OnPong(serial)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) Move(seat *Seat, serial uint32) {
+	if lis.OnMove != nil {
+		lis.OnMove(seat, serial)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) Resize(seat *Seat, serial uint32, edges ShellSurfaceResize) {
+	if lis.OnResize != nil {
+		lis.OnResize(seat, serial, edges)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetToplevel() {
+	if lis.OnSetToplevel != nil {
+		lis.OnSetToplevel()
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetTransient(parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
+	if lis.OnSetTransient != nil {
+		lis.OnSetTransient(parent, x, y, flags)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetFullscreen(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output) {
+	if lis.OnSetFullscreen != nil {
+		lis.OnSetFullscreen(method, framerate, output)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetPopup(seat *Seat, serial uint32, parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
+	if lis.OnSetPopup != nil {
+		lis.OnSetPopup(seat, serial, parent, x, y, flags)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetMaximized(output *Output) {
+	if lis.OnSetMaximized != nil {
+		lis.OnSetMaximized(output)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetTitle(title string) {
+	if lis.OnSetTitle != nil {
+		lis.OnSetTitle(title)
+	}
+}
+
+func (lis *ShellSurfaceListenerFuncs) SetClass(class string) {
+	if lis.OnSetClass != nil {
+		lis.OnSetClass(class)
+	}
+}
+
+// ShellSurfaceRequest is implemented by the message types of all
+// of the requests that a ShellSurface can receive.
+type ShellSurfaceRequest interface {
+	shellSurfaceRequest()
+}
+
+// ShellSurfaceRequestFunc implements ShellSurfaceListener by calling
+// itself with each incoming message.
+type ShellSurfaceRequestFunc func(ShellSurfaceRequest)
+
+func (f ShellSurfaceRequestFunc) Pong(serial uint32) {
+	f(ShellSurfacePongRequest{
+		Serial: serial,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) Move(seat *Seat, serial uint32) {
+	f(ShellSurfaceMoveRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) Resize(seat *Seat, serial uint32, edges ShellSurfaceResize) {
+	f(ShellSurfaceResizeRequest{
+		Seat:   seat,
+		Serial: serial,
+		Edges:  edges,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetToplevel() {
+	f(ShellSurfaceSetToplevelRequest{})
+}
+
+func (f ShellSurfaceRequestFunc) SetTransient(parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
+	f(ShellSurfaceSetTransientRequest{
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetFullscreen(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output) {
+	f(ShellSurfaceSetFullscreenRequest{
+		Method:    method,
+		Framerate: framerate,
+		Output:    output,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetPopup(seat *Seat, serial uint32, parent *Surface, x int32, y int32, flags ShellSurfaceTransient) {
+	f(ShellSurfaceSetPopupRequest{
+		Seat:   seat,
+		Serial: serial,
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetMaximized(output *Output) {
+	f(ShellSurfaceSetMaximizedRequest{
+		Output: output,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetTitle(title string) {
+	f(ShellSurfaceSetTitleRequest{
+		Title: title,
+	})
+}
+
+func (f ShellSurfaceRequestFunc) SetClass(class string) {
+	f(ShellSurfaceSetClassRequest{
+		Class: class,
+	})
+}
+
 // An interface that may be implemented by a wl_surface, for
 // implementations that provide a desktop-style user interface.
 //
@@ -3378,10 +6721,8 @@ func (obj *ShellSurface) State() wire.State {
 func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfacePongRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3389,19 +6730,13 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Pong(
-			serial,
+			m.Serial,
 		)
 		return nil
 
 	case 1:
-
-		seat, _ := obj.state.Get(msg.ReadUint()).(*Seat)
-
-		obj.state.Add(seat)
-
-		serial := msg.ReadUint()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceMoveRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3409,22 +6744,14 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Move(
-			seat,
-			serial,
+			m.Seat,
+			m.Serial,
 		)
 		return nil
 
 	case 2:
-
-		seat, _ := obj.state.Get(msg.ReadUint()).(*Seat)
-
-		obj.state.Add(seat)
-
-		serial := msg.ReadUint()
-
-		edges := ShellSurfaceResize(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceResizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3432,14 +6759,15 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Resize(
-			seat,
-			serial,
-			edges,
+			m.Seat,
+			m.Serial,
+			m.Edges,
 		)
 		return nil
 
 	case 3:
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetToplevelRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3450,18 +6778,8 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 4:
-
-		parent, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(parent)
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		flags := ShellSurfaceTransient(msg.ReadUint())
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetTransientRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3469,56 +6787,32 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetTransient(
-			parent,
-			x,
-			y,
-			flags,
+			m.Parent,
+			m.X,
+			m.Y,
+			m.Flags,
 		)
 		return nil
 
 	case 5:
-
-		method := ShellSurfaceFullscreenMethod(msg.ReadUint())
-
-		framerate := msg.ReadUint()
-
-		output, _ := obj.state.Get(msg.ReadUint()).(*Output)
-
-		obj.state.Add(output)
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetFullscreenRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
-		}
-
-		if obj.Listener == nil {
-			return nil
-		}
-		obj.Listener.SetFullscreen(
-			method,
-			framerate,
-			output,
-		)
-		return nil
-
-	case 6:
-
-		seat, _ := obj.state.Get(msg.ReadUint()).(*Seat)
-
-		obj.state.Add(seat)
-
-		serial := msg.ReadUint()
-
-		parent, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(parent)
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
+		}
 
-		flags := ShellSurfaceTransient(msg.ReadUint())
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetFullscreen(
+			m.Method,
+			m.Framerate,
+			m.Output,
+		)
+		return nil
 
-		if err := msg.Err(); err != nil {
+	case 6:
+		var m ShellSurfaceSetPopupRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3526,22 +6820,18 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetPopup(
-			seat,
-			serial,
-			parent,
-			x,
-			y,
-			flags,
+			m.Seat,
+			m.Serial,
+			m.Parent,
+			m.X,
+			m.Y,
+			m.Flags,
 		)
 		return nil
 
 	case 7:
-
-		output, _ := obj.state.Get(msg.ReadUint()).(*Output)
-
-		obj.state.Add(output)
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetMaximizedRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3549,15 +6839,13 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetMaximized(
-			output,
+			m.Output,
 		)
 		return nil
 
 	case 8:
-
-		title := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetTitleRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3565,15 +6853,13 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetTitle(
-			title,
+			m.Title,
 		)
 		return nil
 
 	case 9:
-
-		class := msg.ReadString()
-
-		if err := msg.Err(); err != nil {
+		var m ShellSurfaceSetClassRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -3581,11 +6867,19 @@ func (obj *ShellSurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetClass(
-			class,
+			m.Class,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ShellSurfaceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_shell_surface",
 		Type:      "request",
@@ -3651,6 +6945,134 @@ func (obj *ShellSurface) Interface() string {
 	return ShellSurfaceInterface
 }
 
+// OnPong sets the function that is called when the
+// pong request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnPong(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPong = f
+}
+
+// OnMove sets the function that is called when the
+// move request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnMove(f func(seat *Seat, serial uint32)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMove = f
+}
+
+// OnResize sets the function that is called when the
+// resize request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnResize(f func(seat *Seat, serial uint32, edges ShellSurfaceResize)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnResize = f
+}
+
+// OnSetToplevel sets the function that is called when the
+// set_toplevel request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetToplevel(f func()) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetToplevel = f
+}
+
+// OnSetTransient sets the function that is called when the
+// set_transient request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetTransient(f func(parent *Surface, x int32, y int32, flags ShellSurfaceTransient)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetTransient = f
+}
+
+// OnSetFullscreen sets the function that is called when the
+// set_fullscreen request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetFullscreen(f func(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetFullscreen = f
+}
+
+// OnSetPopup sets the function that is called when the
+// set_popup request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetPopup(f func(seat *Seat, serial uint32, parent *Surface, x int32, y int32, flags ShellSurfaceTransient)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetPopup = f
+}
+
+// OnSetMaximized sets the function that is called when the
+// set_maximized request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetMaximized(f func(output *Output)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMaximized = f
+}
+
+// OnSetTitle sets the function that is called when the
+// set_title request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetTitle(f func(title string)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetTitle = f
+}
+
+// OnSetClass sets the function that is called when the
+// set_class request is received. If obj's Listener is not a
+// *ShellSurfaceListenerFuncs, it is replaced with one.
+func (obj *ShellSurface) OnSetClass(f func(class string)) {
+	lis, ok := obj.Listener.(*ShellSurfaceListenerFuncs)
+	if !ok {
+		lis = new(ShellSurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetClass = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ShellSurface) Subscribe() *wire.Messages[ShellSurfaceRequest] {
+	q := new(wire.Messages[ShellSurfaceRequest])
+	obj.Listener = ShellSurfaceRequestFunc(q.Push)
+	return q
+}
+
 func (obj *ShellSurface) Version() uint32 {
 	return ShellSurfaceVersion
 }
@@ -3658,13 +7080,9 @@ func (obj *ShellSurface) Version() uint32 {
 // Ping a client to check if it is receiving events and sending
 // requests. A client is expected to reply with a pong request.
 func (obj *ShellSurface) Ping(serial uint32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-
-	builder.Method = "ping"
-	builder.Args = []any{serial}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfacePingEvent{
+		Serial: serial,
+	}.Encode(obj))
 	return
 }
 
@@ -3686,15 +7104,11 @@ func (obj *ShellSurface) Ping(serial uint32) {
 // The width and height arguments specify the size of the window
 // in surface-local coordinates.
 func (obj *ShellSurface) Configure(edges ShellSurfaceResize, width int32, height int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(uint32(edges))
-	builder.WriteInt(width)
-	builder.WriteInt(height)
-
-	builder.Method = "configure"
-	builder.Args = []any{edges, width, height}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfaceConfigureEvent{
+		Edges:  edges,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
 	return
 }
 
@@ -3702,142 +7116,808 @@ func (obj *ShellSurface) Configure(edges ShellSurfaceResize, width int32, height
 // that is, when the user clicks a surface that doesn't belong
 // to the client owning the popup surface.
 func (obj *ShellSurface) PopupDone() {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.Method = "popup_done"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(ShellSurfacePopupDoneEvent{}.Encode(obj))
 	return
 }
 
-// These values are used to indicate which edge of a surface
-// is being dragged in a resize operation. The server may
-// use this information to adapt its behavior, e.g. choose
-// an appropriate cursor image.
-type ShellSurfaceResize int64
+// ShellSurfacePongRequest holds the arguments of the pong request of
+// the wl_shell_surface interface.
+type ShellSurfacePongRequest struct {
+	Serial uint32
+}
 
-const (
-	// no edge
-	ShellSurfaceResizeNone ShellSurfaceResize = 0
+func (ShellSurfacePongRequest) shellSurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfacePongRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// top edge
-	ShellSurfaceResizeTop ShellSurfaceResize = 1
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePongRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
 
-	// bottom edge
-	ShellSurfaceResizeBottom ShellSurfaceResize = 2
+	builder.Method = "pong"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
 
-	// left edge
-	ShellSurfaceResizeLeft ShellSurfaceResize = 4
+// ShellSurfaceMoveRequest holds the arguments of the move request of
+// the wl_shell_surface interface.
+type ShellSurfaceMoveRequest struct {
+	Seat   *Seat
+	Serial uint32
+}
 
-	// top and left edges
-	ShellSurfaceResizeTopLeft ShellSurfaceResize = 5
+func (ShellSurfaceMoveRequest) shellSurfaceRequest() {}
 
-	// bottom and left edges
-	ShellSurfaceResizeBottomLeft ShellSurfaceResize = 6
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceMoveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// right edge
-	ShellSurfaceResizeRight ShellSurfaceResize = 8
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceMoveRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
 
-	// top and right edges
-	ShellSurfaceResizeTopRight ShellSurfaceResize = 9
+	builder.Method = "move"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial}
+	}
+	return builder
+}
+
+// ShellSurfaceResizeRequest holds the arguments of the resize request of
+// the wl_shell_surface interface.
+type ShellSurfaceResizeRequest struct {
+	Seat   *Seat
+	Serial uint32
+	Edges  ShellSurfaceResize
+}
+
+func (ShellSurfaceResizeRequest) shellSurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceResizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.Edges = ShellSurfaceResize(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Edges.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shell_surface",
+			Method:    "resize",
+			Arg:       "edges",
+			Value:     int64(m.Edges),
+		}
+	}
+	return nil
+}
 
-	// bottom and right edges
-	ShellSurfaceResizeBottomRight ShellSurfaceResize = 10
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceResizeRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(uint32(m.Edges))
 
-func (enum ShellSurfaceResize) String() string {
-	switch enum {
-	case 0:
-		return "ShellSurfaceResizeNone"
+	builder.Method = "resize"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.Edges}
+	}
+	return builder
+}
 
-	case 1:
-		return "ShellSurfaceResizeTop"
+// ShellSurfaceSetToplevelRequest holds the arguments of the set_toplevel request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetToplevelRequest struct {
+}
 
-	case 2:
-		return "ShellSurfaceResizeBottom"
+func (ShellSurfaceSetToplevelRequest) shellSurfaceRequest() {}
 
-	case 4:
-		return "ShellSurfaceResizeLeft"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetToplevelRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 5:
-		return "ShellSurfaceResizeTopLeft"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetToplevelRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
 
-	case 6:
-		return "ShellSurfaceResizeBottomLeft"
+	builder.Method = "set_toplevel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ShellSurfaceSetTransientRequest holds the arguments of the set_transient request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetTransientRequest struct {
+	Parent *Surface
+	X      int32
+	Y      int32
+	Flags  ShellSurfaceTransient
+}
+
+func (ShellSurfaceSetTransientRequest) shellSurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetTransientRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Flags = ShellSurfaceTransient(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Flags.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shell_surface",
+			Method:    "set_transient",
+			Arg:       "flags",
+			Value:     int64(m.Flags),
+		}
+	}
+	return nil
+}
 
-	case 8:
-		return "ShellSurfaceResizeRight"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetTransientRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteObject(m.Parent)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "set_transient"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Parent, m.X, m.Y, m.Flags}
+	}
+	return builder
+}
+
+// ShellSurfaceSetFullscreenRequest holds the arguments of the set_fullscreen request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetFullscreenRequest struct {
+	Method    ShellSurfaceFullscreenMethod
+	Framerate uint32
+	Output    *Output
+}
+
+func (ShellSurfaceSetFullscreenRequest) shellSurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetFullscreenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Method = ShellSurfaceFullscreenMethod(msg.ReadUint())
+	m.Framerate = msg.ReadUint()
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Method.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shell_surface",
+			Method:    "set_fullscreen",
+			Arg:       "method",
+			Value:     int64(m.Method),
+		}
+	}
+	return nil
+}
 
-	case 9:
-		return "ShellSurfaceResizeTopRight"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetFullscreenRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(uint32(m.Method))
+	builder.WriteUint(m.Framerate)
+	builder.WriteNullableObject(m.Output)
 
-	case 10:
-		return "ShellSurfaceResizeBottomRight"
+	builder.Method = "set_fullscreen"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Method, m.Framerate, m.Output}
+	}
+	return builder
+}
+
+// ShellSurfaceSetPopupRequest holds the arguments of the set_popup request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetPopupRequest struct {
+	Seat   *Seat
+	Serial uint32
+	Parent *Surface
+	X      int32
+	Y      int32
+	Flags  ShellSurfaceTransient
+}
+
+func (ShellSurfaceSetPopupRequest) shellSurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetPopupRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*Seat](msg, state, SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Flags = ShellSurfaceTransient(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Flags.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shell_surface",
+			Method:    "set_popup",
+			Arg:       "flags",
+			Value:     int64(m.Flags),
+		}
 	}
+	return nil
+}
 
-	return "<invalid ShellSurfaceResize>"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetPopupRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Parent)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "set_popup"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.Parent, m.X, m.Y, m.Flags}
+	}
+	return builder
 }
 
-// These flags specify details of the expected behaviour
-// of transient surfaces. Used in the set_transient request.
-type ShellSurfaceTransient int64
+// ShellSurfaceSetMaximizedRequest holds the arguments of the set_maximized request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetMaximizedRequest struct {
+	Output *Output
+}
 
-const (
-	// do not set keyboard focus
-	ShellSurfaceTransientInactive ShellSurfaceTransient = 1
-)
+func (ShellSurfaceSetMaximizedRequest) shellSurfaceRequest() {}
 
-func (enum ShellSurfaceTransient) String() string {
-	switch enum {
-	case 1:
-		return "ShellSurfaceTransientInactive"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetMaximizedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetMaximizedRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteNullableObject(m.Output)
 
-	return "<invalid ShellSurfaceTransient>"
+	builder.Method = "set_maximized"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
 }
 
-// Hints to indicate to the compositor how to deal with a conflict
-// between the dimensions of the surface and the dimensions of the
-// output. The compositor is free to ignore this parameter.
-type ShellSurfaceFullscreenMethod int64
+// ShellSurfaceSetTitleRequest holds the arguments of the set_title request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetTitleRequest struct {
+	Title string
+}
 
-const (
-	// no preference, apply default policy
-	ShellSurfaceFullscreenMethodDefault ShellSurfaceFullscreenMethod = 0
+func (ShellSurfaceSetTitleRequest) shellSurfaceRequest() {}
 
-	// scale, preserve the surface's aspect ratio and center on output
-	ShellSurfaceFullscreenMethodScale ShellSurfaceFullscreenMethod = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetTitleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Title = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// switch output mode to the smallest mode that can fit the surface, add black borders to compensate size mismatch
-	ShellSurfaceFullscreenMethodDriver ShellSurfaceFullscreenMethod = 2
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetTitleRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteString(m.Title)
 
-	// no upscaling, center on output and add black borders to compensate size mismatch
-	ShellSurfaceFullscreenMethodFill ShellSurfaceFullscreenMethod = 3
-)
+	builder.Method = "set_title"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Title}
+	}
+	return builder
+}
 
-func (enum ShellSurfaceFullscreenMethod) String() string {
-	switch enum {
-	case 0:
-		return "ShellSurfaceFullscreenMethodDefault"
+// ShellSurfaceSetClassRequest holds the arguments of the set_class request of
+// the wl_shell_surface interface.
+type ShellSurfaceSetClassRequest struct {
+	Class string
+}
 
-	case 1:
-		return "ShellSurfaceFullscreenMethodScale"
+func (ShellSurfaceSetClassRequest) shellSurfaceRequest() {}
 
-	case 2:
-		return "ShellSurfaceFullscreenMethodDriver"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceSetClassRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Class = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 3:
-		return "ShellSurfaceFullscreenMethodFill"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceSetClassRequest) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteString(m.Class)
+
+	builder.Method = "set_class"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Class}
+	}
+	return builder
+}
+
+// ShellSurfacePingEvent holds the arguments of the ping event of
+// the wl_shell_surface interface.
+type ShellSurfacePingEvent struct {
+	Serial uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfacePingEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePingEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "ping"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// ShellSurfaceConfigureEvent holds the arguments of the configure event of
+// the wl_shell_surface interface.
+type ShellSurfaceConfigureEvent struct {
+	Edges  ShellSurfaceResize
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfaceConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Edges = ShellSurfaceResize(msg.ReadUint())
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Edges.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_shell_surface",
+			Method:    "configure",
+			Arg:       "edges",
+			Value:     int64(m.Edges),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfaceConfigureEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.Edges))
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Edges, m.Width, m.Height}
+	}
+	return builder
+}
+
+// ShellSurfacePopupDoneEvent holds the arguments of the popup_done event of
+// the wl_shell_surface interface.
+type ShellSurfacePopupDoneEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ShellSurfacePopupDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ShellSurfacePopupDoneEvent) Encode(obj *ShellSurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
 
-	return "<invalid ShellSurfaceFullscreenMethod>"
+	builder.Method = "popup_done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
+// ShellSurfaceResize is an alias for [shared.ShellSurfaceResize].
+type ShellSurfaceResize = shared.ShellSurfaceResize
+
+const (
+	ShellSurfaceResizeNone        = shared.ShellSurfaceResizeNone
+	ShellSurfaceResizeTop         = shared.ShellSurfaceResizeTop
+	ShellSurfaceResizeBottom      = shared.ShellSurfaceResizeBottom
+	ShellSurfaceResizeLeft        = shared.ShellSurfaceResizeLeft
+	ShellSurfaceResizeTopLeft     = shared.ShellSurfaceResizeTopLeft
+	ShellSurfaceResizeBottomLeft  = shared.ShellSurfaceResizeBottomLeft
+	ShellSurfaceResizeRight       = shared.ShellSurfaceResizeRight
+	ShellSurfaceResizeTopRight    = shared.ShellSurfaceResizeTopRight
+	ShellSurfaceResizeBottomRight = shared.ShellSurfaceResizeBottomRight
+)
+
+// ShellSurfaceTransient is an alias for [shared.ShellSurfaceTransient].
+type ShellSurfaceTransient = shared.ShellSurfaceTransient
+
+const (
+	ShellSurfaceTransientInactive = shared.ShellSurfaceTransientInactive
+)
+
+// ShellSurfaceFullscreenMethod is an alias for [shared.ShellSurfaceFullscreenMethod].
+type ShellSurfaceFullscreenMethod = shared.ShellSurfaceFullscreenMethod
+
 const (
-	SurfaceInterface = "wl_surface"
-	SurfaceVersion   = 6
+	ShellSurfaceFullscreenMethodDefault = shared.ShellSurfaceFullscreenMethodDefault
+	ShellSurfaceFullscreenMethodScale   = shared.ShellSurfaceFullscreenMethodScale
+	ShellSurfaceFullscreenMethodDriver  = shared.ShellSurfaceFullscreenMethodDriver
+	ShellSurfaceFullscreenMethodFill    = shared.ShellSurfaceFullscreenMethodFill
 )
 
+const (
+	SurfaceInterface = shared.SurfaceInterface
+
+	// SurfaceVersion is the version of the interface that this
+	// package implements.
+	SurfaceVersion = 6
+)
+
+// The versions of the wl_surface interface that its messages were
+// added in.
+const (
+	SurfaceDestroyRequestSince                = 1
+	SurfaceAttachRequestSince                 = 1
+	SurfaceDamageRequestSince                 = 1
+	SurfaceFrameRequestSince                  = 1
+	SurfaceSetOpaqueRegionRequestSince        = 1
+	SurfaceSetInputRegionRequestSince         = 1
+	SurfaceCommitRequestSince                 = 1
+	SurfaceSetBufferTransformRequestSince     = 2
+	SurfaceSetBufferScaleRequestSince         = 3
+	SurfaceDamageBufferRequestSince           = 4
+	SurfaceOffsetRequestSince                 = 5
+	SurfaceEnterEventSince                    = 1
+	SurfaceLeaveEventSince                    = 1
+	SurfacePreferredBufferScaleEventSince     = 6
+	SurfacePreferredBufferTransformEventSince = 6
+)
+
+// SurfaceDesc describes the wl_surface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SurfaceDesc = &wire.InterfaceDesc{
+	Name:    SurfaceInterface,
+	Version: SurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "attach",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "buffer",
+					Type:      wire.ArgObject,
+					Interface: "wl_buffer",
+					AllowNull: true,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "damage",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wl_callback",
+				},
+			},
+		},
+		{
+			Name:  "set_opaque_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "region",
+					Type:      wire.ArgObject,
+					Interface: "wl_region",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_input_region",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "region",
+					Type:      wire.ArgObject,
+					Interface: "wl_region",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "commit",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_buffer_transform",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "transform",
+					Type: wire.ArgInt,
+					Enum: "wl_output.transform",
+				},
+			},
+		},
+		{
+			Name:  "set_buffer_scale",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "scale",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "damage_buffer",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "offset",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "preferred_buffer_scale",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "factor",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "preferred_buffer_transform",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "transform",
+					Type: wire.ArgUint,
+					Enum: "wl_output.transform",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_scale", Value: 0},
+				{Name: "invalid_transform", Value: 1},
+				{Name: "invalid_size", Value: 2},
+				{Name: "invalid_offset", Value: 3},
+				{Name: "defunct_role_object", Value: 4},
+			},
+		},
+	},
+}
+
 // SurfaceListener is a type that can respond to incoming
 // messages for a Surface object.
 type SurfaceListener interface {
@@ -4070,6 +8150,8 @@ type SurfaceListener interface {
 	// If transform is not one of the values from the
 	// wl_output.transform enum the invalid_transform protocol error
 	// is raised.
+	//
+	// Available since version 2.
 	SetBufferTransform(transform OutputTransform)
 
 	// This request sets an optional scaling factor on how the compositor
@@ -4095,6 +8177,8 @@ type SurfaceListener interface {
 	//
 	// If scale is not greater than 0 the invalid_scale protocol error is
 	// raised.
+	//
+	// Available since version 3.
 	SetBufferScale(scale int32)
 
 	// This request is used to describe the regions where the pending
@@ -4129,6 +8213,8 @@ type SurfaceListener interface {
 	// kinds of damage into account will have to accumulate damage from the
 	// two requests separately and only transform from one to the other
 	// after receiving the wl_surface.commit.
+	//
+	// Available since version 4.
 	DamageBuffer(x int32, y int32, width int32, height int32)
 
 	// The x and y arguments specify the location of the new pending
@@ -4146,9 +8232,187 @@ type SurfaceListener interface {
 	// This request is semantically equivalent to and the replaces the x and y
 	// arguments in the wl_surface.attach request in wl_surface versions prior
 	// to 5. See wl_surface.attach for details.
+	//
+	// Available since version 5.
 	Offset(x int32, y int32)
 }
 
+// SurfaceListenerFuncs implements SurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SurfaceListenerFuncs struct {
+	OnDestroy         func()
+	OnAttach          func(buffer *Buffer, x int32, y int32)
+	OnDamage          func(x int32, y int32, width int32, height int32)
+	OnFrame           func(callback *Callback)
+	OnSetOpaqueRegion func(region *Region)
+	OnSetInputRegion  func(region *Region)
+	OnCommit          func()
+	// Available since version 2.
+	OnSetBufferTransform func(transform OutputTransform)
+	// Available since version 3.
+	OnSetBufferScale func(scale int32)
+	// Available since version 4.
+	OnDamageBuffer func(x int32, y int32, width int32, height int32)
+	// Available since version 5.
+	OnOffset func(x int32, y int32)
+}
+
+func (lis *SurfaceListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *SurfaceListenerFuncs) Attach(buffer *Buffer, x int32, y int32) {
+	if lis.OnAttach != nil {
+		lis.OnAttach(buffer, x, y)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) Damage(x int32, y int32, width int32, height int32) {
+	if lis.OnDamage != nil {
+		lis.OnDamage(x, y, width, height)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) Frame(callback *Callback) {
+	if lis.OnFrame != nil {
+		lis.OnFrame(callback)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) SetOpaqueRegion(region *Region) {
+	if lis.OnSetOpaqueRegion != nil {
+		lis.OnSetOpaqueRegion(region)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) SetInputRegion(region *Region) {
+	if lis.OnSetInputRegion != nil {
+		lis.OnSetInputRegion(region)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) Commit() {
+	if lis.OnCommit != nil {
+		lis.OnCommit()
+	}
+}
+
+// Available since version 2.
+func (lis *SurfaceListenerFuncs) SetBufferTransform(transform OutputTransform) {
+	if lis.OnSetBufferTransform != nil {
+		lis.OnSetBufferTransform(transform)
+	}
+}
+
+// Available since version 3.
+func (lis *SurfaceListenerFuncs) SetBufferScale(scale int32) {
+	if lis.OnSetBufferScale != nil {
+		lis.OnSetBufferScale(scale)
+	}
+}
+
+// Available since version 4.
+func (lis *SurfaceListenerFuncs) DamageBuffer(x int32, y int32, width int32, height int32) {
+	if lis.OnDamageBuffer != nil {
+		lis.OnDamageBuffer(x, y, width, height)
+	}
+}
+
+// Available since version 5.
+func (lis *SurfaceListenerFuncs) Offset(x int32, y int32) {
+	if lis.OnOffset != nil {
+		lis.OnOffset(x, y)
+	}
+}
+
+// SurfaceRequest is implemented by the message types of all
+// of the requests that a Surface can receive.
+type SurfaceRequest interface {
+	surfaceRequest()
+}
+
+// SurfaceRequestFunc implements SurfaceListener by calling
+// itself with each incoming message.
+type SurfaceRequestFunc func(SurfaceRequest)
+
+func (f SurfaceRequestFunc) Destroy() {
+	f(SurfaceDestroyRequest{})
+}
+
+func (f SurfaceRequestFunc) Attach(buffer *Buffer, x int32, y int32) {
+	f(SurfaceAttachRequest{
+		Buffer: buffer,
+		X:      x,
+		Y:      y,
+	})
+}
+
+func (f SurfaceRequestFunc) Damage(x int32, y int32, width int32, height int32) {
+	f(SurfaceDamageRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f SurfaceRequestFunc) Frame(callback *Callback) {
+	f(SurfaceFrameRequest{
+		Callback: callback,
+	})
+}
+
+func (f SurfaceRequestFunc) SetOpaqueRegion(region *Region) {
+	f(SurfaceSetOpaqueRegionRequest{
+		Region: region,
+	})
+}
+
+func (f SurfaceRequestFunc) SetInputRegion(region *Region) {
+	f(SurfaceSetInputRegionRequest{
+		Region: region,
+	})
+}
+
+func (f SurfaceRequestFunc) Commit() {
+	f(SurfaceCommitRequest{})
+}
+
+// Available since version 2.
+func (f SurfaceRequestFunc) SetBufferTransform(transform OutputTransform) {
+	f(SurfaceSetBufferTransformRequest{
+		Transform: transform,
+	})
+}
+
+// Available since version 3.
+func (f SurfaceRequestFunc) SetBufferScale(scale int32) {
+	f(SurfaceSetBufferScaleRequest{
+		Scale: scale,
+	})
+}
+
+// Available since version 4.
+func (f SurfaceRequestFunc) DamageBuffer(x int32, y int32, width int32, height int32) {
+	f(SurfaceDamageBufferRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+// Available since version 5.
+func (f SurfaceRequestFunc) Offset(x int32, y int32) {
+	f(SurfaceOffsetRequest{
+		X: x,
+		Y: y,
+	})
+}
+
 // A surface is a rectangular area that may be displayed on zero
 // or more outputs, and shown any number of times at the compositor's
 // discretion. They can present wl_buffers, receive user input, and
@@ -4218,27 +8482,20 @@ func (obj *Surface) State() wire.State {
 func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m SurfaceDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
-		}
-		obj.Listener.Destroy()
-		return nil
-
-	case 1:
-
-		buffer, _ := obj.state.Get(msg.ReadUint()).(*Buffer)
-
-		obj.state.Add(buffer)
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
+		}
+		obj.Listener.Destroy()
+		return nil
 
-		if err := msg.Err(); err != nil {
+	case 1:
+		var m SurfaceAttachRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4246,23 +8503,15 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Attach(
-			buffer,
-			x,
-			y,
+			m.Buffer,
+			m.X,
+			m.Y,
 		)
 		return nil
 
 	case 2:
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceDamageRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4270,21 +8519,16 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Damage(
-			x,
-			y,
-			width,
-			height,
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
 		)
 		return nil
 
 	case 3:
-
-		callback := NewCallback(obj.state)
-		callback.SetID(msg.ReadUint())
-
-		obj.state.Add(callback)
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceFrameRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4292,17 +8536,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Frame(
-			callback,
+			m.Callback,
 		)
 		return nil
 
 	case 4:
-
-		region, _ := obj.state.Get(msg.ReadUint()).(*Region)
-
-		obj.state.Add(region)
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceSetOpaqueRegionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4310,17 +8550,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetOpaqueRegion(
-			region,
+			m.Region,
 		)
 		return nil
 
 	case 5:
-
-		region, _ := obj.state.Get(msg.ReadUint()).(*Region)
-
-		obj.state.Add(region)
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceSetInputRegionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4328,12 +8564,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetInputRegion(
-			region,
+			m.Region,
 		)
 		return nil
 
 	case 6:
-		if err := msg.Err(); err != nil {
+		var m SurfaceCommitRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4344,10 +8581,8 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 7:
-
-		transform := OutputTransform(msg.ReadInt())
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceSetBufferTransformRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4355,15 +8590,13 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetBufferTransform(
-			transform,
+			m.Transform,
 		)
 		return nil
 
 	case 8:
-
-		scale := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m SurfaceSetBufferScaleRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4371,252 +8604,1023 @@ func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetBufferScale(
-			scale,
+			m.Scale,
 		)
 		return nil
 
 	case 9:
+		var m SurfaceDamageBufferRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
 
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.DamageBuffer(
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
+		)
+		return nil
 
-		if err := msg.Err(); err != nil {
+	case 10:
+		var m SurfaceOffsetRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
 		if obj.Listener == nil {
 			return nil
 		}
-		obj.Listener.DamageBuffer(
-			x,
-			y,
-			width,
-			height,
+		obj.Listener.Offset(
+			m.X,
+			m.Y,
 		)
 		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SurfaceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wl_surface",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Surface) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Surface) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Surface) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Surface) String() string {
+	return fmt.Sprintf("%v(%v)", "wl_surface", obj.id)
+}
+
+func (obj *Surface) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "attach"
+
+	case 2:
+		return "damage"
+
+	case 3:
+		return "frame"
+
+	case 4:
+		return "set_opaque_region"
+
+	case 5:
+		return "set_input_region"
+
+	case 6:
+		return "commit"
+
+	case 7:
+		return "set_buffer_transform"
+
+	case 8:
+		return "set_buffer_scale"
+
+	case 9:
+		return "damage_buffer"
 
 	case 10:
+		return "offset"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Surface) Interface() string {
+	return SurfaceInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnAttach sets the function that is called when the
+// attach request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnAttach(f func(buffer *Buffer, x int32, y int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAttach = f
+}
+
+// OnDamage sets the function that is called when the
+// damage request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnDamage(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDamage = f
+}
+
+// OnFrame sets the function that is called when the
+// frame request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnFrame(f func(callback *Callback)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// OnSetOpaqueRegion sets the function that is called when the
+// set_opaque_region request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnSetOpaqueRegion(f func(region *Region)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetOpaqueRegion = f
+}
+
+// OnSetInputRegion sets the function that is called when the
+// set_input_region request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnSetInputRegion(f func(region *Region)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetInputRegion = f
+}
+
+// OnCommit sets the function that is called when the
+// commit request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnCommit(f func()) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCommit = f
+}
+
+// OnSetBufferTransform sets the function that is called when the
+// set_buffer_transform request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 2.
+func (obj *Surface) OnSetBufferTransform(f func(transform OutputTransform)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetBufferTransform = f
+}
+
+// OnSetBufferScale sets the function that is called when the
+// set_buffer_scale request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Surface) OnSetBufferScale(f func(scale int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetBufferScale = f
+}
+
+// OnDamageBuffer sets the function that is called when the
+// damage_buffer request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 4.
+func (obj *Surface) OnDamageBuffer(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDamageBuffer = f
+}
+
+// OnOffset sets the function that is called when the
+// offset request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+func (obj *Surface) OnOffset(f func(x int32, y int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnOffset = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Surface) Subscribe() *wire.Messages[SurfaceRequest] {
+	q := new(wire.Messages[SurfaceRequest])
+	obj.Listener = SurfaceRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Surface) Version() uint32 {
+	return SurfaceVersion
+}
+
+// This is emitted whenever a surface's creation, movement, or resizing
+// results in some part of it being within the scanout region of an
+// output.
+//
+// Note that a surface may be overlapping with zero or more outputs.
+func (obj *Surface) Enter(output *Output) {
+	obj.state.Enqueue(SurfaceEnterEvent{
+		Output: output,
+	}.Encode(obj))
+	return
+}
+
+// This is emitted whenever a surface's creation, movement, or resizing
+// results in it no longer having any part of it within the scanout region
+// of an output.
+//
+// Clients should not use the number of outputs the surface is on for frame
+// throttling purposes. The surface might be hidden even if no leave event
+// has been sent, and the compositor might expect new surface content
+// updates even if no enter event has been sent. The frame event should be
+// used instead.
+func (obj *Surface) Leave(output *Output) {
+	obj.state.Enqueue(SurfaceLeaveEvent{
+		Output: output,
+	}.Encode(obj))
+	return
+}
+
+// This event indicates the preferred buffer scale for this surface. It is
+// sent whenever the compositor's preference changes.
+//
+// Before receiving this event the preferred buffer scale for this surface
+// is 1.
+//
+// It is intended that scaling aware clients use this event to scale their
+// content and use wl_surface.set_buffer_scale to indicate the scale they
+// have rendered with. This allows clients to supply a higher detail
+// buffer.
+//
+// The compositor shall emit a scale value greater than 0.
+//
+// Available since version 6.
+func (obj *Surface) PreferredBufferScale(factor int32) {
+	obj.state.Enqueue(SurfacePreferredBufferScaleEvent{
+		Factor: factor,
+	}.Encode(obj))
+	return
+}
+
+// This event indicates the preferred buffer transform for this surface.
+// It is sent whenever the compositor's preference changes.
+//
+// Before receiving this event the preferred buffer transform for this
+// surface is normal.
+//
+// Applying this transformation to the surface buffer contents and using
+// wl_surface.set_buffer_transform might allow the compositor to use the
+// surface buffer more efficiently.
+//
+// Available since version 6.
+func (obj *Surface) PreferredBufferTransform(transform OutputTransform) {
+	obj.state.Enqueue(SurfacePreferredBufferTransformEvent{
+		Transform: transform,
+	}.Encode(obj))
+	return
+}
+
+// SurfaceDestroyRequest holds the arguments of the destroy request of
+// the wl_surface interface.
+type SurfaceDestroyRequest struct {
+}
+
+func (SurfaceDestroyRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDestroyRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SurfaceAttachRequest holds the arguments of the attach request of
+// the wl_surface interface.
+type SurfaceAttachRequest struct {
+	Buffer *Buffer
+	X      int32
+	Y      int32
+}
+
+func (SurfaceAttachRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceAttachRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Buffer = wire.ResolveObject[*Buffer](msg, state, BufferInterface, msg.ReadNullableObject())
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceAttachRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteNullableObject(m.Buffer)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "attach"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Buffer, m.X, m.Y}
+	}
+	return builder
+}
+
+// SurfaceDamageRequest holds the arguments of the damage request of
+// the wl_surface interface.
+type SurfaceDamageRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (SurfaceDamageRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceDamageRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDamageRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "damage"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// SurfaceFrameRequest holds the arguments of the frame request of
+// the wl_surface interface.
+type SurfaceFrameRequest struct {
+	Callback *Callback
+}
+
+func (SurfaceFrameRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceFrameRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Callback = NewCallback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, CallbackInterface, msg.ReadNewObject(CallbackInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceFrameRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.Callback)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CallbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
+
+// SurfaceSetOpaqueRegionRequest holds the arguments of the set_opaque_region request of
+// the wl_surface interface.
+type SurfaceSetOpaqueRegionRequest struct {
+	Region *Region
+}
+
+func (SurfaceSetOpaqueRegionRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceSetOpaqueRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Region = wire.ResolveObject[*Region](msg, state, RegionInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetOpaqueRegionRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteNullableObject(m.Region)
+
+	builder.Method = "set_opaque_region"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Region}
+	}
+	return builder
+}
+
+// SurfaceSetInputRegionRequest holds the arguments of the set_input_region request of
+// the wl_surface interface.
+type SurfaceSetInputRegionRequest struct {
+	Region *Region
+}
 
-		x := msg.ReadInt()
+func (SurfaceSetInputRegionRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceSetInputRegionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Region = wire.ResolveObject[*Region](msg, state, RegionInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetInputRegionRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteNullableObject(m.Region)
+
+	builder.Method = "set_input_region"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Region}
+	}
+	return builder
+}
 
-		y := msg.ReadInt()
+// SurfaceCommitRequest holds the arguments of the commit request of
+// the wl_surface interface.
+type SurfaceCommitRequest struct {
+}
 
-		if err := msg.Err(); err != nil {
-			return err
-		}
+func (SurfaceCommitRequest) surfaceRequest() {}
 
-		if obj.Listener == nil {
-			return nil
-		}
-		obj.Listener.Offset(
-			x,
-			y,
-		)
-		return nil
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceCommitRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
 
-	return wire.UnknownOpError{
-		Interface: "wl_surface",
-		Type:      "request",
-		Op:        msg.Op(),
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceCommitRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+
+	builder.Method = "commit"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
 	}
+	return builder
 }
 
-func (obj *Surface) ID() uint32 {
-	return obj.id
+// SurfaceSetBufferTransformRequest holds the arguments of the set_buffer_transform request of
+// the wl_surface interface.
+//
+// Available since version 2.
+type SurfaceSetBufferTransformRequest struct {
+	Transform OutputTransform
 }
 
-func (obj *Surface) SetID(id uint32) {
-	obj.id = id
-}
+func (SurfaceSetBufferTransformRequest) surfaceRequest() {}
 
-func (obj *Surface) Delete() {
-	if obj.OnDelete != nil {
-		obj.OnDelete()
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceSetBufferTransformRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Transform = OutputTransform(msg.ReadInt())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Transform.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_surface",
+			Method:    "set_buffer_transform",
+			Arg:       "transform",
+			Value:     int64(m.Transform),
+		}
 	}
+	return nil
 }
 
-func (obj *Surface) String() string {
-	return fmt.Sprintf("%v(%v)", "wl_surface", obj.id)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetBufferTransformRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteInt(int32(m.Transform))
+
+	builder.Method = "set_buffer_transform"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Transform}
+	}
+	return builder
 }
 
-func (obj *Surface) MethodName(op uint16) string {
-	switch op {
-	case 0:
-		return "destroy"
+// SurfaceSetBufferScaleRequest holds the arguments of the set_buffer_scale request of
+// the wl_surface interface.
+//
+// Available since version 3.
+type SurfaceSetBufferScaleRequest struct {
+	Scale int32
+}
 
-	case 1:
-		return "attach"
+func (SurfaceSetBufferScaleRequest) surfaceRequest() {}
 
-	case 2:
-		return "damage"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceSetBufferScaleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Scale = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 3:
-		return "frame"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetBufferScaleRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteInt(m.Scale)
 
-	case 4:
-		return "set_opaque_region"
+	builder.Method = "set_buffer_scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Scale}
+	}
+	return builder
+}
+
+// SurfaceDamageBufferRequest holds the arguments of the damage_buffer request of
+// the wl_surface interface.
+//
+// Available since version 4.
+type SurfaceDamageBufferRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (SurfaceDamageBufferRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceDamageBufferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 5:
-		return "set_input_region"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDamageBufferRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "damage_buffer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
 
-	case 6:
-		return "commit"
+// SurfaceOffsetRequest holds the arguments of the offset request of
+// the wl_surface interface.
+//
+// Available since version 5.
+type SurfaceOffsetRequest struct {
+	X int32
+	Y int32
+}
 
-	case 7:
-		return "set_buffer_transform"
+func (SurfaceOffsetRequest) surfaceRequest() {}
 
-	case 8:
-		return "set_buffer_scale"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceOffsetRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 9:
-		return "damage_buffer"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceOffsetRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
 
-	case 10:
-		return "offset"
+	builder.Method = "offset"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
 	}
-
-	return "unknown method"
+	return builder
 }
 
-func (obj *Surface) Interface() string {
-	return SurfaceInterface
+// SurfaceEnterEvent holds the arguments of the enter event of
+// the wl_surface interface.
+type SurfaceEnterEvent struct {
+	Output *Output
 }
 
-func (obj *Surface) Version() uint32 {
-	return SurfaceVersion
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// This is emitted whenever a surface's creation, movement, or resizing
-// results in some part of it being within the scanout region of an
-// output.
-//
-// Note that a surface may be overlapping with zero or more outputs.
-func (obj *Surface) Enter(output *Output) {
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceEnterEvent) Encode(obj *Surface) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteObject(output)
+	builder.WriteObject(m.Output)
 
 	builder.Method = "enter"
-	builder.Args = []any{output}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
 }
 
-// This is emitted whenever a surface's creation, movement, or resizing
-// results in it no longer having any part of it within the scanout region
-// of an output.
-//
-// Clients should not use the number of outputs the surface is on for frame
-// throttling purposes. The surface might be hidden even if no leave event
-// has been sent, and the compositor might expect new surface content
-// updates even if no enter event has been sent. The frame event should be
-// used instead.
-func (obj *Surface) Leave(output *Output) {
-	builder := wire.NewMessage(obj, 1)
+// SurfaceLeaveEvent holds the arguments of the leave event of
+// the wl_surface interface.
+type SurfaceLeaveEvent struct {
+	Output *Output
+}
 
-	builder.WriteObject(output)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*Output](msg, state, OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceLeaveEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Output)
 
 	builder.Method = "leave"
-	builder.Args = []any{output}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
 }
 
-// This event indicates the preferred buffer scale for this surface. It is
-// sent whenever the compositor's preference changes.
-//
-// Before receiving this event the preferred buffer scale for this surface
-// is 1.
-//
-// It is intended that scaling aware clients use this event to scale their
-// content and use wl_surface.set_buffer_scale to indicate the scale they
-// have rendered with. This allows clients to supply a higher detail
-// buffer.
+// SurfacePreferredBufferScaleEvent holds the arguments of the preferred_buffer_scale event of
+// the wl_surface interface.
 //
-// The compositor shall emit a scale value greater than 0.
-func (obj *Surface) PreferredBufferScale(factor int32) {
-	builder := wire.NewMessage(obj, 2)
+// Available since version 6.
+type SurfacePreferredBufferScaleEvent struct {
+	Factor int32
+}
 
-	builder.WriteInt(factor)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfacePreferredBufferScaleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Factor = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfacePreferredBufferScaleEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Factor)
 
 	builder.Method = "preferred_buffer_scale"
-	builder.Args = []any{factor}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Factor}
+	}
+	return builder
 }
 
-// This event indicates the preferred buffer transform for this surface.
-// It is sent whenever the compositor's preference changes.
+// SurfacePreferredBufferTransformEvent holds the arguments of the preferred_buffer_transform event of
+// the wl_surface interface.
 //
-// Before receiving this event the preferred buffer transform for this
-// surface is normal.
-//
-// Applying this transformation to the surface buffer contents and using
-// wl_surface.set_buffer_transform might allow the compositor to use the
-// surface buffer more efficiently.
-func (obj *Surface) PreferredBufferTransform(transform OutputTransform) {
-	builder := wire.NewMessage(obj, 3)
+// Available since version 6.
+type SurfacePreferredBufferTransformEvent struct {
+	Transform OutputTransform
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfacePreferredBufferTransformEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Transform = OutputTransform(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Transform.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_surface",
+			Method:    "preferred_buffer_transform",
+			Arg:       "transform",
+			Value:     int64(m.Transform),
+		}
+	}
+	return nil
+}
 
-	builder.WriteUint(uint32(transform))
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfacePreferredBufferTransformEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(uint32(m.Transform))
 
 	builder.Method = "preferred_buffer_transform"
-	builder.Args = []any{transform}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Transform}
+	}
+	return builder
 }
 
-// These errors can be emitted in response to wl_surface requests.
-type SurfaceError int64
+// SurfaceError is an alias for [shared.SurfaceError].
+type SurfaceError = shared.SurfaceError
 
 const (
-	// buffer scale value is invalid
-	SurfaceErrorInvalidScale SurfaceError = 0
-
-	// buffer transform value is invalid
-	SurfaceErrorInvalidTransform SurfaceError = 1
-
-	// buffer size is invalid
-	SurfaceErrorInvalidSize SurfaceError = 2
-
-	// buffer offset is invalid
-	SurfaceErrorInvalidOffset SurfaceError = 3
-
-	// surface was destroyed before its role object
-	SurfaceErrorDefunctRoleObject SurfaceError = 4
+	SurfaceErrorInvalidScale      = shared.SurfaceErrorInvalidScale
+	SurfaceErrorInvalidTransform  = shared.SurfaceErrorInvalidTransform
+	SurfaceErrorInvalidSize       = shared.SurfaceErrorInvalidSize
+	SurfaceErrorInvalidOffset     = shared.SurfaceErrorInvalidOffset
+	SurfaceErrorDefunctRoleObject = shared.SurfaceErrorDefunctRoleObject
 )
 
-func (enum SurfaceError) String() string {
-	switch enum {
-	case 0:
-		return "SurfaceErrorInvalidScale"
-
-	case 1:
-		return "SurfaceErrorInvalidTransform"
-
-	case 2:
-		return "SurfaceErrorInvalidSize"
-
-	case 3:
-		return "SurfaceErrorInvalidOffset"
-
-	case 4:
-		return "SurfaceErrorDefunctRoleObject"
-	}
+const (
+	SeatInterface = shared.SeatInterface
 
-	return "<invalid SurfaceError>"
-}
+	// SeatVersion is the version of the interface that this
+	// package implements.
+	SeatVersion = 10
+)
 
+// The versions of the wl_seat interface that its messages were
+// added in.
 const (
-	SeatInterface = "wl_seat"
-	SeatVersion   = 10
+	SeatGetPointerRequestSince  = 1
+	SeatGetKeyboardRequestSince = 1
+	SeatGetTouchRequestSince    = 1
+	SeatReleaseRequestSince     = 5
+	SeatCapabilitiesEventSince  = 1
+	SeatNameEventSince          = 2
 )
 
+// SeatDesc describes the wl_seat interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SeatDesc = &wire.InterfaceDesc{
+	Name:    SeatInterface,
+	Version: SeatVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "get_pointer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_pointer",
+				},
+			},
+		},
+		{
+			Name:  "get_keyboard",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_keyboard",
+				},
+			},
+		},
+		{
+			Name:  "get_touch",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_touch",
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 5,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "capabilities",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "capabilities",
+					Type: wire.ArgUint,
+					Enum: "capability",
+				},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "capability",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "pointer", Value: 1},
+				{Name: "keyboard", Value: 2},
+				{Name: "touch", Value: 4},
+			},
+		},
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "missing_capability", Value: 0},
+			},
+		},
+	},
+}
+
 // SeatListener is a type that can respond to incoming
 // messages for a Seat object.
 type SeatListener interface {
@@ -4652,9 +9656,80 @@ type SeatListener interface {
 
 	// Using this request a client can tell the server that it is not going to
 	// use the seat object anymore.
+	//
+	// Available since version 5.
 	Release()
 }
 
+// SeatListenerFuncs implements SeatListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SeatListenerFuncs struct {
+	OnGetPointer  func(id *Pointer)
+	OnGetKeyboard func(id *Keyboard)
+	OnGetTouch    func(id *Touch)
+	// Available since version 5.
+	OnRelease func()
+}
+
+func (lis *SeatListenerFuncs) GetPointer(id *Pointer) {
+	if lis.OnGetPointer != nil {
+		lis.OnGetPointer(id)
+	}
+}
+
+func (lis *SeatListenerFuncs) GetKeyboard(id *Keyboard) {
+	if lis.OnGetKeyboard != nil {
+		lis.OnGetKeyboard(id)
+	}
+}
+
+func (lis *SeatListenerFuncs) GetTouch(id *Touch) {
+	if lis.OnGetTouch != nil {
+		lis.OnGetTouch(id)
+	}
+}
+
+// Available since version 5.
+func (lis *SeatListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// SeatRequest is implemented by the message types of all
+// of the requests that a Seat can receive.
+type SeatRequest interface {
+	seatRequest()
+}
+
+// SeatRequestFunc implements SeatListener by calling
+// itself with each incoming message.
+type SeatRequestFunc func(SeatRequest)
+
+func (f SeatRequestFunc) GetPointer(id *Pointer) {
+	f(SeatGetPointerRequest{
+		Id: id,
+	})
+}
+
+func (f SeatRequestFunc) GetKeyboard(id *Keyboard) {
+	f(SeatGetKeyboardRequest{
+		Id: id,
+	})
+}
+
+func (f SeatRequestFunc) GetTouch(id *Touch) {
+	f(SeatGetTouchRequest{
+		Id: id,
+	})
+}
+
+// Available since version 5.
+func (f SeatRequestFunc) Release() {
+	f(SeatReleaseRequest{})
+}
+
 // A seat is a group of keyboards, pointer and touch devices. This
 // object is published as a global during start up, or when such a
 // device is hot plugged.  A seat typically has a pointer and
@@ -4693,13 +9768,8 @@ func (obj *Seat) State() wire.State {
 func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		id := NewPointer(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m SeatGetPointerRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4707,18 +9777,13 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetPointer(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 1:
-
-		id := NewKeyboard(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m SeatGetKeyboardRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4726,18 +9791,13 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetKeyboard(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 2:
-
-		id := NewTouch(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		if err := msg.Err(); err != nil {
+		var m SeatGetTouchRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4745,12 +9805,13 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetTouch(
-			id,
+			m.Id,
 		)
 		return nil
 
 	case 3:
-		if err := msg.Err(); err != nil {
+		var m SeatReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -4761,6 +9822,14 @@ func (obj *Seat) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SeatInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_seat",
 		Type:      "request",
@@ -4808,6 +9877,64 @@ func (obj *Seat) Interface() string {
 	return SeatInterface
 }
 
+// OnGetPointer sets the function that is called when the
+// get_pointer request is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+func (obj *Seat) OnGetPointer(f func(id *Pointer)) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetPointer = f
+}
+
+// OnGetKeyboard sets the function that is called when the
+// get_keyboard request is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+func (obj *Seat) OnGetKeyboard(f func(id *Keyboard)) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetKeyboard = f
+}
+
+// OnGetTouch sets the function that is called when the
+// get_touch request is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+func (obj *Seat) OnGetTouch(f func(id *Touch)) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetTouch = f
+}
+
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *SeatListenerFuncs, it is replaced with one.
+//
+// Available since version 5.
+func (obj *Seat) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*SeatListenerFuncs)
+	if !ok {
+		lis = new(SeatListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Seat) Subscribe() *wire.Messages[SeatRequest] {
+	q := new(wire.Messages[SeatRequest])
+	obj.Listener = SeatRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Seat) Version() uint32 {
 	return SeatVersion
 }
@@ -4838,13 +9965,9 @@ func (obj *Seat) Version() uint32 {
 // The above behavior also applies to wl_keyboard and wl_touch with the
 // keyboard and touch capabilities, respectively.
 func (obj *Seat) Capabilities(capabilities SeatCapability) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(uint32(capabilities))
-
-	builder.Method = "capabilities"
-	builder.Args = []any{capabilities}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(SeatCapabilitiesEvent{
+		Capabilities: capabilities,
+	}.Encode(obj))
 	return
 }
 
@@ -4864,69 +9987,531 @@ func (obj *Seat) Capabilities(capabilities SeatCapability) {
 //
 // Compositors may re-use the same seat name if the wl_seat global is
 // destroyed and re-created later.
+//
+// Available since version 2.
 func (obj *Seat) Name(name string) {
+	obj.state.Enqueue(SeatNameEvent{
+		Name: name,
+	}.Encode(obj))
+	return
+}
+
+// SeatGetPointerRequest holds the arguments of the get_pointer request of
+// the wl_seat interface.
+type SeatGetPointerRequest struct {
+	Id *Pointer
+}
+
+func (SeatGetPointerRequest) seatRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatGetPointerRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewPointer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, PointerInterface, msg.ReadNewObject(PointerInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetPointerRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_pointer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: PointerInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatGetKeyboardRequest holds the arguments of the get_keyboard request of
+// the wl_seat interface.
+type SeatGetKeyboardRequest struct {
+	Id *Keyboard
+}
+
+func (SeatGetKeyboardRequest) seatRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatGetKeyboardRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewKeyboard(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, KeyboardInterface, msg.ReadNewObject(KeyboardInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetKeyboardRequest) Encode(obj *Seat) *wire.MessageBuilder {
 	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_keyboard"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: KeyboardInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatGetTouchRequest holds the arguments of the get_touch request of
+// the wl_seat interface.
+type SeatGetTouchRequest struct {
+	Id *Touch
+}
+
+func (SeatGetTouchRequest) seatRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatGetTouchRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTouch(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TouchInterface, msg.ReadNewObject(TouchInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatGetTouchRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_touch"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TouchInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SeatReleaseRequest holds the arguments of the release request of
+// the wl_seat interface.
+//
+// Available since version 5.
+type SeatReleaseRequest struct {
+}
 
-	builder.WriteString(name)
+func (SeatReleaseRequest) seatRequest() {}
 
-	builder.Method = "name"
-	builder.Args = []any{name}
-	obj.state.Enqueue(builder)
-	return
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// This is a bitmask of capabilities this seat has; if a member is
-// set, then it is present on the seat.
-type SeatCapability int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatReleaseRequest) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
 
-const (
-	// the seat has pointer devices
-	SeatCapabilityPointer SeatCapability = 1
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// the seat has one or more keyboards
-	SeatCapabilityKeyboard SeatCapability = 2
+// SeatCapabilitiesEvent holds the arguments of the capabilities event of
+// the wl_seat interface.
+type SeatCapabilitiesEvent struct {
+	Capabilities SeatCapability
+}
 
-	// the seat has touch devices
-	SeatCapabilityTouch SeatCapability = 4
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatCapabilitiesEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Capabilities = SeatCapability(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Capabilities.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_seat",
+			Method:    "capabilities",
+			Arg:       "capabilities",
+			Value:     int64(m.Capabilities),
+		}
+	}
+	return nil
+}
 
-func (enum SeatCapability) String() string {
-	switch enum {
-	case 1:
-		return "SeatCapabilityPointer"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatCapabilitiesEvent) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Capabilities))
 
-	case 2:
-		return "SeatCapabilityKeyboard"
+	builder.Method = "capabilities"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Capabilities}
+	}
+	return builder
+}
 
-	case 4:
-		return "SeatCapabilityTouch"
+// SeatNameEvent holds the arguments of the name event of
+// the wl_seat interface.
+//
+// Available since version 2.
+type SeatNameEvent struct {
+	Name string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SeatNameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SeatNameEvent) Encode(obj *Seat) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.Name)
 
-	return "<invalid SeatCapability>"
+	builder.Method = "name"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
 }
 
-// These errors can be emitted in response to wl_seat requests.
-type SeatError int64
+// SeatCapability is an alias for [shared.SeatCapability].
+type SeatCapability = shared.SeatCapability
 
 const (
-	// get_pointer, get_keyboard or get_touch called on seat without the matching capability
-	SeatErrorMissingCapability SeatError = 0
+	SeatCapabilityPointer  = shared.SeatCapabilityPointer
+	SeatCapabilityKeyboard = shared.SeatCapabilityKeyboard
+	SeatCapabilityTouch    = shared.SeatCapabilityTouch
 )
 
-func (enum SeatError) String() string {
-	switch enum {
-	case 0:
-		return "SeatErrorMissingCapability"
-	}
+// SeatError is an alias for [shared.SeatError].
+type SeatError = shared.SeatError
 
-	return "<invalid SeatError>"
-}
+const (
+	SeatErrorMissingCapability = shared.SeatErrorMissingCapability
+)
+
+const (
+	PointerInterface = shared.PointerInterface
+
+	// PointerVersion is the version of the interface that this
+	// package implements.
+	PointerVersion = 10
+)
 
+// The versions of the wl_pointer interface that its messages were
+// added in.
 const (
-	PointerInterface = "wl_pointer"
-	PointerVersion   = 10
+	PointerSetCursorRequestSince           = 1
+	PointerReleaseRequestSince             = 3
+	PointerEnterEventSince                 = 1
+	PointerLeaveEventSince                 = 1
+	PointerMotionEventSince                = 1
+	PointerButtonEventSince                = 1
+	PointerAxisEventSince                  = 1
+	PointerFrameEventSince                 = 5
+	PointerAxisSourceEventSince            = 5
+	PointerAxisStopEventSince              = 5
+	PointerAxisDiscreteEventSince          = 5
+	PointerAxisValue120EventSince          = 8
+	PointerAxisRelativeDirectionEventSince = 9
 )
 
+// PointerDesc describes the wl_pointer interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PointerDesc = &wire.InterfaceDesc{
+	Name:    PointerInterface,
+	Version: PointerVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_cursor",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+					AllowNull: true,
+				},
+				{
+					Name: "hotspot_x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "hotspot_y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "surface_x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "surface_y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "surface_x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "surface_y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "button",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "button_state",
+				},
+			},
+		},
+		{
+			Name:  "axis",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "value",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 5,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "axis_source",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis_source",
+					Type: wire.ArgUint,
+					Enum: "axis_source",
+				},
+			},
+		},
+		{
+			Name:  "axis_stop",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+			},
+		},
+		{
+			Name:  "axis_discrete",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "discrete",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "axis_value120",
+			Since: 8,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "value120",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "axis_relative_direction",
+			Since: 9,
+			Args: []wire.ArgDesc{
+				{
+					Name: "axis",
+					Type: wire.ArgUint,
+					Enum: "axis",
+				},
+				{
+					Name: "direction",
+					Type: wire.ArgUint,
+					Enum: "axis_relative_direction",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+			},
+		},
+		{
+			Name: "button_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+			},
+		},
+		{
+			Name: "axis",
+			Entries: []wire.EntryDesc{
+				{Name: "vertical_scroll", Value: 0},
+				{Name: "horizontal_scroll", Value: 1},
+			},
+		},
+		{
+			Name: "axis_source",
+			Entries: []wire.EntryDesc{
+				{Name: "wheel", Value: 0},
+				{Name: "finger", Value: 1},
+				{Name: "continuous", Value: 2},
+				{Name: "wheel_tilt", Value: 3},
+			},
+		},
+		{
+			Name: "axis_relative_direction",
+			Entries: []wire.EntryDesc{
+				{Name: "identical", Value: 0},
+				{Name: "inverted", Value: 1},
+			},
+		},
+	},
+}
+
 // PointerListener is a type that can respond to incoming
 // messages for a Pointer object.
 type PointerListener interface {
@@ -4970,9 +10555,57 @@ type PointerListener interface {
 	//
 	// This request destroys the pointer proxy object, so clients must not call
 	// wl_pointer_destroy() after using this request.
+	//
+	// Available since version 3.
 	Release()
 }
 
+// PointerListenerFuncs implements PointerListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PointerListenerFuncs struct {
+	OnSetCursor func(serial uint32, surface *Surface, hotspotX int32, hotspotY int32)
+	// Available since version 3.
+	OnRelease func()
+}
+
+func (lis *PointerListenerFuncs) SetCursor(serial uint32, surface *Surface, hotspotX int32, hotspotY int32) {
+	if lis.OnSetCursor != nil {
+		lis.OnSetCursor(serial, surface, hotspotX, hotspotY)
+	}
+}
+
+// Available since version 3.
+func (lis *PointerListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// PointerRequest is implemented by the message types of all
+// of the requests that a Pointer can receive.
+type PointerRequest interface {
+	pointerRequest()
+}
+
+// PointerRequestFunc implements PointerListener by calling
+// itself with each incoming message.
+type PointerRequestFunc func(PointerRequest)
+
+func (f PointerRequestFunc) SetCursor(serial uint32, surface *Surface, hotspotX int32, hotspotY int32) {
+	f(PointerSetCursorRequest{
+		Serial:   serial,
+		Surface:  surface,
+		HotspotX: hotspotX,
+		HotspotY: hotspotY,
+	})
+}
+
+// Available since version 3.
+func (f PointerRequestFunc) Release() {
+	f(PointerReleaseRequest{})
+}
+
 // The wl_pointer interface represents one or more input devices,
 // such as mice, which control the pointer location and pointer_focus
 // of a seat.
@@ -5008,18 +10641,8 @@ func (obj *Pointer) State() wire.State {
 func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-
-		serial := msg.ReadUint()
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		hotspotX := msg.ReadInt()
-
-		hotspotY := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m PointerSetCursorRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5027,15 +10650,16 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetCursor(
-			serial,
-			surface,
-			hotspotX,
-			hotspotY,
+			m.Serial,
+			m.Surface,
+			m.HotspotX,
+			m.HotspotY,
 		)
 		return nil
 
 	case 1:
-		if err := msg.Err(); err != nil {
+		var m PointerReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5046,6 +10670,14 @@ func (obj *Pointer) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PointerInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_pointer",
 		Type:      "request",
@@ -5087,6 +10719,40 @@ func (obj *Pointer) Interface() string {
 	return PointerInterface
 }
 
+// OnSetCursor sets the function that is called when the
+// set_cursor request is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+func (obj *Pointer) OnSetCursor(f func(serial uint32, surface *Surface, hotspotX int32, hotspotY int32)) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetCursor = f
+}
+
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *PointerListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Pointer) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*PointerListenerFuncs)
+	if !ok {
+		lis = new(PointerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Pointer) Subscribe() *wire.Messages[PointerRequest] {
+	q := new(wire.Messages[PointerRequest])
+	obj.Listener = PointerRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Pointer) Version() uint32 {
 	return PointerVersion
 }
@@ -5098,16 +10764,12 @@ func (obj *Pointer) Version() uint32 {
 // is undefined and a client should respond to this event by setting
 // an appropriate pointer image with the set_cursor request.
 func (obj *Pointer) Enter(serial uint32, surface *Surface, surfaceX wire.Fixed, surfaceY wire.Fixed) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-	builder.WriteFixed(surfaceX)
-	builder.WriteFixed(surfaceY)
-
-	builder.Method = "enter"
-	builder.Args = []any{serial, surface, surfaceX, surfaceY}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerEnterEvent{
+		Serial:   serial,
+		Surface:  surface,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	}.Encode(obj))
 	return
 }
 
@@ -5117,30 +10779,22 @@ func (obj *Pointer) Enter(serial uint32, surface *Surface, surfaceX wire.Fixed,
 // The leave notification is sent before the enter notification
 // for the new focus.
 func (obj *Pointer) Leave(serial uint32, surface *Surface) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-
-	builder.Method = "leave"
-	builder.Args = []any{serial, surface}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	}.Encode(obj))
 	return
 }
 
 // Notification of pointer location change. The arguments
 // surface_x and surface_y are the location relative to the
 // focused surface.
-func (obj *Pointer) Motion(time uint32, surfaceX wire.Fixed, surfaceY wire.Fixed) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteUint(time)
-	builder.WriteFixed(surfaceX)
-	builder.WriteFixed(surfaceY)
-
-	builder.Method = "motion"
-	builder.Args = []any{time, surfaceX, surfaceY}
-	obj.state.Enqueue(builder)
+func (obj *Pointer) Motion(time time.Duration, surfaceX wire.Fixed, surfaceY wire.Fixed) {
+	obj.state.Enqueue(PointerMotionEvent{
+		Time:     time,
+		SurfaceX: surfaceX,
+		SurfaceY: surfaceY,
+	}.Encode(obj))
 	return
 }
 
@@ -5158,17 +10812,13 @@ func (obj *Pointer) Motion(time uint32, surfaceX wire.Fixed, surfaceY wire.Fixed
 // kernel's event code list. All other button codes above 0xFFFF are
 // currently undefined but may be used in future versions of this
 // protocol.
-func (obj *Pointer) Button(serial uint32, time uint32, button uint32, state PointerButtonState) {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.WriteUint(serial)
-	builder.WriteUint(time)
-	builder.WriteUint(button)
-	builder.WriteUint(uint32(state))
-
-	builder.Method = "button"
-	builder.Args = []any{serial, time, button, state}
-	obj.state.Enqueue(builder)
+func (obj *Pointer) Button(serial uint32, time time.Duration, button pointer.Button, state PointerButtonState) {
+	obj.state.Enqueue(PointerButtonEvent{
+		Serial: serial,
+		Time:   time,
+		Button: button,
+		State:  state,
+	}.Encode(obj))
 	return
 }
 
@@ -5188,16 +10838,12 @@ func (obj *Pointer) Button(serial uint32, time uint32, button uint32, state Poin
 //
 // When applicable, a client can transform its content relative to the
 // scroll distance.
-func (obj *Pointer) Axis(time uint32, axis PointerAxis, value wire.Fixed) {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.WriteUint(time)
-	builder.WriteUint(uint32(axis))
-	builder.WriteFixed(value)
-
-	builder.Method = "axis"
-	builder.Args = []any{time, axis, value}
-	obj.state.Enqueue(builder)
+func (obj *Pointer) Axis(time time.Duration, axis PointerAxis, value wire.Fixed) {
+	obj.state.Enqueue(PointerAxisEvent{
+		Time:  time,
+		Axis:  axis,
+		Value: value,
+	}.Encode(obj))
 	return
 }
 
@@ -5235,12 +10881,10 @@ func (obj *Pointer) Axis(time uint32, axis PointerAxis, value wire.Fixed) {
 // Compositor-specific policies may require the wl_pointer.leave and
 // wl_pointer.enter event being split across multiple wl_pointer.frame
 // groups.
+//
+// Available since version 5.
 func (obj *Pointer) Frame() {
-	builder := wire.NewMessage(obj, 5)
-
-	builder.Method = "frame"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerFrameEvent{}.Encode(obj))
 	return
 }
 
@@ -5269,14 +10913,12 @@ func (obj *Pointer) Frame() {
 //
 // The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 // not guaranteed.
+//
+// Available since version 5.
 func (obj *Pointer) AxisSource(axisSource PointerAxisSource) {
-	builder := wire.NewMessage(obj, 6)
-
-	builder.WriteUint(uint32(axisSource))
-
-	builder.Method = "axis_source"
-	builder.Args = []any{axisSource}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerAxisSourceEvent{
+		AxisSource: axisSource,
+	}.Encode(obj))
 	return
 }
 
@@ -5294,15 +10936,13 @@ func (obj *Pointer) AxisSource(axisSource PointerAxisSource) {
 // The timestamp is to be interpreted identical to the timestamp in the
 // wl_pointer.axis event. The timestamp value may be the same as a
 // preceding wl_pointer.axis event.
-func (obj *Pointer) AxisStop(time uint32, axis PointerAxis) {
-	builder := wire.NewMessage(obj, 7)
-
-	builder.WriteUint(time)
-	builder.WriteUint(uint32(axis))
-
-	builder.Method = "axis_stop"
-	builder.Args = []any{time, axis}
-	obj.state.Enqueue(builder)
+//
+// Available since version 5.
+func (obj *Pointer) AxisStop(time time.Duration, axis PointerAxis) {
+	obj.state.Enqueue(PointerAxisStopEvent{
+		Time: time,
+		Axis: axis,
+	}.Encode(obj))
 	return
 }
 
@@ -5336,15 +10976,15 @@ func (obj *Pointer) AxisStop(time uint32, axis PointerAxis) {
 //
 // The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 // not guaranteed.
+//
+// Available since version 5.
+//
+// Deprecated: Deprecated since version 8.
 func (obj *Pointer) AxisDiscrete(axis PointerAxis, discrete int32) {
-	builder := wire.NewMessage(obj, 8)
-
-	builder.WriteUint(uint32(axis))
-	builder.WriteInt(discrete)
-
-	builder.Method = "axis_discrete"
-	builder.Args = []any{axis, discrete}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerAxisDiscreteEvent{
+		Axis:     axis,
+		Discrete: discrete,
+	}.Encode(obj))
 	return
 }
 
@@ -5369,15 +11009,13 @@ func (obj *Pointer) AxisDiscrete(axis PointerAxis, discrete int32) {
 //
 // The order of wl_pointer.axis_value120 and wl_pointer.axis_source is
 // not guaranteed.
+//
+// Available since version 8.
 func (obj *Pointer) AxisValue120(axis PointerAxis, value120 int32) {
-	builder := wire.NewMessage(obj, 9)
-
-	builder.WriteUint(uint32(axis))
-	builder.WriteInt(value120)
-
-	builder.Method = "axis_value120"
-	builder.Args = []any{axis, value120}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(PointerAxisValue120Event{
+		Axis:     axis,
+		Value120: value120,
+	}.Encode(obj))
 	return
 }
 
@@ -5416,166 +11054,825 @@ func (obj *Pointer) AxisValue120(axis PointerAxis, value120 int32) {
 // The order of wl_pointer.axis_relative_direction,
 // wl_pointer.axis_discrete and wl_pointer.axis_source is not
 // guaranteed.
+//
+// Available since version 9.
 func (obj *Pointer) AxisRelativeDirection(axis PointerAxis, direction PointerAxisRelativeDirection) {
-	builder := wire.NewMessage(obj, 10)
+	obj.state.Enqueue(PointerAxisRelativeDirectionEvent{
+		Axis:      axis,
+		Direction: direction,
+	}.Encode(obj))
+	return
+}
 
-	builder.WriteUint(uint32(axis))
-	builder.WriteUint(uint32(direction))
+// PointerSetCursorRequest holds the arguments of the set_cursor request of
+// the wl_pointer interface.
+type PointerSetCursorRequest struct {
+	Serial   uint32
+	Surface  *Surface
+	HotspotX int32
+	HotspotY int32
+}
+
+func (PointerSetCursorRequest) pointerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerSetCursorRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadNullableObject())
+	m.HotspotX = msg.ReadInt()
+	m.HotspotY = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.Method = "axis_relative_direction"
-	builder.Args = []any{axis, direction}
-	obj.state.Enqueue(builder)
-	return
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerSetCursorRequest) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteNullableObject(m.Surface)
+	builder.WriteInt(m.HotspotX)
+	builder.WriteInt(m.HotspotY)
+
+	builder.Method = "set_cursor"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.HotspotX, m.HotspotY}
+	}
+	return builder
 }
 
-type PointerError int64
+// PointerReleaseRequest holds the arguments of the release request of
+// the wl_pointer interface.
+//
+// Available since version 3.
+type PointerReleaseRequest struct {
+}
 
-const (
-	// given wl_surface has another role
-	PointerErrorRole PointerError = 0
-)
+func (PointerReleaseRequest) pointerRequest() {}
 
-func (enum PointerError) String() string {
-	switch enum {
-	case 0:
-		return "PointerErrorRole"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
 
-	return "<invalid PointerError>"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerReleaseRequest) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PointerEnterEvent holds the arguments of the enter event of
+// the wl_pointer interface.
+type PointerEnterEvent struct {
+	Serial   uint32
+	Surface  *Surface
+	SurfaceX wire.Fixed
+	SurfaceY wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.SurfaceX = msg.ReadFixed()
+	m.SurfaceY = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Describes the physical state of a button that produced the button
-// event.
-type PointerButtonState int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerEnterEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteFixed(m.SurfaceX)
+	builder.WriteFixed(m.SurfaceY)
 
-const (
-	// the button is not pressed
-	PointerButtonStateReleased PointerButtonState = 0
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.SurfaceX, m.SurfaceY}
+	}
+	return builder
+}
+
+// PointerLeaveEvent holds the arguments of the leave event of
+// the wl_pointer interface.
+type PointerLeaveEvent struct {
+	Serial  uint32
+	Surface *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// the button is pressed
-	PointerButtonStatePressed PointerButtonState = 1
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerLeaveEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
 
-func (enum PointerButtonState) String() string {
-	switch enum {
-	case 0:
-		return "PointerButtonStateReleased"
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface}
+	}
+	return builder
+}
+
+// PointerMotionEvent holds the arguments of the motion event of
+// the wl_pointer interface.
+type PointerMotionEvent struct {
+	Time     time.Duration
+	SurfaceX wire.Fixed
+	SurfaceY wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.SurfaceX = msg.ReadFixed()
+	m.SurfaceY = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 1:
-		return "PointerButtonStatePressed"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerMotionEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteFixed(m.SurfaceX)
+	builder.WriteFixed(m.SurfaceY)
+
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.SurfaceX, m.SurfaceY}
+	}
+	return builder
+}
+
+// PointerButtonEvent holds the arguments of the button event of
+// the wl_pointer interface.
+type PointerButtonEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Button pointer.Button
+	State  PointerButtonState
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerButtonEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Button = (pointer.Button)(msg.ReadUint())
+	m.State = PointerButtonState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	if !m.State.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "button",
+			Arg:       "state",
+			Value:     int64(m.State),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerButtonEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Button))
+	builder.WriteUint(uint32(m.State))
 
-	return "<invalid PointerButtonState>"
+	builder.Method = "button"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), uint32(m.Button), m.State}
+	}
+	return builder
+}
+
+// PointerAxisEvent holds the arguments of the axis event of
+// the wl_pointer interface.
+type PointerAxisEvent struct {
+	Time  time.Duration
+	Axis  PointerAxis
+	Value wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Value = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Axis.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis",
+			Arg:       "axis",
+			Value:     int64(m.Axis),
+		}
+	}
+	return nil
 }
 
-// Describes the axis types of scroll events.
-type PointerAxis int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteFixed(m.Value)
 
-const (
-	// vertical axis
-	PointerAxisVerticalScroll PointerAxis = 0
+	builder.Method = "axis"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Axis, m.Value}
+	}
+	return builder
+}
 
-	// horizontal axis
-	PointerAxisHorizontalScroll PointerAxis = 1
-)
+// PointerFrameEvent holds the arguments of the frame event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerFrameEvent struct {
+}
 
-func (enum PointerAxis) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisVerticalScroll"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerFrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 1:
-		return "PointerAxisHorizontalScroll"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerFrameEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PointerAxisSourceEvent holds the arguments of the axis_source event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerAxisSourceEvent struct {
+	AxisSource PointerAxisSource
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisSourceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.AxisSource = PointerAxisSource(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.AxisSource.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_source",
+			Arg:       "axis_source",
+			Value:     int64(m.AxisSource),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisSourceEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteUint(uint32(m.AxisSource))
+
+	builder.Method = "axis_source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.AxisSource}
+	}
+	return builder
+}
+
+// PointerAxisStopEvent holds the arguments of the axis_stop event of
+// the wl_pointer interface.
+//
+// Available since version 5.
+type PointerAxisStopEvent struct {
+	Time time.Duration
+	Axis PointerAxis
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisStopEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Axis = PointerAxis(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Axis.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_stop",
+			Arg:       "axis",
+			Value:     int64(m.Axis),
+		}
 	}
+	return nil
+}
 
-	return "<invalid PointerAxis>"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisStopEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(uint32(m.Axis))
+
+	builder.Method = "axis_stop"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Axis}
+	}
+	return builder
 }
 
-// Describes the source types for axis events. This indicates to the
-// client how an axis event was physically generated; a client may
-// adjust the user interface accordingly. For example, scroll events
-// from a "finger" source may be in a smooth coordinate space with
-// kinetic scrolling whereas a "wheel" source may be in discrete steps
-// of a number of lines.
+// PointerAxisDiscreteEvent holds the arguments of the axis_discrete event of
+// the wl_pointer interface.
 //
-// The "continuous" axis source is a device generating events in a
-// continuous coordinate space, but using something other than a
-// finger. One example for this source is button-based scrolling where
-// the vertical motion of a device is converted to scroll events while
-// a button is held down.
+// Available since version 5.
 //
-// The "wheel tilt" axis source indicates that the actual device is a
-// wheel but the scroll event is not caused by a rotation but a
-// (usually sideways) tilt of the wheel.
-type PointerAxisSource int64
+// Deprecated: Deprecated since version 8.
+type PointerAxisDiscreteEvent struct {
+	Axis     PointerAxis
+	Discrete int32
+}
 
-const (
-	// a physical wheel rotation
-	PointerAxisSourceWheel PointerAxisSource = 0
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisDiscreteEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Discrete = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Axis.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_discrete",
+			Arg:       "axis",
+			Value:     int64(m.Axis),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisDiscreteEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteInt(m.Discrete)
+
+	builder.Method = "axis_discrete"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Discrete}
+	}
+	return builder
+}
 
-	// finger on a touch surface
-	PointerAxisSourceFinger PointerAxisSource = 1
+// PointerAxisValue120Event holds the arguments of the axis_value120 event of
+// the wl_pointer interface.
+//
+// Available since version 8.
+type PointerAxisValue120Event struct {
+	Axis     PointerAxis
+	Value120 int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisValue120Event) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Value120 = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Axis.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_value120",
+			Arg:       "axis",
+			Value:     int64(m.Axis),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisValue120Event) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteInt(m.Value120)
+
+	builder.Method = "axis_value120"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Value120}
+	}
+	return builder
+}
+
+// PointerAxisRelativeDirectionEvent holds the arguments of the axis_relative_direction event of
+// the wl_pointer interface.
+//
+// Available since version 9.
+type PointerAxisRelativeDirectionEvent struct {
+	Axis      PointerAxis
+	Direction PointerAxisRelativeDirection
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PointerAxisRelativeDirectionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Axis = PointerAxis(msg.ReadUint())
+	m.Direction = PointerAxisRelativeDirection(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Axis.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_relative_direction",
+			Arg:       "axis",
+			Value:     int64(m.Axis),
+		}
+	}
+	if !m.Direction.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_pointer",
+			Method:    "axis_relative_direction",
+			Arg:       "direction",
+			Value:     int64(m.Direction),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PointerAxisRelativeDirectionEvent) Encode(obj *Pointer) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+	builder.WriteUint(uint32(m.Axis))
+	builder.WriteUint(uint32(m.Direction))
+
+	builder.Method = "axis_relative_direction"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Axis, m.Direction}
+	}
+	return builder
+}
+
+// PointerError is an alias for [shared.PointerError].
+type PointerError = shared.PointerError
+
+const (
+	PointerErrorRole = shared.PointerErrorRole
+)
 
-	// continuous coordinate space
-	PointerAxisSourceContinuous PointerAxisSource = 2
+// PointerButtonState is an alias for [shared.PointerButtonState].
+type PointerButtonState = shared.PointerButtonState
 
-	// a physical wheel tilt
-	PointerAxisSourceWheelTilt PointerAxisSource = 3
+const (
+	PointerButtonStateReleased = shared.PointerButtonStateReleased
+	PointerButtonStatePressed  = shared.PointerButtonStatePressed
 )
 
-func (enum PointerAxisSource) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisSourceWheel"
-
-	case 1:
-		return "PointerAxisSourceFinger"
+// PointerAxis is an alias for [shared.PointerAxis].
+type PointerAxis = shared.PointerAxis
 
-	case 2:
-		return "PointerAxisSourceContinuous"
+const (
+	PointerAxisVerticalScroll   = shared.PointerAxisVerticalScroll
+	PointerAxisHorizontalScroll = shared.PointerAxisHorizontalScroll
+)
 
-	case 3:
-		return "PointerAxisSourceWheelTilt"
-	}
+// PointerAxisSource is an alias for [shared.PointerAxisSource].
+type PointerAxisSource = shared.PointerAxisSource
 
-	return "<invalid PointerAxisSource>"
-}
+const (
+	PointerAxisSourceWheel      = shared.PointerAxisSourceWheel
+	PointerAxisSourceFinger     = shared.PointerAxisSourceFinger
+	PointerAxisSourceContinuous = shared.PointerAxisSourceContinuous
+	// Available since version 6.
+	PointerAxisSourceWheelTilt = shared.PointerAxisSourceWheelTilt
+)
 
-// This specifies the direction of the physical motion that caused a
-// wl_pointer.axis event, relative to the wl_pointer.axis direction.
-type PointerAxisRelativeDirection int64
+// PointerAxisRelativeDirection is an alias for [shared.PointerAxisRelativeDirection].
+type PointerAxisRelativeDirection = shared.PointerAxisRelativeDirection
 
 const (
-	// physical motion matches axis direction
-	PointerAxisRelativeDirectionIdentical PointerAxisRelativeDirection = 0
-
-	// physical motion is the inverse of the axis direction
-	PointerAxisRelativeDirectionInverted PointerAxisRelativeDirection = 1
+	PointerAxisRelativeDirectionIdentical = shared.PointerAxisRelativeDirectionIdentical
+	PointerAxisRelativeDirectionInverted  = shared.PointerAxisRelativeDirectionInverted
 )
 
-func (enum PointerAxisRelativeDirection) String() string {
-	switch enum {
-	case 0:
-		return "PointerAxisRelativeDirectionIdentical"
-
-	case 1:
-		return "PointerAxisRelativeDirectionInverted"
-	}
+const (
+	KeyboardInterface = shared.KeyboardInterface
 
-	return "<invalid PointerAxisRelativeDirection>"
-}
+	// KeyboardVersion is the version of the interface that this
+	// package implements.
+	KeyboardVersion = 10
+)
 
+// The versions of the wl_keyboard interface that its messages were
+// added in.
 const (
-	KeyboardInterface = "wl_keyboard"
-	KeyboardVersion   = 10
+	KeyboardReleaseRequestSince  = 3
+	KeyboardKeymapEventSince     = 1
+	KeyboardEnterEventSince      = 1
+	KeyboardLeaveEventSince      = 1
+	KeyboardKeyEventSince        = 1
+	KeyboardModifiersEventSince  = 1
+	KeyboardRepeatInfoEventSince = 4
 )
 
+// KeyboardDesc describes the wl_keyboard interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var KeyboardDesc = &wire.InterfaceDesc{
+	Name:    KeyboardInterface,
+	Version: KeyboardVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "keymap",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+					Enum: "keymap_format",
+				},
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "size",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "keys",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "key",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "key",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "key_state",
+				},
+			},
+		},
+		{
+			Name:  "modifiers",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_depressed",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_latched",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mods_locked",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "group",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "repeat_info",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "rate",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "delay",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "keymap_format",
+			Entries: []wire.EntryDesc{
+				{Name: "no_keymap", Value: 0},
+				{Name: "xkb_v1", Value: 1},
+			},
+		},
+		{
+			Name: "key_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+				{Name: "repeated", Value: 2},
+			},
+		},
+	},
+}
+
 // KeyboardListener is a type that can respond to incoming
 // messages for a Keyboard object.
 type KeyboardListener interface {
+	// Available since version 3.
 	Release()
 }
 
+// KeyboardListenerFuncs implements KeyboardListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type KeyboardListenerFuncs struct {
+	// Available since version 3.
+	OnRelease func()
+}
+
+// Available since version 3.
+func (lis *KeyboardListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// KeyboardRequest is implemented by the message types of all
+// of the requests that a Keyboard can receive.
+type KeyboardRequest interface {
+	keyboardRequest()
+}
+
+// KeyboardRequestFunc implements KeyboardListener by calling
+// itself with each incoming message.
+type KeyboardRequestFunc func(KeyboardRequest)
+
+// Available since version 3.
+func (f KeyboardRequestFunc) Release() {
+	f(KeyboardReleaseRequest{})
+}
+
 // The wl_keyboard interface represents one or more keyboards
 // associated with a seat.
 //
@@ -5615,7 +11912,8 @@ func (obj *Keyboard) State() wire.State {
 func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m KeyboardReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5626,6 +11924,14 @@ func (obj *Keyboard) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(KeyboardInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_keyboard",
 		Type:      "request",
@@ -5664,6 +11970,28 @@ func (obj *Keyboard) Interface() string {
 	return KeyboardInterface
 }
 
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *KeyboardListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Keyboard) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*KeyboardListenerFuncs)
+	if !ok {
+		lis = new(KeyboardListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Keyboard) Subscribe() *wire.Messages[KeyboardRequest] {
+	q := new(wire.Messages[KeyboardRequest])
+	obj.Listener = KeyboardRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Keyboard) Version() uint32 {
 	return KeyboardVersion
 }
@@ -5675,15 +12003,24 @@ func (obj *Keyboard) Version() uint32 {
 // From version 7 onwards, the fd must be mapped with MAP_PRIVATE by
 // the recipient, as MAP_SHARED may fail.
 func (obj *Keyboard) Keymap(format KeyboardKeymapFormat, fd *os.File, size uint32) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(uint32(format))
-	builder.WriteFile(fd)
-	builder.WriteUint(size)
+	obj.state.Enqueue(KeyboardKeymapEvent{
+		Format: format,
+		Fd:     fd,
+		Size:   size,
+	}.Encode(obj))
+	return
+}
 
-	builder.Method = "keymap"
-	builder.Args = []any{format, fd, size}
-	obj.state.Enqueue(builder)
+// KeymapTransfer is like Keymap, but ownership of its files is
+// transferred to the message instead of their file descriptors
+// being duplicated. They are closed once the message has been sent
+// or has failed to be and must not be used afterwards.
+func (obj *Keyboard) KeymapTransfer(format KeyboardKeymapFormat, fd *os.File, size uint32) {
+	obj.state.Enqueue(KeyboardKeymapEvent{
+		Format: format,
+		Fd:     fd,
+		Size:   size,
+	}.EncodeTransfer(obj))
 	return
 }
 
@@ -5700,16 +12037,12 @@ func (obj *Keyboard) Keymap(format KeyboardKeymapFormat, fd *os.File, size uint3
 //
 // Clients should not use the list of pressed keys to emulate key-press
 // events. The order of keys in the list is unspecified.
-func (obj *Keyboard) Enter(serial uint32, surface *Surface, keys []byte) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-	builder.WriteArray(keys)
-
-	builder.Method = "enter"
-	builder.Args = []any{serial, surface, keys}
-	obj.state.Enqueue(builder)
+func (obj *Keyboard) Enter(serial uint32, surface *Surface, keys []uint32) {
+	obj.state.Enqueue(KeyboardEnterEvent{
+		Serial:  serial,
+		Surface: surface,
+		Keys:    keys,
+	}.Encode(obj))
 	return
 }
 
@@ -5724,14 +12057,10 @@ func (obj *Keyboard) Enter(serial uint32, surface *Surface, keys []byte) {
 // of the wl_keyboard was not equal to the surface argument immediately
 // before this event.
 func (obj *Keyboard) Leave(serial uint32, surface *Surface) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteUint(serial)
-	builder.WriteObject(surface)
-
-	builder.Method = "leave"
-	builder.Args = []any{serial, surface}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(KeyboardLeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	}.Encode(obj))
 	return
 }
 
@@ -5758,17 +12087,13 @@ func (obj *Keyboard) Leave(serial uint32, surface *Surface) {
 // key state when a wl_keyboard.repeat_info event with a rate argument of
 // 0 has been received. This allows the compositor to take over the
 // responsibility of key repetition.
-func (obj *Keyboard) Key(serial uint32, time uint32, key uint32, state KeyboardKeyState) {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.WriteUint(serial)
-	builder.WriteUint(time)
-	builder.WriteUint(key)
-	builder.WriteUint(uint32(state))
-
-	builder.Method = "key"
-	builder.Args = []any{serial, time, key, state}
-	obj.state.Enqueue(builder)
+func (obj *Keyboard) Key(serial uint32, time time.Duration, key uint32, state KeyboardKeyState) {
+	obj.state.Enqueue(KeyboardKeyEvent{
+		Serial: serial,
+		Time:   time,
+		Key:    key,
+		State:  state,
+	}.Encode(obj))
 	return
 }
 
@@ -5786,17 +12111,13 @@ func (obj *Keyboard) Key(serial uint32, time uint32, key uint32, state KeyboardK
 // In the wl_keyboard logical state, this event updates the modifiers and
 // group.
 func (obj *Keyboard) Modifiers(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32) {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.WriteUint(serial)
-	builder.WriteUint(modsDepressed)
-	builder.WriteUint(modsLatched)
-	builder.WriteUint(modsLocked)
-	builder.WriteUint(group)
-
-	builder.Method = "modifiers"
-	builder.Args = []any{serial, modsDepressed, modsLatched, modsLocked, group}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(KeyboardModifiersEvent{
+		Serial:        serial,
+		ModsDepressed: modsDepressed,
+		ModsLatched:   modsLatched,
+		ModsLocked:    modsLocked,
+		Group:         group,
+	}.Encode(obj))
 	return
 }
 
@@ -5812,90 +12133,530 @@ func (obj *Keyboard) Modifiers(serial uint32, modsDepressed uint32, modsLatched
 // This event can be sent later on as well with a new value if necessary,
 // so clients should continue listening for the event past the creation
 // of wl_keyboard.
+//
+// Available since version 4.
 func (obj *Keyboard) RepeatInfo(rate int32, delay int32) {
-	builder := wire.NewMessage(obj, 5)
+	obj.state.Enqueue(KeyboardRepeatInfoEvent{
+		Rate:  rate,
+		Delay: delay,
+	}.Encode(obj))
+	return
+}
+
+// KeyboardReleaseRequest holds the arguments of the release request of
+// the wl_keyboard interface.
+//
+// Available since version 3.
+type KeyboardReleaseRequest struct {
+}
 
-	builder.WriteInt(rate)
-	builder.WriteInt(delay)
+func (KeyboardReleaseRequest) keyboardRequest() {}
 
-	builder.Method = "repeat_info"
-	builder.Args = []any{rate, delay}
-	obj.state.Enqueue(builder)
-	return
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// This specifies the format of the keymap provided to the
-// client with the wl_keyboard.keymap event.
-type KeyboardKeymapFormat int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardReleaseRequest) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-const (
-	// no keymap; client must understand how to interpret the raw keycode
-	KeyboardKeymapFormatNoKeymap KeyboardKeymapFormat = 0
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// KeyboardKeymapEvent holds the arguments of the keymap event of
+// the wl_keyboard interface.
+type KeyboardKeymapEvent struct {
+	Format KeyboardKeymapFormat
+	Fd     *os.File
+	Size   uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardKeymapEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = KeyboardKeymapFormat(msg.ReadUint())
+	m.Fd = msg.ReadFile()
+	m.Size = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Format.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_keyboard",
+			Method:    "keymap",
+			Arg:       "format",
+			Value:     int64(m.Format),
+		}
+	}
+	return nil
+}
 
-	// libxkbcommon compatible, null-terminated string; to determine the xkb keycode, clients must add 8 to the key event keycode
-	KeyboardKeymapFormatXkbV1 KeyboardKeymapFormat = 1
-)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m KeyboardKeymapEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
 
-func (enum KeyboardKeymapFormat) String() string {
-	switch enum {
-	case 0:
-		return "KeyboardKeymapFormatNoKeymap"
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m KeyboardKeymapEvent) EncodeTransfer(obj *Keyboard) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
 
-	case 1:
-		return "KeyboardKeymapFormatXkbV1"
+func (m KeyboardKeymapEvent) encode(obj *Keyboard, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Format))
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
 	}
+	builder.WriteUint(m.Size)
 
-	return "<invalid KeyboardKeymapFormat>"
+	builder.Method = "keymap"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format, m.Fd, m.Size}
+	}
+	return builder
+}
+
+// KeyboardEnterEvent holds the arguments of the enter event of
+// the wl_keyboard interface.
+type KeyboardEnterEvent struct {
+	Serial  uint32
+	Surface *Surface
+	Keys    []uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardEnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Keys = wire.Uint32s(msg.ReadArray())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Describes the physical state of a key that produced the key event.
-//
-// Since version 10, the key can be in a "repeated" pseudo-state which
-// means the same as "pressed", but is used to signal repetition in the
-// key event.
-//
-// The key may only enter the repeated state after entering the pressed
-// state and before entering the released state. This event may be
-// generated multiple times while the key is down.
-type KeyboardKeyState int64
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardEnterEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+	builder.WriteArray(wire.FromUint32s(m.Keys))
 
-const (
-	// key is not pressed
-	KeyboardKeyStateReleased KeyboardKeyState = 0
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, wire.FromUint32s(m.Keys)}
+	}
+	return builder
+}
+
+// KeyboardLeaveEvent holds the arguments of the leave event of
+// the wl_keyboard interface.
+type KeyboardLeaveEvent struct {
+	Serial  uint32
+	Surface *Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardLeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// key is pressed
-	KeyboardKeyStatePressed KeyboardKeyState = 1
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardLeaveEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
 
-	// key was repeated
-	KeyboardKeyStateRepeated KeyboardKeyState = 2
-)
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface}
+	}
+	return builder
+}
+
+// KeyboardKeyEvent holds the arguments of the key event of
+// the wl_keyboard interface.
+type KeyboardKeyEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Key    uint32
+	State  KeyboardKeyState
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardKeyEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Key = msg.ReadUint()
+	m.State = KeyboardKeyState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.State.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_keyboard",
+			Method:    "key",
+			Arg:       "state",
+			Value:     int64(m.State),
+		}
+	}
+	return nil
+}
 
-func (enum KeyboardKeyState) String() string {
-	switch enum {
-	case 0:
-		return "KeyboardKeyStateReleased"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardKeyEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteUint(m.Key)
+	builder.WriteUint(uint32(m.State))
 
-	case 1:
-		return "KeyboardKeyStatePressed"
+	builder.Method = "key"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Key, m.State}
+	}
+	return builder
+}
+
+// KeyboardModifiersEvent holds the arguments of the modifiers event of
+// the wl_keyboard interface.
+type KeyboardModifiersEvent struct {
+	Serial        uint32
+	ModsDepressed uint32
+	ModsLatched   uint32
+	ModsLocked    uint32
+	Group         uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardModifiersEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.ModsDepressed = msg.ReadUint()
+	m.ModsLatched = msg.ReadUint()
+	m.ModsLocked = msg.ReadUint()
+	m.Group = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 2:
-		return "KeyboardKeyStateRepeated"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardModifiersEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(m.ModsDepressed)
+	builder.WriteUint(m.ModsLatched)
+	builder.WriteUint(m.ModsLocked)
+	builder.WriteUint(m.Group)
+
+	builder.Method = "modifiers"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.ModsDepressed, m.ModsLatched, m.ModsLocked, m.Group}
+	}
+	return builder
+}
+
+// KeyboardRepeatInfoEvent holds the arguments of the repeat_info event of
+// the wl_keyboard interface.
+//
+// Available since version 4.
+type KeyboardRepeatInfoEvent struct {
+	Rate  int32
+	Delay int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *KeyboardRepeatInfoEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Rate = msg.ReadInt()
+	m.Delay = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m KeyboardRepeatInfoEvent) Encode(obj *Keyboard) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteInt(m.Rate)
+	builder.WriteInt(m.Delay)
 
-	return "<invalid KeyboardKeyState>"
+	builder.Method = "repeat_info"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Rate, m.Delay}
+	}
+	return builder
 }
 
+// KeyboardKeymapFormat is an alias for [shared.KeyboardKeymapFormat].
+type KeyboardKeymapFormat = shared.KeyboardKeymapFormat
+
 const (
-	TouchInterface = "wl_touch"
-	TouchVersion   = 10
+	KeyboardKeymapFormatNoKeymap = shared.KeyboardKeymapFormatNoKeymap
+	KeyboardKeymapFormatXkbV1    = shared.KeyboardKeymapFormatXkbV1
 )
 
+// KeyboardKeyState is an alias for [shared.KeyboardKeyState].
+type KeyboardKeyState = shared.KeyboardKeyState
+
+const (
+	KeyboardKeyStateReleased = shared.KeyboardKeyStateReleased
+	KeyboardKeyStatePressed  = shared.KeyboardKeyStatePressed
+	// Available since version 10.
+	KeyboardKeyStateRepeated = shared.KeyboardKeyStateRepeated
+)
+
+const (
+	TouchInterface = shared.TouchInterface
+
+	// TouchVersion is the version of the interface that this
+	// package implements.
+	TouchVersion = 10
+)
+
+// The versions of the wl_touch interface that its messages were
+// added in.
+const (
+	TouchReleaseRequestSince   = 3
+	TouchDownEventSince        = 1
+	TouchUpEventSince          = 1
+	TouchMotionEventSince      = 1
+	TouchFrameEventSince       = 1
+	TouchCancelEventSince      = 1
+	TouchShapeEventSince       = 6
+	TouchOrientationEventSince = 6
+)
+
+// TouchDesc describes the wl_touch interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TouchDesc = &wire.InterfaceDesc{
+	Name:    TouchInterface,
+	Version: TouchVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "down",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "up",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "cancel",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "shape",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "major",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "minor",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "orientation",
+			Since: 6,
+			Args: []wire.ArgDesc{
+				{
+					Name: "id",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "orientation",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
 // TouchListener is a type that can respond to incoming
 // messages for a Touch object.
 type TouchListener interface {
+	// Available since version 3.
 	Release()
 }
 
+// TouchListenerFuncs implements TouchListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TouchListenerFuncs struct {
+	// Available since version 3.
+	OnRelease func()
+}
+
+// Available since version 3.
+func (lis *TouchListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// TouchRequest is implemented by the message types of all
+// of the requests that a Touch can receive.
+type TouchRequest interface {
+	touchRequest()
+}
+
+// TouchRequestFunc implements TouchListener by calling
+// itself with each incoming message.
+type TouchRequestFunc func(TouchRequest)
+
+// Available since version 3.
+func (f TouchRequestFunc) Release() {
+	f(TouchReleaseRequest{})
+}
+
 // The wl_touch interface represents a touchscreen
 // associated with a seat.
 //
@@ -5931,7 +12692,8 @@ func (obj *Touch) State() wire.State {
 func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m TouchReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -5942,6 +12704,14 @@ func (obj *Touch) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TouchInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_touch",
 		Type:      "request",
@@ -5980,6 +12750,28 @@ func (obj *Touch) Interface() string {
 	return TouchInterface
 }
 
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *TouchListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Touch) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*TouchListenerFuncs)
+	if !ok {
+		lis = new(TouchListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Touch) Subscribe() *wire.Messages[TouchRequest] {
+	q := new(wire.Messages[TouchRequest])
+	obj.Listener = TouchRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Touch) Version() uint32 {
 	return TouchVersion
 }
@@ -5988,50 +12780,38 @@ func (obj *Touch) Version() uint32 {
 // assigned a unique ID. Future events from this touch point reference
 // this ID. The ID ceases to be valid after a touch up event and may be
 // reused in the future.
-func (obj *Touch) Down(serial uint32, time uint32, surface *Surface, id int32, x wire.Fixed, y wire.Fixed) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteUint(serial)
-	builder.WriteUint(time)
-	builder.WriteObject(surface)
-	builder.WriteInt(id)
-	builder.WriteFixed(x)
-	builder.WriteFixed(y)
-
-	builder.Method = "down"
-	builder.Args = []any{serial, time, surface, id, x, y}
-	obj.state.Enqueue(builder)
+func (obj *Touch) Down(serial uint32, time time.Duration, surface *Surface, id int32, x wire.Fixed, y wire.Fixed) {
+	obj.state.Enqueue(TouchDownEvent{
+		Serial:  serial,
+		Time:    time,
+		Surface: surface,
+		Id:      id,
+		X:       x,
+		Y:       y,
+	}.Encode(obj))
 	return
 }
 
 // The touch point has disappeared. No further events will be sent for
 // this touch point and the touch point's ID is released and may be
 // reused in a future touch down event.
-func (obj *Touch) Up(serial uint32, time uint32, id int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(serial)
-	builder.WriteUint(time)
-	builder.WriteInt(id)
-
-	builder.Method = "up"
-	builder.Args = []any{serial, time, id}
-	obj.state.Enqueue(builder)
+func (obj *Touch) Up(serial uint32, time time.Duration, id int32) {
+	obj.state.Enqueue(TouchUpEvent{
+		Serial: serial,
+		Time:   time,
+		Id:     id,
+	}.Encode(obj))
 	return
 }
 
 // A touch point has changed coordinates.
-func (obj *Touch) Motion(time uint32, id int32, x wire.Fixed, y wire.Fixed) {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.WriteUint(time)
-	builder.WriteInt(id)
-	builder.WriteFixed(x)
-	builder.WriteFixed(y)
-
-	builder.Method = "motion"
-	builder.Args = []any{time, id, x, y}
-	obj.state.Enqueue(builder)
+func (obj *Touch) Motion(time time.Duration, id int32, x wire.Fixed, y wire.Fixed) {
+	obj.state.Enqueue(TouchMotionEvent{
+		Time: time,
+		Id:   id,
+		X:    x,
+		Y:    y,
+	}.Encode(obj))
 	return
 }
 
@@ -6044,11 +12824,7 @@ func (obj *Touch) Motion(time uint32, id int32, x wire.Fixed, y wire.Fixed) {
 // must assume that any state not updated in a frame is unchanged from the
 // previously known state.
 func (obj *Touch) Frame() {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.Method = "frame"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(TouchFrameEvent{}.Encode(obj))
 	return
 }
 
@@ -6061,11 +12837,7 @@ func (obj *Touch) Frame() {
 //
 // No frame event is required after the cancel event.
 func (obj *Touch) Cancel() {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.Method = "cancel"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(TouchCancelEvent{}.Encode(obj))
 	return
 }
 
@@ -6094,67 +12866,560 @@ func (obj *Touch) Cancel() {
 // This event is only sent by the compositor if the touch device supports
 // shape reports. The client has to make reasonable assumptions about the
 // shape if it did not receive this event.
+//
+// Available since version 6.
 func (obj *Touch) Shape(id int32, major wire.Fixed, minor wire.Fixed) {
-	builder := wire.NewMessage(obj, 5)
+	obj.state.Enqueue(TouchShapeEvent{
+		Id:    id,
+		Major: major,
+		Minor: minor,
+	}.Encode(obj))
+	return
+}
+
+// Sent when a touchpoint has changed its orientation.
+//
+// This event does not occur on its own. It is sent before a
+// wl_touch.frame event and carries the new shape information for
+// any previously reported, or new touch points of that frame.
+//
+// Other events describing the touch point such as wl_touch.down,
+// wl_touch.motion or wl_touch.shape may be sent within the
+// same wl_touch.frame. A client should treat these events as a single
+// logical touch point update. The order of wl_touch.shape,
+// wl_touch.orientation and wl_touch.motion is not guaranteed.
+// A wl_touch.down event is guaranteed to occur before the first
+// wl_touch.orientation event for this touch ID but both events may occur
+// within the same wl_touch.frame.
+//
+// The orientation describes the clockwise angle of a touchpoint's major
+// axis to the positive surface y-axis and is normalized to the -180 to
+// +180 degree range. The granularity of orientation depends on the touch
+// device, some devices only support binary rotation values between 0 and
+// 90 degrees.
+//
+// This event is only sent by the compositor if the touch device supports
+// orientation reports.
+//
+// Available since version 6.
+func (obj *Touch) Orientation(id int32, orientation wire.Fixed) {
+	obj.state.Enqueue(TouchOrientationEvent{
+		Id:          id,
+		Orientation: orientation,
+	}.Encode(obj))
+	return
+}
+
+// TouchReleaseRequest holds the arguments of the release request of
+// the wl_touch interface.
+//
+// Available since version 3.
+type TouchReleaseRequest struct {
+}
+
+func (TouchReleaseRequest) touchRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchReleaseRequest) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TouchDownEvent holds the arguments of the down event of
+// the wl_touch interface.
+type TouchDownEvent struct {
+	Serial  uint32
+	Time    time.Duration
+	Surface *Surface
+	Id      int32
+	X       wire.Fixed
+	Y       wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchDownEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Id = msg.ReadInt()
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchDownEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteObject(m.Surface)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+
+	builder.Method = "down"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Surface, m.Id, m.X, m.Y}
+	}
+	return builder
+}
+
+// TouchUpEvent holds the arguments of the up event of
+// the wl_touch interface.
+type TouchUpEvent struct {
+	Serial uint32
+	Time   time.Duration
+	Id     int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchUpEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Id = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchUpEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteInt(m.Id)
+
+	builder.Method = "up"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, wire.ToMillis(m.Time), m.Id}
+	}
+	return builder
+}
+
+// TouchMotionEvent holds the arguments of the motion event of
+// the wl_touch interface.
+type TouchMotionEvent struct {
+	Time time.Duration
+	Id   int32
+	X    wire.Fixed
+	Y    wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchMotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = wire.Millis(msg.ReadUint())
+	m.Id = msg.ReadInt()
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchMotionEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(wire.ToMillis(m.Time))
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.ToMillis(m.Time), m.Id, m.X, m.Y}
+	}
+	return builder
+}
+
+// TouchFrameEvent holds the arguments of the frame event of
+// the wl_touch interface.
+type TouchFrameEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchFrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchFrameEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TouchCancelEvent holds the arguments of the cancel event of
+// the wl_touch interface.
+type TouchCancelEvent struct {
+}
 
-	builder.WriteInt(id)
-	builder.WriteFixed(major)
-	builder.WriteFixed(minor)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchCancelEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchCancelEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "cancel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TouchShapeEvent holds the arguments of the shape event of
+// the wl_touch interface.
+//
+// Available since version 6.
+type TouchShapeEvent struct {
+	Id    int32
+	Major wire.Fixed
+	Minor wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchShapeEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadInt()
+	m.Major = msg.ReadFixed()
+	m.Minor = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchShapeEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.Major)
+	builder.WriteFixed(m.Minor)
 
 	builder.Method = "shape"
-	builder.Args = []any{id, major, minor}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id, m.Major, m.Minor}
+	}
+	return builder
 }
 
-// Sent when a touchpoint has changed its orientation.
-//
-// This event does not occur on its own. It is sent before a
-// wl_touch.frame event and carries the new shape information for
-// any previously reported, or new touch points of that frame.
-//
-// Other events describing the touch point such as wl_touch.down,
-// wl_touch.motion or wl_touch.shape may be sent within the
-// same wl_touch.frame. A client should treat these events as a single
-// logical touch point update. The order of wl_touch.shape,
-// wl_touch.orientation and wl_touch.motion is not guaranteed.
-// A wl_touch.down event is guaranteed to occur before the first
-// wl_touch.orientation event for this touch ID but both events may occur
-// within the same wl_touch.frame.
-//
-// The orientation describes the clockwise angle of a touchpoint's major
-// axis to the positive surface y-axis and is normalized to the -180 to
-// +180 degree range. The granularity of orientation depends on the touch
-// device, some devices only support binary rotation values between 0 and
-// 90 degrees.
+// TouchOrientationEvent holds the arguments of the orientation event of
+// the wl_touch interface.
 //
-// This event is only sent by the compositor if the touch device supports
-// orientation reports.
-func (obj *Touch) Orientation(id int32, orientation wire.Fixed) {
-	builder := wire.NewMessage(obj, 6)
+// Available since version 6.
+type TouchOrientationEvent struct {
+	Id          int32
+	Orientation wire.Fixed
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TouchOrientationEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = msg.ReadInt()
+	m.Orientation = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	builder.WriteInt(id)
-	builder.WriteFixed(orientation)
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TouchOrientationEvent) Encode(obj *Touch) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteInt(m.Id)
+	builder.WriteFixed(m.Orientation)
 
 	builder.Method = "orientation"
-	builder.Args = []any{id, orientation}
-	obj.state.Enqueue(builder)
-	return
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Id, m.Orientation}
+	}
+	return builder
 }
 
 const (
-	OutputInterface = "wl_output"
-	OutputVersion   = 4
+	OutputInterface = shared.OutputInterface
+
+	// OutputVersion is the version of the interface that this
+	// package implements.
+	OutputVersion = 4
+)
+
+// The versions of the wl_output interface that its messages were
+// added in.
+const (
+	OutputReleaseRequestSince   = 3
+	OutputGeometryEventSince    = 1
+	OutputModeEventSince        = 1
+	OutputDoneEventSince        = 2
+	OutputScaleEventSince       = 2
+	OutputNameEventSince        = 4
+	OutputDescriptionEventSince = 4
 )
 
+// OutputDesc describes the wl_output interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var OutputDesc = &wire.InterfaceDesc{
+	Name:    OutputInterface,
+	Version: OutputVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "release",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "geometry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "physical_width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "physical_height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "subpixel",
+					Type: wire.ArgInt,
+					Enum: "subpixel",
+				},
+				{
+					Name: "make",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "model",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "transform",
+					Type: wire.ArgInt,
+					Enum: "transform",
+				},
+			},
+		},
+		{
+			Name:  "mode",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "mode",
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "refresh",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 2,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "scale",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name: "factor",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "description",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "description",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "subpixel",
+			Entries: []wire.EntryDesc{
+				{Name: "unknown", Value: 0},
+				{Name: "none", Value: 1},
+				{Name: "horizontal_rgb", Value: 2},
+				{Name: "horizontal_bgr", Value: 3},
+				{Name: "vertical_rgb", Value: 4},
+				{Name: "vertical_bgr", Value: 5},
+			},
+		},
+		{
+			Name: "transform",
+			Entries: []wire.EntryDesc{
+				{Name: "normal", Value: 0},
+				{Name: "90", Value: 1},
+				{Name: "180", Value: 2},
+				{Name: "270", Value: 3},
+				{Name: "flipped", Value: 4},
+				{Name: "flipped_90", Value: 5},
+				{Name: "flipped_180", Value: 6},
+				{Name: "flipped_270", Value: 7},
+			},
+		},
+		{
+			Name:     "mode",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "current", Value: 1},
+				{Name: "preferred", Value: 2},
+			},
+		},
+	},
+}
+
 // OutputListener is a type that can respond to incoming
 // messages for a Output object.
 type OutputListener interface {
 	// Using this request a client can tell the server that it is not going to
 	// use the output object anymore.
+	//
+	// Available since version 3.
 	Release()
 }
 
+// OutputListenerFuncs implements OutputListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type OutputListenerFuncs struct {
+	// Available since version 3.
+	OnRelease func()
+}
+
+// Available since version 3.
+func (lis *OutputListenerFuncs) Release() {
+	if lis.OnRelease != nil {
+		lis.OnRelease()
+	}
+}
+
+// OutputRequest is implemented by the message types of all
+// of the requests that a Output can receive.
+type OutputRequest interface {
+	outputRequest()
+}
+
+// OutputRequestFunc implements OutputListener by calling
+// itself with each incoming message.
+type OutputRequestFunc func(OutputRequest)
+
+// Available since version 3.
+func (f OutputRequestFunc) Release() {
+	f(OutputReleaseRequest{})
+}
+
 // An output describes part of the compositor geometry.  The
 // compositor works in the 'compositor coordinate system' and an
 // output corresponds to a rectangular area in that space that is
@@ -6195,7 +13460,8 @@ func (obj *Output) State() wire.State {
 func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m OutputReleaseRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6206,6 +13472,14 @@ func (obj *Output) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(OutputInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_output",
 		Type:      "request",
@@ -6244,6 +13518,28 @@ func (obj *Output) Interface() string {
 	return OutputInterface
 }
 
+// OnRelease sets the function that is called when the
+// release request is received. If obj's Listener is not a
+// *OutputListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Output) OnRelease(f func()) {
+	lis, ok := obj.Listener.(*OutputListenerFuncs)
+	if !ok {
+		lis = new(OutputListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRelease = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Output) Subscribe() *wire.Messages[OutputRequest] {
+	q := new(wire.Messages[OutputRequest])
+	obj.Listener = OutputRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Output) Version() uint32 {
 	return OutputVersion
 }
@@ -6269,20 +13565,16 @@ func (obj *Output) Version() uint32 {
 // should use xdg_output.logical_position. Instead of using make and model,
 // clients should use name and description.
 func (obj *Output) Geometry(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel OutputSubpixel, make string, model string, transform OutputTransform) {
-	builder := wire.NewMessage(obj, 0)
-
-	builder.WriteInt(x)
-	builder.WriteInt(y)
-	builder.WriteInt(physicalWidth)
-	builder.WriteInt(physicalHeight)
-	builder.WriteInt(int32(subpixel))
-	builder.WriteString(make)
-	builder.WriteString(model)
-	builder.WriteInt(int32(transform))
-
-	builder.Method = "geometry"
-	builder.Args = []any{x, y, physicalWidth, physicalHeight, subpixel, make, model, transform}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputGeometryEvent{
+		X:              x,
+		Y:              y,
+		PhysicalWidth:  physicalWidth,
+		PhysicalHeight: physicalHeight,
+		Subpixel:       subpixel,
+		Make:           make,
+		Model:          model,
+		Transform:      transform,
+	}.Encode(obj))
 	return
 }
 
@@ -6320,16 +13612,12 @@ func (obj *Output) Geometry(x int32, y int32, physicalWidth int32, physicalHeigh
 // compositors, such as those exposing virtual outputs, might fake the
 // refresh rate or the size.
 func (obj *Output) Mode(flags OutputMode, width int32, height int32, refresh int32) {
-	builder := wire.NewMessage(obj, 1)
-
-	builder.WriteUint(uint32(flags))
-	builder.WriteInt(width)
-	builder.WriteInt(height)
-	builder.WriteInt(refresh)
-
-	builder.Method = "mode"
-	builder.Args = []any{flags, width, height, refresh}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputModeEvent{
+		Flags:   flags,
+		Width:   width,
+		Height:  height,
+		Refresh: refresh,
+	}.Encode(obj))
 	return
 }
 
@@ -6338,12 +13626,10 @@ func (obj *Output) Mode(flags OutputMode, width int32, height int32, refresh int
 // other property changes done after that. This allows
 // changes to the output properties to be seen as
 // atomic, even if they happen via multiple events.
+//
+// Available since version 2.
 func (obj *Output) Done() {
-	builder := wire.NewMessage(obj, 2)
-
-	builder.Method = "done"
-	builder.Args = []any{}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputDoneEvent{}.Encode(obj))
 	return
 }
 
@@ -6365,14 +13651,12 @@ func (obj *Output) Done() {
 // scale to use for a surface.
 //
 // The scale event will be followed by a done event.
+//
+// Available since version 2.
 func (obj *Output) Scale(factor int32) {
-	builder := wire.NewMessage(obj, 3)
-
-	builder.WriteInt(factor)
-
-	builder.Method = "scale"
-	builder.Args = []any{factor}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputScaleEvent{
+		Factor: factor,
+	}.Encode(obj))
 	return
 }
 
@@ -6404,14 +13688,12 @@ func (obj *Output) Scale(factor int32) {
 // same name if possible.
 //
 // The name event will be followed by a done event.
+//
+// Available since version 4.
 func (obj *Output) Name(name string) {
-	builder := wire.NewMessage(obj, 4)
-
-	builder.WriteString(name)
-
-	builder.Method = "name"
-	builder.Args = []any{name}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputNameEvent{
+		Name: name,
+	}.Encode(obj))
 	return
 }
 
@@ -6429,162 +13711,416 @@ func (obj *Output) Name(name string) {
 // not be sent at all.
 //
 // The description event will be followed by a done event.
+//
+// Available since version 4.
 func (obj *Output) Description(description string) {
-	builder := wire.NewMessage(obj, 5)
-
-	builder.WriteString(description)
-
-	builder.Method = "description"
-	builder.Args = []any{description}
-	obj.state.Enqueue(builder)
+	obj.state.Enqueue(OutputDescriptionEvent{
+		Description: description,
+	}.Encode(obj))
 	return
 }
 
-// This enumeration describes how the physical
-// pixels on an output are laid out.
-type OutputSubpixel int64
-
-const (
-	// unknown geometry
-	OutputSubpixelUnknown OutputSubpixel = 0
-
-	// no geometry
-	OutputSubpixelNone OutputSubpixel = 1
-
-	// horizontal RGB
-	OutputSubpixelHorizontalRgb OutputSubpixel = 2
-
-	// horizontal BGR
-	OutputSubpixelHorizontalBgr OutputSubpixel = 3
+// OutputReleaseRequest holds the arguments of the release request of
+// the wl_output interface.
+//
+// Available since version 3.
+type OutputReleaseRequest struct {
+}
 
-	// vertical RGB
-	OutputSubpixelVerticalRgb OutputSubpixel = 4
+func (OutputReleaseRequest) outputRequest() {}
 
-	// vertical BGR
-	OutputSubpixelVerticalBgr OutputSubpixel = 5
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputReleaseRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum OutputSubpixel) String() string {
-	switch enum {
-	case 0:
-		return "OutputSubpixelUnknown"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputReleaseRequest) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	case 1:
-		return "OutputSubpixelNone"
+	builder.Method = "release"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// OutputGeometryEvent holds the arguments of the geometry event of
+// the wl_output interface.
+type OutputGeometryEvent struct {
+	X              int32
+	Y              int32
+	PhysicalWidth  int32
+	PhysicalHeight int32
+	Subpixel       OutputSubpixel
+	Make           string
+	Model          string
+	Transform      OutputTransform
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputGeometryEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.PhysicalWidth = msg.ReadInt()
+	m.PhysicalHeight = msg.ReadInt()
+	m.Subpixel = OutputSubpixel(msg.ReadInt())
+	m.Make = msg.ReadString()
+	m.Model = msg.ReadString()
+	m.Transform = OutputTransform(msg.ReadInt())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Subpixel.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_output",
+			Method:    "geometry",
+			Arg:       "subpixel",
+			Value:     int64(m.Subpixel),
+		}
+	}
+	if !m.Transform.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_output",
+			Method:    "geometry",
+			Arg:       "transform",
+			Value:     int64(m.Transform),
+		}
+	}
+	return nil
+}
 
-	case 2:
-		return "OutputSubpixelHorizontalRgb"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputGeometryEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.PhysicalWidth)
+	builder.WriteInt(m.PhysicalHeight)
+	builder.WriteInt(int32(m.Subpixel))
+	builder.WriteString(m.Make)
+	builder.WriteString(m.Model)
+	builder.WriteInt(int32(m.Transform))
 
-	case 3:
-		return "OutputSubpixelHorizontalBgr"
+	builder.Method = "geometry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.PhysicalWidth, m.PhysicalHeight, m.Subpixel, m.Make, m.Model, m.Transform}
+	}
+	return builder
+}
+
+// OutputModeEvent holds the arguments of the mode event of
+// the wl_output interface.
+type OutputModeEvent struct {
+	Flags   OutputMode
+	Width   int32
+	Height  int32
+	Refresh int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputModeEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Flags = OutputMode(msg.ReadUint())
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Refresh = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Flags.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wl_output",
+			Method:    "mode",
+			Arg:       "flags",
+			Value:     int64(m.Flags),
+		}
+	}
+	return nil
+}
 
-	case 4:
-		return "OutputSubpixelVerticalRgb"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputModeEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.Flags))
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteInt(m.Refresh)
 
-	case 5:
-		return "OutputSubpixelVerticalBgr"
+	builder.Method = "mode"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Flags, m.Width, m.Height, m.Refresh}
 	}
-
-	return "<invalid OutputSubpixel>"
+	return builder
 }
 
-// This describes transformations that clients and compositors apply to
-// buffer contents.
-//
-// The flipped values correspond to an initial flip around a
-// vertical axis followed by rotation.
+// OutputDoneEvent holds the arguments of the done event of
+// the wl_output interface.
 //
-// The purpose is mainly to allow clients to render accordingly and
-// tell the compositor, so that for fullscreen surfaces, the
-// compositor will still be able to scan out directly from client
-// surfaces.
-type OutputTransform int64
-
-const (
-	// no transform
-	OutputTransformNormal OutputTransform = 0
+// Available since version 2.
+type OutputDoneEvent struct {
+}
 
-	// 90 degrees counter-clockwise
-	OutputTransform90 OutputTransform = 1
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// 180 degrees counter-clockwise
-	OutputTransform180 OutputTransform = 2
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputDoneEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
 
-	// 270 degrees counter-clockwise
-	OutputTransform270 OutputTransform = 3
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
 
-	// 180 degree flip around a vertical axis
-	OutputTransformFlipped OutputTransform = 4
+// OutputScaleEvent holds the arguments of the scale event of
+// the wl_output interface.
+//
+// Available since version 2.
+type OutputScaleEvent struct {
+	Factor int32
+}
 
-	// flip and rotate 90 degrees counter-clockwise
-	OutputTransformFlipped90 OutputTransform = 5
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputScaleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Factor = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// flip and rotate 180 degrees counter-clockwise
-	OutputTransformFlipped180 OutputTransform = 6
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputScaleEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteInt(m.Factor)
 
-	// flip and rotate 270 degrees counter-clockwise
-	OutputTransformFlipped270 OutputTransform = 7
-)
+	builder.Method = "scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Factor}
+	}
+	return builder
+}
 
-func (enum OutputTransform) String() string {
-	switch enum {
-	case 0:
-		return "OutputTransformNormal"
+// OutputNameEvent holds the arguments of the name event of
+// the wl_output interface.
+//
+// Available since version 4.
+type OutputNameEvent struct {
+	Name string
+}
 
-	case 1:
-		return "OutputTransform90"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputNameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 2:
-		return "OutputTransform180"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputNameEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteString(m.Name)
 
-	case 3:
-		return "OutputTransform270"
+	builder.Method = "name"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
+}
 
-	case 4:
-		return "OutputTransformFlipped"
+// OutputDescriptionEvent holds the arguments of the description event of
+// the wl_output interface.
+//
+// Available since version 4.
+type OutputDescriptionEvent struct {
+	Description string
+}
 
-	case 5:
-		return "OutputTransformFlipped90"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *OutputDescriptionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Description = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-	case 6:
-		return "OutputTransformFlipped180"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m OutputDescriptionEvent) Encode(obj *Output) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteString(m.Description)
 
-	case 7:
-		return "OutputTransformFlipped270"
+	builder.Method = "description"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Description}
 	}
-
-	return "<invalid OutputTransform>"
+	return builder
 }
 
-// These flags describe properties of an output mode.
-// They are used in the flags bitfield of the mode event.
-type OutputMode int64
+// OutputSubpixel is an alias for [shared.OutputSubpixel].
+type OutputSubpixel = shared.OutputSubpixel
 
 const (
-	// indicates this is the current mode
-	OutputModeCurrent OutputMode = 1
+	OutputSubpixelUnknown       = shared.OutputSubpixelUnknown
+	OutputSubpixelNone          = shared.OutputSubpixelNone
+	OutputSubpixelHorizontalRgb = shared.OutputSubpixelHorizontalRgb
+	OutputSubpixelHorizontalBgr = shared.OutputSubpixelHorizontalBgr
+	OutputSubpixelVerticalRgb   = shared.OutputSubpixelVerticalRgb
+	OutputSubpixelVerticalBgr   = shared.OutputSubpixelVerticalBgr
+)
+
+// OutputTransform is an alias for [shared.OutputTransform].
+type OutputTransform = shared.OutputTransform
 
-	// indicates this is the preferred mode
-	OutputModePreferred OutputMode = 2
+const (
+	OutputTransformNormal     = shared.OutputTransformNormal
+	OutputTransform90         = shared.OutputTransform90
+	OutputTransform180        = shared.OutputTransform180
+	OutputTransform270        = shared.OutputTransform270
+	OutputTransformFlipped    = shared.OutputTransformFlipped
+	OutputTransformFlipped90  = shared.OutputTransformFlipped90
+	OutputTransformFlipped180 = shared.OutputTransformFlipped180
+	OutputTransformFlipped270 = shared.OutputTransformFlipped270
 )
 
-func (enum OutputMode) String() string {
-	switch enum {
-	case 1:
-		return "OutputModeCurrent"
+// OutputMode is an alias for [shared.OutputMode].
+type OutputMode = shared.OutputMode
 
-	case 2:
-		return "OutputModePreferred"
-	}
+const (
+	OutputModeCurrent   = shared.OutputModeCurrent
+	OutputModePreferred = shared.OutputModePreferred
+)
 
-	return "<invalid OutputMode>"
-}
+const (
+	RegionInterface = shared.RegionInterface
 
+	// RegionVersion is the version of the interface that this
+	// package implements.
+	RegionVersion = 1
+)
+
+// The versions of the wl_region interface that its messages were
+// added in.
 const (
-	RegionInterface = "wl_region"
-	RegionVersion   = 1
+	RegionDestroyRequestSince  = 1
+	RegionAddRequestSince      = 1
+	RegionSubtractRequestSince = 1
 )
 
+// RegionDesc describes the wl_region interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var RegionDesc = &wire.InterfaceDesc{
+	Name:    RegionInterface,
+	Version: RegionVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "add",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "subtract",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // RegionListener is a type that can respond to incoming
 // messages for a Region object.
 type RegionListener interface {
@@ -6598,6 +14134,65 @@ type RegionListener interface {
 	Subtract(x int32, y int32, width int32, height int32)
 }
 
+// RegionListenerFuncs implements RegionListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type RegionListenerFuncs struct {
+	OnDestroy  func()
+	OnAdd      func(x int32, y int32, width int32, height int32)
+	OnSubtract func(x int32, y int32, width int32, height int32)
+}
+
+func (lis *RegionListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *RegionListenerFuncs) Add(x int32, y int32, width int32, height int32) {
+	if lis.OnAdd != nil {
+		lis.OnAdd(x, y, width, height)
+	}
+}
+
+func (lis *RegionListenerFuncs) Subtract(x int32, y int32, width int32, height int32) {
+	if lis.OnSubtract != nil {
+		lis.OnSubtract(x, y, width, height)
+	}
+}
+
+// RegionRequest is implemented by the message types of all
+// of the requests that a Region can receive.
+type RegionRequest interface {
+	regionRequest()
+}
+
+// RegionRequestFunc implements RegionListener by calling
+// itself with each incoming message.
+type RegionRequestFunc func(RegionRequest)
+
+func (f RegionRequestFunc) Destroy() {
+	f(RegionDestroyRequest{})
+}
+
+func (f RegionRequestFunc) Add(x int32, y int32, width int32, height int32) {
+	f(RegionAddRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f RegionRequestFunc) Subtract(x int32, y int32, width int32, height int32) {
+	f(RegionSubtractRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
 // A region object describes an area.
 //
 // Region objects are used to describe the opaque and input
@@ -6629,7 +14224,8 @@ func (obj *Region) State() wire.State {
 func (obj *Region) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m RegionDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6640,16 +14236,8 @@ func (obj *Region) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 1:
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m RegionAddRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6657,24 +14245,16 @@ func (obj *Region) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Add(
-			x,
-			y,
-			width,
-			height,
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
 		)
 		return nil
 
 	case 2:
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		width := msg.ReadInt()
-
-		height := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m RegionSubtractRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6682,14 +14262,22 @@ func (obj *Region) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.Subtract(
-			x,
-			y,
-			width,
-			height,
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(RegionInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_region",
 		Type:      "request",
@@ -6730,19 +14318,238 @@ func (obj *Region) MethodName(op uint16) string {
 	return "unknown method"
 }
 
-func (obj *Region) Interface() string {
-	return RegionInterface
+func (obj *Region) Interface() string {
+	return RegionInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *RegionListenerFuncs, it is replaced with one.
+func (obj *Region) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*RegionListenerFuncs)
+	if !ok {
+		lis = new(RegionListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnAdd sets the function that is called when the
+// add request is received. If obj's Listener is not a
+// *RegionListenerFuncs, it is replaced with one.
+func (obj *Region) OnAdd(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*RegionListenerFuncs)
+	if !ok {
+		lis = new(RegionListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAdd = f
+}
+
+// OnSubtract sets the function that is called when the
+// subtract request is received. If obj's Listener is not a
+// *RegionListenerFuncs, it is replaced with one.
+func (obj *Region) OnSubtract(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*RegionListenerFuncs)
+	if !ok {
+		lis = new(RegionListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSubtract = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Region) Subscribe() *wire.Messages[RegionRequest] {
+	q := new(wire.Messages[RegionRequest])
+	obj.Listener = RegionRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Region) Version() uint32 {
+	return RegionVersion
+}
+
+// RegionDestroyRequest holds the arguments of the destroy request of
+// the wl_region interface.
+type RegionDestroyRequest struct {
+}
+
+func (RegionDestroyRequest) regionRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegionDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionDestroyRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// RegionAddRequest holds the arguments of the add request of
+// the wl_region interface.
+type RegionAddRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (RegionAddRequest) regionRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegionAddRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionAddRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "add"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// RegionSubtractRequest holds the arguments of the subtract request of
+// the wl_region interface.
+type RegionSubtractRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (RegionSubtractRequest) regionRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *RegionSubtractRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m RegionSubtractRequest) Encode(obj *Region) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "subtract"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
 }
 
-func (obj *Region) Version() uint32 {
-	return RegionVersion
-}
+const (
+	SubcompositorInterface = shared.SubcompositorInterface
 
+	// SubcompositorVersion is the version of the interface that this
+	// package implements.
+	SubcompositorVersion = 1
+)
+
+// The versions of the wl_subcompositor interface that its messages were
+// added in.
 const (
-	SubcompositorInterface = "wl_subcompositor"
-	SubcompositorVersion   = 1
+	SubcompositorDestroyRequestSince       = 1
+	SubcompositorGetSubsurfaceRequestSince = 1
 )
 
+// SubcompositorDesc describes the wl_subcompositor interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SubcompositorDesc = &wire.InterfaceDesc{
+	Name:    SubcompositorInterface,
+	Version: SubcompositorVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_subsurface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_subsurface",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "bad_surface", Value: 0},
+				{Name: "bad_parent", Value: 1},
+			},
+		},
+	},
+}
+
 // SubcompositorListener is a type that can respond to incoming
 // messages for a Subcompositor object.
 type SubcompositorListener interface {
@@ -6773,6 +14580,48 @@ type SubcompositorListener interface {
 	GetSubsurface(id *Subsurface, surface *Surface, parent *Surface)
 }
 
+// SubcompositorListenerFuncs implements SubcompositorListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SubcompositorListenerFuncs struct {
+	OnDestroy       func()
+	OnGetSubsurface func(id *Subsurface, surface *Surface, parent *Surface)
+}
+
+func (lis *SubcompositorListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *SubcompositorListenerFuncs) GetSubsurface(id *Subsurface, surface *Surface, parent *Surface) {
+	if lis.OnGetSubsurface != nil {
+		lis.OnGetSubsurface(id, surface, parent)
+	}
+}
+
+// SubcompositorRequest is implemented by the message types of all
+// of the requests that a Subcompositor can receive.
+type SubcompositorRequest interface {
+	subcompositorRequest()
+}
+
+// SubcompositorRequestFunc implements SubcompositorListener by calling
+// itself with each incoming message.
+type SubcompositorRequestFunc func(SubcompositorRequest)
+
+func (f SubcompositorRequestFunc) Destroy() {
+	f(SubcompositorDestroyRequest{})
+}
+
+func (f SubcompositorRequestFunc) GetSubsurface(id *Subsurface, surface *Surface, parent *Surface) {
+	f(SubcompositorGetSubsurfaceRequest{
+		Id:      id,
+		Surface: surface,
+		Parent:  parent,
+	})
+}
+
 // The global interface exposing sub-surface compositing capabilities.
 // A wl_surface, that has sub-surfaces associated, is called the
 // parent surface. Sub-surfaces can be arbitrarily nested and create
@@ -6826,7 +14675,8 @@ func (obj *Subcompositor) State() wire.State {
 func (obj *Subcompositor) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m SubcompositorDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6837,21 +14687,8 @@ func (obj *Subcompositor) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 1:
-
-		id := NewSubsurface(obj.state)
-		id.SetID(msg.ReadUint())
-
-		obj.state.Add(id)
-
-		surface, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(surface)
-
-		parent, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(parent)
-
-		if err := msg.Err(); err != nil {
+		var m SubcompositorGetSubsurfaceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -6859,13 +14696,21 @@ func (obj *Subcompositor) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.GetSubsurface(
-			id,
-			surface,
-			parent,
+			m.Id,
+			m.Surface,
+			m.Parent,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SubcompositorInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_subcompositor",
 		Type:      "request",
@@ -6907,37 +14752,213 @@ func (obj *Subcompositor) Interface() string {
 	return SubcompositorInterface
 }
 
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *SubcompositorListenerFuncs, it is replaced with one.
+func (obj *Subcompositor) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*SubcompositorListenerFuncs)
+	if !ok {
+		lis = new(SubcompositorListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetSubsurface sets the function that is called when the
+// get_subsurface request is received. If obj's Listener is not a
+// *SubcompositorListenerFuncs, it is replaced with one.
+func (obj *Subcompositor) OnGetSubsurface(f func(id *Subsurface, surface *Surface, parent *Surface)) {
+	lis, ok := obj.Listener.(*SubcompositorListenerFuncs)
+	if !ok {
+		lis = new(SubcompositorListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetSubsurface = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Subcompositor) Subscribe() *wire.Messages[SubcompositorRequest] {
+	q := new(wire.Messages[SubcompositorRequest])
+	obj.Listener = SubcompositorRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Subcompositor) Version() uint32 {
 	return SubcompositorVersion
 }
 
-type SubcompositorError int64
+// SubcompositorDestroyRequest holds the arguments of the destroy request of
+// the wl_subcompositor interface.
+type SubcompositorDestroyRequest struct {
+}
 
-const (
-	// the to-be sub-surface is invalid
-	SubcompositorErrorBadSurface SubcompositorError = 0
+func (SubcompositorDestroyRequest) subcompositorRequest() {}
 
-	// the to-be sub-surface parent is invalid
-	SubcompositorErrorBadParent SubcompositorError = 1
-)
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubcompositorDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
 
-func (enum SubcompositorError) String() string {
-	switch enum {
-	case 0:
-		return "SubcompositorErrorBadSurface"
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubcompositorDestroyRequest) Encode(obj *Subcompositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
 
-	case 1:
-		return "SubcompositorErrorBadParent"
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
 	}
+	return builder
+}
+
+// SubcompositorGetSubsurfaceRequest holds the arguments of the get_subsurface request of
+// the wl_subcompositor interface.
+type SubcompositorGetSubsurfaceRequest struct {
+	Id      *Subsurface
+	Surface *Surface
+	Parent  *Surface
+}
+
+func (SubcompositorGetSubsurfaceRequest) subcompositorRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubcompositorGetSubsurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewSubsurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, SubsurfaceInterface, msg.ReadNewObject(SubsurfaceInterface), true))
+	m.Surface = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubcompositorGetSubsurfaceRequest) Encode(obj *Subcompositor) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+	builder.WriteObject(m.Parent)
 
-	return "<invalid SubcompositorError>"
+	builder.Method = "get_subsurface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: SubsurfaceInterface, ID: m.Id.ID()}, m.Surface, m.Parent}
+	}
+	return builder
 }
 
+// SubcompositorError is an alias for [shared.SubcompositorError].
+type SubcompositorError = shared.SubcompositorError
+
+const (
+	SubcompositorErrorBadSurface = shared.SubcompositorErrorBadSurface
+	SubcompositorErrorBadParent  = shared.SubcompositorErrorBadParent
+)
+
+const (
+	SubsurfaceInterface = shared.SubsurfaceInterface
+
+	// SubsurfaceVersion is the version of the interface that this
+	// package implements.
+	SubsurfaceVersion = 1
+)
+
+// The versions of the wl_subsurface interface that its messages were
+// added in.
 const (
-	SubsurfaceInterface = "wl_subsurface"
-	SubsurfaceVersion   = 1
+	SubsurfaceDestroyRequestSince     = 1
+	SubsurfaceSetPositionRequestSince = 1
+	SubsurfacePlaceAboveRequestSince  = 1
+	SubsurfacePlaceBelowRequestSince  = 1
+	SubsurfaceSetSyncRequestSince     = 1
+	SubsurfaceSetDesyncRequestSince   = 1
 )
 
+// SubsurfaceDesc describes the wl_subsurface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SubsurfaceDesc = &wire.InterfaceDesc{
+	Name:    SubsurfaceInterface,
+	Version: SubsurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_position",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "place_above",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "sibling",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "place_below",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "sibling",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "set_sync",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_desync",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "bad_surface", Value: 0},
+			},
+		},
+	},
+}
+
 // SubsurfaceListener is a type that can respond to incoming
 // messages for a Subsurface object.
 type SubsurfaceListener interface {
@@ -7019,6 +15040,95 @@ type SubsurfaceListener interface {
 	SetDesync()
 }
 
+// SubsurfaceListenerFuncs implements SubsurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SubsurfaceListenerFuncs struct {
+	OnDestroy     func()
+	OnSetPosition func(x int32, y int32)
+	OnPlaceAbove  func(sibling *Surface)
+	OnPlaceBelow  func(sibling *Surface)
+	OnSetSync     func()
+	OnSetDesync   func()
+}
+
+func (lis *SubsurfaceListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *SubsurfaceListenerFuncs) SetPosition(x int32, y int32) {
+	if lis.OnSetPosition != nil {
+		lis.OnSetPosition(x, y)
+	}
+}
+
+func (lis *SubsurfaceListenerFuncs) PlaceAbove(sibling *Surface) {
+	if lis.OnPlaceAbove != nil {
+		lis.OnPlaceAbove(sibling)
+	}
+}
+
+func (lis *SubsurfaceListenerFuncs) PlaceBelow(sibling *Surface) {
+	if lis.OnPlaceBelow != nil {
+		lis.OnPlaceBelow(sibling)
+	}
+}
+
+func (lis *SubsurfaceListenerFuncs) SetSync() {
+	if lis.OnSetSync != nil {
+		lis.OnSetSync()
+	}
+}
+
+func (lis *SubsurfaceListenerFuncs) SetDesync() {
+	if lis.OnSetDesync != nil {
+		lis.OnSetDesync()
+	}
+}
+
+// SubsurfaceRequest is implemented by the message types of all
+// of the requests that a Subsurface can receive.
+type SubsurfaceRequest interface {
+	subsurfaceRequest()
+}
+
+// SubsurfaceRequestFunc implements SubsurfaceListener by calling
+// itself with each incoming message.
+type SubsurfaceRequestFunc func(SubsurfaceRequest)
+
+func (f SubsurfaceRequestFunc) Destroy() {
+	f(SubsurfaceDestroyRequest{})
+}
+
+func (f SubsurfaceRequestFunc) SetPosition(x int32, y int32) {
+	f(SubsurfaceSetPositionRequest{
+		X: x,
+		Y: y,
+	})
+}
+
+func (f SubsurfaceRequestFunc) PlaceAbove(sibling *Surface) {
+	f(SubsurfacePlaceAboveRequest{
+		Sibling: sibling,
+	})
+}
+
+func (f SubsurfaceRequestFunc) PlaceBelow(sibling *Surface) {
+	f(SubsurfacePlaceBelowRequest{
+		Sibling: sibling,
+	})
+}
+
+func (f SubsurfaceRequestFunc) SetSync() {
+	f(SubsurfaceSetSyncRequest{})
+}
+
+func (f SubsurfaceRequestFunc) SetDesync() {
+	f(SubsurfaceSetDesyncRequest{})
+}
+
 // An additional interface to a wl_surface object, which has been
 // made a sub-surface. A sub-surface has one parent surface. A
 // sub-surface's size and position are not limited to that of the parent.
@@ -7098,7 +15208,8 @@ func (obj *Subsurface) State() wire.State {
 func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m SubsurfaceDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7109,12 +15220,8 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 1:
-
-		x := msg.ReadInt()
-
-		y := msg.ReadInt()
-
-		if err := msg.Err(); err != nil {
+		var m SubsurfaceSetPositionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7122,18 +15229,14 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.SetPosition(
-			x,
-			y,
+			m.X,
+			m.Y,
 		)
 		return nil
 
 	case 2:
-
-		sibling, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(sibling)
-
-		if err := msg.Err(); err != nil {
+		var m SubsurfacePlaceAboveRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7141,17 +15244,13 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.PlaceAbove(
-			sibling,
+			m.Sibling,
 		)
 		return nil
 
 	case 3:
-
-		sibling, _ := obj.state.Get(msg.ReadUint()).(*Surface)
-
-		obj.state.Add(sibling)
-
-		if err := msg.Err(); err != nil {
+		var m SubsurfacePlaceBelowRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7159,12 +15258,13 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.PlaceBelow(
-			sibling,
+			m.Sibling,
 		)
 		return nil
 
 	case 4:
-		if err := msg.Err(); err != nil {
+		var m SubsurfaceSetSyncRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7175,7 +15275,8 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 5:
-		if err := msg.Err(); err != nil {
+		var m SubsurfaceSetDesyncRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7186,6 +15287,14 @@ func (obj *Subsurface) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SubsurfaceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_subsurface",
 		Type:      "request",
@@ -7239,31 +15348,343 @@ func (obj *Subsurface) Interface() string {
 	return SubsurfaceInterface
 }
 
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetPosition sets the function that is called when the
+// set_position request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnSetPosition(f func(x int32, y int32)) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetPosition = f
+}
+
+// OnPlaceAbove sets the function that is called when the
+// place_above request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnPlaceAbove(f func(sibling *Surface)) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPlaceAbove = f
+}
+
+// OnPlaceBelow sets the function that is called when the
+// place_below request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnPlaceBelow(f func(sibling *Surface)) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPlaceBelow = f
+}
+
+// OnSetSync sets the function that is called when the
+// set_sync request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnSetSync(f func()) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSync = f
+}
+
+// OnSetDesync sets the function that is called when the
+// set_desync request is received. If obj's Listener is not a
+// *SubsurfaceListenerFuncs, it is replaced with one.
+func (obj *Subsurface) OnSetDesync(f func()) {
+	lis, ok := obj.Listener.(*SubsurfaceListenerFuncs)
+	if !ok {
+		lis = new(SubsurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetDesync = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Subsurface) Subscribe() *wire.Messages[SubsurfaceRequest] {
+	q := new(wire.Messages[SubsurfaceRequest])
+	obj.Listener = SubsurfaceRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Subsurface) Version() uint32 {
 	return SubsurfaceVersion
 }
 
-type SubsurfaceError int64
+// SubsurfaceDestroyRequest holds the arguments of the destroy request of
+// the wl_subsurface interface.
+type SubsurfaceDestroyRequest struct {
+}
 
-const (
-	// wl_surface is not a sibling or the parent
-	SubsurfaceErrorBadSurface SubsurfaceError = 0
-)
+func (SubsurfaceDestroyRequest) subsurfaceRequest() {}
 
-func (enum SubsurfaceError) String() string {
-	switch enum {
-	case 0:
-		return "SubsurfaceErrorBadSurface"
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfaceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceDestroyRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SubsurfaceSetPositionRequest holds the arguments of the set_position request of
+// the wl_subsurface interface.
+type SubsurfaceSetPositionRequest struct {
+	X int32
+	Y int32
+}
+
+func (SubsurfaceSetPositionRequest) subsurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfaceSetPositionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetPositionRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "set_position"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
+	}
+	return builder
+}
+
+// SubsurfacePlaceAboveRequest holds the arguments of the place_above request of
+// the wl_subsurface interface.
+type SubsurfacePlaceAboveRequest struct {
+	Sibling *Surface
+}
+
+func (SubsurfacePlaceAboveRequest) subsurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfacePlaceAboveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Sibling = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfacePlaceAboveRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Sibling)
+
+	builder.Method = "place_above"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Sibling}
+	}
+	return builder
+}
+
+// SubsurfacePlaceBelowRequest holds the arguments of the place_below request of
+// the wl_subsurface interface.
+type SubsurfacePlaceBelowRequest struct {
+	Sibling *Surface
+}
+
+func (SubsurfacePlaceBelowRequest) subsurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfacePlaceBelowRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Sibling = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfacePlaceBelowRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.Sibling)
+
+	builder.Method = "place_below"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Sibling}
+	}
+	return builder
+}
+
+// SubsurfaceSetSyncRequest holds the arguments of the set_sync request of
+// the wl_subsurface interface.
+type SubsurfaceSetSyncRequest struct {
+}
+
+func (SubsurfaceSetSyncRequest) subsurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfaceSetSyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetSyncRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "set_sync"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SubsurfaceSetDesyncRequest holds the arguments of the set_desync request of
+// the wl_subsurface interface.
+type SubsurfaceSetDesyncRequest struct {
+}
+
+func (SubsurfaceSetDesyncRequest) subsurfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SubsurfaceSetDesyncRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
 	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SubsurfaceSetDesyncRequest) Encode(obj *Subsurface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
 
-	return "<invalid SubsurfaceError>"
+	builder.Method = "set_desync"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
 }
 
+// SubsurfaceError is an alias for [shared.SubsurfaceError].
+type SubsurfaceError = shared.SubsurfaceError
+
+const (
+	SubsurfaceErrorBadSurface = shared.SubsurfaceErrorBadSurface
+)
+
 const (
-	FixesInterface = "wl_fixes"
-	FixesVersion   = 1
+	FixesInterface = shared.FixesInterface
+
+	// FixesVersion is the version of the interface that this
+	// package implements.
+	FixesVersion = 1
+)
+
+// The versions of the wl_fixes interface that its messages were
+// added in.
+const (
+	FixesDestroyRequestSince         = 1
+	FixesDestroyRegistryRequestSince = 1
 )
 
+// FixesDesc describes the wl_fixes interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var FixesDesc = &wire.InterfaceDesc{
+	Name:    FixesInterface,
+	Version: FixesVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "destroy_registry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "registry",
+					Type:      wire.ArgObject,
+					Interface: "wl_registry",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
 // FixesListener is a type that can respond to incoming
 // messages for a Fixes object.
 type FixesListener interface {
@@ -7281,6 +15702,46 @@ type FixesListener interface {
 	DestroyRegistry(registry *Registry)
 }
 
+// FixesListenerFuncs implements FixesListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type FixesListenerFuncs struct {
+	OnDestroy         func()
+	OnDestroyRegistry func(registry *Registry)
+}
+
+func (lis *FixesListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *FixesListenerFuncs) DestroyRegistry(registry *Registry) {
+	if lis.OnDestroyRegistry != nil {
+		lis.OnDestroyRegistry(registry)
+	}
+}
+
+// FixesRequest is implemented by the message types of all
+// of the requests that a Fixes can receive.
+type FixesRequest interface {
+	fixesRequest()
+}
+
+// FixesRequestFunc implements FixesListener by calling
+// itself with each incoming message.
+type FixesRequestFunc func(FixesRequest)
+
+func (f FixesRequestFunc) Destroy() {
+	f(FixesDestroyRequest{})
+}
+
+func (f FixesRequestFunc) DestroyRegistry(registry *Registry) {
+	f(FixesDestroyRegistryRequest{
+		Registry: registry,
+	})
+}
+
 // This global fixes problems with other core-protocol interfaces that
 // cannot be fixed in these interfaces themselves.
 type Fixes struct {
@@ -7317,7 +15778,8 @@ func (obj *Fixes) State() wire.State {
 func (obj *Fixes) Dispatch(msg *wire.MessageBuffer) error {
 	switch msg.Op() {
 	case 0:
-		if err := msg.Err(); err != nil {
+		var m FixesDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7328,12 +15790,8 @@ func (obj *Fixes) Dispatch(msg *wire.MessageBuffer) error {
 		return nil
 
 	case 1:
-
-		registry, _ := obj.state.Get(msg.ReadUint()).(*Registry)
-
-		obj.state.Add(registry)
-
-		if err := msg.Err(); err != nil {
+		var m FixesDestroyRegistryRequest
+		if err := m.Decode(obj.state, msg); err != nil {
 			return err
 		}
 
@@ -7341,11 +15799,19 @@ func (obj *Fixes) Dispatch(msg *wire.MessageBuffer) error {
 			return nil
 		}
 		obj.Listener.DestroyRegistry(
-			registry,
+			m.Registry,
 		)
 		return nil
 	}
 
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(FixesInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
 	return wire.UnknownOpError{
 		Interface: "wl_fixes",
 		Type:      "request",
@@ -7387,6 +15853,131 @@ func (obj *Fixes) Interface() string {
 	return FixesInterface
 }
 
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *FixesListenerFuncs, it is replaced with one.
+func (obj *Fixes) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*FixesListenerFuncs)
+	if !ok {
+		lis = new(FixesListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnDestroyRegistry sets the function that is called when the
+// destroy_registry request is received. If obj's Listener is not a
+// *FixesListenerFuncs, it is replaced with one.
+func (obj *Fixes) OnDestroyRegistry(f func(registry *Registry)) {
+	lis, ok := obj.Listener.(*FixesListenerFuncs)
+	if !ok {
+		lis = new(FixesListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroyRegistry = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Fixes) Subscribe() *wire.Messages[FixesRequest] {
+	q := new(wire.Messages[FixesRequest])
+	obj.Listener = FixesRequestFunc(q.Push)
+	return q
+}
+
 func (obj *Fixes) Version() uint32 {
 	return FixesVersion
 }
+
+// FixesDestroyRequest holds the arguments of the destroy request of
+// the wl_fixes interface.
+type FixesDestroyRequest struct {
+}
+
+func (FixesDestroyRequest) fixesRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *FixesDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FixesDestroyRequest) Encode(obj *Fixes) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// FixesDestroyRegistryRequest holds the arguments of the destroy_registry request of
+// the wl_fixes interface.
+type FixesDestroyRegistryRequest struct {
+	Registry *Registry
+}
+
+func (FixesDestroyRegistryRequest) fixesRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *FixesDestroyRegistryRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Registry = wire.ResolveObject[*Registry](msg, state, RegistryInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FixesDestroyRegistryRequest) Encode(obj *Fixes) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Registry)
+
+	builder.Method = "destroy_registry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Registry}
+	}
+	return builder
+}
+
+func init() {
+	wire.RegisterInterface(DisplayDesc, false)
+	wire.RegisterInterface(RegistryDesc, false)
+	wire.RegisterInterface(CallbackDesc, false)
+	wire.RegisterInterface(CompositorDesc, false)
+	wire.RegisterInterface(ShmPoolDesc, false)
+	wire.RegisterInterface(ShmDesc, false)
+	wire.RegisterInterface(BufferDesc, false)
+	wire.RegisterInterface(DataOfferDesc, false)
+	wire.RegisterInterface(DataSourceDesc, false)
+	wire.RegisterInterface(DataDeviceDesc, false)
+	wire.RegisterInterface(DataDeviceManagerDesc, false)
+	wire.RegisterInterface(ShellDesc, false)
+	wire.RegisterInterface(ShellSurfaceDesc, false)
+	wire.RegisterInterface(SurfaceDesc, false)
+	wire.RegisterInterface(SeatDesc, false)
+	wire.RegisterInterface(PointerDesc, false)
+	wire.RegisterInterface(KeyboardDesc, false)
+	wire.RegisterInterface(TouchDesc, false)
+	wire.RegisterInterface(OutputDesc, false)
+	wire.RegisterInterface(RegionDesc, false)
+	wire.RegisterInterface(SubcompositorDesc, false)
+	wire.RegisterInterface(SubsurfaceDesc, false)
+	wire.RegisterInterface(FixesDesc, false)
+}
@@ -9,8 +9,6 @@ import (
 	"deedles.dev/wl/wire"
 )
 
-//go:generate go run deedles.dev/wl/cmd/wlgen -out protocol.go -xml ../protocol/wayland.xml
-
 // Server serves the Wayland protocol.
 type Server struct {
 	// Listener is the Unix socket to listen for incoming connections
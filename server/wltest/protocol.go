@@ -0,0 +1,608 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+// Package wltest provides mock listeners for testing code that uses
+// the wayland protocol bindings in package wl. The listeners
+// record the messages that they receive so that they can be checked
+// against expectations.
+package wltest
+
+import (
+	"os"
+
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	"deedles.dev/wl/wire/wiretest"
+)
+
+// DisplayListener is a [wl.DisplayListener] that records the
+// requests that it receives.
+type DisplayListener struct {
+	wiretest.Recorder[wl.DisplayRequest]
+}
+
+var _ wl.DisplayListener = (*DisplayListener)(nil)
+
+func (lis *DisplayListener) Sync(callback *wl.Callback) {
+	lis.Record(wl.DisplaySyncRequest{
+		Callback: callback,
+	})
+}
+
+func (lis *DisplayListener) GetRegistry(registry *wl.Registry) {
+	lis.Record(wl.DisplayGetRegistryRequest{
+		Registry: registry,
+	})
+}
+
+// RegistryListener is a [wl.RegistryListener] that records the
+// requests that it receives.
+type RegistryListener struct {
+	wiretest.Recorder[wl.RegistryRequest]
+}
+
+var _ wl.RegistryListener = (*RegistryListener)(nil)
+
+func (lis *RegistryListener) Bind(name uint32, id wire.NewID) {
+	lis.Record(wl.RegistryBindRequest{
+		Name: name,
+		Id:   id,
+	})
+}
+
+// CompositorListener is a [wl.CompositorListener] that records the
+// requests that it receives.
+type CompositorListener struct {
+	wiretest.Recorder[wl.CompositorRequest]
+}
+
+var _ wl.CompositorListener = (*CompositorListener)(nil)
+
+func (lis *CompositorListener) CreateSurface(id *wl.Surface) {
+	lis.Record(wl.CompositorCreateSurfaceRequest{
+		Id: id,
+	})
+}
+
+func (lis *CompositorListener) CreateRegion(id *wl.Region) {
+	lis.Record(wl.CompositorCreateRegionRequest{
+		Id: id,
+	})
+}
+
+// ShmPoolListener is a [wl.ShmPoolListener] that records the
+// requests that it receives.
+type ShmPoolListener struct {
+	wiretest.Recorder[wl.ShmPoolRequest]
+}
+
+var _ wl.ShmPoolListener = (*ShmPoolListener)(nil)
+
+func (lis *ShmPoolListener) CreateBuffer(id *wl.Buffer, offset int32, width int32, height int32, stride int32, format wl.ShmFormat) {
+	lis.Record(wl.ShmPoolCreateBufferRequest{
+		Id:     id,
+		Offset: offset,
+		Width:  width,
+		Height: height,
+		Stride: stride,
+		Format: format,
+	})
+}
+
+func (lis *ShmPoolListener) Destroy() {
+	lis.Record(wl.ShmPoolDestroyRequest{})
+}
+
+func (lis *ShmPoolListener) Resize(size int32) {
+	lis.Record(wl.ShmPoolResizeRequest{
+		Size: size,
+	})
+}
+
+// ShmListener is a [wl.ShmListener] that records the
+// requests that it receives.
+type ShmListener struct {
+	wiretest.Recorder[wl.ShmRequest]
+}
+
+var _ wl.ShmListener = (*ShmListener)(nil)
+
+func (lis *ShmListener) CreatePool(id *wl.ShmPool, fd *os.File, size int32) {
+	lis.Record(wl.ShmCreatePoolRequest{
+		Id:   id,
+		Fd:   fd,
+		Size: size,
+	})
+}
+
+func (lis *ShmListener) Release() {
+	lis.Record(wl.ShmReleaseRequest{})
+}
+
+// BufferListener is a [wl.BufferListener] that records the
+// requests that it receives.
+type BufferListener struct {
+	wiretest.Recorder[wl.BufferRequest]
+}
+
+var _ wl.BufferListener = (*BufferListener)(nil)
+
+func (lis *BufferListener) Destroy() {
+	lis.Record(wl.BufferDestroyRequest{})
+}
+
+// DataOfferListener is a [wl.DataOfferListener] that records the
+// requests that it receives.
+type DataOfferListener struct {
+	wiretest.Recorder[wl.DataOfferRequest]
+}
+
+var _ wl.DataOfferListener = (*DataOfferListener)(nil)
+
+func (lis *DataOfferListener) Accept(serial uint32, mimeType *string) {
+	lis.Record(wl.DataOfferAcceptRequest{
+		Serial:   serial,
+		MimeType: mimeType,
+	})
+}
+
+func (lis *DataOfferListener) Receive(mimeType string, fd *os.File) {
+	lis.Record(wl.DataOfferReceiveRequest{
+		MimeType: mimeType,
+		Fd:       fd,
+	})
+}
+
+func (lis *DataOfferListener) Destroy() {
+	lis.Record(wl.DataOfferDestroyRequest{})
+}
+
+func (lis *DataOfferListener) Finish() {
+	lis.Record(wl.DataOfferFinishRequest{})
+}
+
+func (lis *DataOfferListener) SetActions(dndActions wl.DataDeviceManagerDndAction, preferredAction wl.DataDeviceManagerDndAction) {
+	lis.Record(wl.DataOfferSetActionsRequest{
+		DndActions:      dndActions,
+		PreferredAction: preferredAction,
+	})
+}
+
+// DataSourceListener is a [wl.DataSourceListener] that records the
+// requests that it receives.
+type DataSourceListener struct {
+	wiretest.Recorder[wl.DataSourceRequest]
+}
+
+var _ wl.DataSourceListener = (*DataSourceListener)(nil)
+
+func (lis *DataSourceListener) Offer(mimeType string) {
+	lis.Record(wl.DataSourceOfferRequest{
+		MimeType: mimeType,
+	})
+}
+
+func (lis *DataSourceListener) Destroy() {
+	lis.Record(wl.DataSourceDestroyRequest{})
+}
+
+func (lis *DataSourceListener) SetActions(dndActions wl.DataDeviceManagerDndAction) {
+	lis.Record(wl.DataSourceSetActionsRequest{
+		DndActions: dndActions,
+	})
+}
+
+// DataDeviceListener is a [wl.DataDeviceListener] that records the
+// requests that it receives.
+type DataDeviceListener struct {
+	wiretest.Recorder[wl.DataDeviceRequest]
+}
+
+var _ wl.DataDeviceListener = (*DataDeviceListener)(nil)
+
+func (lis *DataDeviceListener) StartDrag(source *wl.DataSource, origin *wl.Surface, icon *wl.Surface, serial uint32) {
+	lis.Record(wl.DataDeviceStartDragRequest{
+		Source: source,
+		Origin: origin,
+		Icon:   icon,
+		Serial: serial,
+	})
+}
+
+func (lis *DataDeviceListener) SetSelection(source *wl.DataSource, serial uint32) {
+	lis.Record(wl.DataDeviceSetSelectionRequest{
+		Source: source,
+		Serial: serial,
+	})
+}
+
+func (lis *DataDeviceListener) Release() {
+	lis.Record(wl.DataDeviceReleaseRequest{})
+}
+
+// DataDeviceManagerListener is a [wl.DataDeviceManagerListener] that records the
+// requests that it receives.
+type DataDeviceManagerListener struct {
+	wiretest.Recorder[wl.DataDeviceManagerRequest]
+}
+
+var _ wl.DataDeviceManagerListener = (*DataDeviceManagerListener)(nil)
+
+func (lis *DataDeviceManagerListener) CreateDataSource(id *wl.DataSource) {
+	lis.Record(wl.DataDeviceManagerCreateDataSourceRequest{
+		Id: id,
+	})
+}
+
+func (lis *DataDeviceManagerListener) GetDataDevice(id *wl.DataDevice, seat *wl.Seat) {
+	lis.Record(wl.DataDeviceManagerGetDataDeviceRequest{
+		Id:   id,
+		Seat: seat,
+	})
+}
+
+// ShellListener is a [wl.ShellListener] that records the
+// requests that it receives.
+type ShellListener struct {
+	wiretest.Recorder[wl.ShellRequest]
+}
+
+var _ wl.ShellListener = (*ShellListener)(nil)
+
+func (lis *ShellListener) GetShellSurface(id *wl.ShellSurface, surface *wl.Surface) {
+	lis.Record(wl.ShellGetShellSurfaceRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
+// ShellSurfaceListener is a [wl.ShellSurfaceListener] that records the
+// requests that it receives.
+type ShellSurfaceListener struct {
+	wiretest.Recorder[wl.ShellSurfaceRequest]
+}
+
+var _ wl.ShellSurfaceListener = (*ShellSurfaceListener)(nil)
+
+func (lis *ShellSurfaceListener) Pong(serial uint32) {
+	lis.Record(wl.ShellSurfacePongRequest{
+		Serial: serial,
+	})
+}
+
+func (lis *ShellSurfaceListener) Move(seat *wl.Seat, serial uint32) {
+	lis.Record(wl.ShellSurfaceMoveRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+func (lis *ShellSurfaceListener) Resize(seat *wl.Seat, serial uint32, edges wl.ShellSurfaceResize) {
+	lis.Record(wl.ShellSurfaceResizeRequest{
+		Seat:   seat,
+		Serial: serial,
+		Edges:  edges,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetToplevel() {
+	lis.Record(wl.ShellSurfaceSetToplevelRequest{})
+}
+
+func (lis *ShellSurfaceListener) SetTransient(parent *wl.Surface, x int32, y int32, flags wl.ShellSurfaceTransient) {
+	lis.Record(wl.ShellSurfaceSetTransientRequest{
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetFullscreen(method wl.ShellSurfaceFullscreenMethod, framerate uint32, output *wl.Output) {
+	lis.Record(wl.ShellSurfaceSetFullscreenRequest{
+		Method:    method,
+		Framerate: framerate,
+		Output:    output,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetPopup(seat *wl.Seat, serial uint32, parent *wl.Surface, x int32, y int32, flags wl.ShellSurfaceTransient) {
+	lis.Record(wl.ShellSurfaceSetPopupRequest{
+		Seat:   seat,
+		Serial: serial,
+		Parent: parent,
+		X:      x,
+		Y:      y,
+		Flags:  flags,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetMaximized(output *wl.Output) {
+	lis.Record(wl.ShellSurfaceSetMaximizedRequest{
+		Output: output,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetTitle(title string) {
+	lis.Record(wl.ShellSurfaceSetTitleRequest{
+		Title: title,
+	})
+}
+
+func (lis *ShellSurfaceListener) SetClass(class string) {
+	lis.Record(wl.ShellSurfaceSetClassRequest{
+		Class: class,
+	})
+}
+
+// SurfaceListener is a [wl.SurfaceListener] that records the
+// requests that it receives.
+type SurfaceListener struct {
+	wiretest.Recorder[wl.SurfaceRequest]
+}
+
+var _ wl.SurfaceListener = (*SurfaceListener)(nil)
+
+func (lis *SurfaceListener) Destroy() {
+	lis.Record(wl.SurfaceDestroyRequest{})
+}
+
+func (lis *SurfaceListener) Attach(buffer *wl.Buffer, x int32, y int32) {
+	lis.Record(wl.SurfaceAttachRequest{
+		Buffer: buffer,
+		X:      x,
+		Y:      y,
+	})
+}
+
+func (lis *SurfaceListener) Damage(x int32, y int32, width int32, height int32) {
+	lis.Record(wl.SurfaceDamageRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *SurfaceListener) Frame(callback *wl.Callback) {
+	lis.Record(wl.SurfaceFrameRequest{
+		Callback: callback,
+	})
+}
+
+func (lis *SurfaceListener) SetOpaqueRegion(region *wl.Region) {
+	lis.Record(wl.SurfaceSetOpaqueRegionRequest{
+		Region: region,
+	})
+}
+
+func (lis *SurfaceListener) SetInputRegion(region *wl.Region) {
+	lis.Record(wl.SurfaceSetInputRegionRequest{
+		Region: region,
+	})
+}
+
+func (lis *SurfaceListener) Commit() {
+	lis.Record(wl.SurfaceCommitRequest{})
+}
+
+func (lis *SurfaceListener) SetBufferTransform(transform wl.OutputTransform) {
+	lis.Record(wl.SurfaceSetBufferTransformRequest{
+		Transform: transform,
+	})
+}
+
+func (lis *SurfaceListener) SetBufferScale(scale int32) {
+	lis.Record(wl.SurfaceSetBufferScaleRequest{
+		Scale: scale,
+	})
+}
+
+func (lis *SurfaceListener) DamageBuffer(x int32, y int32, width int32, height int32) {
+	lis.Record(wl.SurfaceDamageBufferRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *SurfaceListener) Offset(x int32, y int32) {
+	lis.Record(wl.SurfaceOffsetRequest{
+		X: x,
+		Y: y,
+	})
+}
+
+// SeatListener is a [wl.SeatListener] that records the
+// requests that it receives.
+type SeatListener struct {
+	wiretest.Recorder[wl.SeatRequest]
+}
+
+var _ wl.SeatListener = (*SeatListener)(nil)
+
+func (lis *SeatListener) GetPointer(id *wl.Pointer) {
+	lis.Record(wl.SeatGetPointerRequest{
+		Id: id,
+	})
+}
+
+func (lis *SeatListener) GetKeyboard(id *wl.Keyboard) {
+	lis.Record(wl.SeatGetKeyboardRequest{
+		Id: id,
+	})
+}
+
+func (lis *SeatListener) GetTouch(id *wl.Touch) {
+	lis.Record(wl.SeatGetTouchRequest{
+		Id: id,
+	})
+}
+
+func (lis *SeatListener) Release() {
+	lis.Record(wl.SeatReleaseRequest{})
+}
+
+// PointerListener is a [wl.PointerListener] that records the
+// requests that it receives.
+type PointerListener struct {
+	wiretest.Recorder[wl.PointerRequest]
+}
+
+var _ wl.PointerListener = (*PointerListener)(nil)
+
+func (lis *PointerListener) SetCursor(serial uint32, surface *wl.Surface, hotspotX int32, hotspotY int32) {
+	lis.Record(wl.PointerSetCursorRequest{
+		Serial:   serial,
+		Surface:  surface,
+		HotspotX: hotspotX,
+		HotspotY: hotspotY,
+	})
+}
+
+func (lis *PointerListener) Release() {
+	lis.Record(wl.PointerReleaseRequest{})
+}
+
+// KeyboardListener is a [wl.KeyboardListener] that records the
+// requests that it receives.
+type KeyboardListener struct {
+	wiretest.Recorder[wl.KeyboardRequest]
+}
+
+var _ wl.KeyboardListener = (*KeyboardListener)(nil)
+
+func (lis *KeyboardListener) Release() {
+	lis.Record(wl.KeyboardReleaseRequest{})
+}
+
+// TouchListener is a [wl.TouchListener] that records the
+// requests that it receives.
+type TouchListener struct {
+	wiretest.Recorder[wl.TouchRequest]
+}
+
+var _ wl.TouchListener = (*TouchListener)(nil)
+
+func (lis *TouchListener) Release() {
+	lis.Record(wl.TouchReleaseRequest{})
+}
+
+// OutputListener is a [wl.OutputListener] that records the
+// requests that it receives.
+type OutputListener struct {
+	wiretest.Recorder[wl.OutputRequest]
+}
+
+var _ wl.OutputListener = (*OutputListener)(nil)
+
+func (lis *OutputListener) Release() {
+	lis.Record(wl.OutputReleaseRequest{})
+}
+
+// RegionListener is a [wl.RegionListener] that records the
+// requests that it receives.
+type RegionListener struct {
+	wiretest.Recorder[wl.RegionRequest]
+}
+
+var _ wl.RegionListener = (*RegionListener)(nil)
+
+func (lis *RegionListener) Destroy() {
+	lis.Record(wl.RegionDestroyRequest{})
+}
+
+func (lis *RegionListener) Add(x int32, y int32, width int32, height int32) {
+	lis.Record(wl.RegionAddRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *RegionListener) Subtract(x int32, y int32, width int32, height int32) {
+	lis.Record(wl.RegionSubtractRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+// SubcompositorListener is a [wl.SubcompositorListener] that records the
+// requests that it receives.
+type SubcompositorListener struct {
+	wiretest.Recorder[wl.SubcompositorRequest]
+}
+
+var _ wl.SubcompositorListener = (*SubcompositorListener)(nil)
+
+func (lis *SubcompositorListener) Destroy() {
+	lis.Record(wl.SubcompositorDestroyRequest{})
+}
+
+func (lis *SubcompositorListener) GetSubsurface(id *wl.Subsurface, surface *wl.Surface, parent *wl.Surface) {
+	lis.Record(wl.SubcompositorGetSubsurfaceRequest{
+		Id:      id,
+		Surface: surface,
+		Parent:  parent,
+	})
+}
+
+// SubsurfaceListener is a [wl.SubsurfaceListener] that records the
+// requests that it receives.
+type SubsurfaceListener struct {
+	wiretest.Recorder[wl.SubsurfaceRequest]
+}
+
+var _ wl.SubsurfaceListener = (*SubsurfaceListener)(nil)
+
+func (lis *SubsurfaceListener) Destroy() {
+	lis.Record(wl.SubsurfaceDestroyRequest{})
+}
+
+func (lis *SubsurfaceListener) SetPosition(x int32, y int32) {
+	lis.Record(wl.SubsurfaceSetPositionRequest{
+		X: x,
+		Y: y,
+	})
+}
+
+func (lis *SubsurfaceListener) PlaceAbove(sibling *wl.Surface) {
+	lis.Record(wl.SubsurfacePlaceAboveRequest{
+		Sibling: sibling,
+	})
+}
+
+func (lis *SubsurfaceListener) PlaceBelow(sibling *wl.Surface) {
+	lis.Record(wl.SubsurfacePlaceBelowRequest{
+		Sibling: sibling,
+	})
+}
+
+func (lis *SubsurfaceListener) SetSync() {
+	lis.Record(wl.SubsurfaceSetSyncRequest{})
+}
+
+func (lis *SubsurfaceListener) SetDesync() {
+	lis.Record(wl.SubsurfaceSetDesyncRequest{})
+}
+
+// FixesListener is a [wl.FixesListener] that records the
+// requests that it receives.
+type FixesListener struct {
+	wiretest.Recorder[wl.FixesRequest]
+}
+
+var _ wl.FixesListener = (*FixesListener)(nil)
+
+func (lis *FixesListener) Destroy() {
+	lis.Record(wl.FixesDestroyRequest{})
+}
+
+func (lis *FixesListener) DestroyRegistry(registry *wl.Registry) {
+	lis.Record(wl.FixesDestroyRegistryRequest{
+		Registry: registry,
+	})
+}
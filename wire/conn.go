@@ -6,8 +6,10 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"deedles.dev/wl/internal/set"
 	"golang.org/x/sys/unix"
@@ -82,7 +84,13 @@ func NewSocketPath() (string, error) {
 // implementation.
 type Conn struct {
 	conn *net.UnixConn
-	fds  []int
+
+	// fds holds received file descriptors that have not yet been
+	// claimed by a message. It is appended to by ReadMessage and
+	// consumed by the messages that it returns, which may be handled
+	// on another goroutine.
+	m   sync.Mutex
+	fds []int
 }
 
 // NewConn creates a new Conn that wraps c. After this is called, use
@@ -94,8 +102,17 @@ func NewConn(c *net.UnixConn) *Conn {
 	}
 }
 
-// Close closes the underlying connection.
+// Close closes the underlying connection, along with any received
+// file descriptors that have not been claimed by a message.
 func (c *Conn) Close() error {
+	c.m.Lock()
+	fds := c.fds
+	c.fds = nil
+	c.m.Unlock()
+
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
 	return c.conn.Close()
 }
 
@@ -107,11 +124,19 @@ func (c *Conn) RemoteAddr() net.Addr {
 	return c.conn.RemoteAddr()
 }
 
+// readFDs adds the file descriptors in the control messages in data
+// to the queue of received file descriptors. The close-on-exec flag is
+// set on each of them. The net package already asks for this when
+// receiving them on most systems, but not all.
 func (c *Conn) readFDs(data []byte) error {
 	cmsgs, err := unix.ParseSocketControlMessage(data)
 	if err != nil {
 		return fmt.Errorf("parse socket control messages: %w", err)
 	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
 	for _, cmsg := range cmsgs {
 		fds, err := unix.ParseUnixRights(&cmsg)
 		if err != nil {
@@ -120,11 +145,33 @@ func (c *Conn) readFDs(data []byte) error {
 			}
 			return fmt.Errorf("parse unix control message: %w", err)
 		}
+		for _, fd := range fds {
+			unix.CloseOnExec(fd)
+		}
 		c.fds = append(c.fds, fds...)
 	}
 	return nil
 }
 
+// popFD removes the next received file descriptor from the queue.
+func (c *Conn) popFD() (int, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return pop(&c.fds)
+}
+
+// claimFDs removes up to n received file descriptors from the queue.
+func (c *Conn) claimFDs(n int) []int {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	n = min(n, len(c.fds))
+	fds := slices.Clone(c.fds[:n])
+	c.fds = c.fds[n:]
+	return fds
+}
+
 // Dial opens a connection to the Wayland socket based on the current
 // environment. It follows the procedure outlined at
 // https://wayland-book.com/protocol-design/wire-protocol.html#transports
@@ -0,0 +1,40 @@
+package wire
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Uint32s interprets an array argument as a list of uint32 values in
+// native byte order, such as the keys of a wl_keyboard.enter event.
+// Trailing bytes that do not make up a whole value are ignored.
+func Uint32s(data []byte) []uint32 {
+	s := make([]uint32, len(data)/4)
+	for i := range s {
+		s[i] = binary.NativeEndian.Uint32(data[i*4:])
+	}
+	return s
+}
+
+// FromUint32s is the inverse of Uint32s.
+func FromUint32s(s []uint32) []byte {
+	data := make([]byte, 0, len(s)*4)
+	for _, v := range s {
+		data = binary.NativeEndian.AppendUint32(data, v)
+	}
+	return data
+}
+
+// Millis converts a timestamp with millisecond granularity, such as
+// those sent with input events, to a time.Duration. The base of such
+// timestamps is undefined, so they are only meaningful relative to
+// each other.
+func Millis(ms uint32) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// ToMillis is the inverse of Millis. The result wraps around if d does
+// not fit into 32 bits of milliseconds.
+func ToMillis(d time.Duration) uint32 {
+	return uint32(d.Milliseconds())
+}
@@ -8,8 +8,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"deedles.dev/wl/internal/bin"
+	"golang.org/x/sys/unix"
 )
 
 // MessageBuffer holds message data that has been read from the socket
@@ -22,15 +24,51 @@ type MessageBuffer struct {
 	data   bytes.Reader
 	err    error
 	args   []any
+
+	// fds holds the file descriptors claimed by ClaimFDs that have not
+	// yet been read. If claimed is false, file descriptors are read
+	// directly from conn instead.
+	fds     []int
+	claimed bool
+
+	// files holds the files that have been returned by ReadFile. They
+	// are closed by Close unless handedOff is true.
+	files     []*os.File
+	handedOff bool
+
+	// local is true if the message was created by
+	// MessageBuilder.Buffer instead of being received.
+	local bool
 }
 
-// ReadMessage reads message data from the socket into a buffer.
+// ReadMessage reads message data from the socket into a buffer. Any
+// file descriptors received along with it are queued on c to be
+// claimed by the messages that they belong to.
 func ReadMessage(c *Conn) (*MessageBuffer, error) {
-	mr := MessageBuffer{conn: c}
-
 	var oob bytes.Buffer
 	r := unixTee{c: c.conn, oob: &oob}
 
+	mr, err := readMessage(c, &r)
+
+	// File descriptors are queued even if reading the message failed
+	// so that they are closed along with c.
+	fderr := c.readFDs(oob.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if r.truncated {
+		return nil, ErrControlTruncated
+	}
+	if fderr != nil {
+		return nil, fmt.Errorf("read FDs: %w", fderr)
+	}
+
+	return mr, nil
+}
+
+func readMessage(c *Conn, r io.Reader) (*MessageBuffer, error) {
+	mr := MessageBuffer{conn: c}
+
 	sender, err := bin.Read[uint32](r)
 	if err != nil {
 		return nil, fmt.Errorf("read message sender: %w", err)
@@ -43,6 +81,9 @@ func ReadMessage(c *Conn) (*MessageBuffer, error) {
 	}
 	mr.size = uint16(so >> 16)
 	mr.op = uint16(so & 0xFFFF)
+	if (mr.size < minMessageSize) || (mr.size%4 != 0) {
+		return nil, FramingError{Sender: mr.sender, Op: mr.op, Size: mr.size}
+	}
 
 	data := bytes.NewBuffer(make([]byte, 0, mr.size))
 	_, err = io.CopyN(data, r, int64(mr.size)-8)
@@ -50,11 +91,6 @@ func ReadMessage(c *Conn) (*MessageBuffer, error) {
 		return nil, fmt.Errorf("copy data to buffer: %w", err)
 	}
 
-	err = c.readFDs(oob.Bytes())
-	if err != nil {
-		return nil, fmt.Errorf("read FDs: %w", err)
-	}
-
 	mr.data.Reset(data.Bytes())
 
 	return &mr, nil
@@ -75,46 +111,114 @@ func (r MessageBuffer) Size() uint16 {
 	return r.size
 }
 
+// Err returns the first error that occurred while decoding the
+// message's arguments. Attempting to read past the end of the message
+// results in io.ErrUnexpectedEOF.
 func (r MessageBuffer) Err() error {
 	if errors.Is(r.err, io.EOF) {
-		if r.data.Size() < int64(r.size)-8 {
-			return io.ErrUnexpectedEOF
-		}
-		return nil
+		return io.ErrUnexpectedEOF
 	}
 	return r.err
 }
 
 func (r *MessageBuffer) ReadInt() (v int32) {
-	if r.err != nil {
-		return
-	}
-
-	v, r.err = bin.Read[int32](&r.data)
-	r.args = append(r.args, v)
+	v = r.readInt()
+	r.record(v)
 	return v
 }
 
 func (r *MessageBuffer) ReadUint() (v uint32) {
-	if r.err != nil {
-		return
+	v = r.readUint()
+	r.record(v)
+	return v
+}
+
+// ReadObject reads the ID of an object that is not allowed to be
+// null. Unlike ReadUint, the value is recorded as an object for the
+// purposes of tracing.
+func (r *MessageBuffer) ReadObject() uint32 {
+	id := r.readUint()
+	if (r.err == nil) && (id == 0) {
+		r.err = ErrNull
 	}
+	r.record(objectRef(id))
+	return id
+}
 
-	v, r.err = bin.Read[uint32](&r.data)
-	r.args = append(r.args, v)
+// ReadNullableObject reads the ID of an object that is allowed to be
+// null. If it is null, the returned ID is 0.
+func (r *MessageBuffer) ReadNullableObject() uint32 {
+	id := r.readUint()
+	r.record(objectRef(id))
+	return id
+}
+
+// ResolveObject returns the object in state identified by id, which
+// should have been read from msg, as a T. If id is 0, the zero value
+// of T is returned. If id does not refer to an object or the object is
+// not a T, an InvalidObjectError is recorded as msg's error. inter is
+// the name of the expected interface and is used only for the error.
+func ResolveObject[T Object](msg *MessageBuffer, state State, inter string, id uint32) (v T) {
+	if (msg.err != nil) || (id == 0) {
+		return v
+	}
+
+	obj := state.Get(id)
+	v, ok := obj.(T)
+	if !ok {
+		msg.err = InvalidObjectError{ID: id, Interface: inter, Object: obj}
+	}
 	return v
 }
 
-func (r *MessageBuffer) ReadObject() uint32 {
-	return r.ReadUint()
+// CheckNewID returns id, which should have been read from msg as the
+// ID of a new object of the interface inter. If id is 0, is outside of
+// the range of IDs allocated by the side of the connection that sent
+// msg, or already refers to an object in state, an InvalidNewIDError
+// is recorded as msg's error. fromClient indicates whether msg was
+// sent by a client. Messages created by MessageBuilder.Buffer are not
+// checked.
+func CheckNewID(msg *MessageBuffer, state State, inter string, id uint32, fromClient bool) uint32 {
+	if (msg.err != nil) || msg.local {
+		return id
+	}
+
+	lo, hi := MinServerID, MaxServerID
+	if fromClient {
+		lo, hi = MinClientID, MaxClientID
+	}
+
+	switch {
+	case (id < lo) || (id > hi):
+		msg.err = InvalidNewIDError{ID: id, Interface: inter, Reason: "outside of the sender's range"}
+	case state.Get(id) != nil:
+		msg.err = InvalidNewIDError{ID: id, Interface: inter, Reason: "already in use"}
+	}
+	return id
 }
 
+// ReadNewID reads a new_id argument that does not have an interface
+// specified by the protocol.
 func (r *MessageBuffer) ReadNewID() NewID {
-	return NewID{
-		Interface: r.ReadString(),
-		Version:   r.ReadUint(),
-		ID:        r.ReadUint(),
+	inter, ok := r.readString()
+	if (r.err == nil) && !ok {
+		r.err = ErrNull
+	}
+	v := NewID{
+		Interface: inter,
+		Version:   r.readUint(),
+		ID:        r.readUint(),
 	}
+	r.record(v)
+	return v
+}
+
+// ReadNewObject reads a new_id argument whose interface, inter, is
+// specified by the protocol, returning the new object's ID.
+func (r *MessageBuffer) ReadNewObject(inter string) uint32 {
+	id := r.readUint()
+	r.record(NewID{Interface: inter, ID: id})
+	return id
 }
 
 func (r *MessageBuffer) ReadFixed() (v Fixed) {
@@ -123,35 +227,30 @@ func (r *MessageBuffer) ReadFixed() (v Fixed) {
 	}
 
 	v, r.err = bin.Read[Fixed](&r.data)
-	r.args = append(r.args, v)
+	r.record(v)
 	return v
 }
 
+// ReadString reads a string that is not allowed to be null.
 func (r *MessageBuffer) ReadString() string {
-	if r.err != nil {
-		return ""
+	v, ok := r.readString()
+	if (r.err == nil) && !ok {
+		r.err = ErrNull
 	}
+	r.record(v)
+	return v
+}
 
-	length := r.ReadUint()
-	if r.err != nil {
-		return ""
-	}
-	pad := padding(length)
-
-	var str strings.Builder
-	str.Grow(int(length + pad))
-	_, r.err = io.CopyN(&str, &r.data, int64(length+pad))
-	if r.err != nil {
-		return ""
-	}
-	v := str.String()
-	if v[length-1] != 0 {
-		r.err = errors.New("string is not null-terminated")
-		return ""
+// ReadNullableString reads a string that is allowed to be null. If it
+// is null, nil is returned.
+func (r *MessageBuffer) ReadNullableString() *string {
+	v, ok := r.readString()
+	if !ok {
+		r.record(nil)
+		return nil
 	}
-
-	r.args = append(r.args, v[:length-1])
-	return v[:length-1]
+	r.record(v)
+	return &v
 }
 
 func (r *MessageBuffer) ReadArray() []byte {
@@ -159,54 +258,231 @@ func (r *MessageBuffer) ReadArray() []byte {
 		return nil
 	}
 
-	length := r.ReadUint()
+	length := r.readUint()
 	if r.err != nil {
 		return nil
 	}
-	pad := padding(length)
+	if !r.checkLength(length) {
+		return nil
+	}
 
-	buf := make([]byte, length+pad)
+	buf := make([]byte, int(length)+int(padding(length)))
 	_, r.err = io.ReadFull(&r.data, buf)
 	if r.err != nil {
 		return nil
 	}
 
-	r.args = append(r.args, buf[:length])
+	r.record(buf[:length])
 	return buf[:length]
 }
 
+// ReadRaw reads the rest of the message's arguments without decoding
+// them. It allows messages whose signatures are not known to be
+// forwarded with MessageBuilder.WriteRaw.
+func (r *MessageBuffer) ReadRaw() []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	buf := make([]byte, r.data.Len())
+	_, r.err = io.ReadFull(&r.data, buf)
+	return buf
+}
+
+// ReadFile reads a file descriptor argument. If the message's file
+// descriptors have been claimed with ClaimFDs, it is the next one of
+// those. Otherwise, it is the next one received on the connection.
 func (r *MessageBuffer) ReadFile() *os.File {
 	if r.err != nil {
 		return nil
 	}
 
-	fd, ok := pop(&r.conn.fds)
+	var fd int
+	var ok bool
+	if r.claimed {
+		fd, ok = pop(&r.fds)
+	} else {
+		fd, ok = r.conn.popFD()
+	}
 	if !ok {
 		r.err = errors.New("no more file descriptors")
 		return nil
 	}
 
 	f := os.NewFile(uintptr(fd), "")
-	r.args = append(r.args, f)
+	r.files = append(r.files, f)
+	r.record(f)
 	return f
 }
 
-func (r *MessageBuffer) Debug(sender Object) string {
-	args := make([]string, 0, len(r.args))
+// ClaimFDs takes ownership of the next n file descriptors received on
+// the connection, which should be the number of file descriptor
+// arguments that the message has. Generated Dispatch methods call this
+// before decoding a message so that the file descriptors that belong
+// to it are removed from the connection's queue even if decoding
+// fails, rather than being read by whatever message is dispatched
+// next. If fewer than n are available, the ones that are are claimed
+// and a MissingFDsError is returned and recorded as the message's
+// error.
+func (r *MessageBuffer) ClaimFDs(n int) error {
+	r.claimed = true
+	if n == 0 {
+		return nil
+	}
+
+	fds := r.conn.claimFDs(n)
+	r.fds = append(r.fds, fds...)
+	if len(fds) < n {
+		err := MissingFDsError{Expected: n, Received: len(fds)}
+		if r.err == nil {
+			r.err = err
+		}
+		return err
+	}
+	return nil
+}
+
+// HandOffFiles records that the files that have been read from the
+// message have been passed on to something that takes ownership of
+// them, such as a listener, so that Close does not close them.
+func (r *MessageBuffer) HandOffFiles() {
+	r.handedOff = true
+}
+
+// Close closes any file descriptors claimed by the message that were
+// not read, along with the files that were read unless HandOffFiles
+// has been called. If the message was created by MessageBuilder.Buffer,
+// the file descriptors that it never claimed are closed too, as
+// they belong to it alone. It is called by the object management system
+// after the message has been dispatched.
+func (r *MessageBuffer) Close() error {
+	fds := r.fds
+	if r.local {
+		fds = append(fds, r.conn.claimFDs(len(r.conn.fds))...)
+	}
+	errs := make([]error, 0, len(fds))
+	for _, fd := range fds {
+		errs = append(errs, unix.Close(fd))
+	}
+	r.fds = nil
+
+	if !r.handedOff {
+		for _, f := range r.files {
+			errs = append(errs, f.Close())
+		}
+	}
+	r.files = nil
+
+	return errors.Join(errs...)
+}
+
+func (r *MessageBuffer) readInt() (v int32) {
+	if r.err != nil {
+		return
+	}
+
+	v, r.err = bin.Read[int32](&r.data)
+	return v
+}
+
+func (r *MessageBuffer) readUint() (v uint32) {
+	if r.err != nil {
+		return
+	}
+
+	v, r.err = bin.Read[uint32](&r.data)
+	return v
+}
+
+// readString reads a string. If the string is null, ok is false.
+func (r *MessageBuffer) readString() (v string, ok bool) {
+	if r.err != nil {
+		return "", true
+	}
+
+	length := r.readUint()
+	if r.err != nil {
+		return "", true
+	}
+	if length == 0 {
+		return "", false
+	}
+	if !r.checkLength(length) {
+		return "", true
+	}
+	size := int(length) + int(padding(length))
+
+	var str strings.Builder
+	str.Grow(size)
+	_, r.err = io.CopyN(&str, &r.data, int64(size))
+	if r.err != nil {
+		return "", true
+	}
+	v = str.String()
+	if v[length-1] != 0 {
+		r.err = errors.New("string is not null-terminated")
+		return "", true
+	}
+
+	return v[:length-1], true
+}
+
+// checkLength checks that an argument of the given length, plus its
+// padding, fits in the unread part of the message. The length comes
+// from the remote end, so this must be done before anything is
+// allocated based on it.
+func (r *MessageBuffer) checkLength(length uint32) bool {
+	if int64(length)+int64(padding(length)) > int64(r.data.Len()) {
+		r.err = fmt.Errorf("argument length %v exceeds remaining %v bytes of message: %w", length, r.data.Len(), io.ErrUnexpectedEOF)
+		return false
+	}
+	return true
+}
+
+// record saves a decoded argument for tracing.
+func (r *MessageBuffer) record(arg any) {
+	if r.err != nil {
+		return
+	}
+	r.args = append(r.args, arg)
+}
+
+// objectRef is an object ID recorded by ReadObject. It is resolved to
+// an actual Object when the message is traced.
+type objectRef uint32
+
+// Trace returns a description of the message as it was decoded. The
+// sender should be the object that the message was dispatched to, if
+// it is known, and get is used to resolve the IDs of any object
+// arguments.
+func (r *MessageBuffer) Trace(sender Object, get func(uint32) Object) TraceEvent {
+	args := make([]any, 0, len(r.args))
 	for _, arg := range r.args {
-		switch arg := arg.(type) {
-		case string:
-			args = append(args, strconv.Quote(arg))
-		case *os.File:
-			args = append(args, fmt.Sprint(arg.Fd()))
-		default:
-			args = append(args, fmt.Sprint(arg))
+		if ref, ok := arg.(objectRef); ok {
+			switch obj := get(uint32(ref)); {
+			case ref == 0:
+				arg = nil
+			case obj == nil:
+				arg = UnknownObject(ref)
+			default:
+				arg = obj
+			}
 		}
+		args = append(args, arg)
 	}
 
-	method := strconv.FormatUint(uint64(r.op), 10)
-	if mn, ok := sender.(DebugObject); ok {
-		method = mn.MethodName(r.op)
+	ev := TraceEvent{
+		Time:      time.Now(),
+		Direction: Incoming,
+		ObjectID:  r.sender,
+		Method:    strconv.FormatUint(uint64(r.op), 10),
+		Args:      args,
+	}
+	if sender != nil {
+		ev.Interface = objectInterface(sender)
+		if mn, ok := sender.(DebugObject); ok {
+			ev.Method = mn.MethodName(r.op)
+		}
 	}
-	return fmt.Sprintf("%v.%v(%v)", sender, method, strings.Join(args, ", "))
+	return ev
 }
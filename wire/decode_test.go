@@ -0,0 +1,222 @@
+package wire
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func socketPair(t testing.TB) (*Conn, *net.UnixConn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		file := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	return NewConn(conns[0]), conns[1]
+}
+
+// testMessage returns a message with the given header fields and
+// arguments, each of which is encoded as a single uint32.
+func testMessage(sender uint32, op uint16, args ...uint32) []byte {
+	size := 8 + 4*len(args)
+	buf := binary.NativeEndian.AppendUint32(nil, sender)
+	buf = binary.NativeEndian.AppendUint32(buf, uint32(size)<<16|uint32(op))
+	for _, arg := range args {
+		buf = binary.NativeEndian.AppendUint32(buf, arg)
+	}
+	return buf
+}
+
+// readArgs reads arguments from msg according to sig, which uses the
+// characters of libwayland's signature strings.
+func readArgs(msg *MessageBuffer, sig string) {
+	for _, c := range []byte(sig) {
+		switch c % 10 {
+		case 0:
+			msg.ReadInt()
+		case 1:
+			msg.ReadUint()
+		case 2:
+			msg.ReadFixed()
+		case 3:
+			msg.ReadString()
+		case 4:
+			msg.ReadNullableString()
+		case 5:
+			msg.ReadObject()
+		case 6:
+			msg.ReadNewObject("test")
+		case 7:
+			msg.ReadNewID()
+		case 8:
+			msg.ReadArray()
+		case 9:
+			msg.ReadFile()
+		}
+	}
+}
+
+func FuzzReadMessage(f *testing.F) {
+	f.Add(testMessage(1, 0, 2), "1", uint8(0))
+	f.Add(testMessage(1, 1, 2, 3), "55", uint8(0))
+	f.Add(testMessage(1, 0), "", uint8(0))
+	f.Add(testMessage(1, 0, 5, 0x64636261, 0x65), "3", uint8(0))
+	f.Add(testMessage(1, 0, 0xFFFFFFFF), "8", uint8(0))
+	f.Add(testMessage(1, 0, 4, 0x64636261, 1, 2), "7", uint8(0))
+	f.Add(testMessage(2, 3), "99", uint8(2))
+	f.Add(append(testMessage(1, 0, 1), testMessage(1, 0, 1)...), "9", uint8(1))
+	f.Add([]byte{1, 0, 0, 0, 0, 0, 7, 0}, "", uint8(0))
+
+	f.Fuzz(func(t *testing.T, data []byte, sig string, nfds uint8) {
+		if len(data) > 4096 {
+			t.Skip()
+		}
+
+		conn, remote := socketPair(t)
+		defer conn.Close()
+		defer remote.Close()
+
+		var oob []byte
+		if nfds%4 != 0 {
+			fds := make([]int, nfds%4)
+			for i := range fds {
+				fds[i] = int(os.Stderr.Fd())
+			}
+			oob = unix.UnixRights(fds...)
+		}
+		_, _, err := remote.WriteMsgUnix(data, oob, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		remote.CloseWrite()
+
+		var read int
+		for {
+			msg, err := ReadMessage(conn)
+			if err != nil {
+				var framingErr FramingError
+				switch {
+				case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+				case errors.As(err, &framingErr):
+					if (framingErr.Size >= minMessageSize) && (framingErr.Size%4 == 0) {
+						t.Fatalf("framing error for valid size %v", framingErr.Size)
+					}
+				default:
+					t.Fatalf("read message: %v", err)
+				}
+				return
+			}
+
+			read += int(msg.Size())
+			if read > len(data) {
+				t.Fatalf("read %v bytes of messages from %v bytes of data", read, len(data))
+			}
+
+			readArgs(msg, sig)
+			msg.Trace(nil, func(uint32) Object { return nil })
+			msg.Close()
+		}
+	})
+}
+
+func TestReadMessageControlTruncated(t *testing.T) {
+	conn, remote := socketPair(t)
+	defer conn.Close()
+	defer remote.Close()
+
+	// More file descriptors than fit in the space that ReadMessage
+	// reserves for them.
+	fds := make([]int, 64)
+	for i := range fds {
+		fds[i] = int(os.Stderr.Fd())
+	}
+	_, _, err := remote.WriteMsgUnix(testMessage(1, 0), unix.UnixRights(fds...), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ReadMessage(conn)
+	if !errors.Is(err, ErrControlTruncated) {
+		t.Fatalf("expected ErrControlTruncated but got %v", err)
+	}
+}
+
+// testState is a State that keeps objects in a map and discards
+// outgoing messages.
+type testState map[uint32]Object
+
+func (s testState) Add(obj Object)          { s[obj.ID()] = obj }
+func (s testState) Get(id uint32) Object    { return s[id] }
+func (s testState) Enqueue(*MessageBuilder) {}
+
+func TestCloseUnhandledFiles(t *testing.T) {
+	desc := &InterfaceDesc{
+		Name:    "test",
+		Version: 1,
+		Requests: []MessageDesc{
+			{Name: "send", Since: 1, Args: []ArgDesc{{Name: "fd", Type: ArgFD}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		listener DynamicListener
+		closed   bool
+	}{
+		{"NoListener", nil, true},
+		{"Listener", DynamicListenerFunc(func(MessageDesc, []any) {}), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn, remote := socketPair(t)
+			defer conn.Close()
+			defer remote.Close()
+
+			_, _, err := remote.WriteMsgUnix(testMessage(1, 0), unix.UnixRights(int(os.Stderr.Fd())), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := make(testState)
+			obj := NewDynamicObject(state, desc, false)
+			obj.SetID(1)
+			obj.Listener = test.listener
+			state.Add(obj)
+
+			msg, err := ReadMessage(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = obj.Dispatch(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(msg.files) != 1 {
+				t.Fatalf("expected 1 file to be read but got %v", len(msg.files))
+			}
+			file := msg.files[0]
+			msg.Close()
+
+			err = file.Close()
+			if closed := errors.Is(err, os.ErrClosed); closed != test.closed {
+				t.Fatalf("expected file to be closed: %v, but was: %v", test.closed, closed)
+			}
+		})
+	}
+}
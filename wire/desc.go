@@ -0,0 +1,245 @@
+package wire
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ArgType is the wire type of a message argument.
+type ArgType int
+
+const (
+	ArgInt ArgType = iota
+	ArgUint
+	ArgFixed
+	ArgString
+	ArgObject
+	ArgNewID
+	ArgArray
+	ArgFD
+)
+
+var argTypeNames = [...]string{
+	ArgInt:    "int",
+	ArgUint:   "uint",
+	ArgFixed:  "fixed",
+	ArgString: "string",
+	ArgObject: "object",
+	ArgNewID:  "new_id",
+	ArgArray:  "array",
+	ArgFD:     "fd",
+}
+
+// ParseArgType returns the ArgType corresponding to the name used for
+// it in protocol specification XML files.
+func ParseArgType(name string) (ArgType, error) {
+	i := slices.Index(argTypeNames[:], name)
+	if i < 0 {
+		return 0, fmt.Errorf("unknown argument type: %q", name)
+	}
+	return ArgType(i), nil
+}
+
+// String returns the name used for t in protocol specification XML
+// files.
+func (t ArgType) String() string {
+	if (t < 0) || (int(t) >= len(argTypeNames)) {
+		return "<invalid ArgType>"
+	}
+	return argTypeNames[t]
+}
+
+// sig returns the character used for t in libwayland's message
+// signature strings.
+func (t ArgType) sig() byte {
+	return "iufsonah"[t]
+}
+
+// ArgDesc describes a single argument of a message.
+type ArgDesc struct {
+	Name string
+	Type ArgType
+
+	// Interface is the name of the interface of an object or new_id
+	// argument. It is empty if any interface is allowed.
+	Interface string
+
+	// AllowNull is true if a string or object argument may be null.
+	AllowNull bool
+
+	// Enum is the name of the enum that the argument's values come
+	// from, if any. Enums from other interfaces are referred to as
+	// "interface.enum".
+	Enum string
+}
+
+// MessageDesc describes a request or event.
+type MessageDesc struct {
+	Name string
+
+	// Since is the version of the interface that the message was
+	// introduced in.
+	Since uint32
+
+	Args []ArgDesc
+}
+
+// FDCount returns the number of file descriptor arguments of m.
+func (m MessageDesc) FDCount() int {
+	var n int
+	for _, arg := range m.Args {
+		if arg.Type == ArgFD {
+			n++
+		}
+	}
+	return n
+}
+
+// Signature returns the signature of m in the format used by
+// libwayland's wl_message, such as "2usn" or "?o".
+func (m MessageDesc) Signature() string {
+	var sb strings.Builder
+	if m.Since > 1 {
+		fmt.Fprint(&sb, m.Since)
+	}
+	for _, arg := range m.Args {
+		if arg.AllowNull {
+			sb.WriteByte('?')
+		}
+		if (arg.Type == ArgNewID) && (arg.Interface == "") {
+			// Untyped new_ids are sent as an interface name, a version and
+			// an ID.
+			sb.WriteString("su")
+		}
+		sb.WriteByte(arg.Type.sig())
+	}
+	return sb.String()
+}
+
+// EnumDesc describes an enum.
+type EnumDesc struct {
+	Name string
+
+	// Bitfield is true if the enum's values are flags that may be
+	// combined.
+	Bitfield bool
+
+	Entries []EntryDesc
+}
+
+// Valid reports whether v is a valid value for the enum. For a
+// bitfield, that means that it is made up only of defined bits.
+// Otherwise, it must be exactly one of the defined values.
+func (desc EnumDesc) Valid(v uint32) bool {
+	if desc.Bitfield {
+		var mask uint32
+		for _, entry := range desc.Entries {
+			mask |= entry.Value
+		}
+		return v&^mask == 0
+	}
+
+	return slices.ContainsFunc(desc.Entries, func(entry EntryDesc) bool { return entry.Value == v })
+}
+
+// EntryDesc describes a single value of an enum.
+type EntryDesc struct {
+	Name  string
+	Value uint32
+}
+
+// InterfaceDesc describes an interface at runtime. Generated code
+// provides one for every interface and registers it with
+// RegisterInterface.
+type InterfaceDesc struct {
+	Name    string
+	Version uint32
+
+	Requests []MessageDesc
+	Events   []MessageDesc
+	Enums    []EnumDesc
+}
+
+// Request returns the description of the request with the given
+// opcode, if it exists.
+func (desc *InterfaceDesc) Request(op uint16) (MessageDesc, bool) {
+	if int(op) >= len(desc.Requests) {
+		return MessageDesc{}, false
+	}
+	return desc.Requests[op], true
+}
+
+// Event returns the description of the event with the given opcode,
+// if it exists.
+func (desc *InterfaceDesc) Event(op uint16) (MessageDesc, bool) {
+	if int(op) >= len(desc.Events) {
+		return MessageDesc{}, false
+	}
+	return desc.Events[op], true
+}
+
+// Enum returns the description of the enum with the given name, if it
+// exists.
+func (desc *InterfaceDesc) Enum(name string) (EnumDesc, bool) {
+	i := slices.IndexFunc(desc.Enums, func(e EnumDesc) bool { return e.Name == name })
+	if i < 0 {
+		return EnumDesc{}, false
+	}
+	return desc.Enums[i], true
+}
+
+// registryKey identifies a registered interface. Client and server
+// code are registered separately, as a program may contain both and
+// they need not have been generated with the same versions.
+type registryKey struct {
+	name     string
+	isClient bool
+}
+
+var registry struct {
+	m     sync.RWMutex
+	descs map[registryKey]*InterfaceDesc
+}
+
+// RegisterInterface adds desc to the global registry of interfaces for
+// the client side of connections if isClient is true and for the
+// server side otherwise, replacing any existing description with the
+// same name for that side. Generated code calls this automatically
+// during initialization.
+func RegisterInterface(desc *InterfaceDesc, isClient bool) {
+	registry.m.Lock()
+	defer registry.m.Unlock()
+
+	if registry.descs == nil {
+		registry.descs = make(map[registryKey]*InterfaceDesc)
+	}
+	registry.descs[registryKey{name: desc.Name, isClient: isClient}] = desc
+}
+
+// LookupInterface returns the registered description of the named
+// interface for the given side, if there is one.
+func LookupInterface(name string, isClient bool) (*InterfaceDesc, bool) {
+	registry.m.RLock()
+	defer registry.m.RUnlock()
+
+	desc, ok := registry.descs[registryKey{name: name, isClient: isClient}]
+	return desc, ok
+}
+
+// Interfaces returns the names of all interfaces registered for the
+// given side in sorted order.
+func Interfaces(isClient bool) []string {
+	registry.m.RLock()
+	defer registry.m.RUnlock()
+
+	names := make([]string, 0, len(registry.descs))
+	for key := range registry.descs {
+		if key.isClient == isClient {
+			names = append(names, key.name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
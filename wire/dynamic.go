@@ -0,0 +1,430 @@
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"deedles.dev/wl/internal/bin"
+)
+
+// DynamicListener is a type that can respond to incoming messages for
+// a DynamicObject.
+type DynamicListener interface {
+	// Message is called with the description of each incoming message
+	// and its decoded arguments. Object arguments are given as Objects,
+	// or nil if they were null, new_id arguments with an interface are
+	// given as the newly created Object and those without one are given
+	// as a NewID. Nullable strings are given as a *string. Other
+	// arguments are given as the same types that the corresponding
+	// MessageBuffer methods return.
+	Message(msg MessageDesc, args []any)
+}
+
+// DynamicListenerFunc is a function that implements DynamicListener.
+type DynamicListenerFunc func(msg MessageDesc, args []any)
+
+func (f DynamicListenerFunc) Message(msg MessageDesc, args []any) {
+	f(msg, args)
+}
+
+// DynamicObject is an Object whose interface is described at runtime
+// by an InterfaceDesc instead of by generated code. It allows
+// protocols that code was not generated for to be used, albeit
+// without type safety.
+type DynamicObject struct {
+	// Listener's method is called by incoming messages from the remote
+	// end via Dispatch. If it is nil, messages are silently ignored.
+	Listener DynamicListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	desc     *InterfaceDesc
+	isClient bool
+	state    State
+	id       uint32
+}
+
+// NewDynamicObject returns a newly instantiated DynamicObject that
+// implements the interface described by desc. If isClient is true,
+// the object sends requests and receives events. Otherwise, it sends
+// events and receives requests.
+func NewDynamicObject(state State, desc *InterfaceDesc, isClient bool) *DynamicObject {
+	return &DynamicObject{
+		desc:     desc,
+		isClient: isClient,
+		state:    state,
+	}
+}
+
+func (obj *DynamicObject) State() State {
+	return obj.state
+}
+
+// Desc returns the description of the object's interface.
+func (obj *DynamicObject) Desc() *InterfaceDesc {
+	return obj.desc
+}
+
+func (obj *DynamicObject) listeners() []MessageDesc {
+	if obj.isClient {
+		return obj.desc.Events
+	}
+	return obj.desc.Requests
+}
+
+func (obj *DynamicObject) senders() []MessageDesc {
+	if obj.isClient {
+		return obj.desc.Requests
+	}
+	return obj.desc.Events
+}
+
+func (obj *DynamicObject) Dispatch(msg *MessageBuffer) error {
+	listeners := obj.listeners()
+	if int(msg.Op()) >= len(listeners) {
+		typ := "request"
+		if obj.isClient {
+			typ = "event"
+		}
+		return UnknownOpError{
+			Interface: obj.desc.Name,
+			Type:      typ,
+			Op:        msg.Op(),
+		}
+	}
+	m := listeners[msg.Op()]
+
+	fds := m.FDCount()
+	if err := msg.ClaimFDs(fds); err != nil {
+		return fmt.Errorf("%v.%v: %w", obj.desc.Name, m.Name, err)
+	}
+
+	args := make([]any, 0, len(m.Args))
+	var created []Object
+	for _, arg := range m.Args {
+		v, err := obj.readArg(msg, arg)
+		if err != nil {
+			return fmt.Errorf("%v.%v: %w", obj.desc.Name, m.Name, err)
+		}
+		if n, ok := v.(*DynamicObject); ok && (arg.Type == ArgNewID) {
+			created = append(created, n)
+		}
+		args = append(args, v)
+	}
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !obj.isClient {
+		err := obj.validateEnums(m, args)
+		if err != nil {
+			return err
+		}
+	}
+
+	// As in generated code, new objects are only added once every
+	// argument has been decoded and validated.
+	for _, n := range created {
+		obj.state.Add(n)
+	}
+
+	if obj.Listener == nil {
+		return nil
+	}
+	if fds != 0 {
+		msg.HandOffFiles()
+	}
+	obj.Listener.Message(m, args)
+	return nil
+}
+
+// validateEnums checks the values of any enum arguments in args. Enums
+// whose descriptions can't be found are not checked.
+func (obj *DynamicObject) validateEnums(m MessageDesc, args []any) error {
+	for i, arg := range m.Args {
+		if arg.Enum == "" {
+			continue
+		}
+
+		desc := obj.desc
+		name := arg.Enum
+		if inter, enum, ok := strings.Cut(arg.Enum, "."); ok {
+			desc, ok = LookupInterface(inter, obj.isClient)
+			if !ok {
+				continue
+			}
+			name = enum
+		}
+		enum, ok := desc.Enum(name)
+		if !ok {
+			continue
+		}
+
+		v := reflect.ValueOf(args[i])
+		var n int64
+		switch {
+		case v.CanInt():
+			n = v.Int()
+		case v.CanUint():
+			n = int64(v.Uint())
+		}
+		if !enum.Valid(uint32(n)) {
+			return InvalidEnumError{
+				Interface: obj.desc.Name,
+				Method:    m.Name,
+				Arg:       arg.Name,
+				Value:     n,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (obj *DynamicObject) readArg(msg *MessageBuffer, arg ArgDesc) (any, error) {
+	switch arg.Type {
+	case ArgInt:
+		return msg.ReadInt(), nil
+	case ArgUint:
+		return msg.ReadUint(), nil
+	case ArgFixed:
+		return msg.ReadFixed(), nil
+	case ArgString:
+		if arg.AllowNull {
+			return msg.ReadNullableString(), nil
+		}
+		return msg.ReadString(), nil
+	case ArgArray:
+		return msg.ReadArray(), nil
+	case ArgFD:
+		return msg.ReadFile(), nil
+	case ArgObject:
+		var id uint32
+		if arg.AllowNull {
+			id = msg.ReadNullableObject()
+		} else {
+			id = msg.ReadObject()
+		}
+		if (msg.Err() != nil) || (id == 0) {
+			return nil, nil
+		}
+
+		o := obj.state.Get(id)
+		if (o == nil) || ((arg.Interface != "") && (objectInterface(o) != arg.Interface)) {
+			return nil, InvalidObjectError{ID: id, Interface: arg.Interface, Object: o}
+		}
+		return o, nil
+	case ArgNewID:
+		if arg.Interface == "" {
+			v := msg.ReadNewID()
+			CheckNewID(msg, obj.state, v.Interface, v.ID, !obj.isClient)
+			return v, nil
+		}
+		id := CheckNewID(msg, obj.state, arg.Interface, msg.ReadNewObject(arg.Interface), !obj.isClient)
+		if msg.Err() != nil {
+			return nil, nil
+		}
+		desc, ok := LookupInterface(arg.Interface, obj.isClient)
+		if !ok {
+			return nil, fmt.Errorf("%v: unknown interface %q", arg.Name, arg.Interface)
+		}
+		n := NewDynamicObject(obj.state, desc, obj.isClient)
+		n.SetID(id)
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("%v: unknown argument type %v", arg.Name, arg.Type)
+}
+
+// Send enqueues the named request or event, depending on which side
+// of the connection the object is on. args must contain one value per
+// argument of the message. Integer arguments may be given as any
+// integer type, object arguments and new_id arguments with an
+// interface must be given as Objects, and new_id arguments without an
+// interface must be given as NewIDs. Any new objects that have not yet
+// been added to the object's State are added automatically.
+func (obj *DynamicObject) Send(name string, args ...any) error {
+	senders := obj.senders()
+	op := slices.IndexFunc(senders, func(m MessageDesc) bool { return m.Name == name })
+	if op < 0 {
+		return fmt.Errorf("%v has no message named %q", obj.desc.Name, name)
+	}
+	m := senders[op]
+	if len(args) != len(m.Args) {
+		return fmt.Errorf("%v.%v: expected %v arguments but got %v", obj.desc.Name, m.Name, len(m.Args), len(args))
+	}
+
+	builder := NewMessage(obj, uint16(op))
+	var created []newObject
+	for i, arg := range m.Args {
+		err := obj.writeArg(builder, arg, args[i], &created)
+		if err != nil {
+			builder.close()
+			return fmt.Errorf("%v.%v: %w", obj.desc.Name, m.Name, err)
+		}
+	}
+
+	// New objects are only added once every argument has been written
+	// so that a failed Send leaves the state unchanged. Their IDs are
+	// filled in afterwards.
+	for _, n := range created {
+		obj.state.Add(n.obj)
+		bin.Put(builder.data.Bytes()[n.offset:], n.obj.ID())
+	}
+
+	if Tracing(obj.state) {
+		for i, arg := range m.Args {
+			v := args[i]
+			if (arg.Type == ArgNewID) && (arg.Interface != "") {
+				v = NewID{Interface: arg.Interface, ID: v.(Object).ID()}
+			}
+			builder.Args = append(builder.Args, v)
+		}
+	}
+
+	builder.Method = m.Name
+	obj.state.Enqueue(builder)
+	return nil
+}
+
+// newObject is an object that is created by a message being sent by
+// Send but that has not yet been added to the state. offset is the
+// position in the message at which its ID is written.
+type newObject struct {
+	obj    Object
+	offset int
+}
+
+// writeArg writes v to builder as arg. New objects that have not yet
+// been added to the state are appended to created, with a placeholder
+// written for their IDs.
+func (obj *DynamicObject) writeArg(builder *MessageBuilder, arg ArgDesc, v any, created *[]newObject) error {
+	switch arg.Type {
+	case ArgInt:
+		rv := reflect.ValueOf(v)
+		if !rv.CanInt() {
+			return fmt.Errorf("%v: expected integer but got %T", arg.Name, v)
+		}
+		builder.WriteInt(int32(rv.Int()))
+	case ArgUint:
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.CanUint():
+			builder.WriteUint(uint32(rv.Uint()))
+		case rv.CanInt():
+			builder.WriteUint(uint32(rv.Int()))
+		default:
+			return fmt.Errorf("%v: expected integer but got %T", arg.Name, v)
+		}
+	case ArgFixed:
+		f, ok := v.(Fixed)
+		if !ok {
+			return fmt.Errorf("%v: expected Fixed but got %T", arg.Name, v)
+		}
+		builder.WriteFixed(f)
+	case ArgString:
+		switch s := v.(type) {
+		case string:
+			builder.WriteString(s)
+		case *string:
+			if (s == nil) && !arg.AllowNull {
+				return fmt.Errorf("%v: %w", arg.Name, ErrNull)
+			}
+			builder.WriteNullableString(s)
+		case nil:
+			if !arg.AllowNull {
+				return fmt.Errorf("%v: %w", arg.Name, ErrNull)
+			}
+			builder.WriteNullableString(nil)
+		default:
+			return fmt.Errorf("%v: expected string but got %T", arg.Name, v)
+		}
+	case ArgArray:
+		a, ok := v.([]byte)
+		if !ok {
+			return fmt.Errorf("%v: expected []byte but got %T", arg.Name, v)
+		}
+		builder.WriteArray(a)
+	case ArgFD:
+		f, ok := v.(*os.File)
+		if !ok || (f == nil) {
+			return fmt.Errorf("%v: expected *os.File but got %T", arg.Name, v)
+		}
+		builder.WriteFile(f)
+	case ArgObject:
+		if isNil(v) {
+			if !arg.AllowNull {
+				return fmt.Errorf("%v: %w", arg.Name, ErrNull)
+			}
+			builder.WriteNullableObject(nil)
+			return nil
+		}
+		o, ok := v.(Object)
+		if !ok {
+			return fmt.Errorf("%v: expected Object but got %T", arg.Name, v)
+		}
+		builder.WriteObject(o)
+	case ArgNewID:
+		if arg.Interface == "" {
+			id, ok := v.(NewID)
+			if !ok {
+				return fmt.Errorf("%v: expected NewID but got %T", arg.Name, v)
+			}
+			builder.WriteNewID(id)
+			return nil
+		}
+		o, ok := v.(Object)
+		if !ok || isNil(o) {
+			return fmt.Errorf("%v: expected Object but got %T", arg.Name, v)
+		}
+		if o.ID() == 0 {
+			*created = append(*created, newObject{obj: o, offset: builder.data.Len()})
+			builder.WriteUint(0)
+			return nil
+		}
+		builder.WriteObject(o)
+	default:
+		return errors.New("unknown argument type")
+	}
+
+	return nil
+}
+
+func (obj *DynamicObject) ID() uint32 {
+	return obj.id
+}
+
+func (obj *DynamicObject) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *DynamicObject) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *DynamicObject) String() string {
+	return fmt.Sprintf("%v(%v)", obj.desc.Name, obj.id)
+}
+
+func (obj *DynamicObject) MethodName(op uint16) string {
+	listeners := obj.listeners()
+	if int(op) >= len(listeners) {
+		return "unknown method"
+	}
+	return listeners[op].Name
+}
+
+func (obj *DynamicObject) Interface() string {
+	return obj.desc.Name
+}
+
+func (obj *DynamicObject) Version() uint32 {
+	return obj.desc.Version
+}
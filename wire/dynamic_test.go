@@ -0,0 +1,104 @@
+package wire
+
+import (
+	"testing"
+
+	"deedles.dev/wl/internal/bin"
+)
+
+// recordingState is a testState that also records outgoing messages.
+type recordingState struct {
+	testState
+	sent []*MessageBuilder
+	next uint32
+}
+
+func (s *recordingState) Add(obj Object) {
+	if obj.ID() == 0 {
+		s.next++
+		obj.SetID(s.next)
+	}
+	s.testState.Add(obj)
+}
+
+func (s *recordingState) Enqueue(mb *MessageBuilder) {
+	s.sent = append(s.sent, mb)
+}
+
+var dynamicTestDesc = &InterfaceDesc{
+	Name:    "dynamic_test",
+	Version: 1,
+	Requests: []MessageDesc{
+		{
+			Name:  "create",
+			Since: 1,
+			Args: []ArgDesc{
+				{Name: "id", Type: ArgNewID, Interface: "dynamic_test"},
+				{Name: "mode", Type: ArgUint, Enum: "mode"},
+			},
+		},
+	},
+	Enums: []EnumDesc{
+		{Name: "mode", Entries: []EntryDesc{{Name: "a", Value: 0}, {Name: "b", Value: 1}}},
+	},
+}
+
+func init() {
+	RegisterInterface(dynamicTestDesc, false)
+	RegisterInterface(dynamicTestDesc, true)
+}
+
+func TestDynamicDispatchInvalidEnum(t *testing.T) {
+	conn, remote := socketPair(t)
+	defer conn.Close()
+	defer remote.Close()
+
+	state := make(testState)
+	obj := NewDynamicObject(state, dynamicTestDesc, false)
+	obj.SetID(1)
+	state.Add(obj)
+
+	_, err := remote.Write(testMessage(1, 0, 2, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := ReadMessage(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obj.Dispatch(msg)
+	if _, ok := err.(InvalidEnumError); !ok {
+		t.Fatalf("expected InvalidEnumError but got %v", err)
+	}
+	if state.Get(2) != nil {
+		t.Fatalf("object 2 was added by a message that failed validation")
+	}
+}
+
+func TestDynamicSend(t *testing.T) {
+	state := &recordingState{testState: make(testState), next: 1}
+	obj := NewDynamicObject(state, dynamicTestDesc, true)
+	obj.SetID(1)
+	state.Add(obj)
+
+	n := NewDynamicObject(state, dynamicTestDesc, true)
+	err := obj.Send("create", n, "not an integer")
+	if err == nil {
+		t.Fatal("expected an error for an invalid argument")
+	}
+	if n.ID() != 0 {
+		t.Fatalf("new object was added with ID %v by a message that failed", n.ID())
+	}
+
+	err = obj.Send("create", n, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (n.ID() == 0) || (state.Get(n.ID()) != n) {
+		t.Fatalf("new object was not added")
+	}
+	data := state.sent[0].data.Bytes()
+	if id := bin.Value[uint32]([4]byte(data[minMessageSize:])); id != n.ID() {
+		t.Fatalf("message contains ID %v but the new object is %v", id, n.ID())
+	}
+}
@@ -7,8 +7,9 @@ import (
 	"io"
 	"os"
 	"runtime"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unsafe"
 
 	"deedles.dev/wl/internal/bin"
@@ -23,21 +24,75 @@ type MessageBuilder struct {
 
 	// Args is the original set of arguments passed to the function from
 	// which this MessageBuilder was generated. It is included purely
-	// for debugging purposes.
+	// for debugging purposes. Generated code only sets it if Tracing
+	// reports that the state that the message is sent via needs it.
 	Args []any
 
 	sender Object
 	op     uint16
-	data   bytes.Buffer
 	fds    []int
+	owned  []io.Closer
 	err    error
+
+	// data holds the header of the message followed by its arguments.
+	// The header is filled in by Build so that the message can be sent
+	// without copying it into another buffer.
+	data bytes.Buffer
 }
 
+// maxPooledSize is the capacity above which the buffers of builders
+// are not reused, so that a rare large message does not keep its
+// memory alive indefinitely.
+const maxPooledSize = 4096
+
+var builderPool = sync.Pool{
+	New: func() any { return new(MessageBuilder) },
+}
+
+// NewMessage returns a MessageBuilder for a message with the given
+// opcode sent from sender. Builders are reused once they have been
+// built, so they must not be retained after calling Build.
 func NewMessage(sender Object, op uint16) *MessageBuilder {
-	return &MessageBuilder{
-		sender: sender,
-		op:     op,
+	mb := builderPool.Get().(*MessageBuilder)
+	mb.sender = sender
+	mb.op = op
+	var header [minMessageSize]byte
+	mb.data.Write(header[:])
+	return mb
+}
+
+// writeValue writes v to buf. Unlike bin.Write, it does not cause v
+// to be allocated on the heap.
+func writeValue[T ~int32 | ~uint32](buf *bytes.Buffer, v T) {
+	b := bin.Bytes(v)
+	buf.Write(b[:])
+}
+
+// release returns mb to the pool of builders.
+func (mb *MessageBuilder) release() {
+	if mb.data.Cap() > maxPooledSize {
+		return
 	}
+
+	mb.Method = ""
+	mb.Args = nil
+	mb.sender = nil
+	mb.op = 0
+	mb.fds = nil
+	mb.owned = nil
+	mb.err = nil
+	mb.data.Reset()
+	builderPool.Put(mb)
+}
+
+// Tracing reports whether the arguments of messages sent via state
+// should be recorded in MessageBuilder.Args. If state has a Tracer
+// method, as the clients of both the client and server packages do,
+// they are only recorded while it returns a non-nil Tracer. Otherwise,
+// they are always recorded.
+func Tracing(state State) bool {
+	t, ok := state.(interface{ Tracer() Tracer })
+	return !ok || (t.Tracer() != nil)
 }
 
 func (mb *MessageBuilder) Sender() Object {
@@ -53,7 +108,7 @@ func (mb *MessageBuilder) WriteInt(v int32) {
 		return
 	}
 
-	bin.Write(&mb.data, v)
+	writeValue(&mb.data, v)
 }
 
 func (mb *MessageBuilder) WriteUint(v uint32) {
@@ -61,10 +116,23 @@ func (mb *MessageBuilder) WriteUint(v uint32) {
 		return
 	}
 
-	bin.Write(&mb.data, v)
+	writeValue(&mb.data, v)
 }
 
+// WriteObject writes the ID of v, which is not allowed to be nil.
 func (mb *MessageBuilder) WriteObject(v Object) {
+	if isNil(v) {
+		if mb.err == nil {
+			mb.err = ErrNull
+		}
+		return
+	}
+	mb.WriteUint(v.ID())
+}
+
+// WriteNullableObject writes the ID of v, or a null object if v is
+// nil.
+func (mb *MessageBuilder) WriteNullableObject(v Object) {
 	var id uint32
 	if !isNil(v) {
 		id = v.ID()
@@ -87,7 +155,7 @@ func (mb *MessageBuilder) WriteFixed(v Fixed) {
 		return
 	}
 
-	bin.Write(&mb.data, v)
+	writeValue(&mb.data, v)
 }
 
 func (mb *MessageBuilder) WriteString(v string) {
@@ -96,7 +164,7 @@ func (mb *MessageBuilder) WriteString(v string) {
 	}
 
 	pad := padding(uint32(len(v) + 1))
-	bin.Write(&mb.data, uint32(len(v)+1))
+	writeValue(&mb.data, uint32(len(v)+1))
 	mb.data.WriteString(v)
 	mb.data.WriteByte(0)
 	for range pad {
@@ -104,79 +172,222 @@ func (mb *MessageBuilder) WriteString(v string) {
 	}
 }
 
+// WriteNullableString writes v, or a null string if v is nil.
+func (mb *MessageBuilder) WriteNullableString(v *string) {
+	if v == nil {
+		mb.WriteUint(0)
+		return
+	}
+	mb.WriteString(*v)
+}
+
 func (mb *MessageBuilder) WriteArray(v []byte) {
 	if mb.err != nil {
 		return
 	}
 
 	pad := padding(uint32(len(v)))
-	bin.Write(&mb.data, uint32(len(v)))
+	writeValue(&mb.data, uint32(len(v)))
 	mb.data.Write(v)
 	for range pad {
 		mb.data.WriteByte(0)
 	}
 }
 
+// WriteRaw writes data, which must already be encoded, as-is. It is
+// the inverse of MessageBuffer.ReadRaw.
+func (mb *MessageBuilder) WriteRaw(data []byte) {
+	if mb.err != nil {
+		return
+	}
+
+	mb.data.Write(data)
+}
+
+// WriteFile writes a duplicate of the file descriptor of file. The
+// caller retains ownership of file.
 func (mb *MessageBuilder) WriteFile(file *os.File) {
 	mb.WriteFD(int(file.Fd()))
 }
 
-func (mb *MessageBuilder) WriteFD(file int) {
-	fd, err := unix.Dup(file)
+// WriteFD writes a duplicate of the file descriptor fd. The caller
+// retains ownership of fd. The duplicate is closed once the message
+// has been sent.
+func (mb *MessageBuilder) WriteFD(fd int) {
+	if mb.err != nil {
+		return
+	}
+
+	fd, err := unix.FcntlInt(uintptr(fd), unix.F_DUPFD_CLOEXEC, 0)
 	if err != nil {
 		mb.err = err
 		return
 	}
+	mb.own(fdCloser(fd))
+	mb.fds = append(mb.fds, fd)
+}
 
-	if len(mb.fds) == 0 {
-		runtime.SetFinalizer(mb, (*MessageBuilder).close)
+// TransferFile writes the file descriptor of file without duplicating
+// it, transferring ownership of file to mb. file is closed once the
+// message has been sent or has failed to be, and must not be used by
+// the caller after this method is called, even if mb already has an
+// error.
+func (mb *MessageBuilder) TransferFile(file *os.File) {
+	mb.own(file)
+	if mb.err != nil {
+		return
 	}
+	mb.fds = append(mb.fds, int(file.Fd()))
+}
 
+// TransferFD is like TransferFile but for a raw file descriptor.
+func (mb *MessageBuilder) TransferFD(fd int) {
+	mb.own(fdCloser(fd))
+	if mb.err != nil {
+		return
+	}
 	mb.fds = append(mb.fds, fd)
 }
 
+// own adds c to the things that are closed along with mb. If mb
+// already has an error, c is closed immediately.
+func (mb *MessageBuilder) own(c io.Closer) {
+	if mb.err != nil {
+		c.Close()
+		return
+	}
+
+	if len(mb.owned) == 0 {
+		runtime.SetFinalizer(mb, (*MessageBuilder).close)
+	}
+	mb.owned = append(mb.owned, c)
+}
+
 // Build builds the message and sends it to c. The MessageBuilder
-// should not be used again after this method is called.
+// must not be used again after this method is called, as it is reused
+// for other messages. Any file descriptors in the message are closed
+// before Build returns, whether or not the message was sent
+// successfully.
 func (mb *MessageBuilder) Build(c *Conn) error {
+	err := mb.build(c)
+	mb.close()
+	mb.release()
+	return err
+}
+
+func (mb *MessageBuilder) build(c *Conn) error {
 	if mb.err != nil {
 		return mb.err
 	}
 
-	length := uint32(8 + mb.data.Len())
-	msg := bytes.NewBuffer(make([]byte, 0, length))
-	bin.Write(msg, mb.sender.ID())
-	bin.Write(msg, (length<<16)|uint32(mb.op))
+	length := uint32(mb.data.Len())
+	if length > MaxMessageSize {
+		return MessageTooLargeError{Method: mb.Method, Size: int(length)}
+	}
 
-	io.Copy(msg, &mb.data)
-	oob := unix.UnixRights(mb.fds...)
+	msg := mb.data.Bytes()
+	bin.Put(msg[0:4], mb.sender.ID())
+	bin.Put(msg[4:8], (length<<16)|uint32(mb.op))
 
-	_, _, mb.err = c.conn.WriteMsgUnix(msg.Bytes(), oob, nil)
-	return mb.err
+	var oob []byte
+	if len(mb.fds) != 0 {
+		oob = unix.UnixRights(mb.fds...)
+	}
+
+	_, _, err := c.conn.WriteMsgUnix(msg, oob, nil)
+	return err
 }
 
-func (mb *MessageBuilder) close() {
-	errs := make([]error, 0, len(mb.fds))
+// Buffer returns a MessageBuffer containing the message as it would
+// be read by the receiving end of a connection, without sending it.
+// File descriptors in the message are duplicated. The IDs of objects
+// that the message creates were allocated locally instead of by the
+// other end of a connection, so CheckNewID does not check them. It is
+// primarily intended for testing code that handles incoming messages.
+func (mb *MessageBuilder) Buffer() (*MessageBuffer, error) {
+	if mb.err != nil {
+		return nil, mb.err
+	}
+	if size := mb.data.Len(); size > MaxMessageSize {
+		return nil, MessageTooLargeError{Method: mb.Method, Size: size}
+	}
+
+	conn := new(Conn)
 	for _, fd := range mb.fds {
-		errs = append(errs, unix.Close(fd))
+		fd, err := unix.FcntlInt(uintptr(fd), unix.F_DUPFD_CLOEXEC, 0)
+		if err != nil {
+			for _, fd := range conn.fds {
+				unix.Close(fd)
+			}
+			return nil, err
+		}
+		conn.fds = append(conn.fds, fd)
+	}
+
+	data := bytes.Clone(mb.data.Bytes()[minMessageSize:])
+	msg := MessageBuffer{
+		sender: mb.sender.ID(),
+		op:     mb.op,
+		size:   uint16(minMessageSize + len(data)),
+		conn:   conn,
+		local:  true,
+	}
+	msg.data.Reset(data)
+
+	return &msg, nil
+}
+
+// Close discards the message without sending it, closing any file
+// descriptors that it owns. It is for messages that will never be
+// built, such as ones enqueued after a connection has been closed. Like
+// Build, it reuses mb, so mb must not be used again after it is called.
+func (mb *MessageBuilder) Close() error {
+	err := mb.close()
+	mb.release()
+	return err
+}
+
+// close closes the file descriptors owned by mb and returns any errors
+// that closing them caused.
+func (mb *MessageBuilder) close() error {
+	errs := make([]error, 0, len(mb.owned))
+	for _, c := range mb.owned {
+		errs = append(errs, c.Close())
 	}
+	err := errors.Join(errs...)
 	if mb.err == nil {
-		mb.err = errors.Join(errs...)
+		mb.err = err
 	}
 	mb.fds = nil
+	mb.owned = nil
 	runtime.SetFinalizer(mb, nil)
+	return err
+}
+
+// fdCloser closes a raw file descriptor.
+type fdCloser int
+
+func (fd fdCloser) Close() error {
+	return unix.Close(int(fd))
+}
+
+// Trace returns a description of the message for tracing purposes.
+// It relies on Method and Args having been set.
+func (mb *MessageBuilder) Trace() TraceEvent {
+	return TraceEvent{
+		Time:      time.Now(),
+		Direction: Outgoing,
+		Interface: objectInterface(mb.sender),
+		ObjectID:  mb.sender.ID(),
+		Method:    mb.Method,
+		Args:      mb.Args,
+	}
 }
 
 func (mb *MessageBuilder) String() string {
 	args := make([]string, 0, len(mb.Args))
 	for _, arg := range mb.Args {
-		switch arg := arg.(type) {
-		case string:
-			args = append(args, strconv.Quote(arg))
-		case *os.File:
-			args = append(args, fmt.Sprint(arg.Fd()))
-		default:
-			args = append(args, fmt.Sprint(arg))
-		}
+		args = append(args, FormatTraceArg(arg))
 	}
 
 	return fmt.Sprintf("%v.%v(%v)", mb.sender, mb.Method, strings.Join(args, ", "))
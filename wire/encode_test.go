@@ -0,0 +1,86 @@
+package wire
+
+import (
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+type testObject uint32
+
+func (obj testObject) ID() uint32                    { return uint32(obj) }
+func (obj testObject) SetID(uint32)                  {}
+func (obj testObject) Dispatch(*MessageBuffer) error { return nil }
+func (obj testObject) Delete()                       {}
+
+func TestWriteFD(t *testing.T) {
+	conn, remote := socketPair(t)
+	defer conn.Close()
+	defer remote.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	mb := NewMessage(testObject(1), 0)
+	mb.WriteFile(r)
+	if err := mb.err; err != nil {
+		t.Fatal(err)
+	}
+	dup := mb.fds[0]
+	flags, err := unix.FcntlInt(uintptr(dup), unix.F_GETFD, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flags&unix.FD_CLOEXEC == 0 {
+		t.Fatalf("duplicate %v of %v does not have close-on-exec set", dup, r.Fd())
+	}
+
+	err = mb.Build(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unix.FcntlInt(uintptr(dup), unix.F_GETFD, 0); err != unix.EBADF {
+		t.Fatalf("duplicate %v is still open after the message was sent", dup)
+	}
+	if _, err := unix.FcntlInt(r.Fd(), unix.F_GETFD, 0); err != nil {
+		t.Fatalf("original was closed: %v", err)
+	}
+}
+
+func TestTransferFile(t *testing.T) {
+	conn, remote := socketPair(t)
+	defer conn.Close()
+	defer remote.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	mb := NewMessage(testObject(1), 0)
+	mb.TransferFile(r)
+	err = mb.Build(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err == nil {
+		t.Fatal("transferred file is still open after the message was sent")
+	}
+
+	peer := NewConn(remote)
+	msg, err := ReadMessage(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := msg.ReadFile()
+	if err := msg.Err(); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+}
@@ -1,9 +1,63 @@
 package wire
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 )
 
+// ErrNull is returned when an object or string argument that is not
+// allowed to be null is null.
+var ErrNull = errors.New("non-nullable argument is null")
+
+// ErrControlTruncated is returned by ReadMessage when the ancillary
+// data that carries file descriptors was truncated by the kernel. The
+// file descriptors that were lost can't be recovered, so it should be
+// treated as a fatal protocol error in the same way as a FramingError.
+var ErrControlTruncated = errors.New("ancillary data truncated: file descriptors were lost")
+
+// MissingFDsError is returned when a message is dispatched that has
+// more file descriptor arguments than there are file descriptors
+// that have been received for it.
+type MissingFDsError struct {
+	Expected, Received int
+}
+
+func (err MissingFDsError) Error() string {
+	return fmt.Sprintf("message expected %v file descriptors but only %v were received", err.Expected, err.Received)
+}
+
+// FramingError is returned by ReadMessage when the header of an
+// incoming message has an invalid size. Once this happens, the
+// boundaries of subsequent messages can no longer be determined, so it
+// should be treated as a fatal protocol error and the connection
+// closed.
+type FramingError struct {
+	Sender uint32
+	Op     uint16
+	Size   uint16
+}
+
+func (err FramingError) Error() string {
+	return fmt.Sprintf("invalid size in header of message for object %v with opcode %v: %v is not a multiple of 4 that is at least 8", err.Sender, err.Op, err.Size)
+}
+
+// MessageTooLargeError is returned when an attempt is made to send a
+// message that is larger than MaxMessageSize.
+type MessageTooLargeError struct {
+	// Method is the name of the method that the message was for, if
+	// known.
+	Method string
+
+	// Size is the size that the message would have had, including its
+	// header.
+	Size int
+}
+
+func (err MessageTooLargeError) Error() string {
+	return fmt.Sprintf("message for %v is %v bytes, which is larger than the maximum of %v", cmp.Or(err.Method, "unknown method"), err.Size, MaxMessageSize)
+}
+
 // UnknownOpError is returned by Object.Dispatch if it is given a
 // message with an invalid opcode.
 type UnknownOpError struct {
@@ -26,3 +80,54 @@ type UnknownSenderIDError struct {
 func (err UnknownSenderIDError) Error() string {
 	return fmt.Sprintf("unknown sender object ID: %v", err.Msg.Sender())
 }
+
+// InvalidObjectError is returned by an attempt to dispatch an incoming
+// message with an object argument that refers to an object that the
+// State doesn't know about or that implements the wrong interface.
+type InvalidObjectError struct {
+	// ID is the object ID that the argument contained.
+	ID uint32
+
+	// Interface is the name of the interface that the argument was
+	// expected to have.
+	Interface string
+
+	// Object is the object that the ID referred to, or nil if there was
+	// no such object.
+	Object Object
+}
+
+func (err InvalidObjectError) Error() string {
+	if err.Object == nil {
+		return fmt.Sprintf("invalid object %v: no such object", err.ID)
+	}
+	return fmt.Sprintf("invalid object %v: expected %v but got %v", err.ID, err.Interface, err.Object)
+}
+
+// InvalidNewIDError is returned by an attempt to dispatch an incoming
+// message that creates an object with an ID that the sender is not
+// allowed to use, either because it is outside of the sender's range,
+// which includes 0, or because it already refers to an object.
+type InvalidNewIDError struct {
+	ID        uint32
+	Interface string
+	Reason    string
+}
+
+func (err InvalidNewIDError) Error() string {
+	return fmt.Sprintf("invalid new id %v for %v: %v", err.ID, err.Interface, err.Reason)
+}
+
+// InvalidEnumError is returned by an attempt to dispatch an incoming
+// message with an enum argument whose value is not defined by the
+// protocol.
+type InvalidEnumError struct {
+	Interface string
+	Method    string
+	Arg       string
+	Value     int64
+}
+
+func (err InvalidEnumError) Error() string {
+	return fmt.Sprintf("invalid value for %v.%v argument %v: %v", err.Interface, err.Method, err.Arg, err.Value)
+}
@@ -2,44 +2,166 @@ package wire
 
 import (
 	"fmt"
+	"image"
 	"math"
-	"strings"
-	"unsafe"
+	"strconv"
 )
 
 // Fixed is a 24_8 fixed-point number. Wayland does not have support
 // for floating point numbers in its core protocol and uses these
 // instead.
+//
+// Conversions follow the semantics of libwayland's wl_fixed_*
+// functions. Converting a Fixed to a float64 is always exact, and
+// converting that float64 back yields the original Fixed.
 type Fixed int32
 
+// FixedInt returns v as a Fixed. Like wl_fixed_from_int, values that
+// do not fit in 24 bits wrap around.
 func FixedInt(v int) Fixed {
 	return Fixed(v << 8)
 }
 
+// FixedFloat returns v as a Fixed, rounded to the nearest 1/256 with
+// ties rounded to even, as wl_fixed_from_double does. Values outside
+// of the range of Fixed are clamped to it, and NaN becomes 0.
 func FixedFloat(v float64) Fixed {
-	i, frac := math.Modf(v)
-	return Fixed((int(i) << 8) | int(math.Abs(frac)*math.Exp2(8)))
+	v = math.RoundToEven(v * 256)
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case v <= math.MinInt32:
+		return math.MinInt32
+	case v >= math.MaxInt32:
+		return math.MaxInt32
+	}
+	return Fixed(v)
+}
+
+// FixedFloat32 is like FixedFloat but for a float32.
+func FixedFloat32(v float32) Fixed {
+	return FixedFloat(float64(v))
+}
+
+// FixedPoint returns the coordinates of p as Fixed values.
+func FixedPoint(p image.Point) (x, y Fixed) {
+	return FixedInt(p.X), FixedInt(p.Y)
 }
 
+// Point returns an image.Point with the coordinates x and y rounded
+// down, which gives the pixel that contains a location such as that of
+// the pointer.
+func Point(x, y Fixed) image.Point {
+	return image.Pt(x.Floor(), y.Floor())
+}
+
+// Int returns the integer part of f, truncated toward zero as
+// wl_fixed_to_int does.
 func (f Fixed) Int() int {
-	return int(f >> 8)
+	return int(f / 256)
 }
 
+// Frac returns the fractional part of f in 256ths. It has the same
+// sign as f, so f is equal to FixedInt(f.Int()) + Fixed(f.Frac()).
 func (f Fixed) Frac() int {
-	return int(*(*uint32)(unsafe.Pointer(&f)) & 0xFF)
+	return int(f % 256)
 }
 
+// Float returns f as a float64. The conversion is exact.
 func (f Fixed) Float() float64 {
-	i := f.Int()
-	frac := f.Frac()
-	return float64(i) + math.Abs(float64(frac)*math.Exp2(-8))
+	return float64(f) / 256
+}
+
+// Float32 returns f as a float32. Values with more than 24 significant
+// bits are rounded.
+func (f Fixed) Float32() float32 {
+	return float32(f.Float())
+}
+
+// Floor returns the greatest integer that is less than or equal to f.
+func (f Fixed) Floor() int {
+	return int(f >> 8)
+}
+
+// Round returns the integer nearest to f, with halves rounded away
+// from zero.
+func (f Fixed) Round() int {
+	if f < 0 {
+		return -int((-int64(f) + 128) >> 8)
+	}
+	return int((int64(f) + 128) >> 8)
+}
+
+// Add returns f + v. Like integer addition, it wraps around on
+// overflow.
+func (f Fixed) Add(v Fixed) Fixed {
+	return f + v
 }
 
+// Sub returns f - v. Like integer subtraction, it wraps around on
+// overflow.
+func (f Fixed) Sub(v Fixed) Fixed {
+	return f - v
+}
+
+// Mul returns f * v rounded to the nearest 1/256, with halves rounded
+// away from zero. Like integer multiplication, it wraps around on
+// overflow.
+func (f Fixed) Mul(v Fixed) Fixed {
+	return Fixed(divRound(int64(f)*int64(v), 256))
+}
+
+// Div returns f / v rounded to the nearest 1/256, with halves rounded
+// away from zero. Like integer division, it panics if v is 0 and wraps
+// around on overflow.
+func (f Fixed) Div(v Fixed) Fixed {
+	return Fixed(divRound(int64(f)*256, int64(v)))
+}
+
+// divRound returns n / d rounded to the nearest integer, with halves
+// rounded away from zero.
+func divRound(n, d int64) int64 {
+	q, r := n/d, n%d
+	if 2*abs(r) >= abs(d) {
+		if (n < 0) != (d < 0) {
+			return q - 1
+		}
+		return q + 1
+	}
+	return q
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// String returns the shortest decimal representation of f that
+// converts back to it exactly, such as "-0.5".
 func (f Fixed) String() string {
-	var sb strings.Builder
-	fmt.Fprint(&sb, f.Int())
-	if frac := f.Frac(); frac != 0 {
-		fmt.Fprintf(&sb, ".%v", frac)
+	return strconv.FormatFloat(f.Float(), 'f', -1, 64)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the same
+// as that returned by String.
+func (f Fixed) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, f.Float(), 'f', -1, 64), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any
+// decimal number in the range of Fixed, rounding it as FixedFloat
+// does.
+func (f *Fixed) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return fmt.Errorf("parse fixed: %w", err)
 	}
-	return sb.String()
+	if r := math.RoundToEven(v * 256); math.IsNaN(r) || (r < math.MinInt32) || (r > math.MaxInt32) {
+		return fmt.Errorf("parse fixed: %q is out of range", text)
+	}
+
+	*f = FixedFloat(v)
+	return nil
 }
@@ -0,0 +1,188 @@
+package wire
+
+import (
+	"math"
+	"testing"
+)
+
+// sweep calls f with every Fixed in a range around zero, where
+// precision problems are most likely, and with a sample of the rest.
+func sweep(f func(Fixed)) {
+	for v := -1 << 20; v <= 1<<20; v++ {
+		f(Fixed(v))
+	}
+	for v := int64(math.MinInt32); v <= math.MaxInt32; v += 9973 {
+		f(Fixed(v))
+	}
+	f(math.MinInt32)
+	f(math.MaxInt32)
+}
+
+func TestFixedRoundTrip(t *testing.T) {
+	sweep(func(f Fixed) {
+		if v := FixedFloat(f.Float()); v != f {
+			t.Fatalf("%v (%d) converted to %v and back became %d", f, f, f.Float(), v)
+		}
+		if FixedInt(f.Int())+Fixed(f.Frac()) != f {
+			t.Fatalf("%d has integer part %v and fractional part %v", f, f.Int(), f.Frac())
+		}
+	})
+}
+
+func TestFixedText(t *testing.T) {
+	sweep(func(f Fixed) {
+		text, err := f.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != f.String() {
+			t.Fatalf("%d marshaled to %q but its string is %q", f, text, f.String())
+		}
+
+		var v Fixed
+		err = v.UnmarshalText(text)
+		if err != nil {
+			t.Fatalf("unmarshal %q: %v", text, err)
+		}
+		if v != f {
+			t.Fatalf("%d marshaled to %q and unmarshaled to %d", f, text, v)
+		}
+	})
+
+	tests := []struct {
+		text string
+		v    Fixed
+		ok   bool
+	}{
+		{"-0.5", -128, true},
+		{"-0.00390625", -1, true},
+		{"1.001953125", 256, true}, // 256.5 rounded to even
+		{"1.005859375", 258, true}, // 257.5 rounded to even
+		{"8388607.99609375", math.MaxInt32, true},
+		{"-8388608", math.MinInt32, true},
+		{"8388608", 0, false},
+		{"-8388609", 0, false},
+		{"NaN", 0, false},
+		{"Inf", 0, false},
+		{"", 0, false},
+		{"one", 0, false},
+	}
+	for _, test := range tests {
+		var v Fixed
+		err := v.UnmarshalText([]byte(test.text))
+		if (err == nil) != test.ok {
+			t.Errorf("%q: expected ok to be %v but got error %v", test.text, test.ok, err)
+			continue
+		}
+		if test.ok && (v != test.v) {
+			t.Errorf("%q: expected %d but got %d", test.text, test.v, v)
+		}
+	}
+}
+
+func TestFixedFloat(t *testing.T) {
+	tests := []struct {
+		in  float64
+		out Fixed
+	}{
+		{0, 0},
+		{-0.5, -128},
+		{-1.0 / 256, -1},
+		{0.5 / 256, 0},
+		{1.5 / 256, 2},
+		{-0.5 / 256, 0},
+		{-1.5 / 256, -2},
+		{1e10, math.MaxInt32},
+		{-1e10, math.MinInt32},
+		{math.Inf(1), math.MaxInt32},
+		{math.Inf(-1), math.MinInt32},
+		{math.NaN(), 0},
+	}
+	for _, test := range tests {
+		if out := FixedFloat(test.in); out != test.out {
+			t.Errorf("%v: expected %d but got %d", test.in, test.out, out)
+		}
+	}
+}
+
+func TestFixedInt(t *testing.T) {
+	tests := []struct {
+		in              Fixed
+		i, floor, round int
+		frac            int
+		str             string
+	}{
+		{0, 0, 0, 0, 0, "0"},
+		{-128, 0, -1, -1, -128, "-0.5"},
+		{-1, 0, -1, 0, -1, "-0.00390625"},
+		{1, 0, 0, 0, 1, "0.00390625"},
+		{128, 0, 0, 1, 128, "0.5"},
+		{127, 0, 0, 0, 127, "0.49609375"},
+		{-127, 0, -1, 0, -127, "-0.49609375"},
+		{384, 1, 1, 2, 128, "1.5"},
+		{640, 2, 2, 3, 128, "2.5"},
+		{-384, -1, -2, -2, -128, "-1.5"},
+		{-640, -2, -3, -3, -128, "-2.5"},
+		{-256, -1, -1, -1, 0, "-1"},
+		{math.MaxInt32, 8388607, 8388607, 8388608, 255, "8388607.99609375"},
+		{math.MinInt32, -8388608, -8388608, -8388608, 0, "-8388608"},
+	}
+	for _, test := range tests {
+		if v := test.in.Int(); v != test.i {
+			t.Errorf("%v.Int(): expected %v but got %v", test.str, test.i, v)
+		}
+		if v := test.in.Floor(); v != test.floor {
+			t.Errorf("%v.Floor(): expected %v but got %v", test.str, test.floor, v)
+		}
+		if v := test.in.Round(); v != test.round {
+			t.Errorf("%v.Round(): expected %v but got %v", test.str, test.round, v)
+		}
+		if v := test.in.Frac(); v != test.frac {
+			t.Errorf("%v.Frac(): expected %v but got %v", test.str, test.frac, v)
+		}
+		if v := test.in.String(); v != test.str {
+			t.Errorf("%d.String(): expected %q but got %q", test.in, test.str, v)
+		}
+	}
+}
+
+func TestFixedMulDiv(t *testing.T) {
+	tests := []struct {
+		name string
+		out  Fixed
+		want Fixed
+	}{
+		{"1.5*1.5", FixedFloat(1.5).Mul(FixedFloat(1.5)), FixedFloat(2.25)},
+		{"-1.5*1.5", FixedFloat(-1.5).Mul(FixedFloat(1.5)), FixedFloat(-2.25)},
+		{"1/256*0.5", Fixed(1).Mul(128), 1},
+		{"-1/256*0.5", Fixed(-1).Mul(128), -1},
+		{"1/256*-0.5", Fixed(1).Mul(-128), -1},
+		{"1/256*127/256", Fixed(1).Mul(127), 0},
+		{"3/256*0.5", Fixed(3).Mul(128), 2},
+		{"1/2", FixedInt(1).Div(FixedInt(2)), 128},
+		{"1/3", FixedInt(1).Div(FixedInt(3)), 85},
+		{"2/3", FixedInt(2).Div(FixedInt(3)), 171},
+		{"-2/3", FixedInt(-2).Div(FixedInt(3)), -171},
+		{"2/-3", FixedInt(2).Div(FixedInt(-3)), -171},
+		{"(1/256)/2", Fixed(1).Div(FixedInt(2)), 1},
+		{"(-1/256)/2", Fixed(-1).Div(FixedInt(2)), -1},
+		{"(1/256)/3", Fixed(1).Div(FixedInt(3)), 0},
+		{"1/-2", FixedInt(1).Div(FixedInt(-2)), -128},
+		{"65536*128", FixedInt(65536).Mul(FixedInt(128)), math.MinInt32},
+		{"-65536*-65536", FixedInt(-65536).Mul(FixedInt(-65536)), 0},
+		{"32768/(1/256)", FixedInt(32768).Div(1), math.MinInt32},
+		{"min/-1", Fixed(math.MinInt32).Div(FixedInt(-1)), math.MinInt32},
+	}
+	for _, test := range tests {
+		if test.out != test.want {
+			t.Errorf("%v: expected %v (%d) but got %v (%d)", test.name, test.want, test.want, test.out, test.out)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("division by zero did not panic")
+		}
+	}()
+	FixedInt(1).Div(0)
+}
@@ -0,0 +1,123 @@
+package wire
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// Messages is a queue of decoded messages. Generated objects provide
+// a Subscribe method that returns one that is filled with the
+// object's incoming messages as they are dispatched. It is safe for
+// concurrent use.
+//
+// Messages are only added as they are dispatched, which happens as
+// the functions yielded by the event queue of a client or server are
+// called. Methods that block waiting for messages must therefore not
+// be called from the goroutine that calls those functions.
+type Messages[T any] struct {
+	m       sync.Mutex
+	pending []T
+	ready   chan struct{}
+}
+
+// Push adds v to the end of the queue.
+func (q *Messages[T]) Push(v T) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	q.pending = append(q.pending, v)
+	q.signal()
+}
+
+// Len returns the number of messages currently in the queue.
+func (q *Messages[T]) Len() int {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	return len(q.pending)
+}
+
+// All returns an iterator that yields the messages in the queue in
+// the order that they were added, removing each one as it is yielded.
+// Messages added during iteration are yielded as well. It does not
+// wait for more messages, so iteration ends as soon as the queue is
+// empty. Use Stream to wait for messages instead.
+func (q *Messages[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := q.pop()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Next removes and returns the first message in the queue, waiting
+// for one to be added if it is empty. If ctx is done first, it returns
+// ctx's error.
+func (q *Messages[T]) Next(ctx context.Context) (v T, err error) {
+	for {
+		v, ok := q.pop()
+		if ok {
+			return v, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case <-q.readyChan():
+		}
+	}
+}
+
+// Stream returns an iterator that is like the one returned by All but
+// that waits for more messages when the queue is empty instead of
+// ending. Iteration ends when ctx is done.
+func (q *Messages[T]) Stream(ctx context.Context) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := q.Next(ctx)
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (q *Messages[T]) pop() (v T, ok bool) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	v, ok = pop(&q.pending)
+	if len(q.pending) != 0 {
+		// Make sure that another waiter is woken up for the messages
+		// that remain.
+		q.signal()
+	}
+	return v, ok
+}
+
+// readyChan returns the channel that is signaled when messages are
+// added.
+func (q *Messages[T]) readyChan() <-chan struct{} {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if q.ready == nil {
+		q.ready = make(chan struct{}, 1)
+	}
+	return q.ready
+}
+
+// signal wakes up a waiter, if there is one. q.m must be held.
+func (q *Messages[T]) signal() {
+	if q.ready == nil {
+		q.ready = make(chan struct{}, 1)
+	}
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,185 @@
+package wire
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Direction indicates which way a traced message was travelling.
+type Direction int
+
+const (
+	// Incoming messages were received from the remote end.
+	Incoming Direction = iota
+
+	// Outgoing messages were sent to the remote end.
+	Outgoing
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Incoming:
+		return "incoming"
+	case Outgoing:
+		return "outgoing"
+	}
+
+	return "<invalid Direction>"
+}
+
+// TraceEvent is a structured description of a single message that
+// was sent or received.
+type TraceEvent struct {
+	// Time is the time at which the message was sent or dispatched.
+	Time time.Time
+
+	// Direction indicates whether the message was sent or received.
+	Direction Direction
+
+	// Interface is the name of the interface of the object that the
+	// message was sent to or from. It is empty if the object's
+	// interface is not known.
+	Interface string
+
+	// ObjectID is the ID of the object that the message was sent to or
+	// from.
+	ObjectID uint32
+
+	// Method is the name of the request or event. If the name is not
+	// known, it is the opcode formatted as a string.
+	Method string
+
+	// Args are the decoded arguments of the message. Object arguments
+	// are represented by the Object itself, or nil if the object was
+	// null, and new_id arguments are represented as a NewID.
+	Args []any
+}
+
+// String formats ev in the same way that libwayland does when the
+// WAYLAND_DEBUG environment variable is set.
+func (ev TraceEvent) String() string {
+	var sb strings.Builder
+	usec := ev.Time.UnixMicro()
+	fmt.Fprintf(&sb, "[%7d.%03d] ", uint32(usec/1000), uint32(usec%1000))
+	if ev.Direction == Outgoing {
+		sb.WriteString(" -> ")
+	}
+	fmt.Fprintf(&sb, "%v@%v.%v(", traceInterface(ev.Interface), ev.ObjectID, ev.Method)
+	for i, arg := range ev.Args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(FormatTraceArg(arg))
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// FormatTraceArg formats a single argument from a TraceEvent in the
+// same way as libwayland does.
+func FormatTraceArg(arg any) string {
+	switch arg := arg.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return `"` + arg + `"`
+	case *string:
+		if arg == nil {
+			return "nil"
+		}
+		return `"` + *arg + `"`
+	case []byte:
+		return fmt.Sprintf("array[%v]", len(arg))
+	case *os.File:
+		if arg == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("fd %v", arg.Fd())
+	case Fixed:
+		return strconv.FormatFloat(arg.Float(), 'f', 6, 64)
+	case NewID:
+		return fmt.Sprintf("new id %v@%v", traceInterface(arg.Interface), arg.ID)
+	case UnknownObject:
+		return fmt.Sprintf("[unknown]@%v", uint32(arg))
+	case Object:
+		if isNil(arg) {
+			return "nil"
+		}
+		return fmt.Sprintf("%v@%v", traceInterface(objectInterface(arg)), arg.ID())
+	}
+
+	v := reflect.ValueOf(arg)
+	switch {
+	case v.CanInt():
+		return strconv.FormatInt(v.Int(), 10)
+	case v.CanUint():
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+	return fmt.Sprint(arg)
+}
+
+func traceInterface(name string) string {
+	if name == "" {
+		return "[unknown]"
+	}
+	return name
+}
+
+// objectInterface returns the interface name of obj if it is able to
+// provide it. Generated objects all provide an Interface method.
+func objectInterface(obj Object) string {
+	if i, ok := obj.(interface{ Interface() string }); ok {
+		return i.Interface()
+	}
+	return ""
+}
+
+// UnknownObject represents an object argument in a TraceEvent whose
+// ID could not be resolved to a known object.
+type UnknownObject uint32
+
+// Tracer is a function that is called with a description of every
+// message sent or received by a connection that it is attached to.
+type Tracer func(TraceEvent)
+
+// TraceWriter returns a Tracer that writes events to w in the format
+// used by libwayland's WAYLAND_DEBUG output, one per line. Writes to
+// w are serialized.
+func TraceWriter(w io.Writer) Tracer {
+	var m sync.Mutex
+	return func(ev TraceEvent) {
+		m.Lock()
+		defer m.Unlock()
+		fmt.Fprintln(w, ev)
+	}
+}
+
+// TraceHandler returns a Tracer that emits events as debug-level
+// records to h. Each record carries the direction, interface, object
+// ID, method name and decoded arguments as attributes.
+func TraceHandler(h slog.Handler) Tracer {
+	return func(ev TraceEvent) {
+		ctx := context.Background()
+		if !h.Enabled(ctx, slog.LevelDebug) {
+			return
+		}
+
+		r := slog.NewRecord(ev.Time, slog.LevelDebug, ev.Interface+"."+ev.Method, 0)
+		r.AddAttrs(
+			slog.String("direction", ev.Direction.String()),
+			slog.String("interface", ev.Interface),
+			slog.Uint64("id", uint64(ev.ObjectID)),
+			slog.String("method", ev.Method),
+			slog.Any("args", ev.Args),
+		)
+		h.Handle(ctx, r)
+	}
+}
@@ -10,6 +10,23 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// MaxMessageSize is the largest size, including its header, that a
+// message can have. The size is given by a 16-bit field in the header.
+const MaxMessageSize = 1<<16 - 1
+
+// minMessageSize is the size of a message header.
+const minMessageSize = 8
+
+// The ranges of object IDs that each side of a connection allocates
+// new objects from. An object created by a message must have an ID in
+// the range of the side that sent it.
+const (
+	MinClientID uint32 = 1
+	MaxClientID uint32 = 0xFEFFFFFF
+	MinServerID uint32 = 0xFF000000
+	MaxServerID uint32 = 0xFFFFFFFF
+)
+
 func padding(length uint32) uint32 {
 	pad := 4 - (length % (32 / 8))
 	if pad == 4 {
@@ -19,20 +36,27 @@ func padding(length uint32) uint32 {
 }
 
 // unixTee reads from c, but also reads out-of-band data
-// simultaneously, writing it into oob.
+// simultaneously, writing it into oob. If the kernel reports that the
+// out-of-band data was truncated, truncated is set. This is recorded
+// instead of being returned as an error because readers such as
+// io.ReadFull discard errors that occur along with a complete read.
 type unixTee struct {
-	c   *net.UnixConn
-	oob io.Writer
+	c         *net.UnixConn
+	oob       io.Writer
+	truncated bool
 }
 
 // 128 bytes are enough for about 32 FDs which covers the protocol
 // with a margin.
 var oobSpace = unix.CmsgSpace(128)
 
-func (t unixTee) Read(buf []byte) (int, error) {
+func (t *unixTee) Read(buf []byte) (int, error) {
 	oob := make([]byte, oobSpace)
-	n, oobn, _, _, err := t.c.ReadMsgUnix(buf, oob)
+	n, oobn, flags, _, err := t.c.ReadMsgUnix(buf, oob)
 	_, ooberr := t.oob.Write(oob[:oobn])
+	if flags&unix.MSG_CTRUNC != 0 {
+		t.truncated = true
+	}
 	return n, errors.Join(err, ooberr)
 }
 
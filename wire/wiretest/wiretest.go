@@ -0,0 +1,148 @@
+// Package wiretest provides utilities for testing code that uses
+// generated protocol bindings without a connection.
+//
+// Packages generated with wlgen's -mock flag contain listeners built
+// on top of the types in this package.
+package wiretest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"deedles.dev/wl/internal/objstore"
+	"deedles.dev/wl/wire"
+)
+
+// State is a wire.State that keeps track of objects in memory and
+// records outgoing messages instead of sending them.
+type State struct {
+	store *objstore.Store
+
+	m    sync.Mutex
+	sent []*wire.MessageBuilder
+}
+
+// NewState returns a new State.
+func NewState() *State {
+	// Messages passed to Dispatch carry their own file descriptors, so
+	// which side the store counts those of deleted objects' messages
+	// for makes no difference.
+	return &State{store: objstore.New(1, false)}
+}
+
+func (s *State) Add(obj wire.Object) {
+	s.store.Add(obj)
+}
+
+func (s *State) Get(id uint32) wire.Object {
+	return s.store.Get(id)
+}
+
+// Delete removes the object with the given ID from the state.
+func (s *State) Delete(id uint32) {
+	s.store.Delete(id)
+}
+
+// Enqueue records msg. It is safe to call concurrently.
+func (s *State) Enqueue(msg *wire.MessageBuilder) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.sent = append(s.sent, msg)
+}
+
+// Sent returns the messages that have been enqueued since the last
+// time that it was called, in the order that they were enqueued. The
+// caller owns the returned messages and must call Close on each of
+// them, or pass them to Dispatch, so that their file descriptors are
+// closed.
+func (s *State) Sent() []*wire.MessageBuilder {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sent := s.sent
+	s.sent = nil
+	return sent
+}
+
+// Dispatch delivers msg to its sender as though it had been received
+// from the other end of a connection. This allows messages to be
+// constructed with the Encode methods of generated message types and
+// then passed to the listeners of the objects that they are for. Like
+// Build, it consumes msg, closing its file descriptors once they have
+// been duplicated into the delivered message, so msg must not be used
+// again after it is called.
+func (s *State) Dispatch(msg *wire.MessageBuilder) error {
+	defer msg.Close()
+
+	buf, err := msg.Buffer()
+	if err != nil {
+		return err
+	}
+	return s.store.Dispatch(buf, nil)
+}
+
+// Recorder records values, such as the messages received by a mock
+// listener, and checks them against expectations. The zero value is
+// ready to use. It is safe for concurrent use.
+type Recorder[T any] struct {
+	m        sync.Mutex
+	calls    []T
+	expected []T
+}
+
+// Record adds v to the recorded values.
+func (r *Recorder[T]) Record(v T) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.calls = append(r.calls, v)
+}
+
+// Calls returns all of the values that have been recorded.
+func (r *Recorder[T]) Calls() []T {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return slices.Clone(r.calls)
+}
+
+// Expect adds vals to the values that are expected to be recorded.
+func (r *Recorder[T]) Expect(vals ...T) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.expected = append(r.expected, vals...)
+}
+
+// Verify returns an error if the recorded values are not the same as
+// the expected ones in the same order. Values are compared with
+// reflect.DeepEqual.
+func (r *Recorder[T]) Verify() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, v := range r.calls {
+		if i >= len(r.expected) {
+			return fmt.Errorf("unexpected call %v: %#v", i, v)
+		}
+		if !reflect.DeepEqual(v, r.expected[i]) {
+			return fmt.Errorf("call %v: expected %#v but got %#v", i, r.expected[i], v)
+		}
+	}
+	if len(r.calls) < len(r.expected) {
+		return fmt.Errorf("missing call %v: expected %#v", len(r.calls), r.expected[len(r.calls)])
+	}
+	return nil
+}
+
+// Reset clears both the recorded and the expected values.
+func (r *Recorder[T]) Reset() {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.calls = nil
+	r.expected = nil
+}
@@ -0,0 +1,44 @@
+package wiretest
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"deedles.dev/wl/wire"
+	"golang.org/x/sys/unix"
+)
+
+type testObject uint32
+
+func (obj testObject) ID() uint32                         { return uint32(obj) }
+func (obj testObject) SetID(uint32)                       {}
+func (obj testObject) Dispatch(*wire.MessageBuffer) error { return nil }
+func (obj testObject) Delete()                            {}
+
+func TestDispatchClosesFiles(t *testing.T) {
+	s := NewState()
+	s.Add(testObject(1))
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	msg := wire.NewMessage(testObject(1), 0)
+	msg.TransferFile(r)
+	err = s.Dispatch(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("file in a dispatched message was not closed: %v", err)
+	}
+	// The duplicate delivered with the message was never claimed, but
+	// writing to the pipe still fails because every read end has been
+	// closed.
+	if _, err := w.Write([]byte{0}); !errors.Is(err, unix.EPIPE) {
+		t.Fatalf("file descriptor delivered with the message was not closed: %v", err)
+	}
+}
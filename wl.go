@@ -0,0 +1,5 @@
+// Package wl contains the enums and interface constants of the core
+// Wayland protocol. The client and server packages declare aliases of
+// everything in this package, so values can be passed between them
+// without conversion.
+package wl
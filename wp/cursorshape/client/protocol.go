@@ -0,0 +1,605 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package cursorshape
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/cursorshape"
+	tablet "deedles.dev/wl/wp/tablet/client"
+	"fmt"
+)
+
+const (
+	CursorShapeManagerV1Interface = shared.CursorShapeManagerV1Interface
+
+	// CursorShapeManagerV1Version is the version of the interface that this
+	// package implements.
+	CursorShapeManagerV1Version = 1
+)
+
+// The versions of the wp_cursor_shape_manager_v1 interface that its messages were
+// added in.
+const (
+	CursorShapeManagerV1DestroyRequestSince         = 1
+	CursorShapeManagerV1GetPointerRequestSince      = 1
+	CursorShapeManagerV1GetTabletToolV2RequestSince = 1
+)
+
+// CursorShapeManagerV1Desc describes the wp_cursor_shape_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CursorShapeManagerV1Desc = &wire.InterfaceDesc{
+	Name:    CursorShapeManagerV1Interface,
+	Version: CursorShapeManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_pointer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "cursor_shape_device",
+					Type:      wire.ArgNewID,
+					Interface: "wp_cursor_shape_device_v1",
+				},
+				{
+					Name:      "pointer",
+					Type:      wire.ArgObject,
+					Interface: "wl_pointer",
+				},
+			},
+		},
+		{
+			Name:  "get_tablet_tool_v2",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "cursor_shape_device",
+					Type:      wire.ArgNewID,
+					Interface: "wp_cursor_shape_device_v1",
+				},
+				{
+					Name:      "tablet_tool",
+					Type:      wire.ArgObject,
+					Interface: "zwp_tablet_tool_v2",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// This global offers an alternative, optional way to set cursor images. This
+// new way uses enumerated cursors instead of a wl_surface like
+// wl_pointer.set_cursor does.
+//
+// Warning! The protocol described in this file is currently in the testing
+// phase. Backward compatible changes may be added together with the
+// corresponding interface version bump. Backward incompatible changes can
+// only be done by creating a new major version of the extension.
+type CursorShapeManagerV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewCursorShapeManagerV1 returns a newly instantiated CursorShapeManagerV1. It is
+// primarily intended for use by generated code.
+func NewCursorShapeManagerV1(state wire.State) *CursorShapeManagerV1 {
+	return &CursorShapeManagerV1{state: state}
+}
+
+func BindCursorShapeManagerV1(state wire.State, registry wire.Binder, name, version uint32) *CursorShapeManagerV1 {
+	obj := NewCursorShapeManagerV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: CursorShapeManagerV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *CursorShapeManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *CursorShapeManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CursorShapeManagerV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_cursor_shape_manager_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *CursorShapeManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *CursorShapeManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *CursorShapeManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *CursorShapeManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_cursor_shape_manager_v1", obj.id)
+}
+
+func (obj *CursorShapeManagerV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *CursorShapeManagerV1) Interface() string {
+	return CursorShapeManagerV1Interface
+}
+
+func (obj *CursorShapeManagerV1) Version() uint32 {
+	return CursorShapeManagerV1Version
+}
+
+// Destroy the cursor shape manager.
+func (obj *CursorShapeManagerV1) Destroy() {
+	obj.state.Enqueue(CursorShapeManagerV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Obtain a wp_cursor_shape_device_v1 for a wl_pointer object.
+//
+// When the pointer capability is removed from the wl_seat, the
+// wp_cursor_shape_device_v1 object becomes inert.
+func (obj *CursorShapeManagerV1) GetPointer(pointer *wl.Pointer) (cursorShapeDevice *CursorShapeDeviceV1) {
+	cursorShapeDevice = NewCursorShapeDeviceV1(obj.state)
+	obj.state.Add(cursorShapeDevice)
+	obj.state.Enqueue(CursorShapeManagerV1GetPointerRequest{
+		CursorShapeDevice: cursorShapeDevice,
+		Pointer:           pointer,
+	}.Encode(obj))
+	return cursorShapeDevice
+}
+
+// Obtain a wp_cursor_shape_device_v1 for a zwp_tablet_tool_v2 object.
+//
+// When the zwp_tablet_tool_v2 is removed, the wp_cursor_shape_device_v1
+// object becomes inert.
+func (obj *CursorShapeManagerV1) GetTabletToolV2(tabletTool *tablet.TabletToolV2) (cursorShapeDevice *CursorShapeDeviceV1) {
+	cursorShapeDevice = NewCursorShapeDeviceV1(obj.state)
+	obj.state.Add(cursorShapeDevice)
+	obj.state.Enqueue(CursorShapeManagerV1GetTabletToolV2Request{
+		CursorShapeDevice: cursorShapeDevice,
+		TabletTool:        tabletTool,
+	}.Encode(obj))
+	return cursorShapeDevice
+}
+
+// CursorShapeManagerV1DestroyRequest holds the arguments of the destroy request of
+// the wp_cursor_shape_manager_v1 interface.
+type CursorShapeManagerV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CursorShapeManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CursorShapeManagerV1DestroyRequest) Encode(obj *CursorShapeManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// CursorShapeManagerV1GetPointerRequest holds the arguments of the get_pointer request of
+// the wp_cursor_shape_manager_v1 interface.
+type CursorShapeManagerV1GetPointerRequest struct {
+	CursorShapeDevice *CursorShapeDeviceV1
+	Pointer           *wl.Pointer
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CursorShapeManagerV1GetPointerRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.CursorShapeDevice = NewCursorShapeDeviceV1(state)
+	m.CursorShapeDevice.SetID(wire.CheckNewID(msg, state, CursorShapeDeviceV1Interface, msg.ReadNewObject(CursorShapeDeviceV1Interface), false))
+	m.Pointer = wire.ResolveObject[*wl.Pointer](msg, state, wl.PointerInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.CursorShapeDevice)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CursorShapeManagerV1GetPointerRequest) Encode(obj *CursorShapeManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.CursorShapeDevice)
+	builder.WriteObject(m.Pointer)
+
+	builder.Method = "get_pointer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CursorShapeDeviceV1Interface, ID: m.CursorShapeDevice.ID()}, m.Pointer}
+	}
+	return builder
+}
+
+// CursorShapeManagerV1GetTabletToolV2Request holds the arguments of the get_tablet_tool_v2 request of
+// the wp_cursor_shape_manager_v1 interface.
+type CursorShapeManagerV1GetTabletToolV2Request struct {
+	CursorShapeDevice *CursorShapeDeviceV1
+	TabletTool        *tablet.TabletToolV2
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CursorShapeManagerV1GetTabletToolV2Request) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.CursorShapeDevice = NewCursorShapeDeviceV1(state)
+	m.CursorShapeDevice.SetID(wire.CheckNewID(msg, state, CursorShapeDeviceV1Interface, msg.ReadNewObject(CursorShapeDeviceV1Interface), false))
+	m.TabletTool = wire.ResolveObject[*tablet.TabletToolV2](msg, state, tablet.TabletToolV2Interface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.CursorShapeDevice)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CursorShapeManagerV1GetTabletToolV2Request) Encode(obj *CursorShapeManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.CursorShapeDevice)
+	builder.WriteObject(m.TabletTool)
+
+	builder.Method = "get_tablet_tool_v2"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: CursorShapeDeviceV1Interface, ID: m.CursorShapeDevice.ID()}, m.TabletTool}
+	}
+	return builder
+}
+
+const (
+	CursorShapeDeviceV1Interface = shared.CursorShapeDeviceV1Interface
+
+	// CursorShapeDeviceV1Version is the version of the interface that this
+	// package implements.
+	CursorShapeDeviceV1Version = 1
+)
+
+// The versions of the wp_cursor_shape_device_v1 interface that its messages were
+// added in.
+const (
+	CursorShapeDeviceV1DestroyRequestSince  = 1
+	CursorShapeDeviceV1SetShapeRequestSince = 1
+)
+
+// CursorShapeDeviceV1Desc describes the wp_cursor_shape_device_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var CursorShapeDeviceV1Desc = &wire.InterfaceDesc{
+	Name:    CursorShapeDeviceV1Interface,
+	Version: CursorShapeDeviceV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_shape",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "shape",
+					Type: wire.ArgUint,
+					Enum: "shape",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "shape",
+			Entries: []wire.EntryDesc{
+				{Name: "default", Value: 1},
+				{Name: "context_menu", Value: 2},
+				{Name: "help", Value: 3},
+				{Name: "pointer", Value: 4},
+				{Name: "progress", Value: 5},
+				{Name: "wait", Value: 6},
+				{Name: "cell", Value: 7},
+				{Name: "crosshair", Value: 8},
+				{Name: "text", Value: 9},
+				{Name: "vertical_text", Value: 10},
+				{Name: "alias", Value: 11},
+				{Name: "copy", Value: 12},
+				{Name: "move", Value: 13},
+				{Name: "no_drop", Value: 14},
+				{Name: "not_allowed", Value: 15},
+				{Name: "grab", Value: 16},
+				{Name: "grabbing", Value: 17},
+				{Name: "e_resize", Value: 18},
+				{Name: "n_resize", Value: 19},
+				{Name: "ne_resize", Value: 20},
+				{Name: "nw_resize", Value: 21},
+				{Name: "s_resize", Value: 22},
+				{Name: "se_resize", Value: 23},
+				{Name: "sw_resize", Value: 24},
+				{Name: "w_resize", Value: 25},
+				{Name: "ew_resize", Value: 26},
+				{Name: "ns_resize", Value: 27},
+				{Name: "nesw_resize", Value: 28},
+				{Name: "nwse_resize", Value: 29},
+				{Name: "col_resize", Value: 30},
+				{Name: "row_resize", Value: 31},
+				{Name: "all_scroll", Value: 32},
+				{Name: "zoom_in", Value: 33},
+				{Name: "zoom_out", Value: 34},
+			},
+		},
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_shape", Value: 1},
+			},
+		},
+	},
+}
+
+// This interface allows clients to set the cursor shape.
+type CursorShapeDeviceV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewCursorShapeDeviceV1 returns a newly instantiated CursorShapeDeviceV1. It is
+// primarily intended for use by generated code.
+func NewCursorShapeDeviceV1(state wire.State) *CursorShapeDeviceV1 {
+	return &CursorShapeDeviceV1{state: state}
+}
+
+func (obj *CursorShapeDeviceV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *CursorShapeDeviceV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(CursorShapeDeviceV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_cursor_shape_device_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *CursorShapeDeviceV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *CursorShapeDeviceV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *CursorShapeDeviceV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *CursorShapeDeviceV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_cursor_shape_device_v1", obj.id)
+}
+
+func (obj *CursorShapeDeviceV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *CursorShapeDeviceV1) Interface() string {
+	return CursorShapeDeviceV1Interface
+}
+
+func (obj *CursorShapeDeviceV1) Version() uint32 {
+	return CursorShapeDeviceV1Version
+}
+
+// Destroy the cursor shape device.
+//
+// The device cursor shape remains unchanged.
+func (obj *CursorShapeDeviceV1) Destroy() {
+	obj.state.Enqueue(CursorShapeDeviceV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Sets the device cursor to the specified shape. The compositor will
+// change the cursor image based on the specified shape.
+//
+// The cursor actually changes only if the input device focus is one of
+// the requesting client's surfaces. If any, the previous cursor image
+// (surface or shape) is replaced.
+//
+// The "shape" argument must be a valid enum entry, otherwise the
+// invalid_shape protocol error is raised.
+//
+// This is similar to the wl_pointer.set_cursor and
+// zwp_tablet_tool_v2.set_cursor requests, but this request accepts a
+// shape instead of contents in the form of a surface. Clients can mix
+// set_cursor and set_shape requests.
+//
+// The serial parameter must match the latest wl_pointer.enter or
+// zwp_tablet_tool_v2.proximity_in serial number sent to the client.
+// Otherwise the request will be ignored.
+func (obj *CursorShapeDeviceV1) SetShape(serial uint32, shape CursorShapeDeviceV1Shape) {
+	obj.state.Enqueue(CursorShapeDeviceV1SetShapeRequest{
+		Serial: serial,
+		Shape:  shape,
+	}.Encode(obj))
+	return
+}
+
+// CursorShapeDeviceV1DestroyRequest holds the arguments of the destroy request of
+// the wp_cursor_shape_device_v1 interface.
+type CursorShapeDeviceV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CursorShapeDeviceV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CursorShapeDeviceV1DestroyRequest) Encode(obj *CursorShapeDeviceV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// CursorShapeDeviceV1SetShapeRequest holds the arguments of the set_shape request of
+// the wp_cursor_shape_device_v1 interface.
+type CursorShapeDeviceV1SetShapeRequest struct {
+	Serial uint32
+	Shape  CursorShapeDeviceV1Shape
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *CursorShapeDeviceV1SetShapeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Shape = CursorShapeDeviceV1Shape(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m CursorShapeDeviceV1SetShapeRequest) Encode(obj *CursorShapeDeviceV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(uint32(m.Shape))
+
+	builder.Method = "set_shape"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Shape}
+	}
+	return builder
+}
+
+// CursorShapeDeviceV1Shape is an alias for [shared.CursorShapeDeviceV1Shape].
+type CursorShapeDeviceV1Shape = shared.CursorShapeDeviceV1Shape
+
+const (
+	CursorShapeDeviceV1ShapeDefault      = shared.CursorShapeDeviceV1ShapeDefault
+	CursorShapeDeviceV1ShapeContextMenu  = shared.CursorShapeDeviceV1ShapeContextMenu
+	CursorShapeDeviceV1ShapeHelp         = shared.CursorShapeDeviceV1ShapeHelp
+	CursorShapeDeviceV1ShapePointer      = shared.CursorShapeDeviceV1ShapePointer
+	CursorShapeDeviceV1ShapeProgress     = shared.CursorShapeDeviceV1ShapeProgress
+	CursorShapeDeviceV1ShapeWait         = shared.CursorShapeDeviceV1ShapeWait
+	CursorShapeDeviceV1ShapeCell         = shared.CursorShapeDeviceV1ShapeCell
+	CursorShapeDeviceV1ShapeCrosshair    = shared.CursorShapeDeviceV1ShapeCrosshair
+	CursorShapeDeviceV1ShapeText         = shared.CursorShapeDeviceV1ShapeText
+	CursorShapeDeviceV1ShapeVerticalText = shared.CursorShapeDeviceV1ShapeVerticalText
+	CursorShapeDeviceV1ShapeAlias        = shared.CursorShapeDeviceV1ShapeAlias
+	CursorShapeDeviceV1ShapeCopy         = shared.CursorShapeDeviceV1ShapeCopy
+	CursorShapeDeviceV1ShapeMove         = shared.CursorShapeDeviceV1ShapeMove
+	CursorShapeDeviceV1ShapeNoDrop       = shared.CursorShapeDeviceV1ShapeNoDrop
+	CursorShapeDeviceV1ShapeNotAllowed   = shared.CursorShapeDeviceV1ShapeNotAllowed
+	CursorShapeDeviceV1ShapeGrab         = shared.CursorShapeDeviceV1ShapeGrab
+	CursorShapeDeviceV1ShapeGrabbing     = shared.CursorShapeDeviceV1ShapeGrabbing
+	CursorShapeDeviceV1ShapeEResize      = shared.CursorShapeDeviceV1ShapeEResize
+	CursorShapeDeviceV1ShapeNResize      = shared.CursorShapeDeviceV1ShapeNResize
+	CursorShapeDeviceV1ShapeNeResize     = shared.CursorShapeDeviceV1ShapeNeResize
+	CursorShapeDeviceV1ShapeNwResize     = shared.CursorShapeDeviceV1ShapeNwResize
+	CursorShapeDeviceV1ShapeSResize      = shared.CursorShapeDeviceV1ShapeSResize
+	CursorShapeDeviceV1ShapeSeResize     = shared.CursorShapeDeviceV1ShapeSeResize
+	CursorShapeDeviceV1ShapeSwResize     = shared.CursorShapeDeviceV1ShapeSwResize
+	CursorShapeDeviceV1ShapeWResize      = shared.CursorShapeDeviceV1ShapeWResize
+	CursorShapeDeviceV1ShapeEwResize     = shared.CursorShapeDeviceV1ShapeEwResize
+	CursorShapeDeviceV1ShapeNsResize     = shared.CursorShapeDeviceV1ShapeNsResize
+	CursorShapeDeviceV1ShapeNeswResize   = shared.CursorShapeDeviceV1ShapeNeswResize
+	CursorShapeDeviceV1ShapeNwseResize   = shared.CursorShapeDeviceV1ShapeNwseResize
+	CursorShapeDeviceV1ShapeColResize    = shared.CursorShapeDeviceV1ShapeColResize
+	CursorShapeDeviceV1ShapeRowResize    = shared.CursorShapeDeviceV1ShapeRowResize
+	CursorShapeDeviceV1ShapeAllScroll    = shared.CursorShapeDeviceV1ShapeAllScroll
+	CursorShapeDeviceV1ShapeZoomIn       = shared.CursorShapeDeviceV1ShapeZoomIn
+	CursorShapeDeviceV1ShapeZoomOut      = shared.CursorShapeDeviceV1ShapeZoomOut
+)
+
+// CursorShapeDeviceV1Error is an alias for [shared.CursorShapeDeviceV1Error].
+type CursorShapeDeviceV1Error = shared.CursorShapeDeviceV1Error
+
+const (
+	CursorShapeDeviceV1ErrorInvalidShape = shared.CursorShapeDeviceV1ErrorInvalidShape
+)
+
+func init() {
+	wire.RegisterInterface(CursorShapeManagerV1Desc, true)
+	wire.RegisterInterface(CursorShapeDeviceV1Desc, true)
+}
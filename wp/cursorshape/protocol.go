@@ -0,0 +1,269 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package cursorshape
+
+const (
+	CursorShapeManagerV1Interface = "wp_cursor_shape_manager_v1"
+	CursorShapeManagerV1Version   = 1
+)
+
+const (
+	CursorShapeDeviceV1Interface = "wp_cursor_shape_device_v1"
+	CursorShapeDeviceV1Version   = 1
+)
+
+// This enum describes cursor shapes.
+//
+// The names are taken from the CSS W3C specification:
+// https://w3c.github.io/csswg-drafts/css-ui/#cursor
+type CursorShapeDeviceV1Shape int64
+
+const (
+	// default cursor
+	CursorShapeDeviceV1ShapeDefault CursorShapeDeviceV1Shape = 1
+
+	// a context menu is available for the object under the cursor
+	CursorShapeDeviceV1ShapeContextMenu CursorShapeDeviceV1Shape = 2
+
+	// help is available for the object under the cursor
+	CursorShapeDeviceV1ShapeHelp CursorShapeDeviceV1Shape = 3
+
+	// pointer that indicates a link or another interactive element
+	CursorShapeDeviceV1ShapePointer CursorShapeDeviceV1Shape = 4
+
+	// progress indicator
+	CursorShapeDeviceV1ShapeProgress CursorShapeDeviceV1Shape = 5
+
+	// program is busy, user should wait
+	CursorShapeDeviceV1ShapeWait CursorShapeDeviceV1Shape = 6
+
+	// a cell or set of cells may be selected
+	CursorShapeDeviceV1ShapeCell CursorShapeDeviceV1Shape = 7
+
+	// simple crosshair
+	CursorShapeDeviceV1ShapeCrosshair CursorShapeDeviceV1Shape = 8
+
+	// text may be selected
+	CursorShapeDeviceV1ShapeText CursorShapeDeviceV1Shape = 9
+
+	// vertical text may be selected
+	CursorShapeDeviceV1ShapeVerticalText CursorShapeDeviceV1Shape = 10
+
+	// drag-and-drop: alias of/shortcut to something is to be created
+	CursorShapeDeviceV1ShapeAlias CursorShapeDeviceV1Shape = 11
+
+	// drag-and-drop: something is to be copied
+	CursorShapeDeviceV1ShapeCopy CursorShapeDeviceV1Shape = 12
+
+	// drag-and-drop: something is to be moved
+	CursorShapeDeviceV1ShapeMove CursorShapeDeviceV1Shape = 13
+
+	// drag-and-drop: the dragged item cannot be dropped at the current cursor location
+	CursorShapeDeviceV1ShapeNoDrop CursorShapeDeviceV1Shape = 14
+
+	// drag-and-drop: the requested action will not be carried out
+	CursorShapeDeviceV1ShapeNotAllowed CursorShapeDeviceV1Shape = 15
+
+	// drag-and-drop: something can be grabbed
+	CursorShapeDeviceV1ShapeGrab CursorShapeDeviceV1Shape = 16
+
+	// drag-and-drop: something is being grabbed
+	CursorShapeDeviceV1ShapeGrabbing CursorShapeDeviceV1Shape = 17
+
+	// resizing: the east border is to be moved
+	CursorShapeDeviceV1ShapeEResize CursorShapeDeviceV1Shape = 18
+
+	// resizing: the north border is to be moved
+	CursorShapeDeviceV1ShapeNResize CursorShapeDeviceV1Shape = 19
+
+	// resizing: the north-east corner is to be moved
+	CursorShapeDeviceV1ShapeNeResize CursorShapeDeviceV1Shape = 20
+
+	// resizing: the north-west corner is to be moved
+	CursorShapeDeviceV1ShapeNwResize CursorShapeDeviceV1Shape = 21
+
+	// resizing: the south border is to be moved
+	CursorShapeDeviceV1ShapeSResize CursorShapeDeviceV1Shape = 22
+
+	// resizing: the south-east corner is to be moved
+	CursorShapeDeviceV1ShapeSeResize CursorShapeDeviceV1Shape = 23
+
+	// resizing: the south-west corner is to be moved
+	CursorShapeDeviceV1ShapeSwResize CursorShapeDeviceV1Shape = 24
+
+	// resizing: the west border is to be moved
+	CursorShapeDeviceV1ShapeWResize CursorShapeDeviceV1Shape = 25
+
+	// resizing: the east and west borders are to be moved
+	CursorShapeDeviceV1ShapeEwResize CursorShapeDeviceV1Shape = 26
+
+	// resizing: the north and south borders are to be moved
+	CursorShapeDeviceV1ShapeNsResize CursorShapeDeviceV1Shape = 27
+
+	// resizing: the north-east and south-west corners are to be moved
+	CursorShapeDeviceV1ShapeNeswResize CursorShapeDeviceV1Shape = 28
+
+	// resizing: the north-west and south-east corners are to be moved
+	CursorShapeDeviceV1ShapeNwseResize CursorShapeDeviceV1Shape = 29
+
+	// resizing: that the item/column can be resized horizontally
+	CursorShapeDeviceV1ShapeColResize CursorShapeDeviceV1Shape = 30
+
+	// resizing: that the item/row can be resized vertically
+	CursorShapeDeviceV1ShapeRowResize CursorShapeDeviceV1Shape = 31
+
+	// something can be scrolled in any direction
+	CursorShapeDeviceV1ShapeAllScroll CursorShapeDeviceV1Shape = 32
+
+	// something can be zoomed in
+	CursorShapeDeviceV1ShapeZoomIn CursorShapeDeviceV1Shape = 33
+
+	// something can be zoomed out
+	CursorShapeDeviceV1ShapeZoomOut CursorShapeDeviceV1Shape = 34
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum CursorShapeDeviceV1Shape) Valid() bool {
+	switch enum {
+	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34:
+		return true
+	}
+
+	return false
+}
+
+func (enum CursorShapeDeviceV1Shape) String() string {
+	switch enum {
+	case 1:
+		return "CursorShapeDeviceV1ShapeDefault"
+
+	case 2:
+		return "CursorShapeDeviceV1ShapeContextMenu"
+
+	case 3:
+		return "CursorShapeDeviceV1ShapeHelp"
+
+	case 4:
+		return "CursorShapeDeviceV1ShapePointer"
+
+	case 5:
+		return "CursorShapeDeviceV1ShapeProgress"
+
+	case 6:
+		return "CursorShapeDeviceV1ShapeWait"
+
+	case 7:
+		return "CursorShapeDeviceV1ShapeCell"
+
+	case 8:
+		return "CursorShapeDeviceV1ShapeCrosshair"
+
+	case 9:
+		return "CursorShapeDeviceV1ShapeText"
+
+	case 10:
+		return "CursorShapeDeviceV1ShapeVerticalText"
+
+	case 11:
+		return "CursorShapeDeviceV1ShapeAlias"
+
+	case 12:
+		return "CursorShapeDeviceV1ShapeCopy"
+
+	case 13:
+		return "CursorShapeDeviceV1ShapeMove"
+
+	case 14:
+		return "CursorShapeDeviceV1ShapeNoDrop"
+
+	case 15:
+		return "CursorShapeDeviceV1ShapeNotAllowed"
+
+	case 16:
+		return "CursorShapeDeviceV1ShapeGrab"
+
+	case 17:
+		return "CursorShapeDeviceV1ShapeGrabbing"
+
+	case 18:
+		return "CursorShapeDeviceV1ShapeEResize"
+
+	case 19:
+		return "CursorShapeDeviceV1ShapeNResize"
+
+	case 20:
+		return "CursorShapeDeviceV1ShapeNeResize"
+
+	case 21:
+		return "CursorShapeDeviceV1ShapeNwResize"
+
+	case 22:
+		return "CursorShapeDeviceV1ShapeSResize"
+
+	case 23:
+		return "CursorShapeDeviceV1ShapeSeResize"
+
+	case 24:
+		return "CursorShapeDeviceV1ShapeSwResize"
+
+	case 25:
+		return "CursorShapeDeviceV1ShapeWResize"
+
+	case 26:
+		return "CursorShapeDeviceV1ShapeEwResize"
+
+	case 27:
+		return "CursorShapeDeviceV1ShapeNsResize"
+
+	case 28:
+		return "CursorShapeDeviceV1ShapeNeswResize"
+
+	case 29:
+		return "CursorShapeDeviceV1ShapeNwseResize"
+
+	case 30:
+		return "CursorShapeDeviceV1ShapeColResize"
+
+	case 31:
+		return "CursorShapeDeviceV1ShapeRowResize"
+
+	case 32:
+		return "CursorShapeDeviceV1ShapeAllScroll"
+
+	case 33:
+		return "CursorShapeDeviceV1ShapeZoomIn"
+
+	case 34:
+		return "CursorShapeDeviceV1ShapeZoomOut"
+	}
+
+	return "<invalid CursorShapeDeviceV1Shape>"
+}
+
+type CursorShapeDeviceV1Error int64
+
+const (
+	// the specified shape value is invalid
+	CursorShapeDeviceV1ErrorInvalidShape CursorShapeDeviceV1Error = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum CursorShapeDeviceV1Error) Valid() bool {
+	switch enum {
+	case 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum CursorShapeDeviceV1Error) String() string {
+	switch enum {
+	case 1:
+		return "CursorShapeDeviceV1ErrorInvalidShape"
+	}
+
+	return "<invalid CursorShapeDeviceV1Error>"
+}
@@ -0,0 +1,2399 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package dmabuf
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/dmabuf"
+	"fmt"
+	"os"
+)
+
+const (
+	LinuxDmabufV1Interface = shared.LinuxDmabufV1Interface
+
+	// LinuxDmabufV1Version is the version of the interface that this
+	// package implements.
+	LinuxDmabufV1Version = 4
+)
+
+// The versions of the zwp_linux_dmabuf_v1 interface that its messages were
+// added in.
+const (
+	LinuxDmabufV1DestroyRequestSince            = 1
+	LinuxDmabufV1CreateParamsRequestSince       = 1
+	LinuxDmabufV1GetDefaultFeedbackRequestSince = 4
+	LinuxDmabufV1GetSurfaceFeedbackRequestSince = 4
+	LinuxDmabufV1FormatEventSince               = 1
+	LinuxDmabufV1ModifierEventSince             = 3
+)
+
+// LinuxDmabufV1Desc describes the zwp_linux_dmabuf_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var LinuxDmabufV1Desc = &wire.InterfaceDesc{
+	Name:    LinuxDmabufV1Interface,
+	Version: LinuxDmabufV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "create_params",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "params_id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_linux_buffer_params_v1",
+				},
+			},
+		},
+		{
+			Name:  "get_default_feedback",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_linux_dmabuf_feedback_v1",
+				},
+			},
+		},
+		{
+			Name:  "get_surface_feedback",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_linux_dmabuf_feedback_v1",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "format",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "modifier",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "modifier_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "modifier_lo",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
+// LinuxDmabufV1Listener is a type that can respond to incoming
+// messages for a LinuxDmabufV1 object.
+type LinuxDmabufV1Listener interface {
+	// This event advertises one buffer format that the server supports.
+	// All the supported formats are advertised once when the client
+	// binds to this interface. A roundtrip after binding guarantees
+	// that the client has received all supported formats.
+	//
+	// For the definition of the format codes, see the
+	// zwp_linux_buffer_params_v1::create request.
+	//
+	// Starting version 4, the format event is deprecated and must not be
+	// sent by compositors. Instead, use get_default_feedback or
+	// get_surface_feedback.
+	//
+	// Deprecated: Deprecated since version 4.
+	Format(format uint32)
+
+	// This event advertises the formats that the server supports, along with
+	// the modifiers supported for each format. All the supported modifiers
+	// for all the supported formats are advertised once when the client
+	// binds to this interface. A roundtrip after binding guarantees that
+	// the client has received all supported format-modifier pairs.
+	//
+	// For legacy support, DRM_FORMAT_MOD_INVALID (that is, modifier_hi ==
+	// 0x00ffffff and modifier_lo == 0xffffffff) is allowed in this event.
+	// It indicates that the server can support the format with an implicit
+	// modifier. When a plane has DRM_FORMAT_MOD_INVALID as its modifier, it
+	// is as if no explicit modifier is specified. The effective modifier
+	// will be derived from the dmabuf.
+	//
+	// A compositor that sends valid modifiers and DRM_FORMAT_MOD_INVALID for
+	// a given format supports both explicit modifiers and implicit modifiers.
+	//
+	// For the definition of the format and modifier codes, see the
+	// zwp_linux_buffer_params_v1::create and zwp_linux_buffer_params_v1::add
+	// requests.
+	//
+	// Starting version 4, the modifier event is deprecated and must not be
+	// sent by compositors. Instead, use get_default_feedback or
+	// get_surface_feedback.
+	//
+	// Available since version 3.
+	//
+	// Deprecated: Deprecated since version 4.
+	Modifier(format uint32, modifierHi uint32, modifierLo uint32)
+}
+
+// LinuxDmabufV1ListenerFuncs implements LinuxDmabufV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type LinuxDmabufV1ListenerFuncs struct {
+	// Deprecated: Deprecated since version 4.
+	OnFormat func(format uint32)
+	// Available since version 3.
+	//
+	// Deprecated: Deprecated since version 4.
+	OnModifier func(format uint32, modifierHi uint32, modifierLo uint32)
+}
+
+// Deprecated: Deprecated since version 4.
+func (lis *LinuxDmabufV1ListenerFuncs) Format(format uint32) {
+	if lis.OnFormat != nil {
+		lis.OnFormat(format)
+	}
+}
+
+// Available since version 3.
+//
+// Deprecated: Deprecated since version 4.
+func (lis *LinuxDmabufV1ListenerFuncs) Modifier(format uint32, modifierHi uint32, modifierLo uint32) {
+	if lis.OnModifier != nil {
+		lis.OnModifier(format, modifierHi, modifierLo)
+	}
+}
+
+// LinuxDmabufV1Event is implemented by the message types of all
+// of the events that a LinuxDmabufV1 can receive.
+type LinuxDmabufV1Event interface {
+	linuxDmabufV1Event()
+}
+
+// LinuxDmabufV1EventFunc implements LinuxDmabufV1Listener by calling
+// itself with each incoming message.
+type LinuxDmabufV1EventFunc func(LinuxDmabufV1Event)
+
+// Deprecated: Deprecated since version 4.
+func (f LinuxDmabufV1EventFunc) Format(format uint32) {
+	f(LinuxDmabufV1FormatEvent{
+		Format: format,
+	})
+}
+
+// Available since version 3.
+//
+// Deprecated: Deprecated since version 4.
+func (f LinuxDmabufV1EventFunc) Modifier(format uint32, modifierHi uint32, modifierLo uint32) {
+	f(LinuxDmabufV1ModifierEvent{
+		Format:     format,
+		ModifierHi: modifierHi,
+		ModifierLo: modifierLo,
+	})
+}
+
+// Following the interfaces from:
+// https://www.khronos.org/registry/egl/extensions/EXT/EGL_EXT_image_dma_buf_import.txt
+// https://www.khronos.org/registry/EGL/extensions/EXT/EGL_EXT_image_dma_buf_import_modifiers.txt
+// and the Linux DRM sub-system's AddFb2 ioctl.
+//
+// This interface offers ways to create generic dmabuf-based wl_buffers.
+//
+// For more information about dmabuf, see:
+// https://www.kernel.org/doc/html/next/userspace-api/dma-buf-alloc-exchange.html
+//
+// Clients can use the get_surface_feedback request to get dmabuf feedback
+// for a particular surface. If the client wants to retrieve feedback not
+// tied to a surface, they can use the get_default_feedback request.
+//
+// The following are required from clients:
+//
+// - Clients must ensure that either all data in the dma-buf is
+// coherent for all subsequent read access or that coherency is
+// correctly handled by the underlying kernel-side dma-buf
+// implementation.
+//
+// - Don't make any more attachments after sending the buffer to the
+// compositor. Making more attachments later increases the risk of
+// the compositor not being able to use (re-import) an existing
+// dmabuf-based wl_buffer.
+//
+// The underlying graphics stack must ensure the following:
+//
+// - The dmabuf file descriptors relayed to the server will stay valid
+// for the whole lifetime of the wl_buffer. This means the server may
+// at any time use those fds to import the dmabuf into any kernel
+// sub-system that might accept it.
+//
+// However, when the underlying graphics stack fails to deliver the
+// promise, because of e.g. a device hot-unplug which raises internal
+// errors, after the wl_buffer has been successfully created the
+// compositor must not raise protocol errors to the client when dmabuf
+// import later fails.
+//
+// To create a wl_buffer from one or more dmabufs, a client creates a
+// zwp_linux_dmabuf_params_v1 object with a zwp_linux_dmabuf_v1.create_params
+// request. All planes required by the intended format are added with
+// the 'add' request. Finally, a 'create' or 'create_immed' request is
+// issued, which has the following outcome depending on the import success.
+//
+// The 'create' request,
+// - on success, triggers a 'created' event which provides the final
+// wl_buffer to the client.
+// - on failure, triggers a 'failed' event to convey that the server
+// cannot use the dmabufs received from the client.
+//
+// For the 'create_immed' request,
+// - on success, the server immediately imports the added dmabufs to
+// create a wl_buffer. No event is sent from the server in this case.
+// - on failure, the server can choose to either:
+// - terminate the client by raising a fatal error.
+// - mark the wl_buffer as failed, and send a 'failed' event to the
+// client. If the client uses a failed wl_buffer as an argument to any
+// request, the behaviour is compositor implementation-defined.
+//
+// For all DRM formats and unless specified in another protocol extension,
+// pre-multiplied alpha is used for pixel values.
+//
+// Unless specified otherwise in another protocol extension, implicit
+// synchronization is used. In other words, compositors and clients must
+// wait and signal fences implicitly passed via the DMA-BUF's reservation
+// mechanism.
+type LinuxDmabufV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener LinuxDmabufV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewLinuxDmabufV1 returns a newly instantiated LinuxDmabufV1. It is
+// primarily intended for use by generated code.
+func NewLinuxDmabufV1(state wire.State) *LinuxDmabufV1 {
+	return &LinuxDmabufV1{state: state}
+}
+
+func BindLinuxDmabufV1(state wire.State, registry wire.Binder, name, version uint32) *LinuxDmabufV1 {
+	obj := NewLinuxDmabufV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: LinuxDmabufV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *LinuxDmabufV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *LinuxDmabufV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m LinuxDmabufV1FormatEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Format(
+			m.Format,
+		)
+		return nil
+
+	case 1:
+		var m LinuxDmabufV1ModifierEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Modifier(
+			m.Format,
+			m.ModifierHi,
+			m.ModifierLo,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(LinuxDmabufV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_linux_dmabuf_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *LinuxDmabufV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *LinuxDmabufV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *LinuxDmabufV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *LinuxDmabufV1) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_linux_dmabuf_v1", obj.id)
+}
+
+func (obj *LinuxDmabufV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "format"
+
+	case 1:
+		return "modifier"
+	}
+
+	return "unknown method"
+}
+
+func (obj *LinuxDmabufV1) Interface() string {
+	return LinuxDmabufV1Interface
+}
+
+// OnFormat sets the function that is called when the
+// format event is received. If obj's Listener is not a
+// *LinuxDmabufV1ListenerFuncs, it is replaced with one.
+//
+// Deprecated: Deprecated since version 4.
+func (obj *LinuxDmabufV1) OnFormat(f func(format uint32)) {
+	lis, ok := obj.Listener.(*LinuxDmabufV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFormat = f
+}
+
+// OnModifier sets the function that is called when the
+// modifier event is received. If obj's Listener is not a
+// *LinuxDmabufV1ListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+//
+// Deprecated: Deprecated since version 4.
+func (obj *LinuxDmabufV1) OnModifier(f func(format uint32, modifierHi uint32, modifierLo uint32)) {
+	lis, ok := obj.Listener.(*LinuxDmabufV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnModifier = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *LinuxDmabufV1) Subscribe() *wire.Messages[LinuxDmabufV1Event] {
+	q := new(wire.Messages[LinuxDmabufV1Event])
+	obj.Listener = LinuxDmabufV1EventFunc(q.Push)
+	return q
+}
+
+func (obj *LinuxDmabufV1) Version() uint32 {
+	return LinuxDmabufV1Version
+}
+
+// Objects created through this interface, especially wl_buffers, will
+// remain valid.
+func (obj *LinuxDmabufV1) Destroy() {
+	obj.state.Enqueue(LinuxDmabufV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// This temporary object is used to collect multiple dmabuf handles into
+// a single batch to create a wl_buffer. It can only be used once and
+// should be destroyed after a 'created' or 'failed' event has been
+// received.
+func (obj *LinuxDmabufV1) CreateParams() (paramsId *LinuxBufferParamsV1) {
+	paramsId = NewLinuxBufferParamsV1(obj.state)
+	obj.state.Add(paramsId)
+	obj.state.Enqueue(LinuxDmabufV1CreateParamsRequest{
+		ParamsId: paramsId,
+	}.Encode(obj))
+	return paramsId
+}
+
+// This request creates a new wp_linux_dmabuf_feedback object not bound
+// to a particular surface. This object will deliver feedback about dmabuf
+// parameters to use if the client doesn't support per-surface feedback
+// (see get_surface_feedback).
+//
+// Available since version 4.
+func (obj *LinuxDmabufV1) GetDefaultFeedback() (id *LinuxDmabufFeedbackV1) {
+	id = NewLinuxDmabufFeedbackV1(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(LinuxDmabufV1GetDefaultFeedbackRequest{
+		Id: id,
+	}.Encode(obj))
+	return id
+}
+
+// This request creates a new wp_linux_dmabuf_feedback object for the
+// specified wl_surface. This object will deliver feedback about dmabuf
+// parameters to use for buffers attached to this surface.
+//
+// If the surface is destroyed before the wp_linux_dmabuf_feedback object,
+// the feedback object becomes inert.
+//
+// Available since version 4.
+func (obj *LinuxDmabufV1) GetSurfaceFeedback(surface *wl.Surface) (id *LinuxDmabufFeedbackV1) {
+	id = NewLinuxDmabufFeedbackV1(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(LinuxDmabufV1GetSurfaceFeedbackRequest{
+		Id:      id,
+		Surface: surface,
+	}.Encode(obj))
+	return id
+}
+
+// LinuxDmabufV1FormatEvent holds the arguments of the format event of
+// the zwp_linux_dmabuf_v1 interface.
+//
+// Deprecated: Deprecated since version 4.
+type LinuxDmabufV1FormatEvent struct {
+	Format uint32
+}
+
+func (LinuxDmabufV1FormatEvent) linuxDmabufV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1FormatEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1FormatEvent) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Format)
+
+	builder.Method = "format"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format}
+	}
+	return builder
+}
+
+// LinuxDmabufV1ModifierEvent holds the arguments of the modifier event of
+// the zwp_linux_dmabuf_v1 interface.
+//
+// Available since version 3.
+//
+// Deprecated: Deprecated since version 4.
+type LinuxDmabufV1ModifierEvent struct {
+	Format     uint32
+	ModifierHi uint32
+	ModifierLo uint32
+}
+
+func (LinuxDmabufV1ModifierEvent) linuxDmabufV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1ModifierEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Format = msg.ReadUint()
+	m.ModifierHi = msg.ReadUint()
+	m.ModifierLo = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1ModifierEvent) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Format)
+	builder.WriteUint(m.ModifierHi)
+	builder.WriteUint(m.ModifierLo)
+
+	builder.Method = "modifier"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Format, m.ModifierHi, m.ModifierLo}
+	}
+	return builder
+}
+
+// LinuxDmabufV1DestroyRequest holds the arguments of the destroy request of
+// the zwp_linux_dmabuf_v1 interface.
+type LinuxDmabufV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1DestroyRequest) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxDmabufV1CreateParamsRequest holds the arguments of the create_params request of
+// the zwp_linux_dmabuf_v1 interface.
+type LinuxDmabufV1CreateParamsRequest struct {
+	ParamsId *LinuxBufferParamsV1
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1CreateParamsRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ParamsId = NewLinuxBufferParamsV1(state)
+	m.ParamsId.SetID(wire.CheckNewID(msg, state, LinuxBufferParamsV1Interface, msg.ReadNewObject(LinuxBufferParamsV1Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.ParamsId)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1CreateParamsRequest) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.ParamsId)
+
+	builder.Method = "create_params"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: LinuxBufferParamsV1Interface, ID: m.ParamsId.ID()}}
+	}
+	return builder
+}
+
+// LinuxDmabufV1GetDefaultFeedbackRequest holds the arguments of the get_default_feedback request of
+// the zwp_linux_dmabuf_v1 interface.
+//
+// Available since version 4.
+type LinuxDmabufV1GetDefaultFeedbackRequest struct {
+	Id *LinuxDmabufFeedbackV1
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1GetDefaultFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewLinuxDmabufFeedbackV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, LinuxDmabufFeedbackV1Interface, msg.ReadNewObject(LinuxDmabufFeedbackV1Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1GetDefaultFeedbackRequest) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_default_feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: LinuxDmabufFeedbackV1Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// LinuxDmabufV1GetSurfaceFeedbackRequest holds the arguments of the get_surface_feedback request of
+// the zwp_linux_dmabuf_v1 interface.
+//
+// Available since version 4.
+type LinuxDmabufV1GetSurfaceFeedbackRequest struct {
+	Id      *LinuxDmabufFeedbackV1
+	Surface *wl.Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufV1GetSurfaceFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewLinuxDmabufFeedbackV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, LinuxDmabufFeedbackV1Interface, msg.ReadNewObject(LinuxDmabufFeedbackV1Interface), false))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufV1GetSurfaceFeedbackRequest) Encode(obj *LinuxDmabufV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_surface_feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: LinuxDmabufFeedbackV1Interface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+const (
+	LinuxBufferParamsV1Interface = shared.LinuxBufferParamsV1Interface
+
+	// LinuxBufferParamsV1Version is the version of the interface that this
+	// package implements.
+	LinuxBufferParamsV1Version = 4
+)
+
+// The versions of the zwp_linux_buffer_params_v1 interface that its messages were
+// added in.
+const (
+	LinuxBufferParamsV1DestroyRequestSince     = 1
+	LinuxBufferParamsV1AddRequestSince         = 1
+	LinuxBufferParamsV1CreateRequestSince      = 1
+	LinuxBufferParamsV1CreateImmedRequestSince = 2
+	LinuxBufferParamsV1CreatedEventSince       = 1
+	LinuxBufferParamsV1FailedEventSince        = 1
+)
+
+// LinuxBufferParamsV1Desc describes the zwp_linux_buffer_params_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var LinuxBufferParamsV1Desc = &wire.InterfaceDesc{
+	Name:    LinuxBufferParamsV1Interface,
+	Version: LinuxBufferParamsV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "add",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "plane_idx",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "offset",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "stride",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "modifier_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "modifier_lo",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "create",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "flags",
+				},
+			},
+		},
+		{
+			Name:  "create_immed",
+			Since: 2,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "buffer_id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_buffer",
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "format",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "flags",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "created",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "buffer",
+					Type:      wire.ArgNewID,
+					Interface: "wl_buffer",
+				},
+			},
+		},
+		{
+			Name:  "failed",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "already_used", Value: 0},
+				{Name: "plane_idx", Value: 1},
+				{Name: "plane_set", Value: 2},
+				{Name: "incomplete", Value: 3},
+				{Name: "invalid_format", Value: 4},
+				{Name: "invalid_dimensions", Value: 5},
+				{Name: "out_of_bounds", Value: 6},
+				{Name: "invalid_wl_buffer", Value: 7},
+			},
+		},
+		{
+			Name:     "flags",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "y_invert", Value: 1},
+				{Name: "interlaced", Value: 2},
+				{Name: "bottom_first", Value: 4},
+			},
+		},
+	},
+}
+
+// LinuxBufferParamsV1Listener is a type that can respond to incoming
+// messages for a LinuxBufferParamsV1 object.
+type LinuxBufferParamsV1Listener interface {
+	// This event indicates that the attempted buffer creation was
+	// successful. It provides the new wl_buffer referencing the dmabuf(s).
+	//
+	// Upon receiving this event, the client should destroy the
+	// zwp_linux_buffer_params_v1 object.
+	Created(buffer *wl.Buffer)
+
+	// This event indicates that the attempted buffer creation has
+	// failed. It usually means that one of the dmabuf constraints
+	// has not been fulfilled.
+	//
+	// Upon receiving this event, the client should destroy the
+	// zwp_linux_buffer_params_v1 object.
+	Failed()
+}
+
+// LinuxBufferParamsV1ListenerFuncs implements LinuxBufferParamsV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type LinuxBufferParamsV1ListenerFuncs struct {
+	OnCreated func(buffer *wl.Buffer)
+	OnFailed  func()
+}
+
+func (lis *LinuxBufferParamsV1ListenerFuncs) Created(buffer *wl.Buffer) {
+	if lis.OnCreated != nil {
+		lis.OnCreated(buffer)
+	}
+}
+
+func (lis *LinuxBufferParamsV1ListenerFuncs) Failed() {
+	if lis.OnFailed != nil {
+		lis.OnFailed()
+	}
+}
+
+// LinuxBufferParamsV1Event is implemented by the message types of all
+// of the events that a LinuxBufferParamsV1 can receive.
+type LinuxBufferParamsV1Event interface {
+	linuxBufferParamsV1Event()
+}
+
+// LinuxBufferParamsV1EventFunc implements LinuxBufferParamsV1Listener by calling
+// itself with each incoming message.
+type LinuxBufferParamsV1EventFunc func(LinuxBufferParamsV1Event)
+
+func (f LinuxBufferParamsV1EventFunc) Created(buffer *wl.Buffer) {
+	f(LinuxBufferParamsV1CreatedEvent{
+		Buffer: buffer,
+	})
+}
+
+func (f LinuxBufferParamsV1EventFunc) Failed() {
+	f(LinuxBufferParamsV1FailedEvent{})
+}
+
+// This temporary object is a collection of dmabufs and other
+// parameters that together form a single logical buffer. The temporary
+// object may eventually create one wl_buffer unless cancelled by
+// destroying it before requesting 'create'.
+//
+// Single-planar formats only require one dmabuf, however
+// multi-planar formats may require more than one dmabuf. For all
+// formats, an 'add' request must be called once per plane (even if the
+// underlying dmabuf fd is identical).
+//
+// You must use consecutive plane indices ('plane_idx' argument for 'add')
+// from zero to the number of planes used by the drm_fourcc format code.
+// All planes required by the format must be given exactly once, but can
+// be given in any order. Each plane index can only be set once; subsequent
+// calls with a plane index which has already been set will result in a
+// plane_set error being generated.
+type LinuxBufferParamsV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener LinuxBufferParamsV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewLinuxBufferParamsV1 returns a newly instantiated LinuxBufferParamsV1. It is
+// primarily intended for use by generated code.
+func NewLinuxBufferParamsV1(state wire.State) *LinuxBufferParamsV1 {
+	return &LinuxBufferParamsV1{state: state}
+}
+
+func (obj *LinuxBufferParamsV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *LinuxBufferParamsV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m LinuxBufferParamsV1CreatedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Created(
+			m.Buffer,
+		)
+		return nil
+
+	case 1:
+		var m LinuxBufferParamsV1FailedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Failed()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(LinuxBufferParamsV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_linux_buffer_params_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *LinuxBufferParamsV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *LinuxBufferParamsV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *LinuxBufferParamsV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *LinuxBufferParamsV1) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_linux_buffer_params_v1", obj.id)
+}
+
+func (obj *LinuxBufferParamsV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "created"
+
+	case 1:
+		return "failed"
+	}
+
+	return "unknown method"
+}
+
+func (obj *LinuxBufferParamsV1) Interface() string {
+	return LinuxBufferParamsV1Interface
+}
+
+// OnCreated sets the function that is called when the
+// created event is received. If obj's Listener is not a
+// *LinuxBufferParamsV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxBufferParamsV1) OnCreated(f func(buffer *wl.Buffer)) {
+	lis, ok := obj.Listener.(*LinuxBufferParamsV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxBufferParamsV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreated = f
+}
+
+// OnFailed sets the function that is called when the
+// failed event is received. If obj's Listener is not a
+// *LinuxBufferParamsV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxBufferParamsV1) OnFailed(f func()) {
+	lis, ok := obj.Listener.(*LinuxBufferParamsV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxBufferParamsV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFailed = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *LinuxBufferParamsV1) Subscribe() *wire.Messages[LinuxBufferParamsV1Event] {
+	q := new(wire.Messages[LinuxBufferParamsV1Event])
+	obj.Listener = LinuxBufferParamsV1EventFunc(q.Push)
+	return q
+}
+
+func (obj *LinuxBufferParamsV1) Version() uint32 {
+	return LinuxBufferParamsV1Version
+}
+
+// Cleans up the temporary data sent to the server for dmabuf-based
+// wl_buffer creation.
+func (obj *LinuxBufferParamsV1) Destroy() {
+	obj.state.Enqueue(LinuxBufferParamsV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// This request adds one dmabuf to the set in this
+// zwp_linux_buffer_params_v1.
+//
+// The 64-bit unsigned value combined from modifier_hi and modifier_lo
+// is the dmabuf layout modifier. DRM AddFB2 ioctl calls this the
+// fb modifier, which is defined in drm_mode.h of Linux UAPI.
+// This is an opaque token. Drivers use this token to express tiling,
+// compression, etc. driver-specific modifications to the base format
+// defined by the DRM fourcc code.
+//
+// Starting from version 4, the invalid_format protocol error is sent if
+// the format + modifier pair was not advertised as supported.
+//
+// Starting from version 5, the invalid_format protocol error is sent if
+// all planes don't use the same modifier.
+//
+// This request raises the PLANE_IDX error if plane_idx is too large.
+// The error PLANE_SET is raised if attempting to set a plane that
+// was already set.
+func (obj *LinuxBufferParamsV1) Add(fd *os.File, planeIdx uint32, offset uint32, stride uint32, modifierHi uint32, modifierLo uint32) {
+	obj.state.Enqueue(LinuxBufferParamsV1AddRequest{
+		Fd:         fd,
+		PlaneIdx:   planeIdx,
+		Offset:     offset,
+		Stride:     stride,
+		ModifierHi: modifierHi,
+		ModifierLo: modifierLo,
+	}.Encode(obj))
+	return
+}
+
+// AddTransfer is like Add, but ownership of its files is
+// transferred to the message instead of their file descriptors
+// being duplicated. They are closed once the message has been sent
+// or has failed to be and must not be used afterwards.
+func (obj *LinuxBufferParamsV1) AddTransfer(fd *os.File, planeIdx uint32, offset uint32, stride uint32, modifierHi uint32, modifierLo uint32) {
+	obj.state.Enqueue(LinuxBufferParamsV1AddRequest{
+		Fd:         fd,
+		PlaneIdx:   planeIdx,
+		Offset:     offset,
+		Stride:     stride,
+		ModifierHi: modifierHi,
+		ModifierLo: modifierLo,
+	}.EncodeTransfer(obj))
+	return
+}
+
+// Asks for creation of a wl_buffer from the added dmabuf
+// buffers. Because there is no way to report the result
+// synchronously, a 'created' or 'failed' event is sent to report
+// the result.
+//
+// The 'create' request uses the width, height, format and flags
+// given here and the planes added with 'add'. The format argument is
+// a DRM_FORMAT code, as defined by the libdrm's drm_fourcc.h. The
+// Linux kernel's drm_fourcc.h is also defined.
+//
+// The flags is a bitfield of the flags defined in enum "flags".
+// 'y_invert' means the that the image needs to be y-flipped.
+//
+// Flag 'interlaced' means that the frame in the buffer is not
+// progressive as usual, but interlaced. An interlaced buffer as
+// supported here must always contain both top and bottom fields.
+// The top field always begins on the first pixel row. The temporal
+// ordering between the two fields is top field first, unless
+// 'bottom_first' is specified. It is undefined whether 'bottom_first'
+// is ignored if 'interlaced' is not set.
+//
+// This protocol does not convey any information about field rate,
+// duration, or timing, other than the relative ordering between the
+// two fields in one buffer. A compositor may have to estimate the
+// intended field rate from the incoming buffer rate. It is undefined
+// whether the time of receiving wl_surface.commit with a new buffer
+// attached, applying the wl_surface state, wl_surface.frame callback
+// trigger, presentation, or any other point in the compositor cycle
+// is used to measure the frame or field times. There is no support
+// for detecting missed or late frames/fields/buffers either, and
+// there is no support whatsoever for cooperating with interlaced
+// compositor output.
+//
+// The composited image quality resulting from the use of interlaced
+// buffers is explicitly undefined. A compositor may use elaborate
+// hardware features or software to deinterlace and create progressive
+// output frames from a sequence of interlaced input buffers, or it
+// may produce substandard image quality. However, compositors that
+// cannot guarantee reasonable image quality in all cases are recommended
+// to just reject all interlaced buffers.
+//
+// Any argument errors, including non-positive width or height,
+// mismatch between the number of planes and the format, bad
+// format, bad offset or stride, may be indicated by fatal protocol
+// errors: INCOMPLETE, INVALID_FORMAT, INVALID_DIMENSIONS,
+// OUT_OF_BOUNDS.
+//
+// Dmabuf import errors in the server that are not obvious client
+// bugs are returned via the 'failed' event as non-fatal. This
+// allows attempting dmabuf sharing and falling back in the client
+// if it fails.
+//
+// This request can be sent only once in the object's lifetime, after
+// which the only legal request is destroy. This object should be
+// destroyed after issuing a 'create' request. Attempting to use this
+// object after issuing 'create' raises ALREADY_USED protocol error.
+//
+// It is not mandatory to issue 'create'. If a client wants to
+// cancel the buffer creation, it can just destroy this object.
+func (obj *LinuxBufferParamsV1) Create(width int32, height int32, format uint32, flags LinuxBufferParamsV1Flags) {
+	obj.state.Enqueue(LinuxBufferParamsV1CreateRequest{
+		Width:  width,
+		Height: height,
+		Format: format,
+		Flags:  flags,
+	}.Encode(obj))
+	return
+}
+
+// This asks for immediate creation of a wl_buffer by importing the
+// added dmabufs.
+//
+// In case of import success, no event is sent from the server, and the
+// wl_buffer is ready to be used by the client.
+//
+// Upon import failure, either of the following may happen, as seen fit
+// by the implementation:
+// - the client is terminated with one of the following fatal protocol
+// errors:
+// - INCOMPLETE, INVALID_FORMAT, INVALID_DIMENSIONS, OUT_OF_BOUNDS,
+// in case of argument errors such as mismatch between the number
+// of planes and the format, bad format, non-positive width or
+// height, or bad offset or stride.
+// - INVALID_WL_BUFFER, in case the cause for failure is unknown or
+// platform specific.
+// - the server creates an invalid wl_buffer, marks it as failed and
+// sends a 'failed' event to the client. The result of using this
+// invalid wl_buffer as an argument in any request by the client is
+// defined by the compositor implementation.
+//
+// This takes the same arguments as a 'create' request, and obeys the
+// same restrictions.
+//
+// Available since version 2.
+func (obj *LinuxBufferParamsV1) CreateImmed(width int32, height int32, format uint32, flags LinuxBufferParamsV1Flags) (bufferId *wl.Buffer) {
+	bufferId = wl.NewBuffer(obj.state)
+	obj.state.Add(bufferId)
+	obj.state.Enqueue(LinuxBufferParamsV1CreateImmedRequest{
+		BufferId: bufferId,
+		Width:    width,
+		Height:   height,
+		Format:   format,
+		Flags:    flags,
+	}.Encode(obj))
+	return bufferId
+}
+
+// LinuxBufferParamsV1CreatedEvent holds the arguments of the created event of
+// the zwp_linux_buffer_params_v1 interface.
+type LinuxBufferParamsV1CreatedEvent struct {
+	Buffer *wl.Buffer
+}
+
+func (LinuxBufferParamsV1CreatedEvent) linuxBufferParamsV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1CreatedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Buffer = wl.NewBuffer(state)
+	m.Buffer.SetID(wire.CheckNewID(msg, state, wl.BufferInterface, msg.ReadNewObject(wl.BufferInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Buffer)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxBufferParamsV1CreatedEvent) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Buffer)
+
+	builder.Method = "created"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: wl.BufferInterface, ID: m.Buffer.ID()}}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1FailedEvent holds the arguments of the failed event of
+// the zwp_linux_buffer_params_v1 interface.
+type LinuxBufferParamsV1FailedEvent struct {
+}
+
+func (LinuxBufferParamsV1FailedEvent) linuxBufferParamsV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1FailedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxBufferParamsV1FailedEvent) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "failed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1DestroyRequest holds the arguments of the destroy request of
+// the zwp_linux_buffer_params_v1 interface.
+type LinuxBufferParamsV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxBufferParamsV1DestroyRequest) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1AddRequest holds the arguments of the add request of
+// the zwp_linux_buffer_params_v1 interface.
+type LinuxBufferParamsV1AddRequest struct {
+	Fd         *os.File
+	PlaneIdx   uint32
+	Offset     uint32
+	Stride     uint32
+	ModifierHi uint32
+	ModifierLo uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1AddRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Fd = msg.ReadFile()
+	m.PlaneIdx = msg.ReadUint()
+	m.Offset = msg.ReadUint()
+	m.Stride = msg.ReadUint()
+	m.ModifierHi = msg.ReadUint()
+	m.ModifierLo = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m LinuxBufferParamsV1AddRequest) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m LinuxBufferParamsV1AddRequest) EncodeTransfer(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m LinuxBufferParamsV1AddRequest) encode(obj *LinuxBufferParamsV1, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+	builder.WriteUint(m.PlaneIdx)
+	builder.WriteUint(m.Offset)
+	builder.WriteUint(m.Stride)
+	builder.WriteUint(m.ModifierHi)
+	builder.WriteUint(m.ModifierLo)
+
+	builder.Method = "add"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Fd, m.PlaneIdx, m.Offset, m.Stride, m.ModifierHi, m.ModifierLo}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1CreateRequest holds the arguments of the create request of
+// the zwp_linux_buffer_params_v1 interface.
+type LinuxBufferParamsV1CreateRequest struct {
+	Width  int32
+	Height int32
+	Format uint32
+	Flags  LinuxBufferParamsV1Flags
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1CreateRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Format = msg.ReadUint()
+	m.Flags = LinuxBufferParamsV1Flags(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxBufferParamsV1CreateRequest) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteUint(m.Format)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "create"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height, m.Format, m.Flags}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1CreateImmedRequest holds the arguments of the create_immed request of
+// the zwp_linux_buffer_params_v1 interface.
+//
+// Available since version 2.
+type LinuxBufferParamsV1CreateImmedRequest struct {
+	BufferId *wl.Buffer
+	Width    int32
+	Height   int32
+	Format   uint32
+	Flags    LinuxBufferParamsV1Flags
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxBufferParamsV1CreateImmedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.BufferId = wl.NewBuffer(state)
+	m.BufferId.SetID(wire.CheckNewID(msg, state, wl.BufferInterface, msg.ReadNewObject(wl.BufferInterface), false))
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.Format = msg.ReadUint()
+	m.Flags = LinuxBufferParamsV1Flags(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.BufferId)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxBufferParamsV1CreateImmedRequest) Encode(obj *LinuxBufferParamsV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteObject(m.BufferId)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteUint(m.Format)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "create_immed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: wl.BufferInterface, ID: m.BufferId.ID()}, m.Width, m.Height, m.Format, m.Flags}
+	}
+	return builder
+}
+
+// LinuxBufferParamsV1Error is an alias for [shared.LinuxBufferParamsV1Error].
+type LinuxBufferParamsV1Error = shared.LinuxBufferParamsV1Error
+
+const (
+	LinuxBufferParamsV1ErrorAlreadyUsed       = shared.LinuxBufferParamsV1ErrorAlreadyUsed
+	LinuxBufferParamsV1ErrorPlaneIdx          = shared.LinuxBufferParamsV1ErrorPlaneIdx
+	LinuxBufferParamsV1ErrorPlaneSet          = shared.LinuxBufferParamsV1ErrorPlaneSet
+	LinuxBufferParamsV1ErrorIncomplete        = shared.LinuxBufferParamsV1ErrorIncomplete
+	LinuxBufferParamsV1ErrorInvalidFormat     = shared.LinuxBufferParamsV1ErrorInvalidFormat
+	LinuxBufferParamsV1ErrorInvalidDimensions = shared.LinuxBufferParamsV1ErrorInvalidDimensions
+	LinuxBufferParamsV1ErrorOutOfBounds       = shared.LinuxBufferParamsV1ErrorOutOfBounds
+	LinuxBufferParamsV1ErrorInvalidWlBuffer   = shared.LinuxBufferParamsV1ErrorInvalidWlBuffer
+)
+
+// LinuxBufferParamsV1Flags is an alias for [shared.LinuxBufferParamsV1Flags].
+type LinuxBufferParamsV1Flags = shared.LinuxBufferParamsV1Flags
+
+const (
+	LinuxBufferParamsV1FlagsYInvert     = shared.LinuxBufferParamsV1FlagsYInvert
+	LinuxBufferParamsV1FlagsInterlaced  = shared.LinuxBufferParamsV1FlagsInterlaced
+	LinuxBufferParamsV1FlagsBottomFirst = shared.LinuxBufferParamsV1FlagsBottomFirst
+)
+
+const (
+	LinuxDmabufFeedbackV1Interface = shared.LinuxDmabufFeedbackV1Interface
+
+	// LinuxDmabufFeedbackV1Version is the version of the interface that this
+	// package implements.
+	LinuxDmabufFeedbackV1Version = 4
+)
+
+// The versions of the zwp_linux_dmabuf_feedback_v1 interface that its messages were
+// added in.
+const (
+	LinuxDmabufFeedbackV1DestroyRequestSince           = 1
+	LinuxDmabufFeedbackV1DoneEventSince                = 1
+	LinuxDmabufFeedbackV1FormatTableEventSince         = 1
+	LinuxDmabufFeedbackV1MainDeviceEventSince          = 1
+	LinuxDmabufFeedbackV1TrancheDoneEventSince         = 1
+	LinuxDmabufFeedbackV1TrancheTargetDeviceEventSince = 1
+	LinuxDmabufFeedbackV1TrancheFormatsEventSince      = 1
+	LinuxDmabufFeedbackV1TrancheFlagsEventSince        = 1
+)
+
+// LinuxDmabufFeedbackV1Desc describes the zwp_linux_dmabuf_feedback_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var LinuxDmabufFeedbackV1Desc = &wire.InterfaceDesc{
+	Name:    LinuxDmabufFeedbackV1Interface,
+	Version: LinuxDmabufFeedbackV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "format_table",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "fd",
+					Type: wire.ArgFD,
+				},
+				{
+					Name: "size",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "main_device",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "device",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "tranche_done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "tranche_target_device",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "device",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "tranche_formats",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "indices",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "tranche_flags",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "tranche_flags",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "tranche_flags",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "scanout", Value: 1},
+			},
+		},
+	},
+}
+
+// LinuxDmabufFeedbackV1Listener is a type that can respond to incoming
+// messages for a LinuxDmabufFeedbackV1 object.
+type LinuxDmabufFeedbackV1Listener interface {
+	// This event is sent after all parameters of a wp_linux_dmabuf_feedback
+	// object have been sent.
+	//
+	// This allows changes to the wp_linux_dmabuf_feedback parameters to be
+	// seen as atomic, even if they happen via multiple events.
+	Done()
+
+	// This event provides a file descriptor which can be memory-mapped to
+	// access the format and modifier table.
+	//
+	// The table contains a tightly packed array of consecutive format +
+	// modifier pairs. Each pair is 16 bytes wide. It contains a format as a
+	// 32-bit unsigned integer, followed by 4 bytes of unused padding, and a
+	// modifier as a 64-bit unsigned integer. The native endianness is used.
+	//
+	// The client must map the file descriptor in read-only private mode.
+	//
+	// Compositors are not allowed to mutate the table file contents once this
+	// event has been sent. Instead, compositors must create a new, separate
+	// table file and re-send feedback parameters. Compositors are allowed to
+	// store duplicate format + modifier pairs in the table.
+	FormatTable(fd *os.File, size uint32)
+
+	// This event advertises the main device that the server prefers to use
+	// when direct scan-out to the target device isn't possible. The
+	// advertised main device may be different for each
+	// wp_linux_dmabuf_feedback object, and may change over time.
+	//
+	// There is exactly one main device. The compositor must send at least
+	// one preference tranche with tranche_target_device equal to main_device.
+	//
+	// Clients need to create buffers that the main device can import and
+	// read from, otherwise creating the dmabuf wl_buffer will fail (see the
+	// wp_linux_buffer_params.create and create_immed requests for details).
+	//
+	// The device is a dev_t value, an array of bytes in native endianness.
+	MainDevice(device []byte)
+
+	// This event splits tranche_target_device and tranche_formats events in
+	// preference tranches. It is sent after a set of tranche_target_device
+	// and tranche_formats events; it represents the end of a tranche. The
+	// next tranche will have a lower preference.
+	TrancheDone()
+
+	// This event advertises the target device that the server prefers to use
+	// for a buffer created given this tranche. The advertised target device
+	// may be different for each preference tranche, and may change over time.
+	//
+	// There is exactly one target device per tranche.
+	//
+	// The device is a dev_t value, an array of bytes in native endianness.
+	TrancheTargetDevice(device []byte)
+
+	// This event advertises the format + modifier combinations that the
+	// compositor supports.
+	//
+	// It carries an array of indices, each referring to a format + modifier
+	// pair in the last received format table (see the format_table event).
+	// Each index is a 16-bit unsigned integer in native endianness.
+	//
+	// For legacy support, DRM_FORMAT_MOD_INVALID is an allowed modifier.
+	// It indicates that the server can support the format with an implicit
+	// modifier.
+	//
+	// Compositors must not send duplicate format + modifier pairs within the
+	// same tranche or across two different tranches with the same target
+	// device and flags.
+	//
+	// This event is tied to a preference tranche, see the tranche_done event.
+	TrancheFormats(indices []byte)
+
+	// This event sets tranche-specific flags.
+	//
+	// The scanout flag is a hint that direct scan-out may be attempted by the
+	// compositor on the target device if the client appropriately allocates a
+	// buffer. How to allocate a buffer that can be scanned out on the target
+	// device is implementation-defined.
+	//
+	// This event is tied to a preference tranche, see the tranche_done event.
+	TrancheFlags(flags LinuxDmabufFeedbackV1TrancheFlags)
+}
+
+// LinuxDmabufFeedbackV1ListenerFuncs implements LinuxDmabufFeedbackV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type LinuxDmabufFeedbackV1ListenerFuncs struct {
+	OnDone                func()
+	OnFormatTable         func(fd *os.File, size uint32)
+	OnMainDevice          func(device []byte)
+	OnTrancheDone         func()
+	OnTrancheTargetDevice func(device []byte)
+	OnTrancheFormats      func(indices []byte)
+	OnTrancheFlags        func(flags LinuxDmabufFeedbackV1TrancheFlags)
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) FormatTable(fd *os.File, size uint32) {
+	if lis.OnFormatTable != nil {
+		lis.OnFormatTable(fd, size)
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) MainDevice(device []byte) {
+	if lis.OnMainDevice != nil {
+		lis.OnMainDevice(device)
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) TrancheDone() {
+	if lis.OnTrancheDone != nil {
+		lis.OnTrancheDone()
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) TrancheTargetDevice(device []byte) {
+	if lis.OnTrancheTargetDevice != nil {
+		lis.OnTrancheTargetDevice(device)
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) TrancheFormats(indices []byte) {
+	if lis.OnTrancheFormats != nil {
+		lis.OnTrancheFormats(indices)
+	}
+}
+
+func (lis *LinuxDmabufFeedbackV1ListenerFuncs) TrancheFlags(flags LinuxDmabufFeedbackV1TrancheFlags) {
+	if lis.OnTrancheFlags != nil {
+		lis.OnTrancheFlags(flags)
+	}
+}
+
+// LinuxDmabufFeedbackV1Event is implemented by the message types of all
+// of the events that a LinuxDmabufFeedbackV1 can receive.
+type LinuxDmabufFeedbackV1Event interface {
+	linuxDmabufFeedbackV1Event()
+}
+
+// LinuxDmabufFeedbackV1EventFunc implements LinuxDmabufFeedbackV1Listener by calling
+// itself with each incoming message.
+type LinuxDmabufFeedbackV1EventFunc func(LinuxDmabufFeedbackV1Event)
+
+func (f LinuxDmabufFeedbackV1EventFunc) Done() {
+	f(LinuxDmabufFeedbackV1DoneEvent{})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) FormatTable(fd *os.File, size uint32) {
+	f(LinuxDmabufFeedbackV1FormatTableEvent{
+		Fd:   fd,
+		Size: size,
+	})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) MainDevice(device []byte) {
+	f(LinuxDmabufFeedbackV1MainDeviceEvent{
+		Device: device,
+	})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) TrancheDone() {
+	f(LinuxDmabufFeedbackV1TrancheDoneEvent{})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) TrancheTargetDevice(device []byte) {
+	f(LinuxDmabufFeedbackV1TrancheTargetDeviceEvent{
+		Device: device,
+	})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) TrancheFormats(indices []byte) {
+	f(LinuxDmabufFeedbackV1TrancheFormatsEvent{
+		Indices: indices,
+	})
+}
+
+func (f LinuxDmabufFeedbackV1EventFunc) TrancheFlags(flags LinuxDmabufFeedbackV1TrancheFlags) {
+	f(LinuxDmabufFeedbackV1TrancheFlagsEvent{
+		Flags: flags,
+	})
+}
+
+// This object advertises dmabuf parameters feedback. This includes the
+// preferred devices and the supported formats/modifiers.
+//
+// The parameters are sent once when this object is created and whenever they
+// change. The done event is always sent once after all parameters have been
+// sent. When a single parameter changes, all parameters are re-sent by the
+// compositor.
+//
+// Compositors can re-send the format table and other device parameters
+// because of various reasons, e.g. when switching to another GPU or when
+// the list of supported formats and modifiers changes.
+//
+// The feedback is organized in tranches. Each tranche contains a set of
+// formats and modifiers supported by a device. Tranches are sent in
+// descending order of preference: the first tranche contains the formats
+// and modifiers the compositor prefers the most.
+type LinuxDmabufFeedbackV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener LinuxDmabufFeedbackV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewLinuxDmabufFeedbackV1 returns a newly instantiated LinuxDmabufFeedbackV1. It is
+// primarily intended for use by generated code.
+func NewLinuxDmabufFeedbackV1(state wire.State) *LinuxDmabufFeedbackV1 {
+	return &LinuxDmabufFeedbackV1{state: state}
+}
+
+func (obj *LinuxDmabufFeedbackV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *LinuxDmabufFeedbackV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m LinuxDmabufFeedbackV1DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done()
+		return nil
+
+	case 1:
+		if err := msg.ClaimFDs(1); err != nil {
+			return err
+		}
+		var m LinuxDmabufFeedbackV1FormatTableEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		msg.HandOffFiles()
+		obj.Listener.FormatTable(
+			m.Fd,
+			m.Size,
+		)
+		return nil
+
+	case 2:
+		var m LinuxDmabufFeedbackV1MainDeviceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.MainDevice(
+			m.Device,
+		)
+		return nil
+
+	case 3:
+		var m LinuxDmabufFeedbackV1TrancheDoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.TrancheDone()
+		return nil
+
+	case 4:
+		var m LinuxDmabufFeedbackV1TrancheTargetDeviceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.TrancheTargetDevice(
+			m.Device,
+		)
+		return nil
+
+	case 5:
+		var m LinuxDmabufFeedbackV1TrancheFormatsEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.TrancheFormats(
+			m.Indices,
+		)
+		return nil
+
+	case 6:
+		var m LinuxDmabufFeedbackV1TrancheFlagsEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.TrancheFlags(
+			m.Flags,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(LinuxDmabufFeedbackV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_linux_dmabuf_feedback_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *LinuxDmabufFeedbackV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *LinuxDmabufFeedbackV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *LinuxDmabufFeedbackV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *LinuxDmabufFeedbackV1) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_linux_dmabuf_feedback_v1", obj.id)
+}
+
+func (obj *LinuxDmabufFeedbackV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "done"
+
+	case 1:
+		return "format_table"
+
+	case 2:
+		return "main_device"
+
+	case 3:
+		return "tranche_done"
+
+	case 4:
+		return "tranche_target_device"
+
+	case 5:
+		return "tranche_formats"
+
+	case 6:
+		return "tranche_flags"
+	}
+
+	return "unknown method"
+}
+
+func (obj *LinuxDmabufFeedbackV1) Interface() string {
+	return LinuxDmabufFeedbackV1Interface
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnDone(f func()) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// OnFormatTable sets the function that is called when the
+// format_table event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnFormatTable(f func(fd *os.File, size uint32)) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFormatTable = f
+}
+
+// OnMainDevice sets the function that is called when the
+// main_device event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnMainDevice(f func(device []byte)) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMainDevice = f
+}
+
+// OnTrancheDone sets the function that is called when the
+// tranche_done event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnTrancheDone(f func()) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTrancheDone = f
+}
+
+// OnTrancheTargetDevice sets the function that is called when the
+// tranche_target_device event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnTrancheTargetDevice(f func(device []byte)) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTrancheTargetDevice = f
+}
+
+// OnTrancheFormats sets the function that is called when the
+// tranche_formats event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnTrancheFormats(f func(indices []byte)) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTrancheFormats = f
+}
+
+// OnTrancheFlags sets the function that is called when the
+// tranche_flags event is received. If obj's Listener is not a
+// *LinuxDmabufFeedbackV1ListenerFuncs, it is replaced with one.
+func (obj *LinuxDmabufFeedbackV1) OnTrancheFlags(f func(flags LinuxDmabufFeedbackV1TrancheFlags)) {
+	lis, ok := obj.Listener.(*LinuxDmabufFeedbackV1ListenerFuncs)
+	if !ok {
+		lis = new(LinuxDmabufFeedbackV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTrancheFlags = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *LinuxDmabufFeedbackV1) Subscribe() *wire.Messages[LinuxDmabufFeedbackV1Event] {
+	q := new(wire.Messages[LinuxDmabufFeedbackV1Event])
+	obj.Listener = LinuxDmabufFeedbackV1EventFunc(q.Push)
+	return q
+}
+
+func (obj *LinuxDmabufFeedbackV1) Version() uint32 {
+	return LinuxDmabufFeedbackV1Version
+}
+
+// Using this request a client can tell the server that it is not going to
+// use the wp_linux_dmabuf_feedback object anymore.
+func (obj *LinuxDmabufFeedbackV1) Destroy() {
+	obj.state.Enqueue(LinuxDmabufFeedbackV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// LinuxDmabufFeedbackV1DoneEvent holds the arguments of the done event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1DoneEvent struct {
+}
+
+func (LinuxDmabufFeedbackV1DoneEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1DoneEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1FormatTableEvent holds the arguments of the format_table event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1FormatTableEvent struct {
+	Fd   *os.File
+	Size uint32
+}
+
+func (LinuxDmabufFeedbackV1FormatTableEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1FormatTableEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Fd = msg.ReadFile()
+	m.Size = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state. The
+// file descriptors of m's files are duplicated, so the caller
+// retains ownership of them.
+func (m LinuxDmabufFeedbackV1FormatTableEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	return m.encode(obj, false)
+}
+
+// EncodeTransfer is like Encode, but ownership of m's files is
+// transferred to the returned message instead of their file
+// descriptors being duplicated. They are closed once the message
+// has been sent or has failed to be and must not be used
+// afterwards.
+func (m LinuxDmabufFeedbackV1FormatTableEvent) EncodeTransfer(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	return m.encode(obj, true)
+}
+
+func (m LinuxDmabufFeedbackV1FormatTableEvent) encode(obj *LinuxDmabufFeedbackV1, transfer bool) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	if transfer {
+		builder.TransferFile(m.Fd)
+	} else {
+		builder.WriteFile(m.Fd)
+	}
+	builder.WriteUint(m.Size)
+
+	builder.Method = "format_table"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Fd, m.Size}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1MainDeviceEvent holds the arguments of the main_device event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1MainDeviceEvent struct {
+	Device []byte
+}
+
+func (LinuxDmabufFeedbackV1MainDeviceEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1MainDeviceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Device = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1MainDeviceEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteArray(m.Device)
+
+	builder.Method = "main_device"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Device}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1TrancheDoneEvent holds the arguments of the tranche_done event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1TrancheDoneEvent struct {
+}
+
+func (LinuxDmabufFeedbackV1TrancheDoneEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1TrancheDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1TrancheDoneEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "tranche_done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1TrancheTargetDeviceEvent holds the arguments of the tranche_target_device event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1TrancheTargetDeviceEvent struct {
+	Device []byte
+}
+
+func (LinuxDmabufFeedbackV1TrancheTargetDeviceEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1TrancheTargetDeviceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Device = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1TrancheTargetDeviceEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteArray(m.Device)
+
+	builder.Method = "tranche_target_device"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Device}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1TrancheFormatsEvent holds the arguments of the tranche_formats event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1TrancheFormatsEvent struct {
+	Indices []byte
+}
+
+func (LinuxDmabufFeedbackV1TrancheFormatsEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1TrancheFormatsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Indices = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1TrancheFormatsEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteArray(m.Indices)
+
+	builder.Method = "tranche_formats"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Indices}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1TrancheFlagsEvent holds the arguments of the tranche_flags event of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1TrancheFlagsEvent struct {
+	Flags LinuxDmabufFeedbackV1TrancheFlags
+}
+
+func (LinuxDmabufFeedbackV1TrancheFlagsEvent) linuxDmabufFeedbackV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1TrancheFlagsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Flags = LinuxDmabufFeedbackV1TrancheFlags(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1TrancheFlagsEvent) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "tranche_flags"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Flags}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1DestroyRequest holds the arguments of the destroy request of
+// the zwp_linux_dmabuf_feedback_v1 interface.
+type LinuxDmabufFeedbackV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *LinuxDmabufFeedbackV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m LinuxDmabufFeedbackV1DestroyRequest) Encode(obj *LinuxDmabufFeedbackV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// LinuxDmabufFeedbackV1TrancheFlags is an alias for [shared.LinuxDmabufFeedbackV1TrancheFlags].
+type LinuxDmabufFeedbackV1TrancheFlags = shared.LinuxDmabufFeedbackV1TrancheFlags
+
+const (
+	LinuxDmabufFeedbackV1TrancheFlagsScanout = shared.LinuxDmabufFeedbackV1TrancheFlagsScanout
+)
+
+func init() {
+	wire.RegisterInterface(LinuxDmabufV1Desc, true)
+	wire.RegisterInterface(LinuxBufferParamsV1Desc, true)
+	wire.RegisterInterface(LinuxDmabufFeedbackV1Desc, true)
+}
@@ -0,0 +1,194 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package dmabuf
+
+import "fmt"
+
+const (
+	LinuxDmabufV1Interface = "zwp_linux_dmabuf_v1"
+	LinuxDmabufV1Version   = 4
+)
+
+const (
+	LinuxBufferParamsV1Interface = "zwp_linux_buffer_params_v1"
+	LinuxBufferParamsV1Version   = 4
+)
+
+type LinuxBufferParamsV1Error int64
+
+const (
+	// the dmabuf_batch object has already been used to create a wl_buffer
+	LinuxBufferParamsV1ErrorAlreadyUsed LinuxBufferParamsV1Error = 0
+
+	// plane index out of bounds
+	LinuxBufferParamsV1ErrorPlaneIdx LinuxBufferParamsV1Error = 1
+
+	// the plane index was already set
+	LinuxBufferParamsV1ErrorPlaneSet LinuxBufferParamsV1Error = 2
+
+	// missing or too many planes to create a buffer
+	LinuxBufferParamsV1ErrorIncomplete LinuxBufferParamsV1Error = 3
+
+	// format not supported
+	LinuxBufferParamsV1ErrorInvalidFormat LinuxBufferParamsV1Error = 4
+
+	// invalid width or height
+	LinuxBufferParamsV1ErrorInvalidDimensions LinuxBufferParamsV1Error = 5
+
+	// offset + stride * height goes out of dmabuf bounds
+	LinuxBufferParamsV1ErrorOutOfBounds LinuxBufferParamsV1Error = 6
+
+	// invalid wl_buffer resulted from importing dmabufs via
+	// the create_immed request on given buffer_params
+	LinuxBufferParamsV1ErrorInvalidWlBuffer LinuxBufferParamsV1Error = 7
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum LinuxBufferParamsV1Error) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5, 6, 7:
+		return true
+	}
+
+	return false
+}
+
+func (enum LinuxBufferParamsV1Error) String() string {
+	switch enum {
+	case 0:
+		return "LinuxBufferParamsV1ErrorAlreadyUsed"
+
+	case 1:
+		return "LinuxBufferParamsV1ErrorPlaneIdx"
+
+	case 2:
+		return "LinuxBufferParamsV1ErrorPlaneSet"
+
+	case 3:
+		return "LinuxBufferParamsV1ErrorIncomplete"
+
+	case 4:
+		return "LinuxBufferParamsV1ErrorInvalidFormat"
+
+	case 5:
+		return "LinuxBufferParamsV1ErrorInvalidDimensions"
+
+	case 6:
+		return "LinuxBufferParamsV1ErrorOutOfBounds"
+
+	case 7:
+		return "LinuxBufferParamsV1ErrorInvalidWlBuffer"
+	}
+
+	return "<invalid LinuxBufferParamsV1Error>"
+}
+
+type LinuxBufferParamsV1Flags int64
+
+const (
+	// contents are y-inverted
+	LinuxBufferParamsV1FlagsYInvert LinuxBufferParamsV1Flags = 1
+
+	// content is interlaced
+	LinuxBufferParamsV1FlagsInterlaced LinuxBufferParamsV1Flags = 2
+
+	// bottom field first
+	LinuxBufferParamsV1FlagsBottomFirst LinuxBufferParamsV1Flags = 4
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum LinuxBufferParamsV1Flags) Has(flags LinuxBufferParamsV1Flags) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum LinuxBufferParamsV1Flags) With(flags LinuxBufferParamsV1Flags) LinuxBufferParamsV1Flags {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum LinuxBufferParamsV1Flags) Without(flags LinuxBufferParamsV1Flags) LinuxBufferParamsV1Flags {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum LinuxBufferParamsV1Flags) Valid() bool {
+	const mask = 0 | LinuxBufferParamsV1FlagsYInvert | LinuxBufferParamsV1FlagsInterlaced | LinuxBufferParamsV1FlagsBottomFirst
+	return enum.Without(mask) == 0
+}
+
+func (enum LinuxBufferParamsV1Flags) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(LinuxBufferParamsV1FlagsYInvert) {
+		str += "|LinuxBufferParamsV1FlagsYInvert"
+		enum = enum.Without(LinuxBufferParamsV1FlagsYInvert)
+	}
+	if enum.Has(LinuxBufferParamsV1FlagsInterlaced) {
+		str += "|LinuxBufferParamsV1FlagsInterlaced"
+		enum = enum.Without(LinuxBufferParamsV1FlagsInterlaced)
+	}
+	if enum.Has(LinuxBufferParamsV1FlagsBottomFirst) {
+		str += "|LinuxBufferParamsV1FlagsBottomFirst"
+		enum = enum.Without(LinuxBufferParamsV1FlagsBottomFirst)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+const (
+	LinuxDmabufFeedbackV1Interface = "zwp_linux_dmabuf_feedback_v1"
+	LinuxDmabufFeedbackV1Version   = 4
+)
+
+type LinuxDmabufFeedbackV1TrancheFlags int64
+
+const (
+	// direct scan-out tranche
+	LinuxDmabufFeedbackV1TrancheFlagsScanout LinuxDmabufFeedbackV1TrancheFlags = 1
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum LinuxDmabufFeedbackV1TrancheFlags) Has(flags LinuxDmabufFeedbackV1TrancheFlags) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum LinuxDmabufFeedbackV1TrancheFlags) With(flags LinuxDmabufFeedbackV1TrancheFlags) LinuxDmabufFeedbackV1TrancheFlags {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum LinuxDmabufFeedbackV1TrancheFlags) Without(flags LinuxDmabufFeedbackV1TrancheFlags) LinuxDmabufFeedbackV1TrancheFlags {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum LinuxDmabufFeedbackV1TrancheFlags) Valid() bool {
+	const mask = 0 | LinuxDmabufFeedbackV1TrancheFlagsScanout
+	return enum.Without(mask) == 0
+}
+
+func (enum LinuxDmabufFeedbackV1TrancheFlags) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(LinuxDmabufFeedbackV1TrancheFlagsScanout) {
+		str += "|LinuxDmabufFeedbackV1TrancheFlagsScanout"
+		enum = enum.Without(LinuxDmabufFeedbackV1TrancheFlagsScanout)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
@@ -0,0 +1,502 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package fractionalscale
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/fractionalscale"
+	"fmt"
+)
+
+const (
+	FractionalScaleManagerV1Interface = shared.FractionalScaleManagerV1Interface
+
+	// FractionalScaleManagerV1Version is the version of the interface that this
+	// package implements.
+	FractionalScaleManagerV1Version = 1
+)
+
+// The versions of the wp_fractional_scale_manager_v1 interface that its messages were
+// added in.
+const (
+	FractionalScaleManagerV1DestroyRequestSince            = 1
+	FractionalScaleManagerV1GetFractionalScaleRequestSince = 1
+)
+
+// FractionalScaleManagerV1Desc describes the wp_fractional_scale_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var FractionalScaleManagerV1Desc = &wire.InterfaceDesc{
+	Name:    FractionalScaleManagerV1Interface,
+	Version: FractionalScaleManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_fractional_scale",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wp_fractional_scale_v1",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "fractional_scale_exists", Value: 0},
+			},
+		},
+	},
+}
+
+// A global interface for requesting surfaces to use fractional scales.
+type FractionalScaleManagerV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewFractionalScaleManagerV1 returns a newly instantiated FractionalScaleManagerV1. It is
+// primarily intended for use by generated code.
+func NewFractionalScaleManagerV1(state wire.State) *FractionalScaleManagerV1 {
+	return &FractionalScaleManagerV1{state: state}
+}
+
+func BindFractionalScaleManagerV1(state wire.State, registry wire.Binder, name, version uint32) *FractionalScaleManagerV1 {
+	obj := NewFractionalScaleManagerV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: FractionalScaleManagerV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *FractionalScaleManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *FractionalScaleManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(FractionalScaleManagerV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_fractional_scale_manager_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *FractionalScaleManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *FractionalScaleManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *FractionalScaleManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *FractionalScaleManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_fractional_scale_manager_v1", obj.id)
+}
+
+func (obj *FractionalScaleManagerV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *FractionalScaleManagerV1) Interface() string {
+	return FractionalScaleManagerV1Interface
+}
+
+func (obj *FractionalScaleManagerV1) Version() uint32 {
+	return FractionalScaleManagerV1Version
+}
+
+// Informs the server that the client will not be using this protocol
+// object anymore. This does not affect any other objects,
+// wp_fractional_scale_v1 objects included.
+func (obj *FractionalScaleManagerV1) Destroy() {
+	obj.state.Enqueue(FractionalScaleManagerV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Create an add-on object for the the wl_surface to let the compositor
+// request fractional scales. If the given wl_surface already has a
+// wp_fractional_scale_v1 object associated, the fractional_scale_exists
+// protocol error is raised.
+func (obj *FractionalScaleManagerV1) GetFractionalScale(surface *wl.Surface) (id *FractionalScaleV1) {
+	id = NewFractionalScaleV1(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(FractionalScaleManagerV1GetFractionalScaleRequest{
+		Id:      id,
+		Surface: surface,
+	}.Encode(obj))
+	return id
+}
+
+// FractionalScaleManagerV1DestroyRequest holds the arguments of the destroy request of
+// the wp_fractional_scale_manager_v1 interface.
+type FractionalScaleManagerV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FractionalScaleManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FractionalScaleManagerV1DestroyRequest) Encode(obj *FractionalScaleManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// FractionalScaleManagerV1GetFractionalScaleRequest holds the arguments of the get_fractional_scale request of
+// the wp_fractional_scale_manager_v1 interface.
+type FractionalScaleManagerV1GetFractionalScaleRequest struct {
+	Id      *FractionalScaleV1
+	Surface *wl.Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FractionalScaleManagerV1GetFractionalScaleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewFractionalScaleV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, FractionalScaleV1Interface, msg.ReadNewObject(FractionalScaleV1Interface), false))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FractionalScaleManagerV1GetFractionalScaleRequest) Encode(obj *FractionalScaleManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_fractional_scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: FractionalScaleV1Interface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+// FractionalScaleManagerV1Error is an alias for [shared.FractionalScaleManagerV1Error].
+type FractionalScaleManagerV1Error = shared.FractionalScaleManagerV1Error
+
+const (
+	FractionalScaleManagerV1ErrorFractionalScaleExists = shared.FractionalScaleManagerV1ErrorFractionalScaleExists
+)
+
+const (
+	FractionalScaleV1Interface = shared.FractionalScaleV1Interface
+
+	// FractionalScaleV1Version is the version of the interface that this
+	// package implements.
+	FractionalScaleV1Version = 1
+)
+
+// The versions of the wp_fractional_scale_v1 interface that its messages were
+// added in.
+const (
+	FractionalScaleV1DestroyRequestSince      = 1
+	FractionalScaleV1PreferredScaleEventSince = 1
+)
+
+// FractionalScaleV1Desc describes the wp_fractional_scale_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var FractionalScaleV1Desc = &wire.InterfaceDesc{
+	Name:    FractionalScaleV1Interface,
+	Version: FractionalScaleV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "preferred_scale",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "scale",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
+// FractionalScaleV1Listener is a type that can respond to incoming
+// messages for a FractionalScaleV1 object.
+type FractionalScaleV1Listener interface {
+	// Notification of a new preferred scale for this surface that the
+	// compositor suggests that the client should use.
+	//
+	// The sent scale is the numerator of a fraction with a denominator of 120.
+	PreferredScale(scale uint32)
+}
+
+// FractionalScaleV1ListenerFuncs implements FractionalScaleV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type FractionalScaleV1ListenerFuncs struct {
+	OnPreferredScale func(scale uint32)
+}
+
+func (lis *FractionalScaleV1ListenerFuncs) PreferredScale(scale uint32) {
+	if lis.OnPreferredScale != nil {
+		lis.OnPreferredScale(scale)
+	}
+}
+
+// FractionalScaleV1Event is implemented by the message types of all
+// of the events that a FractionalScaleV1 can receive.
+type FractionalScaleV1Event interface {
+	fractionalScaleV1Event()
+}
+
+// FractionalScaleV1EventFunc implements FractionalScaleV1Listener by calling
+// itself with each incoming message.
+type FractionalScaleV1EventFunc func(FractionalScaleV1Event)
+
+func (f FractionalScaleV1EventFunc) PreferredScale(scale uint32) {
+	f(FractionalScaleV1PreferredScaleEvent{
+		Scale: scale,
+	})
+}
+
+// An additional interface to a wl_surface object which allows the compositor
+// to inform the client of the preferred scale.
+type FractionalScaleV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener FractionalScaleV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewFractionalScaleV1 returns a newly instantiated FractionalScaleV1. It is
+// primarily intended for use by generated code.
+func NewFractionalScaleV1(state wire.State) *FractionalScaleV1 {
+	return &FractionalScaleV1{state: state}
+}
+
+func (obj *FractionalScaleV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *FractionalScaleV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m FractionalScaleV1PreferredScaleEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.PreferredScale(
+			m.Scale,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(FractionalScaleV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_fractional_scale_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *FractionalScaleV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *FractionalScaleV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *FractionalScaleV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *FractionalScaleV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_fractional_scale_v1", obj.id)
+}
+
+func (obj *FractionalScaleV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "preferred_scale"
+	}
+
+	return "unknown method"
+}
+
+func (obj *FractionalScaleV1) Interface() string {
+	return FractionalScaleV1Interface
+}
+
+// OnPreferredScale sets the function that is called when the
+// preferred_scale event is received. If obj's Listener is not a
+// *FractionalScaleV1ListenerFuncs, it is replaced with one.
+func (obj *FractionalScaleV1) OnPreferredScale(f func(scale uint32)) {
+	lis, ok := obj.Listener.(*FractionalScaleV1ListenerFuncs)
+	if !ok {
+		lis = new(FractionalScaleV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPreferredScale = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *FractionalScaleV1) Subscribe() *wire.Messages[FractionalScaleV1Event] {
+	q := new(wire.Messages[FractionalScaleV1Event])
+	obj.Listener = FractionalScaleV1EventFunc(q.Push)
+	return q
+}
+
+func (obj *FractionalScaleV1) Version() uint32 {
+	return FractionalScaleV1Version
+}
+
+// Destroy the fractional scale object. When this object is destroyed,
+// preferred_scale events will no longer be sent.
+func (obj *FractionalScaleV1) Destroy() {
+	obj.state.Enqueue(FractionalScaleV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// FractionalScaleV1PreferredScaleEvent holds the arguments of the preferred_scale event of
+// the wp_fractional_scale_v1 interface.
+type FractionalScaleV1PreferredScaleEvent struct {
+	Scale uint32
+}
+
+func (FractionalScaleV1PreferredScaleEvent) fractionalScaleV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FractionalScaleV1PreferredScaleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Scale = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FractionalScaleV1PreferredScaleEvent) Encode(obj *FractionalScaleV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Scale)
+
+	builder.Method = "preferred_scale"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Scale}
+	}
+	return builder
+}
+
+// FractionalScaleV1DestroyRequest holds the arguments of the destroy request of
+// the wp_fractional_scale_v1 interface.
+type FractionalScaleV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *FractionalScaleV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m FractionalScaleV1DestroyRequest) Encode(obj *FractionalScaleV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+func init() {
+	wire.RegisterInterface(FractionalScaleManagerV1Desc, true)
+	wire.RegisterInterface(FractionalScaleV1Desc, true)
+}
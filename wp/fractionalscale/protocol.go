@@ -0,0 +1,40 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package fractionalscale
+
+const (
+	FractionalScaleManagerV1Interface = "wp_fractional_scale_manager_v1"
+	FractionalScaleManagerV1Version   = 1
+)
+
+type FractionalScaleManagerV1Error int64
+
+const (
+	// the surface already has a fractional_scale object associated
+	FractionalScaleManagerV1ErrorFractionalScaleExists FractionalScaleManagerV1Error = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum FractionalScaleManagerV1Error) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum FractionalScaleManagerV1Error) String() string {
+	switch enum {
+	case 0:
+		return "FractionalScaleManagerV1ErrorFractionalScaleExists"
+	}
+
+	return "<invalid FractionalScaleManagerV1Error>"
+}
+
+const (
+	FractionalScaleV1Interface = "wp_fractional_scale_v1"
+	FractionalScaleV1Version   = 1
+)
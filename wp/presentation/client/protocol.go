@@ -0,0 +1,914 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package presentation
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/presentation"
+	"fmt"
+)
+
+const (
+	PresentationInterface = shared.PresentationInterface
+
+	// PresentationVersion is the version of the interface that this
+	// package implements.
+	PresentationVersion = 1
+)
+
+// The versions of the wp_presentation interface that its messages were
+// added in.
+const (
+	PresentationDestroyRequestSince  = 1
+	PresentationFeedbackRequestSince = 1
+	PresentationClockIdEventSince    = 1
+)
+
+// PresentationDesc describes the wp_presentation interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PresentationDesc = &wire.InterfaceDesc{
+	Name:    PresentationInterface,
+	Version: PresentationVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "feedback",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wp_presentation_feedback",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "clock_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "clk_id",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_timestamp", Value: 0},
+				{Name: "invalid_flag", Value: 1},
+			},
+		},
+	},
+}
+
+// PresentationListener is a type that can respond to incoming
+// messages for a Presentation object.
+type PresentationListener interface {
+	// This event tells the client in which clock domain the
+	// compositor interprets the timestamps used by the presentation
+	// extension. This clock is called the presentation clock.
+	//
+	// The compositor sends this event when the client binds to the
+	// presentation interface. The presentation clock does not change
+	// during the lifetime of the client connection.
+	//
+	// The clock identifier is platform dependent. On POSIX platforms, the
+	// identifier value is one of the clockid_t values accepted by
+	// clock_gettime(). clock_gettime() is defined by POSIX.1-2001.
+	//
+	// Timestamps in this clock domain are expressed as tv_sec_hi,
+	// tv_sec_lo, tv_nsec triples, each component being an unsigned
+	// 32-bit value. Whole seconds are in tv_sec which is a 64-bit
+	// value combined from tv_sec_hi and tv_sec_lo, and the
+	// additional fractional part in tv_nsec as nanoseconds. Hence,
+	// for valid timestamps tv_nsec must be in [0, 999999999].
+	//
+	// Note that clock_id applies only to the presentation clock,
+	// and implies nothing about e.g. the timestamps used in the
+	// Wayland core protocol input events.
+	//
+	// Compositors should prefer a clock which does not jump and is
+	// not slewed e.g. by NTP. The most appropriate clock is likely
+	// CLOCK_MONOTONIC_RAW.
+	ClockId(clkId uint32)
+}
+
+// PresentationListenerFuncs implements PresentationListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PresentationListenerFuncs struct {
+	OnClockId func(clkId uint32)
+}
+
+func (lis *PresentationListenerFuncs) ClockId(clkId uint32) {
+	if lis.OnClockId != nil {
+		lis.OnClockId(clkId)
+	}
+}
+
+// PresentationEvent is implemented by the message types of all
+// of the events that a Presentation can receive.
+type PresentationEvent interface {
+	presentationEvent()
+}
+
+// PresentationEventFunc implements PresentationListener by calling
+// itself with each incoming message.
+type PresentationEventFunc func(PresentationEvent)
+
+func (f PresentationEventFunc) ClockId(clkId uint32) {
+	f(PresentationClockIdEvent{
+		ClkId: clkId,
+	})
+}
+
+// The main feature of this interface is accurate presentation
+// timing feedback to ensure smooth video playback while maintaining
+// audio/video synchronization. Some features use the concept of a
+// presentation clock, which is defined in the
+// presentation.clock_id event.
+//
+// A content update for a wl_surface is submitted by a
+// wl_surface.commit request. Request 'feedback' associates with
+// the wl_surface.commit and provides feedback on the content
+// update, particularly the final realized presentation time.
+//
+// When the final realized presentation time is available, e.g.
+// after a framebuffer flip completes, the requested
+// presentation_feedback.presented events are sent. The final
+// presentation time can differ from the compositor's predicted
+// display update time and the update's target time, especially
+// when the compositor misses its target vertical blanking period.
+type Presentation struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener PresentationListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPresentation returns a newly instantiated Presentation. It is
+// primarily intended for use by generated code.
+func NewPresentation(state wire.State) *Presentation {
+	return &Presentation{state: state}
+}
+
+func BindPresentation(state wire.State, registry wire.Binder, name, version uint32) *Presentation {
+	obj := NewPresentation(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: PresentationInterface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *Presentation) State() wire.State {
+	return obj.state
+}
+
+func (obj *Presentation) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m PresentationClockIdEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ClockId(
+			m.ClkId,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PresentationInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_presentation",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Presentation) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Presentation) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Presentation) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Presentation) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_presentation", obj.id)
+}
+
+func (obj *Presentation) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "clock_id"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Presentation) Interface() string {
+	return PresentationInterface
+}
+
+// OnClockId sets the function that is called when the
+// clock_id event is received. If obj's Listener is not a
+// *PresentationListenerFuncs, it is replaced with one.
+func (obj *Presentation) OnClockId(f func(clkId uint32)) {
+	lis, ok := obj.Listener.(*PresentationListenerFuncs)
+	if !ok {
+		lis = new(PresentationListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnClockId = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Presentation) Subscribe() *wire.Messages[PresentationEvent] {
+	q := new(wire.Messages[PresentationEvent])
+	obj.Listener = PresentationEventFunc(q.Push)
+	return q
+}
+
+func (obj *Presentation) Version() uint32 {
+	return PresentationVersion
+}
+
+// Informs the server that the client will no longer be using
+// this protocol object. Existing objects created by this object
+// are not affected.
+func (obj *Presentation) Destroy() {
+	obj.state.Enqueue(PresentationDestroyRequest{}.Encode(obj))
+	return
+}
+
+// Request presentation feedback for the current content submission
+// on the given surface. This creates a new presentation_feedback
+// object, which will deliver the feedback information once. If
+// multiple presentation_feedback objects are created for the same
+// submission, they will all deliver the same information.
+//
+// For details on what information is returned, see the
+// presentation_feedback interface.
+func (obj *Presentation) Feedback(surface *wl.Surface) (callback *PresentationFeedback) {
+	callback = NewPresentationFeedback(obj.state)
+	obj.state.Add(callback)
+	obj.state.Enqueue(PresentationFeedbackRequest{
+		Surface:  surface,
+		Callback: callback,
+	}.Encode(obj))
+	return callback
+}
+
+// PresentationClockIdEvent holds the arguments of the clock_id event of
+// the wp_presentation interface.
+type PresentationClockIdEvent struct {
+	ClkId uint32
+}
+
+func (PresentationClockIdEvent) presentationEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationClockIdEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ClkId = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationClockIdEvent) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.ClkId)
+
+	builder.Method = "clock_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ClkId}
+	}
+	return builder
+}
+
+// PresentationDestroyRequest holds the arguments of the destroy request of
+// the wp_presentation interface.
+type PresentationDestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationDestroyRequest) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PresentationFeedbackRequest holds the arguments of the feedback request of
+// the wp_presentation interface.
+type PresentationFeedbackRequest struct {
+	Surface  *wl.Surface
+	Callback *PresentationFeedback
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	m.Callback = NewPresentationFeedback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, PresentationFeedbackInterface, msg.ReadNewObject(PresentationFeedbackInterface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackRequest) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Surface)
+	builder.WriteObject(m.Callback)
+
+	builder.Method = "feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Surface, wire.NewID{Interface: PresentationFeedbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
+
+// PresentationError is an alias for [shared.PresentationError].
+type PresentationError = shared.PresentationError
+
+const (
+	PresentationErrorInvalidTimestamp = shared.PresentationErrorInvalidTimestamp
+	PresentationErrorInvalidFlag      = shared.PresentationErrorInvalidFlag
+)
+
+const (
+	PresentationFeedbackInterface = shared.PresentationFeedbackInterface
+
+	// PresentationFeedbackVersion is the version of the interface that this
+	// package implements.
+	PresentationFeedbackVersion = 1
+)
+
+// The versions of the wp_presentation_feedback interface that its messages were
+// added in.
+const (
+	PresentationFeedbackSyncOutputEventSince = 1
+	PresentationFeedbackPresentedEventSince  = 1
+	PresentationFeedbackDiscardedEventSince  = 1
+)
+
+// PresentationFeedbackDesc describes the wp_presentation_feedback interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PresentationFeedbackDesc = &wire.InterfaceDesc{
+	Name:     PresentationFeedbackInterface,
+	Version:  PresentationFeedbackVersion,
+	Requests: []wire.MessageDesc{},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "sync_output",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "presented",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "tv_sec_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "tv_sec_lo",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "tv_nsec",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "refresh",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "seq_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "seq_lo",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "kind",
+				},
+			},
+		},
+		{
+			Name:  "discarded",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "kind",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "vsync", Value: 1},
+				{Name: "hw_clock", Value: 2},
+				{Name: "hw_completion", Value: 4},
+				{Name: "zero_copy", Value: 8},
+			},
+		},
+	},
+}
+
+// PresentationFeedbackListener is a type that can respond to incoming
+// messages for a PresentationFeedback object.
+type PresentationFeedbackListener interface {
+	// As presentation can be synchronized to only one output at a
+	// time, this event tells which output it was. This event is only
+	// sent prior to the presented event.
+	//
+	// As clients may bind to the same global wl_output multiple
+	// times, this event is sent for each bound instance that matches
+	// the synchronized output. If a client has not bound to the
+	// right wl_output global at all, this event is not sent.
+	SyncOutput(output *wl.Output)
+
+	// The associated content update was displayed to the user at the
+	// indicated time (tv_sec_hi/lo, tv_nsec). For the interpretation of
+	// the timestamp, see presentation.clock_id event.
+	//
+	// The timestamp corresponds to the time when the content update
+	// turned into light the first time on the surface's main output.
+	//
+	// The 'refresh' argument gives the compositor's prediction of how
+	// many nanoseconds after tv_sec, tv_nsec the very next output
+	// refresh may occur. This is to further aid clients in
+	// predicting future refreshes, i.e., estimating the timestamps
+	// targeting the next few vblanks. If such prediction cannot
+	// usefully be done, the argument is zero.
+	//
+	// The 64-bit value combined from seq_hi and seq_lo is the value
+	// of the output's vertical retrace counter when the content
+	// update was first scanned out to the display. This value must
+	// be compatible with the definition of MSC in
+	// GLX_OML_sync_control specification. Note, that if the display
+	// path has a non-zero latency, the time instant specified by
+	// this counter may differ from the timestamp's.
+	//
+	// If the output does not have a constant refresh rate, explicit
+	// video mode switches excluded, then the refresh argument must
+	// be zero.
+	//
+	// If the output does not have a concept of vertical retrace or a
+	// refresh cycle, or the output device is self-refreshing without
+	// a way to query the refresh count, then the arguments seq_hi
+	// and seq_lo must be zero.
+	Presented(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind)
+
+	// The content update was never displayed to the user.
+	Discarded()
+}
+
+// PresentationFeedbackListenerFuncs implements PresentationFeedbackListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PresentationFeedbackListenerFuncs struct {
+	OnSyncOutput func(output *wl.Output)
+	OnPresented  func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind)
+	OnDiscarded  func()
+}
+
+func (lis *PresentationFeedbackListenerFuncs) SyncOutput(output *wl.Output) {
+	if lis.OnSyncOutput != nil {
+		lis.OnSyncOutput(output)
+	}
+}
+
+func (lis *PresentationFeedbackListenerFuncs) Presented(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind) {
+	if lis.OnPresented != nil {
+		lis.OnPresented(tvSecHi, tvSecLo, tvNsec, refresh, seqHi, seqLo, flags)
+	}
+}
+
+func (lis *PresentationFeedbackListenerFuncs) Discarded() {
+	if lis.OnDiscarded != nil {
+		lis.OnDiscarded()
+	}
+}
+
+// PresentationFeedbackEvent is implemented by the message types of all
+// of the events that a PresentationFeedback can receive.
+type PresentationFeedbackEvent interface {
+	presentationFeedbackEvent()
+}
+
+// PresentationFeedbackEventFunc implements PresentationFeedbackListener by calling
+// itself with each incoming message.
+type PresentationFeedbackEventFunc func(PresentationFeedbackEvent)
+
+func (f PresentationFeedbackEventFunc) SyncOutput(output *wl.Output) {
+	f(PresentationFeedbackSyncOutputEvent{
+		Output: output,
+	})
+}
+
+func (f PresentationFeedbackEventFunc) Presented(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind) {
+	f(PresentationFeedbackPresentedEvent{
+		TvSecHi: tvSecHi,
+		TvSecLo: tvSecLo,
+		TvNsec:  tvNsec,
+		Refresh: refresh,
+		SeqHi:   seqHi,
+		SeqLo:   seqLo,
+		Flags:   flags,
+	})
+}
+
+func (f PresentationFeedbackEventFunc) Discarded() {
+	f(PresentationFeedbackDiscardedEvent{})
+}
+
+// A presentation_feedback object returns an indication that a
+// wl_surface content update has become visible to the user.
+// One object corresponds to one content update submission
+// (wl_surface.commit). There are two possible outcomes: the
+// content update is presented to the user, and a presentation
+// timestamp delivered; or, the user did not see the content
+// update because it was superseded or its surface destroyed,
+// and the content update is discarded.
+//
+// Once a presentation_feedback object has delivered a 'presented'
+// or 'discarded' event it is automatically destroyed.
+type PresentationFeedback struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener PresentationFeedbackListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPresentationFeedback returns a newly instantiated PresentationFeedback. It is
+// primarily intended for use by generated code.
+func NewPresentationFeedback(state wire.State) *PresentationFeedback {
+	return &PresentationFeedback{state: state}
+}
+
+func (obj *PresentationFeedback) State() wire.State {
+	return obj.state
+}
+
+func (obj *PresentationFeedback) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m PresentationFeedbackSyncOutputEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SyncOutput(
+			m.Output,
+		)
+		return nil
+
+	case 1:
+		var m PresentationFeedbackPresentedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Presented(
+			m.TvSecHi,
+			m.TvSecLo,
+			m.TvNsec,
+			m.Refresh,
+			m.SeqHi,
+			m.SeqLo,
+			m.Flags,
+		)
+		return nil
+
+	case 2:
+		var m PresentationFeedbackDiscardedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Discarded()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PresentationFeedbackInterface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_presentation_feedback",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *PresentationFeedback) ID() uint32 {
+	return obj.id
+}
+
+func (obj *PresentationFeedback) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *PresentationFeedback) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *PresentationFeedback) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_presentation_feedback", obj.id)
+}
+
+func (obj *PresentationFeedback) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "sync_output"
+
+	case 1:
+		return "presented"
+
+	case 2:
+		return "discarded"
+	}
+
+	return "unknown method"
+}
+
+func (obj *PresentationFeedback) Interface() string {
+	return PresentationFeedbackInterface
+}
+
+// OnSyncOutput sets the function that is called when the
+// sync_output event is received. If obj's Listener is not a
+// *PresentationFeedbackListenerFuncs, it is replaced with one.
+func (obj *PresentationFeedback) OnSyncOutput(f func(output *wl.Output)) {
+	lis, ok := obj.Listener.(*PresentationFeedbackListenerFuncs)
+	if !ok {
+		lis = new(PresentationFeedbackListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSyncOutput = f
+}
+
+// OnPresented sets the function that is called when the
+// presented event is received. If obj's Listener is not a
+// *PresentationFeedbackListenerFuncs, it is replaced with one.
+func (obj *PresentationFeedback) OnPresented(f func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind)) {
+	lis, ok := obj.Listener.(*PresentationFeedbackListenerFuncs)
+	if !ok {
+		lis = new(PresentationFeedbackListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPresented = f
+}
+
+// OnDiscarded sets the function that is called when the
+// discarded event is received. If obj's Listener is not a
+// *PresentationFeedbackListenerFuncs, it is replaced with one.
+func (obj *PresentationFeedback) OnDiscarded(f func()) {
+	lis, ok := obj.Listener.(*PresentationFeedbackListenerFuncs)
+	if !ok {
+		lis = new(PresentationFeedbackListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDiscarded = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *PresentationFeedback) Subscribe() *wire.Messages[PresentationFeedbackEvent] {
+	q := new(wire.Messages[PresentationFeedbackEvent])
+	obj.Listener = PresentationFeedbackEventFunc(q.Push)
+	return q
+}
+
+func (obj *PresentationFeedback) Version() uint32 {
+	return PresentationFeedbackVersion
+}
+
+// PresentationFeedbackSyncOutputEvent holds the arguments of the sync_output event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackSyncOutputEvent struct {
+	Output *wl.Output
+}
+
+func (PresentationFeedbackSyncOutputEvent) presentationFeedbackEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationFeedbackSyncOutputEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*wl.Output](msg, state, wl.OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackSyncOutputEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Output)
+
+	builder.Method = "sync_output"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
+
+// PresentationFeedbackPresentedEvent holds the arguments of the presented event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackPresentedEvent struct {
+	TvSecHi uint32
+	TvSecLo uint32
+	TvNsec  uint32
+	Refresh uint32
+	SeqHi   uint32
+	SeqLo   uint32
+	Flags   PresentationFeedbackKind
+}
+
+func (PresentationFeedbackPresentedEvent) presentationFeedbackEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationFeedbackPresentedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.TvSecHi = msg.ReadUint()
+	m.TvSecLo = msg.ReadUint()
+	m.TvNsec = msg.ReadUint()
+	m.Refresh = msg.ReadUint()
+	m.SeqHi = msg.ReadUint()
+	m.SeqLo = msg.ReadUint()
+	m.Flags = PresentationFeedbackKind(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackPresentedEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.TvSecHi)
+	builder.WriteUint(m.TvSecLo)
+	builder.WriteUint(m.TvNsec)
+	builder.WriteUint(m.Refresh)
+	builder.WriteUint(m.SeqHi)
+	builder.WriteUint(m.SeqLo)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "presented"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.TvSecHi, m.TvSecLo, m.TvNsec, m.Refresh, m.SeqHi, m.SeqLo, m.Flags}
+	}
+	return builder
+}
+
+// PresentationFeedbackDiscardedEvent holds the arguments of the discarded event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackDiscardedEvent struct {
+}
+
+func (PresentationFeedbackDiscardedEvent) presentationFeedbackEvent() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *PresentationFeedbackDiscardedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackDiscardedEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "discarded"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PresentationFeedbackKind is an alias for [shared.PresentationFeedbackKind].
+type PresentationFeedbackKind = shared.PresentationFeedbackKind
+
+const (
+	PresentationFeedbackKindVsync        = shared.PresentationFeedbackKindVsync
+	PresentationFeedbackKindHwClock      = shared.PresentationFeedbackKindHwClock
+	PresentationFeedbackKindHwCompletion = shared.PresentationFeedbackKindHwCompletion
+	PresentationFeedbackKindZeroCopy     = shared.PresentationFeedbackKindZeroCopy
+)
+
+func init() {
+	wire.RegisterInterface(PresentationDesc, true)
+	wire.RegisterInterface(PresentationFeedbackDesc, true)
+}
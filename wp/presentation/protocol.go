@@ -0,0 +1,116 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package presentation
+
+import "fmt"
+
+const (
+	PresentationInterface = "wp_presentation"
+	PresentationVersion   = 1
+)
+
+// These fatal protocol errors may be emitted in response to
+// illegal presentation requests.
+type PresentationError int64
+
+const (
+	// invalid value in tv_nsec
+	PresentationErrorInvalidTimestamp PresentationError = 0
+
+	// invalid flag
+	PresentationErrorInvalidFlag PresentationError = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PresentationError) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum PresentationError) String() string {
+	switch enum {
+	case 0:
+		return "PresentationErrorInvalidTimestamp"
+
+	case 1:
+		return "PresentationErrorInvalidFlag"
+	}
+
+	return "<invalid PresentationError>"
+}
+
+const (
+	PresentationFeedbackInterface = "wp_presentation_feedback"
+	PresentationFeedbackVersion   = 1
+)
+
+// These flags provide information about how the presentation of
+// the related content update was done. The intent is to help
+// clients assess the reliability of the feedback and the visual
+// quality with respect to possible tearing and timings.
+type PresentationFeedbackKind int64
+
+const (
+	PresentationFeedbackKindVsync PresentationFeedbackKind = 1
+
+	PresentationFeedbackKindHwClock PresentationFeedbackKind = 2
+
+	PresentationFeedbackKindHwCompletion PresentationFeedbackKind = 4
+
+	PresentationFeedbackKindZeroCopy PresentationFeedbackKind = 8
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum PresentationFeedbackKind) Has(flags PresentationFeedbackKind) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum PresentationFeedbackKind) With(flags PresentationFeedbackKind) PresentationFeedbackKind {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum PresentationFeedbackKind) Without(flags PresentationFeedbackKind) PresentationFeedbackKind {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum PresentationFeedbackKind) Valid() bool {
+	const mask = 0 | PresentationFeedbackKindVsync | PresentationFeedbackKindHwClock | PresentationFeedbackKindHwCompletion | PresentationFeedbackKindZeroCopy
+	return enum.Without(mask) == 0
+}
+
+func (enum PresentationFeedbackKind) String() string {
+	if enum == 0 {
+		return "0"
+	}
+
+	var str string
+	if enum.Has(PresentationFeedbackKindVsync) {
+		str += "|PresentationFeedbackKindVsync"
+		enum = enum.Without(PresentationFeedbackKindVsync)
+	}
+	if enum.Has(PresentationFeedbackKindHwClock) {
+		str += "|PresentationFeedbackKindHwClock"
+		enum = enum.Without(PresentationFeedbackKindHwClock)
+	}
+	if enum.Has(PresentationFeedbackKindHwCompletion) {
+		str += "|PresentationFeedbackKindHwCompletion"
+		enum = enum.Without(PresentationFeedbackKindHwCompletion)
+	}
+	if enum.Has(PresentationFeedbackKindZeroCopy) {
+		str += "|PresentationFeedbackKindZeroCopy"
+		enum = enum.Without(PresentationFeedbackKindZeroCopy)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
@@ -0,0 +1,817 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package presentation
+
+import (
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/presentation"
+	"fmt"
+)
+
+const (
+	PresentationInterface = shared.PresentationInterface
+
+	// PresentationVersion is the version of the interface that this
+	// package implements.
+	PresentationVersion = 1
+)
+
+// The versions of the wp_presentation interface that its messages were
+// added in.
+const (
+	PresentationDestroyRequestSince  = 1
+	PresentationFeedbackRequestSince = 1
+	PresentationClockIdEventSince    = 1
+)
+
+// PresentationDesc describes the wp_presentation interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PresentationDesc = &wire.InterfaceDesc{
+	Name:    PresentationInterface,
+	Version: PresentationVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "feedback",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+				{
+					Name:      "callback",
+					Type:      wire.ArgNewID,
+					Interface: "wp_presentation_feedback",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "clock_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "clk_id",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_timestamp", Value: 0},
+				{Name: "invalid_flag", Value: 1},
+			},
+		},
+	},
+}
+
+// PresentationListener is a type that can respond to incoming
+// messages for a Presentation object.
+type PresentationListener interface {
+	// Informs the server that the client will no longer be using
+	// this protocol object. Existing objects created by this object
+	// are not affected.
+	Destroy()
+
+	// Request presentation feedback for the current content submission
+	// on the given surface. This creates a new presentation_feedback
+	// object, which will deliver the feedback information once. If
+	// multiple presentation_feedback objects are created for the same
+	// submission, they will all deliver the same information.
+	//
+	// For details on what information is returned, see the
+	// presentation_feedback interface.
+	Feedback(surface *wl.Surface, callback *PresentationFeedback)
+}
+
+// PresentationListenerFuncs implements PresentationListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PresentationListenerFuncs struct {
+	OnDestroy  func()
+	OnFeedback func(surface *wl.Surface, callback *PresentationFeedback)
+}
+
+func (lis *PresentationListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *PresentationListenerFuncs) Feedback(surface *wl.Surface, callback *PresentationFeedback) {
+	if lis.OnFeedback != nil {
+		lis.OnFeedback(surface, callback)
+	}
+}
+
+// PresentationRequest is implemented by the message types of all
+// of the requests that a Presentation can receive.
+type PresentationRequest interface {
+	presentationRequest()
+}
+
+// PresentationRequestFunc implements PresentationListener by calling
+// itself with each incoming message.
+type PresentationRequestFunc func(PresentationRequest)
+
+func (f PresentationRequestFunc) Destroy() {
+	f(PresentationDestroyRequest{})
+}
+
+func (f PresentationRequestFunc) Feedback(surface *wl.Surface, callback *PresentationFeedback) {
+	f(PresentationFeedbackRequest{
+		Surface:  surface,
+		Callback: callback,
+	})
+}
+
+// The main feature of this interface is accurate presentation
+// timing feedback to ensure smooth video playback while maintaining
+// audio/video synchronization. Some features use the concept of a
+// presentation clock, which is defined in the
+// presentation.clock_id event.
+//
+// A content update for a wl_surface is submitted by a
+// wl_surface.commit request. Request 'feedback' associates with
+// the wl_surface.commit and provides feedback on the content
+// update, particularly the final realized presentation time.
+//
+// When the final realized presentation time is available, e.g.
+// after a framebuffer flip completes, the requested
+// presentation_feedback.presented events are sent. The final
+// presentation time can differ from the compositor's predicted
+// display update time and the update's target time, especially
+// when the compositor misses its target vertical blanking period.
+type Presentation struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener PresentationListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPresentation returns a newly instantiated Presentation. It is
+// primarily intended for use by generated code.
+func NewPresentation(state wire.State) *Presentation {
+	return &Presentation{state: state}
+}
+
+func BindPresentation(state wire.State, id wire.NewID) *Presentation {
+	obj := NewPresentation(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *Presentation) State() wire.State {
+	return obj.state
+}
+
+func (obj *Presentation) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m PresentationDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m PresentationFeedbackRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Feedback(
+			m.Surface,
+			m.Callback,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PresentationInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_presentation",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Presentation) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Presentation) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Presentation) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Presentation) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_presentation", obj.id)
+}
+
+func (obj *Presentation) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "feedback"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Presentation) Interface() string {
+	return PresentationInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *PresentationListenerFuncs, it is replaced with one.
+func (obj *Presentation) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*PresentationListenerFuncs)
+	if !ok {
+		lis = new(PresentationListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnFeedback sets the function that is called when the
+// feedback request is received. If obj's Listener is not a
+// *PresentationListenerFuncs, it is replaced with one.
+func (obj *Presentation) OnFeedback(f func(surface *wl.Surface, callback *PresentationFeedback)) {
+	lis, ok := obj.Listener.(*PresentationListenerFuncs)
+	if !ok {
+		lis = new(PresentationListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFeedback = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Presentation) Subscribe() *wire.Messages[PresentationRequest] {
+	q := new(wire.Messages[PresentationRequest])
+	obj.Listener = PresentationRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Presentation) Version() uint32 {
+	return PresentationVersion
+}
+
+// This event tells the client in which clock domain the
+// compositor interprets the timestamps used by the presentation
+// extension. This clock is called the presentation clock.
+//
+// The compositor sends this event when the client binds to the
+// presentation interface. The presentation clock does not change
+// during the lifetime of the client connection.
+//
+// The clock identifier is platform dependent. On POSIX platforms, the
+// identifier value is one of the clockid_t values accepted by
+// clock_gettime(). clock_gettime() is defined by POSIX.1-2001.
+//
+// Timestamps in this clock domain are expressed as tv_sec_hi,
+// tv_sec_lo, tv_nsec triples, each component being an unsigned
+// 32-bit value. Whole seconds are in tv_sec which is a 64-bit
+// value combined from tv_sec_hi and tv_sec_lo, and the
+// additional fractional part in tv_nsec as nanoseconds. Hence,
+// for valid timestamps tv_nsec must be in [0, 999999999].
+//
+// Note that clock_id applies only to the presentation clock,
+// and implies nothing about e.g. the timestamps used in the
+// Wayland core protocol input events.
+//
+// Compositors should prefer a clock which does not jump and is
+// not slewed e.g. by NTP. The most appropriate clock is likely
+// CLOCK_MONOTONIC_RAW.
+func (obj *Presentation) ClockId(clkId uint32) {
+	obj.state.Enqueue(PresentationClockIdEvent{
+		ClkId: clkId,
+	}.Encode(obj))
+	return
+}
+
+// PresentationDestroyRequest holds the arguments of the destroy request of
+// the wp_presentation interface.
+type PresentationDestroyRequest struct {
+}
+
+func (PresentationDestroyRequest) presentationRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationDestroyRequest) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PresentationFeedbackRequest holds the arguments of the feedback request of
+// the wp_presentation interface.
+type PresentationFeedbackRequest struct {
+	Surface  *wl.Surface
+	Callback *PresentationFeedback
+}
+
+func (PresentationFeedbackRequest) presentationRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	m.Callback = NewPresentationFeedback(state)
+	m.Callback.SetID(wire.CheckNewID(msg, state, PresentationFeedbackInterface, msg.ReadNewObject(PresentationFeedbackInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Callback)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackRequest) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Surface)
+	builder.WriteObject(m.Callback)
+
+	builder.Method = "feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Surface, wire.NewID{Interface: PresentationFeedbackInterface, ID: m.Callback.ID()}}
+	}
+	return builder
+}
+
+// PresentationClockIdEvent holds the arguments of the clock_id event of
+// the wp_presentation interface.
+type PresentationClockIdEvent struct {
+	ClkId uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationClockIdEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ClkId = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationClockIdEvent) Encode(obj *Presentation) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.ClkId)
+
+	builder.Method = "clock_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ClkId}
+	}
+	return builder
+}
+
+// PresentationError is an alias for [shared.PresentationError].
+type PresentationError = shared.PresentationError
+
+const (
+	PresentationErrorInvalidTimestamp = shared.PresentationErrorInvalidTimestamp
+	PresentationErrorInvalidFlag      = shared.PresentationErrorInvalidFlag
+)
+
+const (
+	PresentationFeedbackInterface = shared.PresentationFeedbackInterface
+
+	// PresentationFeedbackVersion is the version of the interface that this
+	// package implements.
+	PresentationFeedbackVersion = 1
+)
+
+// The versions of the wp_presentation_feedback interface that its messages were
+// added in.
+const (
+	PresentationFeedbackSyncOutputEventSince = 1
+	PresentationFeedbackPresentedEventSince  = 1
+	PresentationFeedbackDiscardedEventSince  = 1
+)
+
+// PresentationFeedbackDesc describes the wp_presentation_feedback interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PresentationFeedbackDesc = &wire.InterfaceDesc{
+	Name:     PresentationFeedbackInterface,
+	Version:  PresentationFeedbackVersion,
+	Requests: []wire.MessageDesc{},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "sync_output",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+				},
+			},
+		},
+		{
+			Name:  "presented",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "tv_sec_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "tv_sec_lo",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "tv_nsec",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "refresh",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "seq_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "seq_lo",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "flags",
+					Type: wire.ArgUint,
+					Enum: "kind",
+				},
+			},
+		},
+		{
+			Name:  "discarded",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name:     "kind",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "vsync", Value: 1},
+				{Name: "hw_clock", Value: 2},
+				{Name: "hw_completion", Value: 4},
+				{Name: "zero_copy", Value: 8},
+			},
+		},
+	},
+}
+
+// A presentation_feedback object returns an indication that a
+// wl_surface content update has become visible to the user.
+// One object corresponds to one content update submission
+// (wl_surface.commit). There are two possible outcomes: the
+// content update is presented to the user, and a presentation
+// timestamp delivered; or, the user did not see the content
+// update because it was superseded or its surface destroyed,
+// and the content update is discarded.
+//
+// Once a presentation_feedback object has delivered a 'presented'
+// or 'discarded' event it is automatically destroyed.
+type PresentationFeedback struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPresentationFeedback returns a newly instantiated PresentationFeedback. It is
+// primarily intended for use by generated code.
+func NewPresentationFeedback(state wire.State) *PresentationFeedback {
+	return &PresentationFeedback{state: state}
+}
+
+func (obj *PresentationFeedback) State() wire.State {
+	return obj.state
+}
+
+func (obj *PresentationFeedback) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PresentationFeedbackInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_presentation_feedback",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *PresentationFeedback) ID() uint32 {
+	return obj.id
+}
+
+func (obj *PresentationFeedback) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *PresentationFeedback) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *PresentationFeedback) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_presentation_feedback", obj.id)
+}
+
+func (obj *PresentationFeedback) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *PresentationFeedback) Interface() string {
+	return PresentationFeedbackInterface
+}
+
+func (obj *PresentationFeedback) Version() uint32 {
+	return PresentationFeedbackVersion
+}
+
+// As presentation can be synchronized to only one output at a
+// time, this event tells which output it was. This event is only
+// sent prior to the presented event.
+//
+// As clients may bind to the same global wl_output multiple
+// times, this event is sent for each bound instance that matches
+// the synchronized output. If a client has not bound to the
+// right wl_output global at all, this event is not sent.
+func (obj *PresentationFeedback) SyncOutput(output *wl.Output) {
+	obj.state.Enqueue(PresentationFeedbackSyncOutputEvent{
+		Output: output,
+	}.Encode(obj))
+	return
+}
+
+// The associated content update was displayed to the user at the
+// indicated time (tv_sec_hi/lo, tv_nsec). For the interpretation of
+// the timestamp, see presentation.clock_id event.
+//
+// The timestamp corresponds to the time when the content update
+// turned into light the first time on the surface's main output.
+//
+// The 'refresh' argument gives the compositor's prediction of how
+// many nanoseconds after tv_sec, tv_nsec the very next output
+// refresh may occur. This is to further aid clients in
+// predicting future refreshes, i.e., estimating the timestamps
+// targeting the next few vblanks. If such prediction cannot
+// usefully be done, the argument is zero.
+//
+// The 64-bit value combined from seq_hi and seq_lo is the value
+// of the output's vertical retrace counter when the content
+// update was first scanned out to the display. This value must
+// be compatible with the definition of MSC in
+// GLX_OML_sync_control specification. Note, that if the display
+// path has a non-zero latency, the time instant specified by
+// this counter may differ from the timestamp's.
+//
+// If the output does not have a constant refresh rate, explicit
+// video mode switches excluded, then the refresh argument must
+// be zero.
+//
+// If the output does not have a concept of vertical retrace or a
+// refresh cycle, or the output device is self-refreshing without
+// a way to query the refresh count, then the arguments seq_hi
+// and seq_lo must be zero.
+func (obj *PresentationFeedback) Presented(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags PresentationFeedbackKind) {
+	obj.state.Enqueue(PresentationFeedbackPresentedEvent{
+		TvSecHi: tvSecHi,
+		TvSecLo: tvSecLo,
+		TvNsec:  tvNsec,
+		Refresh: refresh,
+		SeqHi:   seqHi,
+		SeqLo:   seqLo,
+		Flags:   flags,
+	}.Encode(obj))
+	return
+}
+
+// The content update was never displayed to the user.
+func (obj *PresentationFeedback) Discarded() {
+	obj.state.Enqueue(PresentationFeedbackDiscardedEvent{}.Encode(obj))
+	return
+}
+
+// PresentationFeedbackSyncOutputEvent holds the arguments of the sync_output event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackSyncOutputEvent struct {
+	Output *wl.Output
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationFeedbackSyncOutputEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*wl.Output](msg, state, wl.OutputInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackSyncOutputEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Output)
+
+	builder.Method = "sync_output"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
+
+// PresentationFeedbackPresentedEvent holds the arguments of the presented event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackPresentedEvent struct {
+	TvSecHi uint32
+	TvSecLo uint32
+	TvNsec  uint32
+	Refresh uint32
+	SeqHi   uint32
+	SeqLo   uint32
+	Flags   PresentationFeedbackKind
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationFeedbackPresentedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.TvSecHi = msg.ReadUint()
+	m.TvSecLo = msg.ReadUint()
+	m.TvNsec = msg.ReadUint()
+	m.Refresh = msg.ReadUint()
+	m.SeqHi = msg.ReadUint()
+	m.SeqLo = msg.ReadUint()
+	m.Flags = PresentationFeedbackKind(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Flags.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wp_presentation_feedback",
+			Method:    "presented",
+			Arg:       "flags",
+			Value:     int64(m.Flags),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackPresentedEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.TvSecHi)
+	builder.WriteUint(m.TvSecLo)
+	builder.WriteUint(m.TvNsec)
+	builder.WriteUint(m.Refresh)
+	builder.WriteUint(m.SeqHi)
+	builder.WriteUint(m.SeqLo)
+	builder.WriteUint(uint32(m.Flags))
+
+	builder.Method = "presented"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.TvSecHi, m.TvSecLo, m.TvNsec, m.Refresh, m.SeqHi, m.SeqLo, m.Flags}
+	}
+	return builder
+}
+
+// PresentationFeedbackDiscardedEvent holds the arguments of the discarded event of
+// the wp_presentation_feedback interface.
+type PresentationFeedbackDiscardedEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PresentationFeedbackDiscardedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PresentationFeedbackDiscardedEvent) Encode(obj *PresentationFeedback) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "discarded"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PresentationFeedbackKind is an alias for [shared.PresentationFeedbackKind].
+type PresentationFeedbackKind = shared.PresentationFeedbackKind
+
+const (
+	PresentationFeedbackKindVsync        = shared.PresentationFeedbackKindVsync
+	PresentationFeedbackKindHwClock      = shared.PresentationFeedbackKindHwClock
+	PresentationFeedbackKindHwCompletion = shared.PresentationFeedbackKindHwCompletion
+	PresentationFeedbackKindZeroCopy     = shared.PresentationFeedbackKindZeroCopy
+)
+
+func init() {
+	wire.RegisterInterface(PresentationDesc, false)
+	wire.RegisterInterface(PresentationFeedbackDesc, false)
+}
@@ -0,0 +1,251 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package singlepixel
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/singlepixel"
+	"fmt"
+)
+
+const (
+	SinglePixelBufferManagerV1Interface = shared.SinglePixelBufferManagerV1Interface
+
+	// SinglePixelBufferManagerV1Version is the version of the interface that this
+	// package implements.
+	SinglePixelBufferManagerV1Version = 1
+)
+
+// The versions of the wp_single_pixel_buffer_manager_v1 interface that its messages were
+// added in.
+const (
+	SinglePixelBufferManagerV1DestroyRequestSince             = 1
+	SinglePixelBufferManagerV1CreateU32RgbaBufferRequestSince = 1
+)
+
+// SinglePixelBufferManagerV1Desc describes the wp_single_pixel_buffer_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SinglePixelBufferManagerV1Desc = &wire.InterfaceDesc{
+	Name:    SinglePixelBufferManagerV1Interface,
+	Version: SinglePixelBufferManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "create_u32_rgba_buffer",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wl_buffer",
+				},
+				{
+					Name: "r",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "g",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "b",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "a",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// The wp_single_pixel_buffer_manager_v1 interface is a factory for
+// single-pixel buffers.
+type SinglePixelBufferManagerV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewSinglePixelBufferManagerV1 returns a newly instantiated SinglePixelBufferManagerV1. It is
+// primarily intended for use by generated code.
+func NewSinglePixelBufferManagerV1(state wire.State) *SinglePixelBufferManagerV1 {
+	return &SinglePixelBufferManagerV1{state: state}
+}
+
+func BindSinglePixelBufferManagerV1(state wire.State, registry wire.Binder, name, version uint32) *SinglePixelBufferManagerV1 {
+	obj := NewSinglePixelBufferManagerV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: SinglePixelBufferManagerV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *SinglePixelBufferManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *SinglePixelBufferManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SinglePixelBufferManagerV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_single_pixel_buffer_manager_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *SinglePixelBufferManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *SinglePixelBufferManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *SinglePixelBufferManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *SinglePixelBufferManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_single_pixel_buffer_manager_v1", obj.id)
+}
+
+func (obj *SinglePixelBufferManagerV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *SinglePixelBufferManagerV1) Interface() string {
+	return SinglePixelBufferManagerV1Interface
+}
+
+func (obj *SinglePixelBufferManagerV1) Version() uint32 {
+	return SinglePixelBufferManagerV1Version
+}
+
+// Destroy the wp_single_pixel_buffer_manager_v1 object.
+//
+// The child objects created via this interface are unaffected.
+func (obj *SinglePixelBufferManagerV1) Destroy() {
+	obj.state.Enqueue(SinglePixelBufferManagerV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Create a single-pixel buffer from four 32-bit RGBA values.
+//
+// Unless specified in another protocol extension, the RGBA values use
+// pre-multiplied alpha.
+//
+// The width and height of the buffer are 1.
+func (obj *SinglePixelBufferManagerV1) CreateU32RgbaBuffer(r uint32, g uint32, b uint32, a uint32) (id *wl.Buffer) {
+	id = wl.NewBuffer(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(SinglePixelBufferManagerV1CreateU32RgbaBufferRequest{
+		Id: id,
+		R:  r,
+		G:  g,
+		B:  b,
+		A:  a,
+	}.Encode(obj))
+	return id
+}
+
+// SinglePixelBufferManagerV1DestroyRequest holds the arguments of the destroy request of
+// the wp_single_pixel_buffer_manager_v1 interface.
+type SinglePixelBufferManagerV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SinglePixelBufferManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SinglePixelBufferManagerV1DestroyRequest) Encode(obj *SinglePixelBufferManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SinglePixelBufferManagerV1CreateU32RgbaBufferRequest holds the arguments of the create_u32_rgba_buffer request of
+// the wp_single_pixel_buffer_manager_v1 interface.
+type SinglePixelBufferManagerV1CreateU32RgbaBufferRequest struct {
+	Id *wl.Buffer
+	R  uint32
+	G  uint32
+	B  uint32
+	A  uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *SinglePixelBufferManagerV1CreateU32RgbaBufferRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = wl.NewBuffer(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, wl.BufferInterface, msg.ReadNewObject(wl.BufferInterface), false))
+	m.R = msg.ReadUint()
+	m.G = msg.ReadUint()
+	m.B = msg.ReadUint()
+	m.A = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SinglePixelBufferManagerV1CreateU32RgbaBufferRequest) Encode(obj *SinglePixelBufferManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteUint(m.R)
+	builder.WriteUint(m.G)
+	builder.WriteUint(m.B)
+	builder.WriteUint(m.A)
+
+	builder.Method = "create_u32_rgba_buffer"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: wl.BufferInterface, ID: m.Id.ID()}, m.R, m.G, m.B, m.A}
+	}
+	return builder
+}
+
+func init() {
+	wire.RegisterInterface(SinglePixelBufferManagerV1Desc, true)
+}
@@ -0,0 +1,6699 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package tablet
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/tablet"
+	"fmt"
+)
+
+const (
+	TabletManagerV2Interface = shared.TabletManagerV2Interface
+
+	// TabletManagerV2Version is the version of the interface that this
+	// package implements.
+	TabletManagerV2Version = 1
+)
+
+// The versions of the zwp_tablet_manager_v2 interface that its messages were
+// added in.
+const (
+	TabletManagerV2GetTabletSeatRequestSince = 1
+	TabletManagerV2DestroyRequestSince       = 1
+)
+
+// TabletManagerV2Desc describes the zwp_tablet_manager_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletManagerV2Desc = &wire.InterfaceDesc{
+	Name:    TabletManagerV2Interface,
+	Version: TabletManagerV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "get_tablet_seat",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "tablet_seat",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_seat_v2",
+				},
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// An object that provides access to the graphics tablets available on this
+// system. All tablets are associated with a seat, to get access to the
+// actual tablets, use wp_tablet_manager.get_tablet_seat.
+type TabletManagerV2 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletManagerV2 returns a newly instantiated TabletManagerV2. It is
+// primarily intended for use by generated code.
+func NewTabletManagerV2(state wire.State) *TabletManagerV2 {
+	return &TabletManagerV2{state: state}
+}
+
+func BindTabletManagerV2(state wire.State, registry wire.Binder, name, version uint32) *TabletManagerV2 {
+	obj := NewTabletManagerV2(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: TabletManagerV2Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *TabletManagerV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletManagerV2) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletManagerV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_manager_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletManagerV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletManagerV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletManagerV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletManagerV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_manager_v2", obj.id)
+}
+
+func (obj *TabletManagerV2) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletManagerV2) Interface() string {
+	return TabletManagerV2Interface
+}
+
+func (obj *TabletManagerV2) Version() uint32 {
+	return TabletManagerV2Version
+}
+
+// Get the wp_tablet_seat object for the given seat. This object
+// provides access to all graphics tablets in this seat.
+func (obj *TabletManagerV2) GetTabletSeat(seat *wl.Seat) (tabletSeat *TabletSeatV2) {
+	tabletSeat = NewTabletSeatV2(obj.state)
+	obj.state.Add(tabletSeat)
+	obj.state.Enqueue(TabletManagerV2GetTabletSeatRequest{
+		TabletSeat: tabletSeat,
+		Seat:       seat,
+	}.Encode(obj))
+	return tabletSeat
+}
+
+// Destroy the wp_tablet_manager object. Objects created from this
+// object are unaffected and should be destroyed separately.
+func (obj *TabletManagerV2) Destroy() {
+	obj.state.Enqueue(TabletManagerV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletManagerV2GetTabletSeatRequest holds the arguments of the get_tablet_seat request of
+// the zwp_tablet_manager_v2 interface.
+type TabletManagerV2GetTabletSeatRequest struct {
+	TabletSeat *TabletSeatV2
+	Seat       *wl.Seat
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletManagerV2GetTabletSeatRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.TabletSeat = NewTabletSeatV2(state)
+	m.TabletSeat.SetID(wire.CheckNewID(msg, state, TabletSeatV2Interface, msg.ReadNewObject(TabletSeatV2Interface), false))
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.TabletSeat)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletManagerV2GetTabletSeatRequest) Encode(obj *TabletManagerV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.TabletSeat)
+	builder.WriteObject(m.Seat)
+
+	builder.Method = "get_tablet_seat"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletSeatV2Interface, ID: m.TabletSeat.ID()}, m.Seat}
+	}
+	return builder
+}
+
+// TabletManagerV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_manager_v2 interface.
+type TabletManagerV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletManagerV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletManagerV2DestroyRequest) Encode(obj *TabletManagerV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+const (
+	TabletSeatV2Interface = shared.TabletSeatV2Interface
+
+	// TabletSeatV2Version is the version of the interface that this
+	// package implements.
+	TabletSeatV2Version = 1
+)
+
+// The versions of the zwp_tablet_seat_v2 interface that its messages were
+// added in.
+const (
+	TabletSeatV2DestroyRequestSince   = 1
+	TabletSeatV2TabletAddedEventSince = 1
+	TabletSeatV2ToolAddedEventSince   = 1
+	TabletSeatV2PadAddedEventSince    = 1
+)
+
+// TabletSeatV2Desc describes the zwp_tablet_seat_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletSeatV2Desc = &wire.InterfaceDesc{
+	Name:    TabletSeatV2Interface,
+	Version: TabletSeatV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "tablet_added",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_v2",
+				},
+			},
+		},
+		{
+			Name:  "tool_added",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_tool_v2",
+				},
+			},
+		},
+		{
+			Name:  "pad_added",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_pad_v2",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
+// TabletSeatV2Listener is a type that can respond to incoming
+// messages for a TabletSeatV2 object.
+type TabletSeatV2Listener interface {
+	// This event is sent whenever a new tablet becomes available on this
+	// seat. This event only provides the object id of the tablet, any
+	// static information about the tablet (device name, vid/pid, etc.) is
+	// sent through the wp_tablet interface.
+	TabletAdded(id *TabletV2)
+
+	// This event is sent whenever a tool that has not previously been used
+	// with a tablet comes into use. This event only provides the object id
+	// of the tool; any static information about the tool (capabilities,
+	// type, etc.) is sent through the wp_tablet_tool interface.
+	ToolAdded(id *TabletToolV2)
+
+	// This event is sent whenever a new pad is known to the system. Typically,
+	// pads are physically attached to tablets and a pad_added event is
+	// sent immediately after the wp_tablet_seat.tablet_added.
+	// However, some standalone pad devices logically attach to tablets at
+	// runtime, and the client must wait for wp_tablet_pad.enter to know
+	// the tablet a pad is attached to.
+	//
+	// This event only provides the object id of the pad. All further
+	// features (buttons, strips, rings) are sent through the wp_tablet_pad
+	// interface.
+	PadAdded(id *TabletPadV2)
+}
+
+// TabletSeatV2ListenerFuncs implements TabletSeatV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletSeatV2ListenerFuncs struct {
+	OnTabletAdded func(id *TabletV2)
+	OnToolAdded   func(id *TabletToolV2)
+	OnPadAdded    func(id *TabletPadV2)
+}
+
+func (lis *TabletSeatV2ListenerFuncs) TabletAdded(id *TabletV2) {
+	if lis.OnTabletAdded != nil {
+		lis.OnTabletAdded(id)
+	}
+}
+
+func (lis *TabletSeatV2ListenerFuncs) ToolAdded(id *TabletToolV2) {
+	if lis.OnToolAdded != nil {
+		lis.OnToolAdded(id)
+	}
+}
+
+func (lis *TabletSeatV2ListenerFuncs) PadAdded(id *TabletPadV2) {
+	if lis.OnPadAdded != nil {
+		lis.OnPadAdded(id)
+	}
+}
+
+// TabletSeatV2Event is implemented by the message types of all
+// of the events that a TabletSeatV2 can receive.
+type TabletSeatV2Event interface {
+	tabletSeatV2Event()
+}
+
+// TabletSeatV2EventFunc implements TabletSeatV2Listener by calling
+// itself with each incoming message.
+type TabletSeatV2EventFunc func(TabletSeatV2Event)
+
+func (f TabletSeatV2EventFunc) TabletAdded(id *TabletV2) {
+	f(TabletSeatV2TabletAddedEvent{
+		Id: id,
+	})
+}
+
+func (f TabletSeatV2EventFunc) ToolAdded(id *TabletToolV2) {
+	f(TabletSeatV2ToolAddedEvent{
+		Id: id,
+	})
+}
+
+func (f TabletSeatV2EventFunc) PadAdded(id *TabletPadV2) {
+	f(TabletSeatV2PadAddedEvent{
+		Id: id,
+	})
+}
+
+// An object that provides access to the graphics tablets available on this
+// seat. After binding to this interface, the compositor sends a set of
+// wp_tablet_seat.tablet_added and wp_tablet_seat.tool_added events.
+type TabletSeatV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletSeatV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletSeatV2 returns a newly instantiated TabletSeatV2. It is
+// primarily intended for use by generated code.
+func NewTabletSeatV2(state wire.State) *TabletSeatV2 {
+	return &TabletSeatV2{state: state}
+}
+
+func (obj *TabletSeatV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletSeatV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletSeatV2TabletAddedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.TabletAdded(
+			m.Id,
+		)
+		return nil
+
+	case 1:
+		var m TabletSeatV2ToolAddedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ToolAdded(
+			m.Id,
+		)
+		return nil
+
+	case 2:
+		var m TabletSeatV2PadAddedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.PadAdded(
+			m.Id,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletSeatV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_seat_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletSeatV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletSeatV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletSeatV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletSeatV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_seat_v2", obj.id)
+}
+
+func (obj *TabletSeatV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "tablet_added"
+
+	case 1:
+		return "tool_added"
+
+	case 2:
+		return "pad_added"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletSeatV2) Interface() string {
+	return TabletSeatV2Interface
+}
+
+// OnTabletAdded sets the function that is called when the
+// tablet_added event is received. If obj's Listener is not a
+// *TabletSeatV2ListenerFuncs, it is replaced with one.
+func (obj *TabletSeatV2) OnTabletAdded(f func(id *TabletV2)) {
+	lis, ok := obj.Listener.(*TabletSeatV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletSeatV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTabletAdded = f
+}
+
+// OnToolAdded sets the function that is called when the
+// tool_added event is received. If obj's Listener is not a
+// *TabletSeatV2ListenerFuncs, it is replaced with one.
+func (obj *TabletSeatV2) OnToolAdded(f func(id *TabletToolV2)) {
+	lis, ok := obj.Listener.(*TabletSeatV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletSeatV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnToolAdded = f
+}
+
+// OnPadAdded sets the function that is called when the
+// pad_added event is received. If obj's Listener is not a
+// *TabletSeatV2ListenerFuncs, it is replaced with one.
+func (obj *TabletSeatV2) OnPadAdded(f func(id *TabletPadV2)) {
+	lis, ok := obj.Listener.(*TabletSeatV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletSeatV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPadAdded = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletSeatV2) Subscribe() *wire.Messages[TabletSeatV2Event] {
+	q := new(wire.Messages[TabletSeatV2Event])
+	obj.Listener = TabletSeatV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletSeatV2) Version() uint32 {
+	return TabletSeatV2Version
+}
+
+// Destroy the wp_tablet_seat object. Objects created from this
+// object are unaffected and should be destroyed separately.
+func (obj *TabletSeatV2) Destroy() {
+	obj.state.Enqueue(TabletSeatV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletSeatV2TabletAddedEvent holds the arguments of the tablet_added event of
+// the zwp_tablet_seat_v2 interface.
+type TabletSeatV2TabletAddedEvent struct {
+	Id *TabletV2
+}
+
+func (TabletSeatV2TabletAddedEvent) tabletSeatV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletSeatV2TabletAddedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTabletV2(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TabletV2Interface, msg.ReadNewObject(TabletV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletSeatV2TabletAddedEvent) Encode(obj *TabletSeatV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "tablet_added"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletV2Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// TabletSeatV2ToolAddedEvent holds the arguments of the tool_added event of
+// the zwp_tablet_seat_v2 interface.
+type TabletSeatV2ToolAddedEvent struct {
+	Id *TabletToolV2
+}
+
+func (TabletSeatV2ToolAddedEvent) tabletSeatV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletSeatV2ToolAddedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTabletToolV2(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TabletToolV2Interface, msg.ReadNewObject(TabletToolV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletSeatV2ToolAddedEvent) Encode(obj *TabletSeatV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "tool_added"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletToolV2Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// TabletSeatV2PadAddedEvent holds the arguments of the pad_added event of
+// the zwp_tablet_seat_v2 interface.
+type TabletSeatV2PadAddedEvent struct {
+	Id *TabletPadV2
+}
+
+func (TabletSeatV2PadAddedEvent) tabletSeatV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletSeatV2PadAddedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTabletPadV2(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TabletPadV2Interface, msg.ReadNewObject(TabletPadV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletSeatV2PadAddedEvent) Encode(obj *TabletSeatV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "pad_added"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletPadV2Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// TabletSeatV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_seat_v2 interface.
+type TabletSeatV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletSeatV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletSeatV2DestroyRequest) Encode(obj *TabletSeatV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+const (
+	TabletToolV2Interface = shared.TabletToolV2Interface
+
+	// TabletToolV2Version is the version of the interface that this
+	// package implements.
+	TabletToolV2Version = 1
+)
+
+// The versions of the zwp_tablet_tool_v2 interface that its messages were
+// added in.
+const (
+	TabletToolV2SetCursorRequestSince     = 1
+	TabletToolV2DestroyRequestSince       = 1
+	TabletToolV2TypeEventSince            = 1
+	TabletToolV2HardwareSerialEventSince  = 1
+	TabletToolV2HardwareIdWacomEventSince = 1
+	TabletToolV2CapabilityEventSince      = 1
+	TabletToolV2DoneEventSince            = 1
+	TabletToolV2RemovedEventSince         = 1
+	TabletToolV2ProximityInEventSince     = 1
+	TabletToolV2ProximityOutEventSince    = 1
+	TabletToolV2DownEventSince            = 1
+	TabletToolV2UpEventSince              = 1
+	TabletToolV2MotionEventSince          = 1
+	TabletToolV2PressureEventSince        = 1
+	TabletToolV2DistanceEventSince        = 1
+	TabletToolV2TiltEventSince            = 1
+	TabletToolV2RotationEventSince        = 1
+	TabletToolV2SliderEventSince          = 1
+	TabletToolV2WheelEventSince           = 1
+	TabletToolV2ButtonEventSince          = 1
+	TabletToolV2FrameEventSince           = 1
+)
+
+// TabletToolV2Desc describes the zwp_tablet_tool_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletToolV2Desc = &wire.InterfaceDesc{
+	Name:    TabletToolV2Interface,
+	Version: TabletToolV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_cursor",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+					AllowNull: true,
+				},
+				{
+					Name: "hotspot_x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "hotspot_y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "type",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "tool_type",
+					Type: wire.ArgUint,
+					Enum: "type",
+				},
+			},
+		},
+		{
+			Name:  "hardware_serial",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "hardware_serial_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "hardware_serial_lo",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "hardware_id_wacom",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "hardware_id_hi",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "hardware_id_lo",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "capability",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "capability",
+					Type: wire.ArgUint,
+					Enum: "capability",
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "removed",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "proximity_in",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "tablet",
+					Type:      wire.ArgObject,
+					Interface: "zwp_tablet_v2",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "proximity_out",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "down",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "up",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "pressure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "pressure",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "distance",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "distance",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "tilt",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "tilt_x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "tilt_y",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "rotation",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "degrees",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "slider",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "position",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "wheel",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "degrees",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "clicks",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "button",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "button_state",
+				},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "type",
+			Entries: []wire.EntryDesc{
+				{Name: "pen", Value: 320},
+				{Name: "eraser", Value: 321},
+				{Name: "brush", Value: 322},
+				{Name: "pencil", Value: 323},
+				{Name: "airbrush", Value: 324},
+				{Name: "finger", Value: 325},
+				{Name: "mouse", Value: 326},
+				{Name: "lens", Value: 327},
+			},
+		},
+		{
+			Name: "capability",
+			Entries: []wire.EntryDesc{
+				{Name: "tilt", Value: 1},
+				{Name: "pressure", Value: 2},
+				{Name: "distance", Value: 3},
+				{Name: "rotation", Value: 4},
+				{Name: "slider", Value: 5},
+				{Name: "wheel", Value: 6},
+			},
+		},
+		{
+			Name: "button_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+			},
+		},
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+			},
+		},
+	},
+}
+
+// TabletToolV2Listener is a type that can respond to incoming
+// messages for a TabletToolV2 object.
+type TabletToolV2Listener interface {
+	// The tool type is the high-level type of the tool and usually decides
+	// the interaction expected from this tool.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_tool.done event.
+	Type(toolType TabletToolV2Type)
+
+	// If the physical tool can be identified by a unique 64-bit serial
+	// number, this event notifies the client of this serial number.
+	//
+	// If multiple tablets are available in the same seat and the tool is
+	// uniquely identifiable by the serial number, that tool may move
+	// between tablets.
+	//
+	// Otherwise, if the tool has no serial number and this event is
+	// missing, the tool is tied to the tablet it first comes into
+	// proximity with. Even if the physical tool is used on multiple
+	// tablets, separate wp_tablet_tool objects will be created, one per
+	// tablet.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_tool.done event.
+	HardwareSerial(hardwareSerialHi uint32, hardwareSerialLo uint32)
+
+	// This event notifies the client of a hardware id available on this tool.
+	//
+	// The hardware id is a device-specific 64-bit id that provides extra
+	// information about the tool in use, beyond the wl_tool.type
+	// enumeration. The format of the id is specific to tablets made by
+	// Wacom Inc. For example, the hardware id of a Wacom Grip
+	// Pen (a stylus) is 0x802.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_tool.done event.
+	HardwareIdWacom(hardwareIdHi uint32, hardwareIdLo uint32)
+
+	// This event notifies the client of any capabilities of this tool,
+	// beyond the main set of x/y axes and tip up/down detection.
+	//
+	// One event is sent for each extra capability available on this tool.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_tool.done event.
+	Capability(capability TabletToolV2Capability)
+
+	// This event signals the end of the initial burst of descriptive
+	// events. A client may consider the static description of the tool to
+	// be complete and finalize initialization of the tool.
+	Done()
+
+	// This event is sent when the tool is removed from the system and will
+	// send no further events. Should the physical tool come back into
+	// proximity later, a new wp_tablet_tool object will be created.
+	//
+	// It is compositor-dependent when a tool is removed. A compositor may
+	// remove a tool on proximity out, tablet removal or any other reason.
+	// A compositor may also keep a tool alive until shutdown.
+	//
+	// If the tool is currently in proximity, a proximity_out event will be
+	// sent before the removed event. See wp_tablet_tool.proximity_out for
+	// the handling of any buttons logically down.
+	//
+	// When this event is received, the client must wp_tablet_tool.destroy
+	// the object.
+	Removed()
+
+	// Notification that this tool is focused on a certain surface.
+	//
+	// This event can be received when the tool has moved from one surface to
+	// another, or when the tool has come back into proximity above the
+	// surface.
+	//
+	// If any button is logically down when the tool comes into proximity,
+	// the respective button event is sent after the proximity_in event but
+	// within the same frame as the proximity_in event.
+	ProximityIn(serial uint32, tablet *TabletV2, surface *wl.Surface)
+
+	// Notification that this tool has either left proximity, or is no
+	// longer focused on a certain surface.
+	//
+	// When the tablet tool leaves proximity of the tablet, button release
+	// events are sent for each button that was held down at the time of
+	// leaving proximity. These events are sent before the proximity_out
+	// event but within the same wp_tablet.frame.
+	//
+	// If the tool stays within proximity of the tablet, but the focus
+	// changes from one surface to another, a button release event may not
+	// be sent until the button is actually released or the tool leaves the
+	// proximity of the tablet.
+	ProximityOut()
+
+	// Sent whenever the tablet tool comes in contact with the surface of the
+	// tablet.
+	//
+	// If the tool is already in contact with the tablet when entering the
+	// input region, the client owning said region will receive a
+	// wp_tablet.proximity_in event, followed by a wp_tablet.down
+	// event and a wp_tablet.frame event.
+	//
+	// Note that this event describes logical contact, not physical
+	// contact. On some devices, a compositor may not consider a tool in
+	// logical contact until a minimum physical pressure threshold is
+	// exceeded.
+	Down(serial uint32)
+
+	// Sent whenever the tablet tool stops making contact with the surface of
+	// the tablet, or when the tablet tool moves out of the input region
+	// and the compositor grab (if any) is dismissed.
+	//
+	// If the tablet tool moves out of the input region while in contact
+	// with the surface of the tablet and the compositor does not have an
+	// ongoing grab on the surface, the client owning said region will
+	// receive a wp_tablet.up event, followed by a wp_tablet.proximity_out
+	// event and a wp_tablet.frame event. If the compositor has an ongoing
+	// grab on this device, this event sequence is sent whenever the grab
+	// is dismissed in the future.
+	//
+	// Note that this event describes logical contact, not physical
+	// contact. On some devices, a compositor may not consider a tool out
+	// of logical contact until physical pressure falls below a specific
+	// threshold.
+	Up()
+
+	// Sent whenever a tablet tool moves.
+	Motion(x wire.Fixed, y wire.Fixed)
+
+	// Sent whenever the pressure axis on a tool changes. The value of this
+	// event is normalized to a value between 0 and 65535.
+	//
+	// Note that pressure may be nonzero even when a tool is not in logical
+	// contact. See the down and up events for more details.
+	Pressure(pressure uint32)
+
+	// Sent whenever the distance axis on a tool changes. The value of this
+	// event is normalized to a value between 0 and 65535.
+	//
+	// Note that distance may be nonzero even when a tool is not in logical
+	// contact. See the down and up events for more details.
+	Distance(distance uint32)
+
+	// Sent whenever one or both of the tilt axes on a tool change. Each tilt
+	// value is in degrees, relative to the z-axis of the tablet.
+	// The angle is positive when the top of a tool tilts along the
+	// positive x or y axis.
+	Tilt(tiltX wire.Fixed, tiltY wire.Fixed)
+
+	// Sent whenever the z-rotation axis on the tool changes. The
+	// rotation value is in degrees clockwise from the tool's
+	// logical neutral position.
+	Rotation(degrees wire.Fixed)
+
+	// Sent whenever the slider position on the tool changes. The
+	// value is normalized between -65535 and 65535, with 0 as the logical
+	// neutral position of the slider.
+	//
+	// The slider is available on e.g. the Wacom Airbrush tool.
+	Slider(position int32)
+
+	// Sent whenever the wheel on the tool emits an event. This event
+	// contains two values for the same axis change. The degrees value is
+	// in the same orientation as the wl_pointer.vertical_scroll axis. The
+	// clicks value is in discrete logical clicks of the mouse wheel. This
+	// value may be zero if the movement of the wheel was less
+	// than one logical click.
+	//
+	// Clients should choose either value and avoid mixing degrees and
+	// clicks. The compositor may accumulate values smaller than a logical
+	// click and emulate click events when a certain threshold is met.
+	// Thus, wl_tablet_tool.wheel events with non-zero clicks values may
+	// have different degrees values.
+	Wheel(degrees wire.Fixed, clicks int32)
+
+	// Sent whenever a button on the tool is pressed or released.
+	//
+	// If a button is held down when the tool moves in or out of proximity,
+	// button events are generated by the compositor. See
+	// wp_tablet_tool.proximity_in and wp_tablet_tool.proximity_out for
+	// details.
+	Button(serial uint32, button uint32, state TabletToolV2ButtonState)
+
+	// Marks the end of a series of axis and/or button updates from the
+	// tablet. The Wayland protocol requires axis updates to be sent
+	// sequentially, however all events within a frame should be considered
+	// one hardware event.
+	Frame(time uint32)
+}
+
+// TabletToolV2ListenerFuncs implements TabletToolV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletToolV2ListenerFuncs struct {
+	OnType            func(toolType TabletToolV2Type)
+	OnHardwareSerial  func(hardwareSerialHi uint32, hardwareSerialLo uint32)
+	OnHardwareIdWacom func(hardwareIdHi uint32, hardwareIdLo uint32)
+	OnCapability      func(capability TabletToolV2Capability)
+	OnDone            func()
+	OnRemoved         func()
+	OnProximityIn     func(serial uint32, tablet *TabletV2, surface *wl.Surface)
+	OnProximityOut    func()
+	OnDown            func(serial uint32)
+	OnUp              func()
+	OnMotion          func(x wire.Fixed, y wire.Fixed)
+	OnPressure        func(pressure uint32)
+	OnDistance        func(distance uint32)
+	OnTilt            func(tiltX wire.Fixed, tiltY wire.Fixed)
+	OnRotation        func(degrees wire.Fixed)
+	OnSlider          func(position int32)
+	OnWheel           func(degrees wire.Fixed, clicks int32)
+	OnButton          func(serial uint32, button uint32, state TabletToolV2ButtonState)
+	OnFrame           func(time uint32)
+}
+
+func (lis *TabletToolV2ListenerFuncs) Type(toolType TabletToolV2Type) {
+	if lis.OnType != nil {
+		lis.OnType(toolType)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) HardwareSerial(hardwareSerialHi uint32, hardwareSerialLo uint32) {
+	if lis.OnHardwareSerial != nil {
+		lis.OnHardwareSerial(hardwareSerialHi, hardwareSerialLo)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) HardwareIdWacom(hardwareIdHi uint32, hardwareIdLo uint32) {
+	if lis.OnHardwareIdWacom != nil {
+		lis.OnHardwareIdWacom(hardwareIdHi, hardwareIdLo)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Capability(capability TabletToolV2Capability) {
+	if lis.OnCapability != nil {
+		lis.OnCapability(capability)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Removed() {
+	if lis.OnRemoved != nil {
+		lis.OnRemoved()
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) ProximityIn(serial uint32, tablet *TabletV2, surface *wl.Surface) {
+	if lis.OnProximityIn != nil {
+		lis.OnProximityIn(serial, tablet, surface)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) ProximityOut() {
+	if lis.OnProximityOut != nil {
+		lis.OnProximityOut()
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Down(serial uint32) {
+	if lis.OnDown != nil {
+		lis.OnDown(serial)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Up() {
+	if lis.OnUp != nil {
+		lis.OnUp()
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Motion(x wire.Fixed, y wire.Fixed) {
+	if lis.OnMotion != nil {
+		lis.OnMotion(x, y)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Pressure(pressure uint32) {
+	if lis.OnPressure != nil {
+		lis.OnPressure(pressure)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Distance(distance uint32) {
+	if lis.OnDistance != nil {
+		lis.OnDistance(distance)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Tilt(tiltX wire.Fixed, tiltY wire.Fixed) {
+	if lis.OnTilt != nil {
+		lis.OnTilt(tiltX, tiltY)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Rotation(degrees wire.Fixed) {
+	if lis.OnRotation != nil {
+		lis.OnRotation(degrees)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Slider(position int32) {
+	if lis.OnSlider != nil {
+		lis.OnSlider(position)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Wheel(degrees wire.Fixed, clicks int32) {
+	if lis.OnWheel != nil {
+		lis.OnWheel(degrees, clicks)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Button(serial uint32, button uint32, state TabletToolV2ButtonState) {
+	if lis.OnButton != nil {
+		lis.OnButton(serial, button, state)
+	}
+}
+
+func (lis *TabletToolV2ListenerFuncs) Frame(time uint32) {
+	if lis.OnFrame != nil {
+		lis.OnFrame(time)
+	}
+}
+
+// TabletToolV2Event is implemented by the message types of all
+// of the events that a TabletToolV2 can receive.
+type TabletToolV2Event interface {
+	tabletToolV2Event()
+}
+
+// TabletToolV2EventFunc implements TabletToolV2Listener by calling
+// itself with each incoming message.
+type TabletToolV2EventFunc func(TabletToolV2Event)
+
+func (f TabletToolV2EventFunc) Type(toolType TabletToolV2Type) {
+	f(TabletToolV2TypeEvent{
+		ToolType: toolType,
+	})
+}
+
+func (f TabletToolV2EventFunc) HardwareSerial(hardwareSerialHi uint32, hardwareSerialLo uint32) {
+	f(TabletToolV2HardwareSerialEvent{
+		HardwareSerialHi: hardwareSerialHi,
+		HardwareSerialLo: hardwareSerialLo,
+	})
+}
+
+func (f TabletToolV2EventFunc) HardwareIdWacom(hardwareIdHi uint32, hardwareIdLo uint32) {
+	f(TabletToolV2HardwareIdWacomEvent{
+		HardwareIdHi: hardwareIdHi,
+		HardwareIdLo: hardwareIdLo,
+	})
+}
+
+func (f TabletToolV2EventFunc) Capability(capability TabletToolV2Capability) {
+	f(TabletToolV2CapabilityEvent{
+		Capability: capability,
+	})
+}
+
+func (f TabletToolV2EventFunc) Done() {
+	f(TabletToolV2DoneEvent{})
+}
+
+func (f TabletToolV2EventFunc) Removed() {
+	f(TabletToolV2RemovedEvent{})
+}
+
+func (f TabletToolV2EventFunc) ProximityIn(serial uint32, tablet *TabletV2, surface *wl.Surface) {
+	f(TabletToolV2ProximityInEvent{
+		Serial:  serial,
+		Tablet:  tablet,
+		Surface: surface,
+	})
+}
+
+func (f TabletToolV2EventFunc) ProximityOut() {
+	f(TabletToolV2ProximityOutEvent{})
+}
+
+func (f TabletToolV2EventFunc) Down(serial uint32) {
+	f(TabletToolV2DownEvent{
+		Serial: serial,
+	})
+}
+
+func (f TabletToolV2EventFunc) Up() {
+	f(TabletToolV2UpEvent{})
+}
+
+func (f TabletToolV2EventFunc) Motion(x wire.Fixed, y wire.Fixed) {
+	f(TabletToolV2MotionEvent{
+		X: x,
+		Y: y,
+	})
+}
+
+func (f TabletToolV2EventFunc) Pressure(pressure uint32) {
+	f(TabletToolV2PressureEvent{
+		Pressure: pressure,
+	})
+}
+
+func (f TabletToolV2EventFunc) Distance(distance uint32) {
+	f(TabletToolV2DistanceEvent{
+		Distance: distance,
+	})
+}
+
+func (f TabletToolV2EventFunc) Tilt(tiltX wire.Fixed, tiltY wire.Fixed) {
+	f(TabletToolV2TiltEvent{
+		TiltX: tiltX,
+		TiltY: tiltY,
+	})
+}
+
+func (f TabletToolV2EventFunc) Rotation(degrees wire.Fixed) {
+	f(TabletToolV2RotationEvent{
+		Degrees: degrees,
+	})
+}
+
+func (f TabletToolV2EventFunc) Slider(position int32) {
+	f(TabletToolV2SliderEvent{
+		Position: position,
+	})
+}
+
+func (f TabletToolV2EventFunc) Wheel(degrees wire.Fixed, clicks int32) {
+	f(TabletToolV2WheelEvent{
+		Degrees: degrees,
+		Clicks:  clicks,
+	})
+}
+
+func (f TabletToolV2EventFunc) Button(serial uint32, button uint32, state TabletToolV2ButtonState) {
+	f(TabletToolV2ButtonEvent{
+		Serial: serial,
+		Button: button,
+		State:  state,
+	})
+}
+
+func (f TabletToolV2EventFunc) Frame(time uint32) {
+	f(TabletToolV2FrameEvent{
+		Time: time,
+	})
+}
+
+// An object that represents a physical tool that has been, or is
+// currently in use with a tablet in this seat. Each wp_tablet_tool
+// object stays valid until the client destroys it; the compositor
+// reuses the wp_tablet_tool object to indicate that the object's
+// respective physical tool has come into proximity of a tablet again.
+//
+// A wp_tablet_tool object's relation to a physical tool depends on the
+// tablet's ability to report serial numbers. If the tablet supports
+// this capability, then the object represents a specific physical tool
+// and can be identified even when used on multiple tablets.
+//
+// A tablet tool has a number of static characteristics, e.g. tool type,
+// hardware_serial and capabilities. These capabilities are sent in an
+// event sequence after the wp_tablet_seat.tool_added event before any
+// actual events from this tool. This initial event sequence is
+// terminated by a wp_tablet_tool.done event.
+//
+// Tablet tool events are grouped by wp_tablet_tool.frame events.
+// Any events received before a wp_tablet_tool.frame event should be
+// considered part of the same hardware state change.
+type TabletToolV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletToolV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletToolV2 returns a newly instantiated TabletToolV2. It is
+// primarily intended for use by generated code.
+func NewTabletToolV2(state wire.State) *TabletToolV2 {
+	return &TabletToolV2{state: state}
+}
+
+func (obj *TabletToolV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletToolV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletToolV2TypeEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Type(
+			m.ToolType,
+		)
+		return nil
+
+	case 1:
+		var m TabletToolV2HardwareSerialEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.HardwareSerial(
+			m.HardwareSerialHi,
+			m.HardwareSerialLo,
+		)
+		return nil
+
+	case 2:
+		var m TabletToolV2HardwareIdWacomEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.HardwareIdWacom(
+			m.HardwareIdHi,
+			m.HardwareIdLo,
+		)
+		return nil
+
+	case 3:
+		var m TabletToolV2CapabilityEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Capability(
+			m.Capability,
+		)
+		return nil
+
+	case 4:
+		var m TabletToolV2DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done()
+		return nil
+
+	case 5:
+		var m TabletToolV2RemovedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Removed()
+		return nil
+
+	case 6:
+		var m TabletToolV2ProximityInEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ProximityIn(
+			m.Serial,
+			m.Tablet,
+			m.Surface,
+		)
+		return nil
+
+	case 7:
+		var m TabletToolV2ProximityOutEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ProximityOut()
+		return nil
+
+	case 8:
+		var m TabletToolV2DownEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Down(
+			m.Serial,
+		)
+		return nil
+
+	case 9:
+		var m TabletToolV2UpEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Up()
+		return nil
+
+	case 10:
+		var m TabletToolV2MotionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Motion(
+			m.X,
+			m.Y,
+		)
+		return nil
+
+	case 11:
+		var m TabletToolV2PressureEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Pressure(
+			m.Pressure,
+		)
+		return nil
+
+	case 12:
+		var m TabletToolV2DistanceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Distance(
+			m.Distance,
+		)
+		return nil
+
+	case 13:
+		var m TabletToolV2TiltEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Tilt(
+			m.TiltX,
+			m.TiltY,
+		)
+		return nil
+
+	case 14:
+		var m TabletToolV2RotationEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Rotation(
+			m.Degrees,
+		)
+		return nil
+
+	case 15:
+		var m TabletToolV2SliderEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Slider(
+			m.Position,
+		)
+		return nil
+
+	case 16:
+		var m TabletToolV2WheelEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Wheel(
+			m.Degrees,
+			m.Clicks,
+		)
+		return nil
+
+	case 17:
+		var m TabletToolV2ButtonEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Button(
+			m.Serial,
+			m.Button,
+			m.State,
+		)
+		return nil
+
+	case 18:
+		var m TabletToolV2FrameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Frame(
+			m.Time,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletToolV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_tool_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletToolV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletToolV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletToolV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletToolV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_tool_v2", obj.id)
+}
+
+func (obj *TabletToolV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "type"
+
+	case 1:
+		return "hardware_serial"
+
+	case 2:
+		return "hardware_id_wacom"
+
+	case 3:
+		return "capability"
+
+	case 4:
+		return "done"
+
+	case 5:
+		return "removed"
+
+	case 6:
+		return "proximity_in"
+
+	case 7:
+		return "proximity_out"
+
+	case 8:
+		return "down"
+
+	case 9:
+		return "up"
+
+	case 10:
+		return "motion"
+
+	case 11:
+		return "pressure"
+
+	case 12:
+		return "distance"
+
+	case 13:
+		return "tilt"
+
+	case 14:
+		return "rotation"
+
+	case 15:
+		return "slider"
+
+	case 16:
+		return "wheel"
+
+	case 17:
+		return "button"
+
+	case 18:
+		return "frame"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletToolV2) Interface() string {
+	return TabletToolV2Interface
+}
+
+// OnType sets the function that is called when the
+// type event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnType(f func(toolType TabletToolV2Type)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnType = f
+}
+
+// OnHardwareSerial sets the function that is called when the
+// hardware_serial event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnHardwareSerial(f func(hardwareSerialHi uint32, hardwareSerialLo uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnHardwareSerial = f
+}
+
+// OnHardwareIdWacom sets the function that is called when the
+// hardware_id_wacom event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnHardwareIdWacom(f func(hardwareIdHi uint32, hardwareIdLo uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnHardwareIdWacom = f
+}
+
+// OnCapability sets the function that is called when the
+// capability event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnCapability(f func(capability TabletToolV2Capability)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCapability = f
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnDone(f func()) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// OnRemoved sets the function that is called when the
+// removed event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnRemoved(f func()) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRemoved = f
+}
+
+// OnProximityIn sets the function that is called when the
+// proximity_in event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnProximityIn(f func(serial uint32, tablet *TabletV2, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnProximityIn = f
+}
+
+// OnProximityOut sets the function that is called when the
+// proximity_out event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnProximityOut(f func()) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnProximityOut = f
+}
+
+// OnDown sets the function that is called when the
+// down event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnDown(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDown = f
+}
+
+// OnUp sets the function that is called when the
+// up event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnUp(f func()) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnUp = f
+}
+
+// OnMotion sets the function that is called when the
+// motion event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnMotion(f func(x wire.Fixed, y wire.Fixed)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMotion = f
+}
+
+// OnPressure sets the function that is called when the
+// pressure event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnPressure(f func(pressure uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPressure = f
+}
+
+// OnDistance sets the function that is called when the
+// distance event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnDistance(f func(distance uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDistance = f
+}
+
+// OnTilt sets the function that is called when the
+// tilt event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnTilt(f func(tiltX wire.Fixed, tiltY wire.Fixed)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnTilt = f
+}
+
+// OnRotation sets the function that is called when the
+// rotation event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnRotation(f func(degrees wire.Fixed)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRotation = f
+}
+
+// OnSlider sets the function that is called when the
+// slider event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnSlider(f func(position int32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSlider = f
+}
+
+// OnWheel sets the function that is called when the
+// wheel event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnWheel(f func(degrees wire.Fixed, clicks int32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnWheel = f
+}
+
+// OnButton sets the function that is called when the
+// button event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnButton(f func(serial uint32, button uint32, state TabletToolV2ButtonState)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnButton = f
+}
+
+// OnFrame sets the function that is called when the
+// frame event is received. If obj's Listener is not a
+// *TabletToolV2ListenerFuncs, it is replaced with one.
+func (obj *TabletToolV2) OnFrame(f func(time uint32)) {
+	lis, ok := obj.Listener.(*TabletToolV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletToolV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletToolV2) Subscribe() *wire.Messages[TabletToolV2Event] {
+	q := new(wire.Messages[TabletToolV2Event])
+	obj.Listener = TabletToolV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletToolV2) Version() uint32 {
+	return TabletToolV2Version
+}
+
+// Sets the surface of the cursor used for this tool on the given
+// tablet. This request only takes effect if the tool is in proximity
+// of one of the requesting client's surfaces or the surface parameter
+// is the current pointer surface. If there was a previous surface set
+// with this request it is replaced. If surface is NULL, the cursor
+// image is hidden.
+//
+// The parameters hotspot_x and hotspot_y define the position of the
+// pointer surface relative to the pointer location. Its top-left corner
+// is always at (x, y) - (hotspot_x, hotspot_y), where (x, y) are the
+// coordinates of the pointer location, in surface-local coordinates.
+//
+// On surface.attach requests to the pointer surface, hotspot_x and
+// hotspot_y are decremented by the x and y parameters passed to the
+// request. Attach must be confirmed by wl_surface.commit as usual.
+//
+// The hotspot can also be updated by passing the currently set pointer
+// surface to this request with new values for hotspot_x and hotspot_y.
+//
+// The current and pending input regions of the wl_surface are cleared,
+// and wl_surface.set_input_region is ignored until the wl_surface is no
+// longer used as the cursor. When the use as a cursor ends, the current
+// and pending input regions become undefined, and the wl_surface is
+// unmapped.
+//
+// This request gives the surface the role of a wp_tablet_tool cursor. A
+// surface may only ever be used as the cursor surface for one
+// wp_tablet_tool. If the surface already has another role or has
+// previously been used as cursor surface for a different tool, a
+// protocol error is raised.
+func (obj *TabletToolV2) SetCursor(serial uint32, surface *wl.Surface, hotspotX int32, hotspotY int32) {
+	obj.state.Enqueue(TabletToolV2SetCursorRequest{
+		Serial:   serial,
+		Surface:  surface,
+		HotspotX: hotspotX,
+		HotspotY: hotspotY,
+	}.Encode(obj))
+	return
+}
+
+// This destroys the client's resource for this tool object.
+func (obj *TabletToolV2) Destroy() {
+	obj.state.Enqueue(TabletToolV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletToolV2TypeEvent holds the arguments of the type event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2TypeEvent struct {
+	ToolType TabletToolV2Type
+}
+
+func (TabletToolV2TypeEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2TypeEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ToolType = TabletToolV2Type(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2TypeEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.ToolType))
+
+	builder.Method = "type"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ToolType}
+	}
+	return builder
+}
+
+// TabletToolV2HardwareSerialEvent holds the arguments of the hardware_serial event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2HardwareSerialEvent struct {
+	HardwareSerialHi uint32
+	HardwareSerialLo uint32
+}
+
+func (TabletToolV2HardwareSerialEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2HardwareSerialEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.HardwareSerialHi = msg.ReadUint()
+	m.HardwareSerialLo = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2HardwareSerialEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.HardwareSerialHi)
+	builder.WriteUint(m.HardwareSerialLo)
+
+	builder.Method = "hardware_serial"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.HardwareSerialHi, m.HardwareSerialLo}
+	}
+	return builder
+}
+
+// TabletToolV2HardwareIdWacomEvent holds the arguments of the hardware_id_wacom event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2HardwareIdWacomEvent struct {
+	HardwareIdHi uint32
+	HardwareIdLo uint32
+}
+
+func (TabletToolV2HardwareIdWacomEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2HardwareIdWacomEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.HardwareIdHi = msg.ReadUint()
+	m.HardwareIdLo = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2HardwareIdWacomEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(m.HardwareIdHi)
+	builder.WriteUint(m.HardwareIdLo)
+
+	builder.Method = "hardware_id_wacom"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.HardwareIdHi, m.HardwareIdLo}
+	}
+	return builder
+}
+
+// TabletToolV2CapabilityEvent holds the arguments of the capability event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2CapabilityEvent struct {
+	Capability TabletToolV2Capability
+}
+
+func (TabletToolV2CapabilityEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2CapabilityEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Capability = TabletToolV2Capability(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2CapabilityEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(uint32(m.Capability))
+
+	builder.Method = "capability"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Capability}
+	}
+	return builder
+}
+
+// TabletToolV2DoneEvent holds the arguments of the done event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2DoneEvent struct {
+}
+
+func (TabletToolV2DoneEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2DoneEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletToolV2RemovedEvent holds the arguments of the removed event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2RemovedEvent struct {
+}
+
+func (TabletToolV2RemovedEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2RemovedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2RemovedEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+
+	builder.Method = "removed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletToolV2ProximityInEvent holds the arguments of the proximity_in event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2ProximityInEvent struct {
+	Serial  uint32
+	Tablet  *TabletV2
+	Surface *wl.Surface
+}
+
+func (TabletToolV2ProximityInEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2ProximityInEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Tablet = wire.ResolveObject[*TabletV2](msg, state, TabletV2Interface, msg.ReadObject())
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2ProximityInEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Tablet)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "proximity_in"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Tablet, m.Surface}
+	}
+	return builder
+}
+
+// TabletToolV2ProximityOutEvent holds the arguments of the proximity_out event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2ProximityOutEvent struct {
+}
+
+func (TabletToolV2ProximityOutEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2ProximityOutEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2ProximityOutEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+
+	builder.Method = "proximity_out"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletToolV2DownEvent holds the arguments of the down event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2DownEvent struct {
+	Serial uint32
+}
+
+func (TabletToolV2DownEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2DownEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2DownEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "down"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// TabletToolV2UpEvent holds the arguments of the up event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2UpEvent struct {
+}
+
+func (TabletToolV2UpEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2UpEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2UpEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+
+	builder.Method = "up"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletToolV2MotionEvent holds the arguments of the motion event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2MotionEvent struct {
+	X wire.Fixed
+	Y wire.Fixed
+}
+
+func (TabletToolV2MotionEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2MotionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2MotionEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+
+	builder.Method = "motion"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
+	}
+	return builder
+}
+
+// TabletToolV2PressureEvent holds the arguments of the pressure event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2PressureEvent struct {
+	Pressure uint32
+}
+
+func (TabletToolV2PressureEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2PressureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Pressure = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2PressureEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 11)
+	builder.WriteUint(m.Pressure)
+
+	builder.Method = "pressure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Pressure}
+	}
+	return builder
+}
+
+// TabletToolV2DistanceEvent holds the arguments of the distance event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2DistanceEvent struct {
+	Distance uint32
+}
+
+func (TabletToolV2DistanceEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2DistanceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Distance = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2DistanceEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 12)
+	builder.WriteUint(m.Distance)
+
+	builder.Method = "distance"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Distance}
+	}
+	return builder
+}
+
+// TabletToolV2TiltEvent holds the arguments of the tilt event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2TiltEvent struct {
+	TiltX wire.Fixed
+	TiltY wire.Fixed
+}
+
+func (TabletToolV2TiltEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2TiltEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.TiltX = msg.ReadFixed()
+	m.TiltY = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2TiltEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 13)
+	builder.WriteFixed(m.TiltX)
+	builder.WriteFixed(m.TiltY)
+
+	builder.Method = "tilt"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.TiltX, m.TiltY}
+	}
+	return builder
+}
+
+// TabletToolV2RotationEvent holds the arguments of the rotation event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2RotationEvent struct {
+	Degrees wire.Fixed
+}
+
+func (TabletToolV2RotationEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2RotationEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Degrees = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2RotationEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 14)
+	builder.WriteFixed(m.Degrees)
+
+	builder.Method = "rotation"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Degrees}
+	}
+	return builder
+}
+
+// TabletToolV2SliderEvent holds the arguments of the slider event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2SliderEvent struct {
+	Position int32
+}
+
+func (TabletToolV2SliderEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2SliderEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Position = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2SliderEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 15)
+	builder.WriteInt(m.Position)
+
+	builder.Method = "slider"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Position}
+	}
+	return builder
+}
+
+// TabletToolV2WheelEvent holds the arguments of the wheel event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2WheelEvent struct {
+	Degrees wire.Fixed
+	Clicks  int32
+}
+
+func (TabletToolV2WheelEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2WheelEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Degrees = msg.ReadFixed()
+	m.Clicks = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2WheelEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 16)
+	builder.WriteFixed(m.Degrees)
+	builder.WriteInt(m.Clicks)
+
+	builder.Method = "wheel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Degrees, m.Clicks}
+	}
+	return builder
+}
+
+// TabletToolV2ButtonEvent holds the arguments of the button event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2ButtonEvent struct {
+	Serial uint32
+	Button uint32
+	State  TabletToolV2ButtonState
+}
+
+func (TabletToolV2ButtonEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2ButtonEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Button = msg.ReadUint()
+	m.State = TabletToolV2ButtonState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2ButtonEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 17)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(m.Button)
+	builder.WriteUint(uint32(m.State))
+
+	builder.Method = "button"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Button, m.State}
+	}
+	return builder
+}
+
+// TabletToolV2FrameEvent holds the arguments of the frame event of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2FrameEvent struct {
+	Time uint32
+}
+
+func (TabletToolV2FrameEvent) tabletToolV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2FrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2FrameEvent) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 18)
+	builder.WriteUint(m.Time)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Time}
+	}
+	return builder
+}
+
+// TabletToolV2SetCursorRequest holds the arguments of the set_cursor request of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2SetCursorRequest struct {
+	Serial   uint32
+	Surface  *wl.Surface
+	HotspotX int32
+	HotspotY int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2SetCursorRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadNullableObject())
+	m.HotspotX = msg.ReadInt()
+	m.HotspotY = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2SetCursorRequest) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteNullableObject(m.Surface)
+	builder.WriteInt(m.HotspotX)
+	builder.WriteInt(m.HotspotY)
+
+	builder.Method = "set_cursor"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface, m.HotspotX, m.HotspotY}
+	}
+	return builder
+}
+
+// TabletToolV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_tool_v2 interface.
+type TabletToolV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletToolV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletToolV2DestroyRequest) Encode(obj *TabletToolV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletToolV2Type is an alias for [shared.TabletToolV2Type].
+type TabletToolV2Type = shared.TabletToolV2Type
+
+const (
+	TabletToolV2TypePen      = shared.TabletToolV2TypePen
+	TabletToolV2TypeEraser   = shared.TabletToolV2TypeEraser
+	TabletToolV2TypeBrush    = shared.TabletToolV2TypeBrush
+	TabletToolV2TypePencil   = shared.TabletToolV2TypePencil
+	TabletToolV2TypeAirbrush = shared.TabletToolV2TypeAirbrush
+	TabletToolV2TypeFinger   = shared.TabletToolV2TypeFinger
+	TabletToolV2TypeMouse    = shared.TabletToolV2TypeMouse
+	TabletToolV2TypeLens     = shared.TabletToolV2TypeLens
+)
+
+// TabletToolV2Capability is an alias for [shared.TabletToolV2Capability].
+type TabletToolV2Capability = shared.TabletToolV2Capability
+
+const (
+	TabletToolV2CapabilityTilt     = shared.TabletToolV2CapabilityTilt
+	TabletToolV2CapabilityPressure = shared.TabletToolV2CapabilityPressure
+	TabletToolV2CapabilityDistance = shared.TabletToolV2CapabilityDistance
+	TabletToolV2CapabilityRotation = shared.TabletToolV2CapabilityRotation
+	TabletToolV2CapabilitySlider   = shared.TabletToolV2CapabilitySlider
+	TabletToolV2CapabilityWheel    = shared.TabletToolV2CapabilityWheel
+)
+
+// TabletToolV2ButtonState is an alias for [shared.TabletToolV2ButtonState].
+type TabletToolV2ButtonState = shared.TabletToolV2ButtonState
+
+const (
+	TabletToolV2ButtonStateReleased = shared.TabletToolV2ButtonStateReleased
+	TabletToolV2ButtonStatePressed  = shared.TabletToolV2ButtonStatePressed
+)
+
+// TabletToolV2Error is an alias for [shared.TabletToolV2Error].
+type TabletToolV2Error = shared.TabletToolV2Error
+
+const (
+	TabletToolV2ErrorRole = shared.TabletToolV2ErrorRole
+)
+
+const (
+	TabletV2Interface = shared.TabletV2Interface
+
+	// TabletV2Version is the version of the interface that this
+	// package implements.
+	TabletV2Version = 1
+)
+
+// The versions of the zwp_tablet_v2 interface that its messages were
+// added in.
+const (
+	TabletV2DestroyRequestSince = 1
+	TabletV2NameEventSince      = 1
+	TabletV2IdEventSince        = 1
+	TabletV2PathEventSince      = 1
+	TabletV2DoneEventSince      = 1
+	TabletV2RemovedEventSince   = 1
+)
+
+// TabletV2Desc describes the zwp_tablet_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletV2Desc = &wire.InterfaceDesc{
+	Name:    TabletV2Interface,
+	Version: TabletV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "name",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "name",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "vid",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "pid",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "path",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "path",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "removed",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
+// TabletV2Listener is a type that can respond to incoming
+// messages for a TabletV2 object.
+type TabletV2Listener interface {
+	// A descriptive name for the tablet device.
+	//
+	// If the device has no descriptive name, this event is not sent.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet.done event.
+	Name(name string)
+
+	// The USB vendor and product IDs for the tablet device.
+	//
+	// If the device has no USB vendor/product ID, this event is not sent.
+	// This can happen for virtual devices or non-USB devices, for instance.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet.done event.
+	Id(vid uint32, pid uint32)
+
+	// A system-specific device path that indicates which device is behind
+	// this wp_tablet. This information may be used to gather additional
+	// information about the device, e.g. through libwacom.
+	//
+	// A device may have more than one device path. If so, multiple
+	// wp_tablet.path events are sent. A device may be emulated and not
+	// have a device path, and in that case this event will not be sent.
+	//
+	// The format of the path is unspecified, it may be a device node, a
+	// sysfs path, or some other identifier. It is up to the client to
+	// identify the string provided.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet.done event.
+	Path(path string)
+
+	// This event is sent immediately to signal the end of the initial
+	// burst of descriptive events. A client may consider the static
+	// description of the tablet to be complete and finalize initialization
+	// of the tablet.
+	Done()
+
+	// Sent when the tablet has been removed from the system. When a tablet
+	// is removed, some tools may be removed.
+	//
+	// When this event is received, the client must wp_tablet.destroy
+	// the object.
+	Removed()
+}
+
+// TabletV2ListenerFuncs implements TabletV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletV2ListenerFuncs struct {
+	OnName    func(name string)
+	OnId      func(vid uint32, pid uint32)
+	OnPath    func(path string)
+	OnDone    func()
+	OnRemoved func()
+}
+
+func (lis *TabletV2ListenerFuncs) Name(name string) {
+	if lis.OnName != nil {
+		lis.OnName(name)
+	}
+}
+
+func (lis *TabletV2ListenerFuncs) Id(vid uint32, pid uint32) {
+	if lis.OnId != nil {
+		lis.OnId(vid, pid)
+	}
+}
+
+func (lis *TabletV2ListenerFuncs) Path(path string) {
+	if lis.OnPath != nil {
+		lis.OnPath(path)
+	}
+}
+
+func (lis *TabletV2ListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+func (lis *TabletV2ListenerFuncs) Removed() {
+	if lis.OnRemoved != nil {
+		lis.OnRemoved()
+	}
+}
+
+// TabletV2Event is implemented by the message types of all
+// of the events that a TabletV2 can receive.
+type TabletV2Event interface {
+	tabletV2Event()
+}
+
+// TabletV2EventFunc implements TabletV2Listener by calling
+// itself with each incoming message.
+type TabletV2EventFunc func(TabletV2Event)
+
+func (f TabletV2EventFunc) Name(name string) {
+	f(TabletV2NameEvent{
+		Name: name,
+	})
+}
+
+func (f TabletV2EventFunc) Id(vid uint32, pid uint32) {
+	f(TabletV2IdEvent{
+		Vid: vid,
+		Pid: pid,
+	})
+}
+
+func (f TabletV2EventFunc) Path(path string) {
+	f(TabletV2PathEvent{
+		Path: path,
+	})
+}
+
+func (f TabletV2EventFunc) Done() {
+	f(TabletV2DoneEvent{})
+}
+
+func (f TabletV2EventFunc) Removed() {
+	f(TabletV2RemovedEvent{})
+}
+
+// The wp_tablet interface represents one graphics tablet device. The
+// tablet interface itself does not generate events; all events are
+// generated by wp_tablet_tool objects when in proximity above a tablet.
+//
+// A tablet has a number of static characteristics, e.g. device name and
+// pid/vid. These capabilities are sent in an event sequence after the
+// wp_tablet_seat.tablet_added event. This initial event sequence is
+// terminated by a wp_tablet.done event.
+type TabletV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletV2 returns a newly instantiated TabletV2. It is
+// primarily intended for use by generated code.
+func NewTabletV2(state wire.State) *TabletV2 {
+	return &TabletV2{state: state}
+}
+
+func (obj *TabletV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletV2NameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Name(
+			m.Name,
+		)
+		return nil
+
+	case 1:
+		var m TabletV2IdEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Id(
+			m.Vid,
+			m.Pid,
+		)
+		return nil
+
+	case 2:
+		var m TabletV2PathEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Path(
+			m.Path,
+		)
+		return nil
+
+	case 3:
+		var m TabletV2DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done()
+		return nil
+
+	case 4:
+		var m TabletV2RemovedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Removed()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_v2", obj.id)
+}
+
+func (obj *TabletV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "name"
+
+	case 1:
+		return "id"
+
+	case 2:
+		return "path"
+
+	case 3:
+		return "done"
+
+	case 4:
+		return "removed"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletV2) Interface() string {
+	return TabletV2Interface
+}
+
+// OnName sets the function that is called when the
+// name event is received. If obj's Listener is not a
+// *TabletV2ListenerFuncs, it is replaced with one.
+func (obj *TabletV2) OnName(f func(name string)) {
+	lis, ok := obj.Listener.(*TabletV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnName = f
+}
+
+// OnId sets the function that is called when the
+// id event is received. If obj's Listener is not a
+// *TabletV2ListenerFuncs, it is replaced with one.
+func (obj *TabletV2) OnId(f func(vid uint32, pid uint32)) {
+	lis, ok := obj.Listener.(*TabletV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnId = f
+}
+
+// OnPath sets the function that is called when the
+// path event is received. If obj's Listener is not a
+// *TabletV2ListenerFuncs, it is replaced with one.
+func (obj *TabletV2) OnPath(f func(path string)) {
+	lis, ok := obj.Listener.(*TabletV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPath = f
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *TabletV2ListenerFuncs, it is replaced with one.
+func (obj *TabletV2) OnDone(f func()) {
+	lis, ok := obj.Listener.(*TabletV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// OnRemoved sets the function that is called when the
+// removed event is received. If obj's Listener is not a
+// *TabletV2ListenerFuncs, it is replaced with one.
+func (obj *TabletV2) OnRemoved(f func()) {
+	lis, ok := obj.Listener.(*TabletV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRemoved = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletV2) Subscribe() *wire.Messages[TabletV2Event] {
+	q := new(wire.Messages[TabletV2Event])
+	obj.Listener = TabletV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletV2) Version() uint32 {
+	return TabletV2Version
+}
+
+// This destroys the client's resource for this tablet object.
+func (obj *TabletV2) Destroy() {
+	obj.state.Enqueue(TabletV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletV2NameEvent holds the arguments of the name event of
+// the zwp_tablet_v2 interface.
+type TabletV2NameEvent struct {
+	Name string
+}
+
+func (TabletV2NameEvent) tabletV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2NameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Name = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2NameEvent) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.Name)
+
+	builder.Method = "name"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Name}
+	}
+	return builder
+}
+
+// TabletV2IdEvent holds the arguments of the id event of
+// the zwp_tablet_v2 interface.
+type TabletV2IdEvent struct {
+	Vid uint32
+	Pid uint32
+}
+
+func (TabletV2IdEvent) tabletV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2IdEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Vid = msg.ReadUint()
+	m.Pid = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2IdEvent) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Vid)
+	builder.WriteUint(m.Pid)
+
+	builder.Method = "id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Vid, m.Pid}
+	}
+	return builder
+}
+
+// TabletV2PathEvent holds the arguments of the path event of
+// the zwp_tablet_v2 interface.
+type TabletV2PathEvent struct {
+	Path string
+}
+
+func (TabletV2PathEvent) tabletV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2PathEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Path = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2PathEvent) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteString(m.Path)
+
+	builder.Method = "path"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Path}
+	}
+	return builder
+}
+
+// TabletV2DoneEvent holds the arguments of the done event of
+// the zwp_tablet_v2 interface.
+type TabletV2DoneEvent struct {
+}
+
+func (TabletV2DoneEvent) tabletV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2DoneEvent) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletV2RemovedEvent holds the arguments of the removed event of
+// the zwp_tablet_v2 interface.
+type TabletV2RemovedEvent struct {
+}
+
+func (TabletV2RemovedEvent) tabletV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2RemovedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2RemovedEvent) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "removed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_v2 interface.
+type TabletV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletV2DestroyRequest) Encode(obj *TabletV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+const (
+	TabletPadRingV2Interface = shared.TabletPadRingV2Interface
+
+	// TabletPadRingV2Version is the version of the interface that this
+	// package implements.
+	TabletPadRingV2Version = 1
+)
+
+// The versions of the zwp_tablet_pad_ring_v2 interface that its messages were
+// added in.
+const (
+	TabletPadRingV2SetFeedbackRequestSince = 1
+	TabletPadRingV2DestroyRequestSince     = 1
+	TabletPadRingV2SourceEventSince        = 1
+	TabletPadRingV2AngleEventSince         = 1
+	TabletPadRingV2StopEventSince          = 1
+	TabletPadRingV2FrameEventSince         = 1
+)
+
+// TabletPadRingV2Desc describes the zwp_tablet_pad_ring_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletPadRingV2Desc = &wire.InterfaceDesc{
+	Name:    TabletPadRingV2Interface,
+	Version: TabletPadRingV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_feedback",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "description",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "source",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "source",
+					Type: wire.ArgUint,
+					Enum: "source",
+				},
+			},
+		},
+		{
+			Name:  "angle",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "degrees",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "stop",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "source",
+			Entries: []wire.EntryDesc{
+				{Name: "finger", Value: 1},
+			},
+		},
+	},
+}
+
+// TabletPadRingV2Listener is a type that can respond to incoming
+// messages for a TabletPadRingV2 object.
+type TabletPadRingV2Listener interface {
+	// Source information for ring events.
+	//
+	// This event does not occur on its own. It is sent before a
+	// wp_tablet_pad_ring.frame event and carries the source information
+	// for all events within that frame.
+	//
+	// The source specifies how this event was generated. If the source is
+	// wp_tablet_pad_ring.source.finger, a wp_tablet_pad_ring.stop event
+	// will be sent when the user lifts the finger off the device.
+	//
+	// This event is optional. If the source is unknown for an interaction,
+	// no event is sent.
+	Source(source TabletPadRingV2Source)
+
+	// Sent whenever the angle on a ring changes.
+	//
+	// The angle is provided in degrees clockwise from the logical
+	// north of the ring in the pad's current rotation.
+	Angle(degrees wire.Fixed)
+
+	// Stop notification for ring events.
+	//
+	// For some wp_tablet_pad_ring.source types, a wp_tablet_pad_ring.stop
+	// event is sent to notify a client that the interaction with the ring
+	// has terminated. This enables the client to implement kinetic scrolling.
+	// See the wp_tablet_pad_ring.source documentation for information on
+	// when this event may be generated.
+	//
+	// Any wp_tablet_pad_ring.angle events with the same source after this
+	// event should be considered as the start of a new interaction.
+	Stop()
+
+	// Indicates the end of a set of ring events that logically belong
+	// together. A client is expected to accumulate the data in all events
+	// within the frame before proceeding.
+	//
+	// All wp_tablet_pad_ring events before a wp_tablet_pad_ring.frame event belong
+	// logically together. For example, on termination of a finger interaction
+	// on a ring the compositor will send a wp_tablet_pad_ring.source event,
+	// a wp_tablet_pad_ring.stop event and a wp_tablet_pad_ring.frame event.
+	//
+	// A wp_tablet_pad_ring.frame event is sent for every logical event
+	// group, even if the group only contains a single wp_tablet_pad_ring
+	// event. Specifically, a client may get a sequence: angle, frame,
+	// angle, frame, etc.
+	Frame(time uint32)
+}
+
+// TabletPadRingV2ListenerFuncs implements TabletPadRingV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletPadRingV2ListenerFuncs struct {
+	OnSource func(source TabletPadRingV2Source)
+	OnAngle  func(degrees wire.Fixed)
+	OnStop   func()
+	OnFrame  func(time uint32)
+}
+
+func (lis *TabletPadRingV2ListenerFuncs) Source(source TabletPadRingV2Source) {
+	if lis.OnSource != nil {
+		lis.OnSource(source)
+	}
+}
+
+func (lis *TabletPadRingV2ListenerFuncs) Angle(degrees wire.Fixed) {
+	if lis.OnAngle != nil {
+		lis.OnAngle(degrees)
+	}
+}
+
+func (lis *TabletPadRingV2ListenerFuncs) Stop() {
+	if lis.OnStop != nil {
+		lis.OnStop()
+	}
+}
+
+func (lis *TabletPadRingV2ListenerFuncs) Frame(time uint32) {
+	if lis.OnFrame != nil {
+		lis.OnFrame(time)
+	}
+}
+
+// TabletPadRingV2Event is implemented by the message types of all
+// of the events that a TabletPadRingV2 can receive.
+type TabletPadRingV2Event interface {
+	tabletPadRingV2Event()
+}
+
+// TabletPadRingV2EventFunc implements TabletPadRingV2Listener by calling
+// itself with each incoming message.
+type TabletPadRingV2EventFunc func(TabletPadRingV2Event)
+
+func (f TabletPadRingV2EventFunc) Source(source TabletPadRingV2Source) {
+	f(TabletPadRingV2SourceEvent{
+		Source: source,
+	})
+}
+
+func (f TabletPadRingV2EventFunc) Angle(degrees wire.Fixed) {
+	f(TabletPadRingV2AngleEvent{
+		Degrees: degrees,
+	})
+}
+
+func (f TabletPadRingV2EventFunc) Stop() {
+	f(TabletPadRingV2StopEvent{})
+}
+
+func (f TabletPadRingV2EventFunc) Frame(time uint32) {
+	f(TabletPadRingV2FrameEvent{
+		Time: time,
+	})
+}
+
+// A circular interaction area, such as the touch ring on the Wacom Intuos
+// Pro series tablets.
+//
+// Events on a ring are logically grouped by the wl_tablet_pad_ring.frame
+// event.
+type TabletPadRingV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletPadRingV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletPadRingV2 returns a newly instantiated TabletPadRingV2. It is
+// primarily intended for use by generated code.
+func NewTabletPadRingV2(state wire.State) *TabletPadRingV2 {
+	return &TabletPadRingV2{state: state}
+}
+
+func (obj *TabletPadRingV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletPadRingV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletPadRingV2SourceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Source(
+			m.Source,
+		)
+		return nil
+
+	case 1:
+		var m TabletPadRingV2AngleEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Angle(
+			m.Degrees,
+		)
+		return nil
+
+	case 2:
+		var m TabletPadRingV2StopEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Stop()
+		return nil
+
+	case 3:
+		var m TabletPadRingV2FrameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Frame(
+			m.Time,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletPadRingV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_pad_ring_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletPadRingV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletPadRingV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletPadRingV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletPadRingV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_pad_ring_v2", obj.id)
+}
+
+func (obj *TabletPadRingV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "source"
+
+	case 1:
+		return "angle"
+
+	case 2:
+		return "stop"
+
+	case 3:
+		return "frame"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletPadRingV2) Interface() string {
+	return TabletPadRingV2Interface
+}
+
+// OnSource sets the function that is called when the
+// source event is received. If obj's Listener is not a
+// *TabletPadRingV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadRingV2) OnSource(f func(source TabletPadRingV2Source)) {
+	lis, ok := obj.Listener.(*TabletPadRingV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadRingV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSource = f
+}
+
+// OnAngle sets the function that is called when the
+// angle event is received. If obj's Listener is not a
+// *TabletPadRingV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadRingV2) OnAngle(f func(degrees wire.Fixed)) {
+	lis, ok := obj.Listener.(*TabletPadRingV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadRingV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAngle = f
+}
+
+// OnStop sets the function that is called when the
+// stop event is received. If obj's Listener is not a
+// *TabletPadRingV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadRingV2) OnStop(f func()) {
+	lis, ok := obj.Listener.(*TabletPadRingV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadRingV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnStop = f
+}
+
+// OnFrame sets the function that is called when the
+// frame event is received. If obj's Listener is not a
+// *TabletPadRingV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadRingV2) OnFrame(f func(time uint32)) {
+	lis, ok := obj.Listener.(*TabletPadRingV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadRingV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletPadRingV2) Subscribe() *wire.Messages[TabletPadRingV2Event] {
+	q := new(wire.Messages[TabletPadRingV2Event])
+	obj.Listener = TabletPadRingV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletPadRingV2) Version() uint32 {
+	return TabletPadRingV2Version
+}
+
+// Request that the compositor use the provided feedback string
+// associated with this ring. This request should be issued immediately
+// after a wp_tablet_pad_group.mode_switch event from the corresponding
+// group is received, or whenever the ring is mapped to a different
+// action. See wp_tablet_pad_group.mode_switch for more details.
+//
+// Clients are encouraged to provide context-aware descriptions for
+// the actions associated with the ring; compositors may use this
+// information to offer visual feedback about the button layout
+// (eg. on-screen displays).
+//
+// The provided string 'description' is a UTF-8 encoded string to be
+// associated with this ring, and is considered user-visible; general
+// internationalization rules apply.
+//
+// The serial argument will be that of the last
+// wp_tablet_pad_group.mode_switch event received for the group of this
+// ring. Requests providing other serials than the most recent one will be
+// ignored.
+func (obj *TabletPadRingV2) SetFeedback(description string, serial uint32) {
+	obj.state.Enqueue(TabletPadRingV2SetFeedbackRequest{
+		Description: description,
+		Serial:      serial,
+	}.Encode(obj))
+	return
+}
+
+// This destroys the client's resource for this ring object.
+func (obj *TabletPadRingV2) Destroy() {
+	obj.state.Enqueue(TabletPadRingV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletPadRingV2SourceEvent holds the arguments of the source event of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2SourceEvent struct {
+	Source TabletPadRingV2Source
+}
+
+func (TabletPadRingV2SourceEvent) tabletPadRingV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2SourceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = TabletPadRingV2Source(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2SourceEvent) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Source))
+
+	builder.Method = "source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source}
+	}
+	return builder
+}
+
+// TabletPadRingV2AngleEvent holds the arguments of the angle event of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2AngleEvent struct {
+	Degrees wire.Fixed
+}
+
+func (TabletPadRingV2AngleEvent) tabletPadRingV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2AngleEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Degrees = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2AngleEvent) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteFixed(m.Degrees)
+
+	builder.Method = "angle"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Degrees}
+	}
+	return builder
+}
+
+// TabletPadRingV2StopEvent holds the arguments of the stop event of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2StopEvent struct {
+}
+
+func (TabletPadRingV2StopEvent) tabletPadRingV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2StopEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2StopEvent) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "stop"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadRingV2FrameEvent holds the arguments of the frame event of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2FrameEvent struct {
+	Time uint32
+}
+
+func (TabletPadRingV2FrameEvent) tabletPadRingV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2FrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2FrameEvent) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Time)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Time}
+	}
+	return builder
+}
+
+// TabletPadRingV2SetFeedbackRequest holds the arguments of the set_feedback request of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2SetFeedbackRequest struct {
+	Description string
+	Serial      uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2SetFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Description = msg.ReadString()
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2SetFeedbackRequest) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.Description)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Description, m.Serial}
+	}
+	return builder
+}
+
+// TabletPadRingV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_pad_ring_v2 interface.
+type TabletPadRingV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadRingV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadRingV2DestroyRequest) Encode(obj *TabletPadRingV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadRingV2Source is an alias for [shared.TabletPadRingV2Source].
+type TabletPadRingV2Source = shared.TabletPadRingV2Source
+
+const (
+	TabletPadRingV2SourceFinger = shared.TabletPadRingV2SourceFinger
+)
+
+const (
+	TabletPadStripV2Interface = shared.TabletPadStripV2Interface
+
+	// TabletPadStripV2Version is the version of the interface that this
+	// package implements.
+	TabletPadStripV2Version = 1
+)
+
+// The versions of the zwp_tablet_pad_strip_v2 interface that its messages were
+// added in.
+const (
+	TabletPadStripV2SetFeedbackRequestSince = 1
+	TabletPadStripV2DestroyRequestSince     = 1
+	TabletPadStripV2SourceEventSince        = 1
+	TabletPadStripV2PositionEventSince      = 1
+	TabletPadStripV2StopEventSince          = 1
+	TabletPadStripV2FrameEventSince         = 1
+)
+
+// TabletPadStripV2Desc describes the zwp_tablet_pad_strip_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletPadStripV2Desc = &wire.InterfaceDesc{
+	Name:    TabletPadStripV2Interface,
+	Version: TabletPadStripV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_feedback",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "description",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "source",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "source",
+					Type: wire.ArgUint,
+					Enum: "source",
+				},
+			},
+		},
+		{
+			Name:  "position",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "position",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "stop",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "source",
+			Entries: []wire.EntryDesc{
+				{Name: "finger", Value: 1},
+			},
+		},
+	},
+}
+
+// TabletPadStripV2Listener is a type that can respond to incoming
+// messages for a TabletPadStripV2 object.
+type TabletPadStripV2Listener interface {
+	// Source information for strip events.
+	//
+	// This event does not occur on its own. It is sent before a
+	// wp_tablet_pad_strip.frame event and carries the source information
+	// for all events within that frame.
+	//
+	// The source specifies how this event was generated. If the source is
+	// wp_tablet_pad_strip.source.finger, a wp_tablet_pad_strip.stop event
+	// will be sent when the user lifts their finger off the device.
+	//
+	// This event is optional. If the source is unknown for an interaction,
+	// no event is sent.
+	Source(source TabletPadStripV2Source)
+
+	// Sent whenever the position on a strip changes.
+	//
+	// The position is normalized to a range of [0, 65535], the 0-value
+	// represents the top-most and/or left-most position of the strip in
+	// the pad's current rotation.
+	Position(position uint32)
+
+	// Stop notification for strip events.
+	//
+	// For some wp_tablet_pad_strip.source types, a wp_tablet_pad_strip.stop
+	// event is sent to notify a client that the interaction with the strip
+	// has terminated. This enables the client to implement kinetic
+	// scrolling. See the wp_tablet_pad_strip.source documentation for
+	// information on when this event may be generated.
+	//
+	// Any wp_tablet_pad_strip.position events with the same source after this
+	// event should be considered as the start of a new interaction.
+	Stop()
+
+	// Indicates the end of a set of events that represent one logical
+	// hardware strip event. A client is expected to accumulate the data
+	// in all events within the frame before proceeding.
+	//
+	// All wp_tablet_pad_strip events before a wp_tablet_pad_strip.frame event belong
+	// logically together. For example, on termination of a finger interaction
+	// on a strip the compositor will send a wp_tablet_pad_strip.source event,
+	// a wp_tablet_pad_strip.stop event and a wp_tablet_pad_strip.frame
+	// event.
+	//
+	// A wp_tablet_pad_strip.frame event is sent for every logical event
+	// group, even if the group only contains a single wp_tablet_pad_strip
+	// event. Specifically, a client may get a sequence: position, frame,
+	// position, frame, etc.
+	Frame(time uint32)
+}
+
+// TabletPadStripV2ListenerFuncs implements TabletPadStripV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletPadStripV2ListenerFuncs struct {
+	OnSource   func(source TabletPadStripV2Source)
+	OnPosition func(position uint32)
+	OnStop     func()
+	OnFrame    func(time uint32)
+}
+
+func (lis *TabletPadStripV2ListenerFuncs) Source(source TabletPadStripV2Source) {
+	if lis.OnSource != nil {
+		lis.OnSource(source)
+	}
+}
+
+func (lis *TabletPadStripV2ListenerFuncs) Position(position uint32) {
+	if lis.OnPosition != nil {
+		lis.OnPosition(position)
+	}
+}
+
+func (lis *TabletPadStripV2ListenerFuncs) Stop() {
+	if lis.OnStop != nil {
+		lis.OnStop()
+	}
+}
+
+func (lis *TabletPadStripV2ListenerFuncs) Frame(time uint32) {
+	if lis.OnFrame != nil {
+		lis.OnFrame(time)
+	}
+}
+
+// TabletPadStripV2Event is implemented by the message types of all
+// of the events that a TabletPadStripV2 can receive.
+type TabletPadStripV2Event interface {
+	tabletPadStripV2Event()
+}
+
+// TabletPadStripV2EventFunc implements TabletPadStripV2Listener by calling
+// itself with each incoming message.
+type TabletPadStripV2EventFunc func(TabletPadStripV2Event)
+
+func (f TabletPadStripV2EventFunc) Source(source TabletPadStripV2Source) {
+	f(TabletPadStripV2SourceEvent{
+		Source: source,
+	})
+}
+
+func (f TabletPadStripV2EventFunc) Position(position uint32) {
+	f(TabletPadStripV2PositionEvent{
+		Position: position,
+	})
+}
+
+func (f TabletPadStripV2EventFunc) Stop() {
+	f(TabletPadStripV2StopEvent{})
+}
+
+func (f TabletPadStripV2EventFunc) Frame(time uint32) {
+	f(TabletPadStripV2FrameEvent{
+		Time: time,
+	})
+}
+
+// A linear interaction area, such as the strips found in Wacom Cintiq
+// models.
+//
+// Events on a strip are logically grouped by the wl_tablet_pad_strip.frame
+// event.
+type TabletPadStripV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletPadStripV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletPadStripV2 returns a newly instantiated TabletPadStripV2. It is
+// primarily intended for use by generated code.
+func NewTabletPadStripV2(state wire.State) *TabletPadStripV2 {
+	return &TabletPadStripV2{state: state}
+}
+
+func (obj *TabletPadStripV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletPadStripV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletPadStripV2SourceEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Source(
+			m.Source,
+		)
+		return nil
+
+	case 1:
+		var m TabletPadStripV2PositionEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Position(
+			m.Position,
+		)
+		return nil
+
+	case 2:
+		var m TabletPadStripV2StopEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Stop()
+		return nil
+
+	case 3:
+		var m TabletPadStripV2FrameEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Frame(
+			m.Time,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletPadStripV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_pad_strip_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletPadStripV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletPadStripV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletPadStripV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletPadStripV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_pad_strip_v2", obj.id)
+}
+
+func (obj *TabletPadStripV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "source"
+
+	case 1:
+		return "position"
+
+	case 2:
+		return "stop"
+
+	case 3:
+		return "frame"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletPadStripV2) Interface() string {
+	return TabletPadStripV2Interface
+}
+
+// OnSource sets the function that is called when the
+// source event is received. If obj's Listener is not a
+// *TabletPadStripV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadStripV2) OnSource(f func(source TabletPadStripV2Source)) {
+	lis, ok := obj.Listener.(*TabletPadStripV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadStripV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSource = f
+}
+
+// OnPosition sets the function that is called when the
+// position event is received. If obj's Listener is not a
+// *TabletPadStripV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadStripV2) OnPosition(f func(position uint32)) {
+	lis, ok := obj.Listener.(*TabletPadStripV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadStripV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPosition = f
+}
+
+// OnStop sets the function that is called when the
+// stop event is received. If obj's Listener is not a
+// *TabletPadStripV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadStripV2) OnStop(f func()) {
+	lis, ok := obj.Listener.(*TabletPadStripV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadStripV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnStop = f
+}
+
+// OnFrame sets the function that is called when the
+// frame event is received. If obj's Listener is not a
+// *TabletPadStripV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadStripV2) OnFrame(f func(time uint32)) {
+	lis, ok := obj.Listener.(*TabletPadStripV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadStripV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnFrame = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletPadStripV2) Subscribe() *wire.Messages[TabletPadStripV2Event] {
+	q := new(wire.Messages[TabletPadStripV2Event])
+	obj.Listener = TabletPadStripV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletPadStripV2) Version() uint32 {
+	return TabletPadStripV2Version
+}
+
+// Requests the compositor to use the provided feedback string
+// associated with this strip. This request should be issued immediately
+// after a wp_tablet_pad_group.mode_switch event from the corresponding
+// group is received, or whenever the strip is mapped to a different
+// action. See wp_tablet_pad_group.mode_switch for more details.
+//
+// Clients are encouraged to provide context-aware descriptions for
+// the actions associated with the strip, and compositors may use this
+// information to offer visual feedback about the button layout
+// (eg. on-screen displays).
+//
+// The provided string 'description' is a UTF-8 encoded string to be
+// associated with this ring, and is considered user-visible; general
+// internationalization rules apply.
+//
+// The serial argument will be that of the last
+// wp_tablet_pad_group.mode_switch event received for the group of this
+// strip. Requests providing other serials than the most recent one will be
+// ignored.
+func (obj *TabletPadStripV2) SetFeedback(description string, serial uint32) {
+	obj.state.Enqueue(TabletPadStripV2SetFeedbackRequest{
+		Description: description,
+		Serial:      serial,
+	}.Encode(obj))
+	return
+}
+
+// This destroys the client's resource for this strip object.
+func (obj *TabletPadStripV2) Destroy() {
+	obj.state.Enqueue(TabletPadStripV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletPadStripV2SourceEvent holds the arguments of the source event of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2SourceEvent struct {
+	Source TabletPadStripV2Source
+}
+
+func (TabletPadStripV2SourceEvent) tabletPadStripV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2SourceEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Source = TabletPadStripV2Source(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2SourceEvent) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Source))
+
+	builder.Method = "source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Source}
+	}
+	return builder
+}
+
+// TabletPadStripV2PositionEvent holds the arguments of the position event of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2PositionEvent struct {
+	Position uint32
+}
+
+func (TabletPadStripV2PositionEvent) tabletPadStripV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2PositionEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Position = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2PositionEvent) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(m.Position)
+
+	builder.Method = "position"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Position}
+	}
+	return builder
+}
+
+// TabletPadStripV2StopEvent holds the arguments of the stop event of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2StopEvent struct {
+}
+
+func (TabletPadStripV2StopEvent) tabletPadStripV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2StopEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2StopEvent) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "stop"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadStripV2FrameEvent holds the arguments of the frame event of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2FrameEvent struct {
+	Time uint32
+}
+
+func (TabletPadStripV2FrameEvent) tabletPadStripV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2FrameEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2FrameEvent) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Time)
+
+	builder.Method = "frame"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Time}
+	}
+	return builder
+}
+
+// TabletPadStripV2SetFeedbackRequest holds the arguments of the set_feedback request of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2SetFeedbackRequest struct {
+	Description string
+	Serial      uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2SetFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Description = msg.ReadString()
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2SetFeedbackRequest) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.Description)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Description, m.Serial}
+	}
+	return builder
+}
+
+// TabletPadStripV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_pad_strip_v2 interface.
+type TabletPadStripV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadStripV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadStripV2DestroyRequest) Encode(obj *TabletPadStripV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadStripV2Source is an alias for [shared.TabletPadStripV2Source].
+type TabletPadStripV2Source = shared.TabletPadStripV2Source
+
+const (
+	TabletPadStripV2SourceFinger = shared.TabletPadStripV2SourceFinger
+)
+
+const (
+	TabletPadGroupV2Interface = shared.TabletPadGroupV2Interface
+
+	// TabletPadGroupV2Version is the version of the interface that this
+	// package implements.
+	TabletPadGroupV2Version = 1
+)
+
+// The versions of the zwp_tablet_pad_group_v2 interface that its messages were
+// added in.
+const (
+	TabletPadGroupV2DestroyRequestSince  = 1
+	TabletPadGroupV2ButtonsEventSince    = 1
+	TabletPadGroupV2RingEventSince       = 1
+	TabletPadGroupV2StripEventSince      = 1
+	TabletPadGroupV2ModesEventSince      = 1
+	TabletPadGroupV2DoneEventSince       = 1
+	TabletPadGroupV2ModeSwitchEventSince = 1
+)
+
+// TabletPadGroupV2Desc describes the zwp_tablet_pad_group_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletPadGroupV2Desc = &wire.InterfaceDesc{
+	Name:    TabletPadGroupV2Interface,
+	Version: TabletPadGroupV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "buttons",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "buttons",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "ring",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "ring",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_pad_ring_v2",
+				},
+			},
+		},
+		{
+			Name:  "strip",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "strip",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_pad_strip_v2",
+				},
+			},
+		},
+		{
+			Name:  "modes",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "modes",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "mode_switch",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "mode",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{},
+}
+
+// TabletPadGroupV2Listener is a type that can respond to incoming
+// messages for a TabletPadGroupV2 object.
+type TabletPadGroupV2Listener interface {
+	// Sent on wp_tablet_pad_group initialization to announce the available
+	// buttons in the group. Button indices start at 0, a button may only be
+	// in one group at a time.
+	//
+	// This event is first sent in the initial burst of events before the
+	// wp_tablet_pad_group.done event.
+	//
+	// Some buttons are reserved by the compositor. These buttons may not be
+	// assigned to any wp_tablet_pad_group. Compositors may broadcast this
+	// event in the case of changes to the mapping of these reserved buttons.
+	// If the compositor happens to reserve all buttons in a group, this event
+	// will be sent with an empty array.
+	Buttons(buttons []byte)
+
+	// Sent on wp_tablet_pad_group initialization to announce available rings.
+	// One event is sent for each ring available on this pad group.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad_group.done event.
+	Ring(ring *TabletPadRingV2)
+
+	// Sent on wp_tablet_pad initialization to announce available strips.
+	// One event is sent for each strip available on this pad group.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad_group.done event.
+	Strip(strip *TabletPadStripV2)
+
+	// Sent on wp_tablet_pad_group initialization to announce that the pad
+	// group may switch between modes. A client may use a mode to store a
+	// specific configuration for buttons, rings and strips and use the
+	// wl_tablet_pad_group.mode_switch event to toggle between these
+	// configurations. Mode indices start at 0.
+	//
+	// Switching modes is compositor-dependent. See the
+	// wp_tablet_pad_group.mode_switch event for more details.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad_group.done event. This event is only sent when more than
+	// more than one mode is available.
+	Modes(modes uint32)
+
+	// This event is sent immediately to signal the end of the initial
+	// burst of descriptive events. A client may consider the static
+	// description of the tablet to be complete and finalize initialization
+	// of the tablet group.
+	Done()
+
+	// Notification that the mode was switched.
+	//
+	// A mode applies to all buttons, rings and strips in a group
+	// simultaneously, but a client is not required to assign different actions
+	// for each mode. For example, a client may have mode-specific button
+	// mappings but map the ring to vertical scrolling in all modes. Mode
+	// indices start at 0.
+	//
+	// Switching modes is compositor-dependent. The compositor may provide
+	// visual cues to the user about the mode, e.g. by toggling LEDs on
+	// the tablet device. Mode-switching may be software-controlled or
+	// controlled by one or more physical buttons. For example, on a Wacom
+	// Intuos Pro, the button inside the ring may be assigned to switch
+	// between modes.
+	//
+	// The compositor will also send this event after wp_tablet_pad.enter on
+	// each group in order to notify of the current mode. Groups that only
+	// feature one mode will use mode=0 when emitting this event.
+	//
+	// If a button action in the new mode differs from the action in the
+	// previous mode, the client should immediately issue a
+	// wp_tablet_pad.set_feedback request for each changed button.
+	//
+	// If a ring or strip action in the new mode differs from the action
+	// in the previous mode, the client should immediately issue a
+	// wp_tablet_ring.set_feedback or wp_tablet_strip.set_feedback request
+	// for each changed ring or strip.
+	ModeSwitch(time uint32, serial uint32, mode uint32)
+}
+
+// TabletPadGroupV2ListenerFuncs implements TabletPadGroupV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletPadGroupV2ListenerFuncs struct {
+	OnButtons    func(buttons []byte)
+	OnRing       func(ring *TabletPadRingV2)
+	OnStrip      func(strip *TabletPadStripV2)
+	OnModes      func(modes uint32)
+	OnDone       func()
+	OnModeSwitch func(time uint32, serial uint32, mode uint32)
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) Buttons(buttons []byte) {
+	if lis.OnButtons != nil {
+		lis.OnButtons(buttons)
+	}
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) Ring(ring *TabletPadRingV2) {
+	if lis.OnRing != nil {
+		lis.OnRing(ring)
+	}
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) Strip(strip *TabletPadStripV2) {
+	if lis.OnStrip != nil {
+		lis.OnStrip(strip)
+	}
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) Modes(modes uint32) {
+	if lis.OnModes != nil {
+		lis.OnModes(modes)
+	}
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+func (lis *TabletPadGroupV2ListenerFuncs) ModeSwitch(time uint32, serial uint32, mode uint32) {
+	if lis.OnModeSwitch != nil {
+		lis.OnModeSwitch(time, serial, mode)
+	}
+}
+
+// TabletPadGroupV2Event is implemented by the message types of all
+// of the events that a TabletPadGroupV2 can receive.
+type TabletPadGroupV2Event interface {
+	tabletPadGroupV2Event()
+}
+
+// TabletPadGroupV2EventFunc implements TabletPadGroupV2Listener by calling
+// itself with each incoming message.
+type TabletPadGroupV2EventFunc func(TabletPadGroupV2Event)
+
+func (f TabletPadGroupV2EventFunc) Buttons(buttons []byte) {
+	f(TabletPadGroupV2ButtonsEvent{
+		Buttons: buttons,
+	})
+}
+
+func (f TabletPadGroupV2EventFunc) Ring(ring *TabletPadRingV2) {
+	f(TabletPadGroupV2RingEvent{
+		Ring: ring,
+	})
+}
+
+func (f TabletPadGroupV2EventFunc) Strip(strip *TabletPadStripV2) {
+	f(TabletPadGroupV2StripEvent{
+		Strip: strip,
+	})
+}
+
+func (f TabletPadGroupV2EventFunc) Modes(modes uint32) {
+	f(TabletPadGroupV2ModesEvent{
+		Modes: modes,
+	})
+}
+
+func (f TabletPadGroupV2EventFunc) Done() {
+	f(TabletPadGroupV2DoneEvent{})
+}
+
+func (f TabletPadGroupV2EventFunc) ModeSwitch(time uint32, serial uint32, mode uint32) {
+	f(TabletPadGroupV2ModeSwitchEvent{
+		Time:   time,
+		Serial: serial,
+		Mode:   mode,
+	})
+}
+
+// A pad group describes a distinct (sub)set of buttons, rings and strips
+// present in the tablet. The criteria of this grouping is usually positional,
+// eg. if a tablet has buttons on the left and right side, 2 groups will be
+// presented. The physical arrangement of groups is undisclosed and may
+// change on the fly.
+//
+// Pad groups will announce their features during pad initialization. Between
+// the corresponding wp_tablet_pad.group event and wp_tablet_pad_group.done, the
+// pad group will announce the buttons, rings and strips contained in it,
+// plus the number of supported modes.
+//
+// Modes are a mechanism to allow multiple groups of actions for every element
+// in the pad group. The number of groups and available modes in each is
+// persistent across device plugs. The current mode is user-switchable, it
+// will be announced through the wp_tablet_pad_group.mode_switch event both
+// whenever it is switched, and after wp_tablet_pad.enter.
+//
+// The current mode logically applies to all elements in the pad group,
+// although it is at clients' discretion whether to actually perform different
+// actions, and/or issue the respective .set_feedback requests to notify the
+// compositor. See the wp_tablet_pad_group.mode_switch event for more details.
+type TabletPadGroupV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletPadGroupV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletPadGroupV2 returns a newly instantiated TabletPadGroupV2. It is
+// primarily intended for use by generated code.
+func NewTabletPadGroupV2(state wire.State) *TabletPadGroupV2 {
+	return &TabletPadGroupV2{state: state}
+}
+
+func (obj *TabletPadGroupV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletPadGroupV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletPadGroupV2ButtonsEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Buttons(
+			m.Buttons,
+		)
+		return nil
+
+	case 1:
+		var m TabletPadGroupV2RingEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Ring(
+			m.Ring,
+		)
+		return nil
+
+	case 2:
+		var m TabletPadGroupV2StripEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Strip(
+			m.Strip,
+		)
+		return nil
+
+	case 3:
+		var m TabletPadGroupV2ModesEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Modes(
+			m.Modes,
+		)
+		return nil
+
+	case 4:
+		var m TabletPadGroupV2DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done()
+		return nil
+
+	case 5:
+		var m TabletPadGroupV2ModeSwitchEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ModeSwitch(
+			m.Time,
+			m.Serial,
+			m.Mode,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletPadGroupV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_pad_group_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletPadGroupV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletPadGroupV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletPadGroupV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletPadGroupV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_pad_group_v2", obj.id)
+}
+
+func (obj *TabletPadGroupV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "buttons"
+
+	case 1:
+		return "ring"
+
+	case 2:
+		return "strip"
+
+	case 3:
+		return "modes"
+
+	case 4:
+		return "done"
+
+	case 5:
+		return "mode_switch"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletPadGroupV2) Interface() string {
+	return TabletPadGroupV2Interface
+}
+
+// OnButtons sets the function that is called when the
+// buttons event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnButtons(f func(buttons []byte)) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnButtons = f
+}
+
+// OnRing sets the function that is called when the
+// ring event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnRing(f func(ring *TabletPadRingV2)) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRing = f
+}
+
+// OnStrip sets the function that is called when the
+// strip event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnStrip(f func(strip *TabletPadStripV2)) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnStrip = f
+}
+
+// OnModes sets the function that is called when the
+// modes event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnModes(f func(modes uint32)) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnModes = f
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnDone(f func()) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// OnModeSwitch sets the function that is called when the
+// mode_switch event is received. If obj's Listener is not a
+// *TabletPadGroupV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadGroupV2) OnModeSwitch(f func(time uint32, serial uint32, mode uint32)) {
+	lis, ok := obj.Listener.(*TabletPadGroupV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadGroupV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnModeSwitch = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletPadGroupV2) Subscribe() *wire.Messages[TabletPadGroupV2Event] {
+	q := new(wire.Messages[TabletPadGroupV2Event])
+	obj.Listener = TabletPadGroupV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletPadGroupV2) Version() uint32 {
+	return TabletPadGroupV2Version
+}
+
+// Destroy the wp_tablet_pad_group object. Objects created from this object
+// are unaffected and should be destroyed separately.
+func (obj *TabletPadGroupV2) Destroy() {
+	obj.state.Enqueue(TabletPadGroupV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletPadGroupV2ButtonsEvent holds the arguments of the buttons event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2ButtonsEvent struct {
+	Buttons []byte
+}
+
+func (TabletPadGroupV2ButtonsEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2ButtonsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Buttons = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2ButtonsEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteArray(m.Buttons)
+
+	builder.Method = "buttons"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Buttons}
+	}
+	return builder
+}
+
+// TabletPadGroupV2RingEvent holds the arguments of the ring event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2RingEvent struct {
+	Ring *TabletPadRingV2
+}
+
+func (TabletPadGroupV2RingEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2RingEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Ring = NewTabletPadRingV2(state)
+	m.Ring.SetID(wire.CheckNewID(msg, state, TabletPadRingV2Interface, msg.ReadNewObject(TabletPadRingV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Ring)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2RingEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Ring)
+
+	builder.Method = "ring"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletPadRingV2Interface, ID: m.Ring.ID()}}
+	}
+	return builder
+}
+
+// TabletPadGroupV2StripEvent holds the arguments of the strip event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2StripEvent struct {
+	Strip *TabletPadStripV2
+}
+
+func (TabletPadGroupV2StripEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2StripEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Strip = NewTabletPadStripV2(state)
+	m.Strip.SetID(wire.CheckNewID(msg, state, TabletPadStripV2Interface, msg.ReadNewObject(TabletPadStripV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Strip)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2StripEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Strip)
+
+	builder.Method = "strip"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletPadStripV2Interface, ID: m.Strip.ID()}}
+	}
+	return builder
+}
+
+// TabletPadGroupV2ModesEvent holds the arguments of the modes event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2ModesEvent struct {
+	Modes uint32
+}
+
+func (TabletPadGroupV2ModesEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2ModesEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Modes = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2ModesEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Modes)
+
+	builder.Method = "modes"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Modes}
+	}
+	return builder
+}
+
+// TabletPadGroupV2DoneEvent holds the arguments of the done event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2DoneEvent struct {
+}
+
+func (TabletPadGroupV2DoneEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2DoneEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadGroupV2ModeSwitchEvent holds the arguments of the mode_switch event of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2ModeSwitchEvent struct {
+	Time   uint32
+	Serial uint32
+	Mode   uint32
+}
+
+func (TabletPadGroupV2ModeSwitchEvent) tabletPadGroupV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2ModeSwitchEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = msg.ReadUint()
+	m.Serial = msg.ReadUint()
+	m.Mode = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2ModeSwitchEvent) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(m.Time)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(m.Mode)
+
+	builder.Method = "mode_switch"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Time, m.Serial, m.Mode}
+	}
+	return builder
+}
+
+// TabletPadGroupV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_pad_group_v2 interface.
+type TabletPadGroupV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadGroupV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadGroupV2DestroyRequest) Encode(obj *TabletPadGroupV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+const (
+	TabletPadV2Interface = shared.TabletPadV2Interface
+
+	// TabletPadV2Version is the version of the interface that this
+	// package implements.
+	TabletPadV2Version = 1
+)
+
+// The versions of the zwp_tablet_pad_v2 interface that its messages were
+// added in.
+const (
+	TabletPadV2SetFeedbackRequestSince = 1
+	TabletPadV2DestroyRequestSince     = 1
+	TabletPadV2GroupEventSince         = 1
+	TabletPadV2PathEventSince          = 1
+	TabletPadV2ButtonsEventSince       = 1
+	TabletPadV2DoneEventSince          = 1
+	TabletPadV2ButtonEventSince        = 1
+	TabletPadV2EnterEventSince         = 1
+	TabletPadV2LeaveEventSince         = 1
+	TabletPadV2RemovedEventSince       = 1
+)
+
+// TabletPadV2Desc describes the zwp_tablet_pad_v2 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TabletPadV2Desc = &wire.InterfaceDesc{
+	Name:    TabletPadV2Interface,
+	Version: TabletPadV2Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_feedback",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "button",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "description",
+					Type: wire.ArgString,
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "group",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "pad_group",
+					Type:      wire.ArgNewID,
+					Interface: "zwp_tablet_pad_group_v2",
+				},
+			},
+		},
+		{
+			Name:  "path",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "path",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "buttons",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "buttons",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "time",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "button",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "state",
+					Type: wire.ArgUint,
+					Enum: "button_state",
+				},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "tablet",
+					Type:      wire.ArgObject,
+					Interface: "zwp_tablet_v2",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "removed",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "button_state",
+			Entries: []wire.EntryDesc{
+				{Name: "released", Value: 0},
+				{Name: "pressed", Value: 1},
+			},
+		},
+	},
+}
+
+// TabletPadV2Listener is a type that can respond to incoming
+// messages for a TabletPadV2 object.
+type TabletPadV2Listener interface {
+	// Sent on wp_tablet_pad initialization to announce available groups.
+	// One event is sent for each pad group available.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad.done event. At least one group will be announced.
+	Group(padGroup *TabletPadGroupV2)
+
+	// A system-specific device path that indicates which device is behind
+	// this wp_tablet_pad. This information may be used to gather additional
+	// information about the device, e.g. through libwacom.
+	//
+	// The format of the path is unspecified, it may be a device node, a
+	// sysfs path, or some other identifier. It is up to the client to
+	// identify the string provided.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad.done event.
+	Path(path string)
+
+	// Sent on wp_tablet_pad initialization to announce the available
+	// buttons.
+	//
+	// This event is sent in the initial burst of events before the
+	// wp_tablet_pad.done event. This event is only sent when at least one
+	// button is available.
+	Buttons(buttons uint32)
+
+	// This event signals the end of the initial burst of descriptive
+	// events. A client may consider the static description of the pad to
+	// be complete and finalize initialization of the pad.
+	Done()
+
+	// Sent whenever the physical state of a button changes.
+	Button(time uint32, button uint32, state TabletPadV2ButtonState)
+
+	// Notification that this pad is focused on the specified surface.
+	Enter(serial uint32, tablet *TabletV2, surface *wl.Surface)
+
+	// Notification that this pad is no longer focused on the specified
+	// surface.
+	Leave(serial uint32, surface *wl.Surface)
+
+	// Sent when the pad has been removed from the system. When a tablet
+	// is removed its pad(s) will be removed too.
+	//
+	// When this event is received, the client must destroy all rings, strips
+	// and groups that were offered by this pad, and issue wp_tablet_pad.destroy
+	// the pad itself.
+	Removed()
+}
+
+// TabletPadV2ListenerFuncs implements TabletPadV2Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TabletPadV2ListenerFuncs struct {
+	OnGroup   func(padGroup *TabletPadGroupV2)
+	OnPath    func(path string)
+	OnButtons func(buttons uint32)
+	OnDone    func()
+	OnButton  func(time uint32, button uint32, state TabletPadV2ButtonState)
+	OnEnter   func(serial uint32, tablet *TabletV2, surface *wl.Surface)
+	OnLeave   func(serial uint32, surface *wl.Surface)
+	OnRemoved func()
+}
+
+func (lis *TabletPadV2ListenerFuncs) Group(padGroup *TabletPadGroupV2) {
+	if lis.OnGroup != nil {
+		lis.OnGroup(padGroup)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Path(path string) {
+	if lis.OnPath != nil {
+		lis.OnPath(path)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Buttons(buttons uint32) {
+	if lis.OnButtons != nil {
+		lis.OnButtons(buttons)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Done() {
+	if lis.OnDone != nil {
+		lis.OnDone()
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Button(time uint32, button uint32, state TabletPadV2ButtonState) {
+	if lis.OnButton != nil {
+		lis.OnButton(time, button, state)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Enter(serial uint32, tablet *TabletV2, surface *wl.Surface) {
+	if lis.OnEnter != nil {
+		lis.OnEnter(serial, tablet, surface)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Leave(serial uint32, surface *wl.Surface) {
+	if lis.OnLeave != nil {
+		lis.OnLeave(serial, surface)
+	}
+}
+
+func (lis *TabletPadV2ListenerFuncs) Removed() {
+	if lis.OnRemoved != nil {
+		lis.OnRemoved()
+	}
+}
+
+// TabletPadV2Event is implemented by the message types of all
+// of the events that a TabletPadV2 can receive.
+type TabletPadV2Event interface {
+	tabletPadV2Event()
+}
+
+// TabletPadV2EventFunc implements TabletPadV2Listener by calling
+// itself with each incoming message.
+type TabletPadV2EventFunc func(TabletPadV2Event)
+
+func (f TabletPadV2EventFunc) Group(padGroup *TabletPadGroupV2) {
+	f(TabletPadV2GroupEvent{
+		PadGroup: padGroup,
+	})
+}
+
+func (f TabletPadV2EventFunc) Path(path string) {
+	f(TabletPadV2PathEvent{
+		Path: path,
+	})
+}
+
+func (f TabletPadV2EventFunc) Buttons(buttons uint32) {
+	f(TabletPadV2ButtonsEvent{
+		Buttons: buttons,
+	})
+}
+
+func (f TabletPadV2EventFunc) Done() {
+	f(TabletPadV2DoneEvent{})
+}
+
+func (f TabletPadV2EventFunc) Button(time uint32, button uint32, state TabletPadV2ButtonState) {
+	f(TabletPadV2ButtonEvent{
+		Time:   time,
+		Button: button,
+		State:  state,
+	})
+}
+
+func (f TabletPadV2EventFunc) Enter(serial uint32, tablet *TabletV2, surface *wl.Surface) {
+	f(TabletPadV2EnterEvent{
+		Serial:  serial,
+		Tablet:  tablet,
+		Surface: surface,
+	})
+}
+
+func (f TabletPadV2EventFunc) Leave(serial uint32, surface *wl.Surface) {
+	f(TabletPadV2LeaveEvent{
+		Serial:  serial,
+		Surface: surface,
+	})
+}
+
+func (f TabletPadV2EventFunc) Removed() {
+	f(TabletPadV2RemovedEvent{})
+}
+
+// A pad device is a set of buttons, rings and strips
+// usually physically present on the tablet device itself. Some
+// exceptions exist where the pad device is physically detached, e.g. the
+// Wacom ExpressKey Remote.
+//
+// Pad devices have no axes that control the cursor and are generally
+// auxiliary devices to the tool devices used on the tablet surface.
+//
+// A pad device has a number of static characteristics, e.g. the number
+// of rings. These capabilities are sent in an event sequence after the
+// wp_tablet_seat.pad_added event before any actual events from this pad.
+// This initial event sequence is terminated by a wp_tablet_pad.done
+// event.
+//
+// All pad features (buttons, rings and strips) are logically divided into
+// groups and all pads have at least one group. The available groups are
+// notified through the wp_tablet_pad.group event; the compositor will
+// emit one event per group before emitting wp_tablet_pad.done.
+//
+// Groups may have multiple modes. Modes allow clients to map multiple
+// actions to a single pad feature. Only one mode can be active per group,
+// although different groups may have different active modes.
+type TabletPadV2 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TabletPadV2Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTabletPadV2 returns a newly instantiated TabletPadV2. It is
+// primarily intended for use by generated code.
+func NewTabletPadV2(state wire.State) *TabletPadV2 {
+	return &TabletPadV2{state: state}
+}
+
+func (obj *TabletPadV2) State() wire.State {
+	return obj.state
+}
+
+func (obj *TabletPadV2) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TabletPadV2GroupEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Group(
+			m.PadGroup,
+		)
+		return nil
+
+	case 1:
+		var m TabletPadV2PathEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Path(
+			m.Path,
+		)
+		return nil
+
+	case 2:
+		var m TabletPadV2ButtonsEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Buttons(
+			m.Buttons,
+		)
+		return nil
+
+	case 3:
+		var m TabletPadV2DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done()
+		return nil
+
+	case 4:
+		var m TabletPadV2ButtonEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Button(
+			m.Time,
+			m.Button,
+			m.State,
+		)
+		return nil
+
+	case 5:
+		var m TabletPadV2EnterEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Enter(
+			m.Serial,
+			m.Tablet,
+			m.Surface,
+		)
+		return nil
+
+	case 6:
+		var m TabletPadV2LeaveEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Leave(
+			m.Serial,
+			m.Surface,
+		)
+		return nil
+
+	case 7:
+		var m TabletPadV2RemovedEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Removed()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TabletPadV2Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zwp_tablet_pad_v2",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TabletPadV2) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TabletPadV2) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TabletPadV2) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TabletPadV2) String() string {
+	return fmt.Sprintf("%v(%v)", "zwp_tablet_pad_v2", obj.id)
+}
+
+func (obj *TabletPadV2) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "group"
+
+	case 1:
+		return "path"
+
+	case 2:
+		return "buttons"
+
+	case 3:
+		return "done"
+
+	case 4:
+		return "button"
+
+	case 5:
+		return "enter"
+
+	case 6:
+		return "leave"
+
+	case 7:
+		return "removed"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TabletPadV2) Interface() string {
+	return TabletPadV2Interface
+}
+
+// OnGroup sets the function that is called when the
+// group event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnGroup(f func(padGroup *TabletPadGroupV2)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGroup = f
+}
+
+// OnPath sets the function that is called when the
+// path event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnPath(f func(path string)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPath = f
+}
+
+// OnButtons sets the function that is called when the
+// buttons event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnButtons(f func(buttons uint32)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnButtons = f
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnDone(f func()) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// OnButton sets the function that is called when the
+// button event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnButton(f func(time uint32, button uint32, state TabletPadV2ButtonState)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnButton = f
+}
+
+// OnEnter sets the function that is called when the
+// enter event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnEnter(f func(serial uint32, tablet *TabletV2, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnEnter = f
+}
+
+// OnLeave sets the function that is called when the
+// leave event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnLeave(f func(serial uint32, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnLeave = f
+}
+
+// OnRemoved sets the function that is called when the
+// removed event is received. If obj's Listener is not a
+// *TabletPadV2ListenerFuncs, it is replaced with one.
+func (obj *TabletPadV2) OnRemoved(f func()) {
+	lis, ok := obj.Listener.(*TabletPadV2ListenerFuncs)
+	if !ok {
+		lis = new(TabletPadV2ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnRemoved = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TabletPadV2) Subscribe() *wire.Messages[TabletPadV2Event] {
+	q := new(wire.Messages[TabletPadV2Event])
+	obj.Listener = TabletPadV2EventFunc(q.Push)
+	return q
+}
+
+func (obj *TabletPadV2) Version() uint32 {
+	return TabletPadV2Version
+}
+
+// Requests the compositor to use the provided feedback string
+// associated with this button. This request should be issued immediately
+// after a wp_tablet_pad_group.mode_switch event from the corresponding
+// group is received, or whenever a button is mapped to a different
+// action. See wp_tablet_pad_group.mode_switch for more details.
+//
+// Clients are encouraged to provide context-aware descriptions for
+// the actions associated with each button, and compositors may use
+// this information to offer visual feedback on the button layout
+// (e.g. on-screen displays).
+//
+// Button indices start at 0. Setting the feedback string on a button
+// that is reserved by the compositor (i.e. not belonging to any
+// wp_tablet_pad_group) does not generate an error but the compositor
+// is free to ignore the request.
+//
+// The provided string 'description' is a UTF-8 encoded string to be
+// associated with this ring, and is considered user-visible; general
+// internationalization rules apply.
+//
+// The serial argument will be that of the last
+// wp_tablet_pad_group.mode_switch event received for the group of this
+// button. Requests providing other serials than the most recent one will
+// be ignored.
+func (obj *TabletPadV2) SetFeedback(button uint32, description string, serial uint32) {
+	obj.state.Enqueue(TabletPadV2SetFeedbackRequest{
+		Button:      button,
+		Description: description,
+		Serial:      serial,
+	}.Encode(obj))
+	return
+}
+
+// Destroy the wp_tablet_pad object. Objects created from this object
+// are unaffected and should be destroyed separately.
+func (obj *TabletPadV2) Destroy() {
+	obj.state.Enqueue(TabletPadV2DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TabletPadV2GroupEvent holds the arguments of the group event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2GroupEvent struct {
+	PadGroup *TabletPadGroupV2
+}
+
+func (TabletPadV2GroupEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2GroupEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.PadGroup = NewTabletPadGroupV2(state)
+	m.PadGroup.SetID(wire.CheckNewID(msg, state, TabletPadGroupV2Interface, msg.ReadNewObject(TabletPadGroupV2Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.PadGroup)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2GroupEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteObject(m.PadGroup)
+
+	builder.Method = "group"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TabletPadGroupV2Interface, ID: m.PadGroup.ID()}}
+	}
+	return builder
+}
+
+// TabletPadV2PathEvent holds the arguments of the path event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2PathEvent struct {
+	Path string
+}
+
+func (TabletPadV2PathEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2PathEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Path = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2PathEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.Path)
+
+	builder.Method = "path"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Path}
+	}
+	return builder
+}
+
+// TabletPadV2ButtonsEvent holds the arguments of the buttons event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2ButtonsEvent struct {
+	Buttons uint32
+}
+
+func (TabletPadV2ButtonsEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2ButtonsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Buttons = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2ButtonsEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(m.Buttons)
+
+	builder.Method = "buttons"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Buttons}
+	}
+	return builder
+}
+
+// TabletPadV2DoneEvent holds the arguments of the done event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2DoneEvent struct {
+}
+
+func (TabletPadV2DoneEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2DoneEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadV2ButtonEvent holds the arguments of the button event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2ButtonEvent struct {
+	Time   uint32
+	Button uint32
+	State  TabletPadV2ButtonState
+}
+
+func (TabletPadV2ButtonEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2ButtonEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Time = msg.ReadUint()
+	m.Button = msg.ReadUint()
+	m.State = TabletPadV2ButtonState(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2ButtonEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(m.Time)
+	builder.WriteUint(m.Button)
+	builder.WriteUint(uint32(m.State))
+
+	builder.Method = "button"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Time, m.Button, m.State}
+	}
+	return builder
+}
+
+// TabletPadV2EnterEvent holds the arguments of the enter event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2EnterEvent struct {
+	Serial  uint32
+	Tablet  *TabletV2
+	Surface *wl.Surface
+}
+
+func (TabletPadV2EnterEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2EnterEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Tablet = wire.ResolveObject[*TabletV2](msg, state, TabletV2Interface, msg.ReadObject())
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2EnterEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Tablet)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "enter"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Tablet, m.Surface}
+	}
+	return builder
+}
+
+// TabletPadV2LeaveEvent holds the arguments of the leave event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2LeaveEvent struct {
+	Serial  uint32
+	Surface *wl.Surface
+}
+
+func (TabletPadV2LeaveEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2LeaveEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2LeaveEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "leave"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Surface}
+	}
+	return builder
+}
+
+// TabletPadV2RemovedEvent holds the arguments of the removed event of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2RemovedEvent struct {
+}
+
+func (TabletPadV2RemovedEvent) tabletPadV2Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2RemovedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2RemovedEvent) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+
+	builder.Method = "removed"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadV2SetFeedbackRequest holds the arguments of the set_feedback request of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2SetFeedbackRequest struct {
+	Button      uint32
+	Description string
+	Serial      uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2SetFeedbackRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Button = msg.ReadUint()
+	m.Description = msg.ReadString()
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2SetFeedbackRequest) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Button)
+	builder.WriteString(m.Description)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_feedback"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Button, m.Description, m.Serial}
+	}
+	return builder
+}
+
+// TabletPadV2DestroyRequest holds the arguments of the destroy request of
+// the zwp_tablet_pad_v2 interface.
+type TabletPadV2DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TabletPadV2DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TabletPadV2DestroyRequest) Encode(obj *TabletPadV2) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TabletPadV2ButtonState is an alias for [shared.TabletPadV2ButtonState].
+type TabletPadV2ButtonState = shared.TabletPadV2ButtonState
+
+const (
+	TabletPadV2ButtonStateReleased = shared.TabletPadV2ButtonStateReleased
+	TabletPadV2ButtonStatePressed  = shared.TabletPadV2ButtonStatePressed
+)
+
+func init() {
+	wire.RegisterInterface(TabletManagerV2Desc, true)
+	wire.RegisterInterface(TabletSeatV2Desc, true)
+	wire.RegisterInterface(TabletToolV2Desc, true)
+	wire.RegisterInterface(TabletV2Desc, true)
+	wire.RegisterInterface(TabletPadRingV2Desc, true)
+	wire.RegisterInterface(TabletPadStripV2Desc, true)
+	wire.RegisterInterface(TabletPadGroupV2Desc, true)
+	wire.RegisterInterface(TabletPadV2Desc, true)
+}
@@ -0,0 +1,340 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package tablet
+
+const (
+	TabletManagerV2Interface = "zwp_tablet_manager_v2"
+	TabletManagerV2Version   = 1
+)
+
+const (
+	TabletSeatV2Interface = "zwp_tablet_seat_v2"
+	TabletSeatV2Version   = 1
+)
+
+const (
+	TabletToolV2Interface = "zwp_tablet_tool_v2"
+	TabletToolV2Version   = 1
+)
+
+// Describes the physical type of a tool. The physical type of a tool
+// generally defines its base usage.
+//
+// The mouse tool represents a mouse-shaped tool that is not a relative
+// device but bound to the tablet's surface, providing absolute
+// coordinates.
+//
+// The lens tool is a mouse-shaped tool with an attached lens to
+// provide precision focus.
+type TabletToolV2Type int64
+
+const (
+	// Pen
+	TabletToolV2TypePen TabletToolV2Type = 320
+
+	// Eraser
+	TabletToolV2TypeEraser TabletToolV2Type = 321
+
+	// Brush
+	TabletToolV2TypeBrush TabletToolV2Type = 322
+
+	// Pencil
+	TabletToolV2TypePencil TabletToolV2Type = 323
+
+	// Airbrush
+	TabletToolV2TypeAirbrush TabletToolV2Type = 324
+
+	// Finger
+	TabletToolV2TypeFinger TabletToolV2Type = 325
+
+	// Mouse
+	TabletToolV2TypeMouse TabletToolV2Type = 326
+
+	// Lens
+	TabletToolV2TypeLens TabletToolV2Type = 327
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletToolV2Type) Valid() bool {
+	switch enum {
+	case 320, 321, 322, 323, 324, 325, 326, 327:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletToolV2Type) String() string {
+	switch enum {
+	case 320:
+		return "TabletToolV2TypePen"
+
+	case 321:
+		return "TabletToolV2TypeEraser"
+
+	case 322:
+		return "TabletToolV2TypeBrush"
+
+	case 323:
+		return "TabletToolV2TypePencil"
+
+	case 324:
+		return "TabletToolV2TypeAirbrush"
+
+	case 325:
+		return "TabletToolV2TypeFinger"
+
+	case 326:
+		return "TabletToolV2TypeMouse"
+
+	case 327:
+		return "TabletToolV2TypeLens"
+	}
+
+	return "<invalid TabletToolV2Type>"
+}
+
+// Describes extra capabilities on a tablet.
+//
+// Any tool must provide x and y values, extra axes are
+// device-specific.
+type TabletToolV2Capability int64
+
+const (
+	// Tilt axes
+	TabletToolV2CapabilityTilt TabletToolV2Capability = 1
+
+	// Pressure axis
+	TabletToolV2CapabilityPressure TabletToolV2Capability = 2
+
+	// Distance axis
+	TabletToolV2CapabilityDistance TabletToolV2Capability = 3
+
+	// Z-rotation axis
+	TabletToolV2CapabilityRotation TabletToolV2Capability = 4
+
+	// Slider axis
+	TabletToolV2CapabilitySlider TabletToolV2Capability = 5
+
+	// Wheel axis
+	TabletToolV2CapabilityWheel TabletToolV2Capability = 6
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletToolV2Capability) Valid() bool {
+	switch enum {
+	case 1, 2, 3, 4, 5, 6:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletToolV2Capability) String() string {
+	switch enum {
+	case 1:
+		return "TabletToolV2CapabilityTilt"
+
+	case 2:
+		return "TabletToolV2CapabilityPressure"
+
+	case 3:
+		return "TabletToolV2CapabilityDistance"
+
+	case 4:
+		return "TabletToolV2CapabilityRotation"
+
+	case 5:
+		return "TabletToolV2CapabilitySlider"
+
+	case 6:
+		return "TabletToolV2CapabilityWheel"
+	}
+
+	return "<invalid TabletToolV2Capability>"
+}
+
+// Describes the physical state of a button that produced the button event.
+type TabletToolV2ButtonState int64
+
+const (
+	// button is not pressed
+	TabletToolV2ButtonStateReleased TabletToolV2ButtonState = 0
+
+	// button is pressed
+	TabletToolV2ButtonStatePressed TabletToolV2ButtonState = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletToolV2ButtonState) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletToolV2ButtonState) String() string {
+	switch enum {
+	case 0:
+		return "TabletToolV2ButtonStateReleased"
+
+	case 1:
+		return "TabletToolV2ButtonStatePressed"
+	}
+
+	return "<invalid TabletToolV2ButtonState>"
+}
+
+type TabletToolV2Error int64
+
+const (
+	// given wl_surface has another role
+	TabletToolV2ErrorRole TabletToolV2Error = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletToolV2Error) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletToolV2Error) String() string {
+	switch enum {
+	case 0:
+		return "TabletToolV2ErrorRole"
+	}
+
+	return "<invalid TabletToolV2Error>"
+}
+
+const (
+	TabletV2Interface = "zwp_tablet_v2"
+	TabletV2Version   = 1
+)
+
+const (
+	TabletPadRingV2Interface = "zwp_tablet_pad_ring_v2"
+	TabletPadRingV2Version   = 1
+)
+
+// Describes the source types for ring events. This indicates to the
+// client how a ring event was physically generated; a client may
+// adjust the user interface accordingly. For example, events
+// from a "finger" source may trigger kinetic scrolling.
+type TabletPadRingV2Source int64
+
+const (
+	// finger
+	TabletPadRingV2SourceFinger TabletPadRingV2Source = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletPadRingV2Source) Valid() bool {
+	switch enum {
+	case 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletPadRingV2Source) String() string {
+	switch enum {
+	case 1:
+		return "TabletPadRingV2SourceFinger"
+	}
+
+	return "<invalid TabletPadRingV2Source>"
+}
+
+const (
+	TabletPadStripV2Interface = "zwp_tablet_pad_strip_v2"
+	TabletPadStripV2Version   = 1
+)
+
+// Describes the source types for strip events. This indicates to the
+// client how a strip event was physically generated; a client may
+// adjust the user interface accordingly. For example, events
+// from a "finger" source may trigger kinetic scrolling.
+type TabletPadStripV2Source int64
+
+const (
+	// finger
+	TabletPadStripV2SourceFinger TabletPadStripV2Source = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletPadStripV2Source) Valid() bool {
+	switch enum {
+	case 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletPadStripV2Source) String() string {
+	switch enum {
+	case 1:
+		return "TabletPadStripV2SourceFinger"
+	}
+
+	return "<invalid TabletPadStripV2Source>"
+}
+
+const (
+	TabletPadGroupV2Interface = "zwp_tablet_pad_group_v2"
+	TabletPadGroupV2Version   = 1
+)
+
+const (
+	TabletPadV2Interface = "zwp_tablet_pad_v2"
+	TabletPadV2Version   = 1
+)
+
+// Describes the physical state of a button that caused the button
+// event.
+type TabletPadV2ButtonState int64
+
+const (
+	// the button is not pressed
+	TabletPadV2ButtonStateReleased TabletPadV2ButtonState = 0
+
+	// the button is pressed
+	TabletPadV2ButtonStatePressed TabletPadV2ButtonState = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TabletPadV2ButtonState) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum TabletPadV2ButtonState) String() string {
+	switch enum {
+	case 0:
+		return "TabletPadV2ButtonStateReleased"
+
+	case 1:
+		return "TabletPadV2ButtonStatePressed"
+	}
+
+	return "<invalid TabletPadV2ButtonState>"
+}
@@ -0,0 +1,470 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package tearing
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/tearing"
+	"fmt"
+)
+
+const (
+	TearingControlManagerV1Interface = shared.TearingControlManagerV1Interface
+
+	// TearingControlManagerV1Version is the version of the interface that this
+	// package implements.
+	TearingControlManagerV1Version = 1
+)
+
+// The versions of the wp_tearing_control_manager_v1 interface that its messages were
+// added in.
+const (
+	TearingControlManagerV1DestroyRequestSince           = 1
+	TearingControlManagerV1GetTearingControlRequestSince = 1
+)
+
+// TearingControlManagerV1Desc describes the wp_tearing_control_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TearingControlManagerV1Desc = &wire.InterfaceDesc{
+	Name:    TearingControlManagerV1Interface,
+	Version: TearingControlManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_tearing_control",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wp_tearing_control_v1",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "tearing_control_exists", Value: 0},
+			},
+		},
+	},
+}
+
+// For some use cases like games or drawing tablets it can make sense to
+// reduce latency by accepting tearing with the use of asynchronous page
+// flips. This global is a factory interface, allowing clients to inform
+// which type of presentation the content of their surfaces is suitable for.
+//
+// Graphics APIs like EGL or Vulkan, that manage the buffer queue and commits
+// of a wl_surface themselves, are likely to be using this extension
+// internally. If a client is using such an API for a wl_surface, it should
+// not directly use this extension on that surface, to avoid raising a
+// tearing_control_exists protocol error.
+//
+// Warning! The protocol described in this file is currently in the testing
+// phase. Backward compatible changes may be added together with the
+// corresponding interface version bump. Backward incompatible changes can
+// only be done by creating a new major version of the extension.
+type TearingControlManagerV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTearingControlManagerV1 returns a newly instantiated TearingControlManagerV1. It is
+// primarily intended for use by generated code.
+func NewTearingControlManagerV1(state wire.State) *TearingControlManagerV1 {
+	return &TearingControlManagerV1{state: state}
+}
+
+func BindTearingControlManagerV1(state wire.State, registry wire.Binder, name, version uint32) *TearingControlManagerV1 {
+	obj := NewTearingControlManagerV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: TearingControlManagerV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *TearingControlManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *TearingControlManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TearingControlManagerV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_tearing_control_manager_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TearingControlManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TearingControlManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TearingControlManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TearingControlManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_tearing_control_manager_v1", obj.id)
+}
+
+func (obj *TearingControlManagerV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *TearingControlManagerV1) Interface() string {
+	return TearingControlManagerV1Interface
+}
+
+func (obj *TearingControlManagerV1) Version() uint32 {
+	return TearingControlManagerV1Version
+}
+
+// Destroy this tearing control factory object. Other objects, including
+// wp_tearing_control_v1 objects created by this factory, are not affected
+// by this request.
+func (obj *TearingControlManagerV1) Destroy() {
+	obj.state.Enqueue(TearingControlManagerV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Instantiate an interface extension for the given wl_surface to request
+// asynchronous page flips for presentation.
+//
+// If the given wl_surface already has a wp_tearing_control_v1 object
+// associated, the tearing_control_exists protocol error is raised.
+func (obj *TearingControlManagerV1) GetTearingControl(surface *wl.Surface) (id *TearingControlV1) {
+	id = NewTearingControlV1(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(TearingControlManagerV1GetTearingControlRequest{
+		Id:      id,
+		Surface: surface,
+	}.Encode(obj))
+	return id
+}
+
+// TearingControlManagerV1DestroyRequest holds the arguments of the destroy request of
+// the wp_tearing_control_manager_v1 interface.
+type TearingControlManagerV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TearingControlManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlManagerV1DestroyRequest) Encode(obj *TearingControlManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TearingControlManagerV1GetTearingControlRequest holds the arguments of the get_tearing_control request of
+// the wp_tearing_control_manager_v1 interface.
+type TearingControlManagerV1GetTearingControlRequest struct {
+	Id      *TearingControlV1
+	Surface *wl.Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TearingControlManagerV1GetTearingControlRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTearingControlV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TearingControlV1Interface, msg.ReadNewObject(TearingControlV1Interface), false))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlManagerV1GetTearingControlRequest) Encode(obj *TearingControlManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_tearing_control"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TearingControlV1Interface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+// TearingControlManagerV1Error is an alias for [shared.TearingControlManagerV1Error].
+type TearingControlManagerV1Error = shared.TearingControlManagerV1Error
+
+const (
+	TearingControlManagerV1ErrorTearingControlExists = shared.TearingControlManagerV1ErrorTearingControlExists
+)
+
+const (
+	TearingControlV1Interface = shared.TearingControlV1Interface
+
+	// TearingControlV1Version is the version of the interface that this
+	// package implements.
+	TearingControlV1Version = 1
+)
+
+// The versions of the wp_tearing_control_v1 interface that its messages were
+// added in.
+const (
+	TearingControlV1SetPresentationHintRequestSince = 1
+	TearingControlV1DestroyRequestSince             = 1
+)
+
+// TearingControlV1Desc describes the wp_tearing_control_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TearingControlV1Desc = &wire.InterfaceDesc{
+	Name:    TearingControlV1Interface,
+	Version: TearingControlV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_presentation_hint",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "hint",
+					Type: wire.ArgUint,
+					Enum: "presentation_hint",
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "presentation_hint",
+			Entries: []wire.EntryDesc{
+				{Name: "vsync", Value: 0},
+				{Name: "async", Value: 1},
+			},
+		},
+	},
+}
+
+// An additional interface to a wl_surface object, which allows the client
+// to hint to the compositor if the content on the surface is suitable for
+// presentation with tearing.
+// The default presentation hint is vsync. See presentation_hint for more
+// details.
+//
+// If the associated wl_surface is destroyed, this object becomes inert and
+// should be destroyed.
+type TearingControlV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTearingControlV1 returns a newly instantiated TearingControlV1. It is
+// primarily intended for use by generated code.
+func NewTearingControlV1(state wire.State) *TearingControlV1 {
+	return &TearingControlV1{state: state}
+}
+
+func (obj *TearingControlV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *TearingControlV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TearingControlV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_tearing_control_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TearingControlV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TearingControlV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TearingControlV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TearingControlV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_tearing_control_v1", obj.id)
+}
+
+func (obj *TearingControlV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *TearingControlV1) Interface() string {
+	return TearingControlV1Interface
+}
+
+func (obj *TearingControlV1) Version() uint32 {
+	return TearingControlV1Version
+}
+
+// Set the presentation hint for the associated wl_surface. This state is
+// double-buffered, see wl_surface.commit.
+//
+// The compositor is free to dynamically respect or ignore this hint based
+// on various conditions like hardware capabilities, surface state and
+// user preferences.
+func (obj *TearingControlV1) SetPresentationHint(hint TearingControlV1PresentationHint) {
+	obj.state.Enqueue(TearingControlV1SetPresentationHintRequest{
+		Hint: hint,
+	}.Encode(obj))
+	return
+}
+
+// Destroy this surface tearing object and revert the presentation hint to
+// vsync. The change will be applied on the next wl_surface.commit.
+func (obj *TearingControlV1) Destroy() {
+	obj.state.Enqueue(TearingControlV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// TearingControlV1SetPresentationHintRequest holds the arguments of the set_presentation_hint request of
+// the wp_tearing_control_v1 interface.
+type TearingControlV1SetPresentationHintRequest struct {
+	Hint TearingControlV1PresentationHint
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TearingControlV1SetPresentationHintRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Hint = TearingControlV1PresentationHint(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlV1SetPresentationHintRequest) Encode(obj *TearingControlV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Hint))
+
+	builder.Method = "set_presentation_hint"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Hint}
+	}
+	return builder
+}
+
+// TearingControlV1DestroyRequest holds the arguments of the destroy request of
+// the wp_tearing_control_v1 interface.
+type TearingControlV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *TearingControlV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlV1DestroyRequest) Encode(obj *TearingControlV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TearingControlV1PresentationHint is an alias for [shared.TearingControlV1PresentationHint].
+type TearingControlV1PresentationHint = shared.TearingControlV1PresentationHint
+
+const (
+	TearingControlV1PresentationHintVsync = shared.TearingControlV1PresentationHintVsync
+	TearingControlV1PresentationHintAsync = shared.TearingControlV1PresentationHintAsync
+)
+
+func init() {
+	wire.RegisterInterface(TearingControlManagerV1Desc, true)
+	wire.RegisterInterface(TearingControlV1Desc, true)
+}
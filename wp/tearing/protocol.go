@@ -0,0 +1,73 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package tearing
+
+const (
+	TearingControlManagerV1Interface = "wp_tearing_control_manager_v1"
+	TearingControlManagerV1Version   = 1
+)
+
+type TearingControlManagerV1Error int64
+
+const (
+	// the surface already has a tearing object associated
+	TearingControlManagerV1ErrorTearingControlExists TearingControlManagerV1Error = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TearingControlManagerV1Error) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum TearingControlManagerV1Error) String() string {
+	switch enum {
+	case 0:
+		return "TearingControlManagerV1ErrorTearingControlExists"
+	}
+
+	return "<invalid TearingControlManagerV1Error>"
+}
+
+const (
+	TearingControlV1Interface = "wp_tearing_control_v1"
+	TearingControlV1Version   = 1
+)
+
+// This enum provides information for if submitted frames from the client
+// may be presented with tearing.
+type TearingControlV1PresentationHint int64
+
+const (
+	TearingControlV1PresentationHintVsync TearingControlV1PresentationHint = 0
+
+	TearingControlV1PresentationHintAsync TearingControlV1PresentationHint = 1
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum TearingControlV1PresentationHint) Valid() bool {
+	switch enum {
+	case 0, 1:
+		return true
+	}
+
+	return false
+}
+
+func (enum TearingControlV1PresentationHint) String() string {
+	switch enum {
+	case 0:
+		return "TearingControlV1PresentationHintVsync"
+
+	case 1:
+		return "TearingControlV1PresentationHintAsync"
+	}
+
+	return "<invalid TearingControlV1PresentationHint>"
+}
@@ -0,0 +1,701 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package tearing
+
+import (
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/tearing"
+	"fmt"
+)
+
+const (
+	TearingControlManagerV1Interface = shared.TearingControlManagerV1Interface
+
+	// TearingControlManagerV1Version is the version of the interface that this
+	// package implements.
+	TearingControlManagerV1Version = 1
+)
+
+// The versions of the wp_tearing_control_manager_v1 interface that its messages were
+// added in.
+const (
+	TearingControlManagerV1DestroyRequestSince           = 1
+	TearingControlManagerV1GetTearingControlRequestSince = 1
+)
+
+// TearingControlManagerV1Desc describes the wp_tearing_control_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TearingControlManagerV1Desc = &wire.InterfaceDesc{
+	Name:    TearingControlManagerV1Interface,
+	Version: TearingControlManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_tearing_control",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wp_tearing_control_v1",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "tearing_control_exists", Value: 0},
+			},
+		},
+	},
+}
+
+// TearingControlManagerV1Listener is a type that can respond to incoming
+// messages for a TearingControlManagerV1 object.
+type TearingControlManagerV1Listener interface {
+	// Destroy this tearing control factory object. Other objects, including
+	// wp_tearing_control_v1 objects created by this factory, are not affected
+	// by this request.
+	Destroy()
+
+	// Instantiate an interface extension for the given wl_surface to request
+	// asynchronous page flips for presentation.
+	//
+	// If the given wl_surface already has a wp_tearing_control_v1 object
+	// associated, the tearing_control_exists protocol error is raised.
+	GetTearingControl(id *TearingControlV1, surface *wl.Surface)
+}
+
+// TearingControlManagerV1ListenerFuncs implements TearingControlManagerV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TearingControlManagerV1ListenerFuncs struct {
+	OnDestroy           func()
+	OnGetTearingControl func(id *TearingControlV1, surface *wl.Surface)
+}
+
+func (lis *TearingControlManagerV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *TearingControlManagerV1ListenerFuncs) GetTearingControl(id *TearingControlV1, surface *wl.Surface) {
+	if lis.OnGetTearingControl != nil {
+		lis.OnGetTearingControl(id, surface)
+	}
+}
+
+// TearingControlManagerV1Request is implemented by the message types of all
+// of the requests that a TearingControlManagerV1 can receive.
+type TearingControlManagerV1Request interface {
+	tearingControlManagerV1Request()
+}
+
+// TearingControlManagerV1RequestFunc implements TearingControlManagerV1Listener by calling
+// itself with each incoming message.
+type TearingControlManagerV1RequestFunc func(TearingControlManagerV1Request)
+
+func (f TearingControlManagerV1RequestFunc) Destroy() {
+	f(TearingControlManagerV1DestroyRequest{})
+}
+
+func (f TearingControlManagerV1RequestFunc) GetTearingControl(id *TearingControlV1, surface *wl.Surface) {
+	f(TearingControlManagerV1GetTearingControlRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
+// For some use cases like games or drawing tablets it can make sense to
+// reduce latency by accepting tearing with the use of asynchronous page
+// flips. This global is a factory interface, allowing clients to inform
+// which type of presentation the content of their surfaces is suitable for.
+//
+// Graphics APIs like EGL or Vulkan, that manage the buffer queue and commits
+// of a wl_surface themselves, are likely to be using this extension
+// internally. If a client is using such an API for a wl_surface, it should
+// not directly use this extension on that surface, to avoid raising a
+// tearing_control_exists protocol error.
+//
+// Warning! The protocol described in this file is currently in the testing
+// phase. Backward compatible changes may be added together with the
+// corresponding interface version bump. Backward incompatible changes can
+// only be done by creating a new major version of the extension.
+type TearingControlManagerV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TearingControlManagerV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTearingControlManagerV1 returns a newly instantiated TearingControlManagerV1. It is
+// primarily intended for use by generated code.
+func NewTearingControlManagerV1(state wire.State) *TearingControlManagerV1 {
+	return &TearingControlManagerV1{state: state}
+}
+
+func BindTearingControlManagerV1(state wire.State, id wire.NewID) *TearingControlManagerV1 {
+	obj := NewTearingControlManagerV1(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *TearingControlManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *TearingControlManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TearingControlManagerV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m TearingControlManagerV1GetTearingControlRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetTearingControl(
+			m.Id,
+			m.Surface,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TearingControlManagerV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_tearing_control_manager_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TearingControlManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TearingControlManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TearingControlManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TearingControlManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_tearing_control_manager_v1", obj.id)
+}
+
+func (obj *TearingControlManagerV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "get_tearing_control"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TearingControlManagerV1) Interface() string {
+	return TearingControlManagerV1Interface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *TearingControlManagerV1ListenerFuncs, it is replaced with one.
+func (obj *TearingControlManagerV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*TearingControlManagerV1ListenerFuncs)
+	if !ok {
+		lis = new(TearingControlManagerV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetTearingControl sets the function that is called when the
+// get_tearing_control request is received. If obj's Listener is not a
+// *TearingControlManagerV1ListenerFuncs, it is replaced with one.
+func (obj *TearingControlManagerV1) OnGetTearingControl(f func(id *TearingControlV1, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*TearingControlManagerV1ListenerFuncs)
+	if !ok {
+		lis = new(TearingControlManagerV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetTearingControl = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TearingControlManagerV1) Subscribe() *wire.Messages[TearingControlManagerV1Request] {
+	q := new(wire.Messages[TearingControlManagerV1Request])
+	obj.Listener = TearingControlManagerV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *TearingControlManagerV1) Version() uint32 {
+	return TearingControlManagerV1Version
+}
+
+// TearingControlManagerV1DestroyRequest holds the arguments of the destroy request of
+// the wp_tearing_control_manager_v1 interface.
+type TearingControlManagerV1DestroyRequest struct {
+}
+
+func (TearingControlManagerV1DestroyRequest) tearingControlManagerV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TearingControlManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlManagerV1DestroyRequest) Encode(obj *TearingControlManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TearingControlManagerV1GetTearingControlRequest holds the arguments of the get_tearing_control request of
+// the wp_tearing_control_manager_v1 interface.
+type TearingControlManagerV1GetTearingControlRequest struct {
+	Id      *TearingControlV1
+	Surface *wl.Surface
+}
+
+func (TearingControlManagerV1GetTearingControlRequest) tearingControlManagerV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TearingControlManagerV1GetTearingControlRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewTearingControlV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, TearingControlV1Interface, msg.ReadNewObject(TearingControlV1Interface), true))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlManagerV1GetTearingControlRequest) Encode(obj *TearingControlManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_tearing_control"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: TearingControlV1Interface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+// TearingControlManagerV1Error is an alias for [shared.TearingControlManagerV1Error].
+type TearingControlManagerV1Error = shared.TearingControlManagerV1Error
+
+const (
+	TearingControlManagerV1ErrorTearingControlExists = shared.TearingControlManagerV1ErrorTearingControlExists
+)
+
+const (
+	TearingControlV1Interface = shared.TearingControlV1Interface
+
+	// TearingControlV1Version is the version of the interface that this
+	// package implements.
+	TearingControlV1Version = 1
+)
+
+// The versions of the wp_tearing_control_v1 interface that its messages were
+// added in.
+const (
+	TearingControlV1SetPresentationHintRequestSince = 1
+	TearingControlV1DestroyRequestSince             = 1
+)
+
+// TearingControlV1Desc describes the wp_tearing_control_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var TearingControlV1Desc = &wire.InterfaceDesc{
+	Name:    TearingControlV1Interface,
+	Version: TearingControlV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_presentation_hint",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "hint",
+					Type: wire.ArgUint,
+					Enum: "presentation_hint",
+				},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "presentation_hint",
+			Entries: []wire.EntryDesc{
+				{Name: "vsync", Value: 0},
+				{Name: "async", Value: 1},
+			},
+		},
+	},
+}
+
+// TearingControlV1Listener is a type that can respond to incoming
+// messages for a TearingControlV1 object.
+type TearingControlV1Listener interface {
+	// Set the presentation hint for the associated wl_surface. This state is
+	// double-buffered, see wl_surface.commit.
+	//
+	// The compositor is free to dynamically respect or ignore this hint based
+	// on various conditions like hardware capabilities, surface state and
+	// user preferences.
+	SetPresentationHint(hint TearingControlV1PresentationHint)
+
+	// Destroy this surface tearing object and revert the presentation hint to
+	// vsync. The change will be applied on the next wl_surface.commit.
+	Destroy()
+}
+
+// TearingControlV1ListenerFuncs implements TearingControlV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type TearingControlV1ListenerFuncs struct {
+	OnSetPresentationHint func(hint TearingControlV1PresentationHint)
+	OnDestroy             func()
+}
+
+func (lis *TearingControlV1ListenerFuncs) SetPresentationHint(hint TearingControlV1PresentationHint) {
+	if lis.OnSetPresentationHint != nil {
+		lis.OnSetPresentationHint(hint)
+	}
+}
+
+func (lis *TearingControlV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+// TearingControlV1Request is implemented by the message types of all
+// of the requests that a TearingControlV1 can receive.
+type TearingControlV1Request interface {
+	tearingControlV1Request()
+}
+
+// TearingControlV1RequestFunc implements TearingControlV1Listener by calling
+// itself with each incoming message.
+type TearingControlV1RequestFunc func(TearingControlV1Request)
+
+func (f TearingControlV1RequestFunc) SetPresentationHint(hint TearingControlV1PresentationHint) {
+	f(TearingControlV1SetPresentationHintRequest{
+		Hint: hint,
+	})
+}
+
+func (f TearingControlV1RequestFunc) Destroy() {
+	f(TearingControlV1DestroyRequest{})
+}
+
+// An additional interface to a wl_surface object, which allows the client
+// to hint to the compositor if the content on the surface is suitable for
+// presentation with tearing.
+// The default presentation hint is vsync. See presentation_hint for more
+// details.
+//
+// If the associated wl_surface is destroyed, this object becomes inert and
+// should be destroyed.
+type TearingControlV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener TearingControlV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewTearingControlV1 returns a newly instantiated TearingControlV1. It is
+// primarily intended for use by generated code.
+func NewTearingControlV1(state wire.State) *TearingControlV1 {
+	return &TearingControlV1{state: state}
+}
+
+func (obj *TearingControlV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *TearingControlV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m TearingControlV1SetPresentationHintRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetPresentationHint(
+			m.Hint,
+		)
+		return nil
+
+	case 1:
+		var m TearingControlV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(TearingControlV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_tearing_control_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *TearingControlV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *TearingControlV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *TearingControlV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *TearingControlV1) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_tearing_control_v1", obj.id)
+}
+
+func (obj *TearingControlV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "set_presentation_hint"
+
+	case 1:
+		return "destroy"
+	}
+
+	return "unknown method"
+}
+
+func (obj *TearingControlV1) Interface() string {
+	return TearingControlV1Interface
+}
+
+// OnSetPresentationHint sets the function that is called when the
+// set_presentation_hint request is received. If obj's Listener is not a
+// *TearingControlV1ListenerFuncs, it is replaced with one.
+func (obj *TearingControlV1) OnSetPresentationHint(f func(hint TearingControlV1PresentationHint)) {
+	lis, ok := obj.Listener.(*TearingControlV1ListenerFuncs)
+	if !ok {
+		lis = new(TearingControlV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetPresentationHint = f
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *TearingControlV1ListenerFuncs, it is replaced with one.
+func (obj *TearingControlV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*TearingControlV1ListenerFuncs)
+	if !ok {
+		lis = new(TearingControlV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *TearingControlV1) Subscribe() *wire.Messages[TearingControlV1Request] {
+	q := new(wire.Messages[TearingControlV1Request])
+	obj.Listener = TearingControlV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *TearingControlV1) Version() uint32 {
+	return TearingControlV1Version
+}
+
+// TearingControlV1SetPresentationHintRequest holds the arguments of the set_presentation_hint request of
+// the wp_tearing_control_v1 interface.
+type TearingControlV1SetPresentationHintRequest struct {
+	Hint TearingControlV1PresentationHint
+}
+
+func (TearingControlV1SetPresentationHintRequest) tearingControlV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TearingControlV1SetPresentationHintRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Hint = TearingControlV1PresentationHint(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Hint.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "wp_tearing_control_v1",
+			Method:    "set_presentation_hint",
+			Arg:       "hint",
+			Value:     int64(m.Hint),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlV1SetPresentationHintRequest) Encode(obj *TearingControlV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Hint))
+
+	builder.Method = "set_presentation_hint"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Hint}
+	}
+	return builder
+}
+
+// TearingControlV1DestroyRequest holds the arguments of the destroy request of
+// the wp_tearing_control_v1 interface.
+type TearingControlV1DestroyRequest struct {
+}
+
+func (TearingControlV1DestroyRequest) tearingControlV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *TearingControlV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m TearingControlV1DestroyRequest) Encode(obj *TearingControlV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// TearingControlV1PresentationHint is an alias for [shared.TearingControlV1PresentationHint].
+type TearingControlV1PresentationHint = shared.TearingControlV1PresentationHint
+
+const (
+	TearingControlV1PresentationHintVsync = shared.TearingControlV1PresentationHintVsync
+	TearingControlV1PresentationHintAsync = shared.TearingControlV1PresentationHintAsync
+)
+
+func init() {
+	wire.RegisterInterface(TearingControlManagerV1Desc, false)
+	wire.RegisterInterface(TearingControlV1Desc, false)
+}
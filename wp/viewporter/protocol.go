@@ -0,0 +1,85 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package viewporter
+
+const (
+	ViewporterInterface = "wp_viewporter"
+	ViewporterVersion   = 1
+)
+
+type ViewporterError int64
+
+const (
+	// the surface already has a viewport object associated
+	ViewporterErrorViewportExists ViewporterError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ViewporterError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum ViewporterError) String() string {
+	switch enum {
+	case 0:
+		return "ViewporterErrorViewportExists"
+	}
+
+	return "<invalid ViewporterError>"
+}
+
+const (
+	ViewportInterface = "wp_viewport"
+	ViewportVersion   = 1
+)
+
+type ViewportError int64
+
+const (
+	// negative or zero values in width or height
+	ViewportErrorBadValue ViewportError = 0
+
+	// destination size is not integer
+	ViewportErrorBadSize ViewportError = 1
+
+	// source rectangle extends outside of the content area
+	ViewportErrorOutOfBuffer ViewportError = 2
+
+	// the wl_surface was destroyed
+	ViewportErrorNoSurface ViewportError = 3
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ViewportError) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3:
+		return true
+	}
+
+	return false
+}
+
+func (enum ViewportError) String() string {
+	switch enum {
+	case 0:
+		return "ViewportErrorBadValue"
+
+	case 1:
+		return "ViewportErrorBadSize"
+
+	case 2:
+		return "ViewportErrorOutOfBuffer"
+
+	case 3:
+		return "ViewportErrorNoSurface"
+	}
+
+	return "<invalid ViewportError>"
+}
@@ -0,0 +1,872 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package viewporter
+
+import (
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/wp/viewporter"
+	"fmt"
+)
+
+const (
+	ViewporterInterface = shared.ViewporterInterface
+
+	// ViewporterVersion is the version of the interface that this
+	// package implements.
+	ViewporterVersion = 1
+)
+
+// The versions of the wp_viewporter interface that its messages were
+// added in.
+const (
+	ViewporterDestroyRequestSince     = 1
+	ViewporterGetViewportRequestSince = 1
+)
+
+// ViewporterDesc describes the wp_viewporter interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ViewporterDesc = &wire.InterfaceDesc{
+	Name:    ViewporterInterface,
+	Version: ViewporterVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_viewport",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "wp_viewport",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "viewport_exists", Value: 0},
+			},
+		},
+	},
+}
+
+// ViewporterListener is a type that can respond to incoming
+// messages for a Viewporter object.
+type ViewporterListener interface {
+	// Informs the server that the client will not be using this
+	// protocol object anymore. This does not affect any other objects,
+	// wp_viewport objects included.
+	Destroy()
+
+	// Instantiate an interface extension for the given wl_surface to
+	// crop and scale its content. If the given wl_surface already has
+	// a wp_viewport object associated, the viewport_exists
+	// protocol error is raised.
+	GetViewport(id *Viewport, surface *wl.Surface)
+}
+
+// ViewporterListenerFuncs implements ViewporterListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ViewporterListenerFuncs struct {
+	OnDestroy     func()
+	OnGetViewport func(id *Viewport, surface *wl.Surface)
+}
+
+func (lis *ViewporterListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ViewporterListenerFuncs) GetViewport(id *Viewport, surface *wl.Surface) {
+	if lis.OnGetViewport != nil {
+		lis.OnGetViewport(id, surface)
+	}
+}
+
+// ViewporterRequest is implemented by the message types of all
+// of the requests that a Viewporter can receive.
+type ViewporterRequest interface {
+	viewporterRequest()
+}
+
+// ViewporterRequestFunc implements ViewporterListener by calling
+// itself with each incoming message.
+type ViewporterRequestFunc func(ViewporterRequest)
+
+func (f ViewporterRequestFunc) Destroy() {
+	f(ViewporterDestroyRequest{})
+}
+
+func (f ViewporterRequestFunc) GetViewport(id *Viewport, surface *wl.Surface) {
+	f(ViewporterGetViewportRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
+// The global interface exposing surface cropping and scaling
+// capabilities is used to instantiate an interface extension for a
+// wl_surface object. This extended interface will then allow
+// cropping and scaling the surface contents, effectively
+// disconnecting the direct relationship between the buffer and the
+// surface size.
+type Viewporter struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ViewporterListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewViewporter returns a newly instantiated Viewporter. It is
+// primarily intended for use by generated code.
+func NewViewporter(state wire.State) *Viewporter {
+	return &Viewporter{state: state}
+}
+
+func BindViewporter(state wire.State, id wire.NewID) *Viewporter {
+	obj := NewViewporter(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *Viewporter) State() wire.State {
+	return obj.state
+}
+
+func (obj *Viewporter) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ViewporterDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m ViewporterGetViewportRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetViewport(
+			m.Id,
+			m.Surface,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ViewporterInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_viewporter",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Viewporter) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Viewporter) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Viewporter) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Viewporter) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_viewporter", obj.id)
+}
+
+func (obj *Viewporter) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "get_viewport"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Viewporter) Interface() string {
+	return ViewporterInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ViewporterListenerFuncs, it is replaced with one.
+func (obj *Viewporter) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ViewporterListenerFuncs)
+	if !ok {
+		lis = new(ViewporterListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetViewport sets the function that is called when the
+// get_viewport request is received. If obj's Listener is not a
+// *ViewporterListenerFuncs, it is replaced with one.
+func (obj *Viewporter) OnGetViewport(f func(id *Viewport, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*ViewporterListenerFuncs)
+	if !ok {
+		lis = new(ViewporterListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetViewport = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Viewporter) Subscribe() *wire.Messages[ViewporterRequest] {
+	q := new(wire.Messages[ViewporterRequest])
+	obj.Listener = ViewporterRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Viewporter) Version() uint32 {
+	return ViewporterVersion
+}
+
+// ViewporterDestroyRequest holds the arguments of the destroy request of
+// the wp_viewporter interface.
+type ViewporterDestroyRequest struct {
+}
+
+func (ViewporterDestroyRequest) viewporterRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ViewporterDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ViewporterDestroyRequest) Encode(obj *Viewporter) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ViewporterGetViewportRequest holds the arguments of the get_viewport request of
+// the wp_viewporter interface.
+type ViewporterGetViewportRequest struct {
+	Id      *Viewport
+	Surface *wl.Surface
+}
+
+func (ViewporterGetViewportRequest) viewporterRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ViewporterGetViewportRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewViewport(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ViewportInterface, msg.ReadNewObject(ViewportInterface), true))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ViewporterGetViewportRequest) Encode(obj *Viewporter) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_viewport"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ViewportInterface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+// ViewporterError is an alias for [shared.ViewporterError].
+type ViewporterError = shared.ViewporterError
+
+const (
+	ViewporterErrorViewportExists = shared.ViewporterErrorViewportExists
+)
+
+const (
+	ViewportInterface = shared.ViewportInterface
+
+	// ViewportVersion is the version of the interface that this
+	// package implements.
+	ViewportVersion = 1
+)
+
+// The versions of the wp_viewport interface that its messages were
+// added in.
+const (
+	ViewportDestroyRequestSince        = 1
+	ViewportSetSourceRequestSince      = 1
+	ViewportSetDestinationRequestSince = 1
+)
+
+// ViewportDesc describes the wp_viewport interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ViewportDesc = &wire.InterfaceDesc{
+	Name:    ViewportInterface,
+	Version: ViewportVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_source",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgFixed,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgFixed,
+				},
+			},
+		},
+		{
+			Name:  "set_destination",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "bad_value", Value: 0},
+				{Name: "bad_size", Value: 1},
+				{Name: "out_of_buffer", Value: 2},
+				{Name: "no_surface", Value: 3},
+			},
+		},
+	},
+}
+
+// ViewportListener is a type that can respond to incoming
+// messages for a Viewport object.
+type ViewportListener interface {
+	// The associated wl_surface's crop and scale state is removed.
+	// The change is applied on the next wl_surface.commit.
+	Destroy()
+
+	// Set the source rectangle of the associated wl_surface. See
+	// wp_viewport for the description, and relation to the wl_buffer
+	// size.
+	//
+	// If all of x, y, width and height are -1.0, the source rectangle is
+	// unset instead. Any other set of values where width or height are zero
+	// or negative, or x or y are negative, raise the bad_value protocol
+	// error.
+	//
+	// The crop and scale state is double-buffered, see wl_surface.commit.
+	SetSource(x wire.Fixed, y wire.Fixed, width wire.Fixed, height wire.Fixed)
+
+	// Set the destination size of the associated wl_surface. See
+	// wp_viewport for the description, and relation to the wl_buffer
+	// size.
+	//
+	// If width is -1 and height is -1, the destination size is unset
+	// instead. Any other pair of values for width and height that
+	// contains zero or negative values raises the bad_value protocol
+	// error.
+	//
+	// The crop and scale state is double-buffered, see wl_surface.commit.
+	SetDestination(width int32, height int32)
+}
+
+// ViewportListenerFuncs implements ViewportListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ViewportListenerFuncs struct {
+	OnDestroy        func()
+	OnSetSource      func(x wire.Fixed, y wire.Fixed, width wire.Fixed, height wire.Fixed)
+	OnSetDestination func(width int32, height int32)
+}
+
+func (lis *ViewportListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ViewportListenerFuncs) SetSource(x wire.Fixed, y wire.Fixed, width wire.Fixed, height wire.Fixed) {
+	if lis.OnSetSource != nil {
+		lis.OnSetSource(x, y, width, height)
+	}
+}
+
+func (lis *ViewportListenerFuncs) SetDestination(width int32, height int32) {
+	if lis.OnSetDestination != nil {
+		lis.OnSetDestination(width, height)
+	}
+}
+
+// ViewportRequest is implemented by the message types of all
+// of the requests that a Viewport can receive.
+type ViewportRequest interface {
+	viewportRequest()
+}
+
+// ViewportRequestFunc implements ViewportListener by calling
+// itself with each incoming message.
+type ViewportRequestFunc func(ViewportRequest)
+
+func (f ViewportRequestFunc) Destroy() {
+	f(ViewportDestroyRequest{})
+}
+
+func (f ViewportRequestFunc) SetSource(x wire.Fixed, y wire.Fixed, width wire.Fixed, height wire.Fixed) {
+	f(ViewportSetSourceRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f ViewportRequestFunc) SetDestination(width int32, height int32) {
+	f(ViewportSetDestinationRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+// An additional interface to a wl_surface object, which allows the
+// client to specify the cropping and scaling of the surface
+// contents.
+//
+// This interface works with two concepts: the source rectangle (src_x,
+// src_y, src_width, src_height), and the destination size (dst_width,
+// dst_height). The contents of the source rectangle are scaled to the
+// destination size, and content outside the source rectangle is ignored.
+// This state is double-buffered, see wl_surface.commit.
+//
+// The two parts of crop and scale state are independent: the source
+// rectangle, and the destination size. Initially both are unset, that
+// is, no scaling is applied. The whole of the current wl_buffer is
+// used as the source, and the surface size is as defined in
+// wl_surface.attach.
+//
+// If the destination size is set, it causes the surface size to become
+// dst_width, dst_height. The source (rectangle) is scaled to exactly
+// this size. This overrides whatever the attached wl_buffer size is,
+// unless the wl_buffer is NULL. If the wl_buffer is NULL, the surface
+// has no content and therefore no size. Otherwise, the size is always
+// at least 1x1 in surface local coordinates.
+//
+// If the source rectangle is set, it defines what area of the wl_buffer is
+// taken as the source. If the source rectangle is set and the destination
+// size is not set, then src_width and src_height must be integers, and the
+// surface size becomes the source rectangle size. This results in cropping
+// without scaling. If src_width or src_height are not integers and
+// destination size is not set, the bad_size protocol error is raised when
+// the surface state is applied.
+//
+// The coordinate transformations from buffer pixel coordinates up to
+// the surface-local coordinates happen in the following order:
+// 1. buffer_transform (wl_surface.set_buffer_transform)
+// 2. buffer_scale (wl_surface.set_buffer_scale)
+// 3. crop and scale (wp_viewport.set*)
+// This means, that the source rectangle coordinates of crop and scale
+// are given in the coordinates after the buffer transform and scale,
+// i.e. in the coordinates that would be the surface-local coordinates
+// if the crop and scale was not applied.
+//
+// If src_x or src_y are negative, the bad_value protocol error is raised.
+// Otherwise, if the source rectangle is partially or completely outside of
+// the non-NULL wl_buffer, then the out_of_buffer protocol error is raised
+// when the surface state is applied. A NULL wl_buffer does not raise the
+// out_of_buffer error.
+//
+// If the wl_surface associated with the wp_viewport is destroyed,
+// all wp_viewport requests except 'destroy' raise the protocol error
+// no_surface.
+//
+// If the wp_viewport object is destroyed, the crop and scale
+// state is removed from the wl_surface. The change will be applied
+// on the next wl_surface.commit.
+type Viewport struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ViewportListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewViewport returns a newly instantiated Viewport. It is
+// primarily intended for use by generated code.
+func NewViewport(state wire.State) *Viewport {
+	return &Viewport{state: state}
+}
+
+func (obj *Viewport) State() wire.State {
+	return obj.state
+}
+
+func (obj *Viewport) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ViewportDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m ViewportSetSourceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetSource(
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 2:
+		var m ViewportSetDestinationRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetDestination(
+			m.Width,
+			m.Height,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ViewportInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "wp_viewport",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Viewport) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Viewport) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Viewport) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Viewport) String() string {
+	return fmt.Sprintf("%v(%v)", "wp_viewport", obj.id)
+}
+
+func (obj *Viewport) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "set_source"
+
+	case 2:
+		return "set_destination"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Viewport) Interface() string {
+	return ViewportInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ViewportListenerFuncs, it is replaced with one.
+func (obj *Viewport) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ViewportListenerFuncs)
+	if !ok {
+		lis = new(ViewportListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetSource sets the function that is called when the
+// set_source request is received. If obj's Listener is not a
+// *ViewportListenerFuncs, it is replaced with one.
+func (obj *Viewport) OnSetSource(f func(x wire.Fixed, y wire.Fixed, width wire.Fixed, height wire.Fixed)) {
+	lis, ok := obj.Listener.(*ViewportListenerFuncs)
+	if !ok {
+		lis = new(ViewportListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSource = f
+}
+
+// OnSetDestination sets the function that is called when the
+// set_destination request is received. If obj's Listener is not a
+// *ViewportListenerFuncs, it is replaced with one.
+func (obj *Viewport) OnSetDestination(f func(width int32, height int32)) {
+	lis, ok := obj.Listener.(*ViewportListenerFuncs)
+	if !ok {
+		lis = new(ViewportListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetDestination = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Viewport) Subscribe() *wire.Messages[ViewportRequest] {
+	q := new(wire.Messages[ViewportRequest])
+	obj.Listener = ViewportRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Viewport) Version() uint32 {
+	return ViewportVersion
+}
+
+// ViewportDestroyRequest holds the arguments of the destroy request of
+// the wp_viewport interface.
+type ViewportDestroyRequest struct {
+}
+
+func (ViewportDestroyRequest) viewportRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ViewportDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ViewportDestroyRequest) Encode(obj *Viewport) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ViewportSetSourceRequest holds the arguments of the set_source request of
+// the wp_viewport interface.
+type ViewportSetSourceRequest struct {
+	X      wire.Fixed
+	Y      wire.Fixed
+	Width  wire.Fixed
+	Height wire.Fixed
+}
+
+func (ViewportSetSourceRequest) viewportRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ViewportSetSourceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadFixed()
+	m.Y = msg.ReadFixed()
+	m.Width = msg.ReadFixed()
+	m.Height = msg.ReadFixed()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ViewportSetSourceRequest) Encode(obj *Viewport) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteFixed(m.X)
+	builder.WriteFixed(m.Y)
+	builder.WriteFixed(m.Width)
+	builder.WriteFixed(m.Height)
+
+	builder.Method = "set_source"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// ViewportSetDestinationRequest holds the arguments of the set_destination request of
+// the wp_viewport interface.
+type ViewportSetDestinationRequest struct {
+	Width  int32
+	Height int32
+}
+
+func (ViewportSetDestinationRequest) viewportRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ViewportSetDestinationRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ViewportSetDestinationRequest) Encode(obj *Viewport) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_destination"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height}
+	}
+	return builder
+}
+
+// ViewportError is an alias for [shared.ViewportError].
+type ViewportError = shared.ViewportError
+
+const (
+	ViewportErrorBadValue    = shared.ViewportErrorBadValue
+	ViewportErrorBadSize     = shared.ViewportErrorBadSize
+	ViewportErrorOutOfBuffer = shared.ViewportErrorOutOfBuffer
+	ViewportErrorNoSurface   = shared.ViewportErrorNoSurface
+)
+
+func init() {
+	wire.RegisterInterface(ViewporterDesc, false)
+	wire.RegisterInterface(ViewportDesc, false)
+}
@@ -0,0 +1,5 @@
+// Package activation contains generated bindings for the xdg-activation
+// protocol. The client and server subpackages contain the client-side
+// and server-side bindings, respectively. This package contains the
+// enums and interface constants that they share.
+package activation
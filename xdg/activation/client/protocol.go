@@ -0,0 +1,789 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package activation
+
+import (
+	wl "deedles.dev/wl/client"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/xdg/activation"
+	"fmt"
+)
+
+const (
+	ActivationV1Interface = shared.ActivationV1Interface
+
+	// ActivationV1Version is the version of the interface that this
+	// package implements.
+	ActivationV1Version = 1
+)
+
+// The versions of the xdg_activation_v1 interface that its messages were
+// added in.
+const (
+	ActivationV1DestroyRequestSince            = 1
+	ActivationV1GetActivationTokenRequestSince = 1
+	ActivationV1ActivateRequestSince           = 1
+)
+
+// ActivationV1Desc describes the xdg_activation_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ActivationV1Desc = &wire.InterfaceDesc{
+	Name:    ActivationV1Interface,
+	Version: ActivationV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_activation_token",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_activation_token_v1",
+				},
+			},
+		},
+		{
+			Name:  "activate",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "token",
+					Type: wire.ArgString,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// A global interface used for informing the compositor about applications
+// being activated or started, or for applications to request to be
+// activated.
+type ActivationV1 struct {
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewActivationV1 returns a newly instantiated ActivationV1. It is
+// primarily intended for use by generated code.
+func NewActivationV1(state wire.State) *ActivationV1 {
+	return &ActivationV1{state: state}
+}
+
+func BindActivationV1(state wire.State, registry wire.Binder, name, version uint32) *ActivationV1 {
+	obj := NewActivationV1(state)
+	state.Add(obj)
+	registry.Bind(name, wire.NewID{Interface: ActivationV1Interface, Version: version, ID: obj.ID()})
+	return obj
+}
+
+func (obj *ActivationV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *ActivationV1) Dispatch(msg *wire.MessageBuffer) error {
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ActivationV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_activation_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *ActivationV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *ActivationV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *ActivationV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *ActivationV1) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_activation_v1", obj.id)
+}
+
+func (obj *ActivationV1) MethodName(op uint16) string {
+	switch op {
+	}
+
+	return "unknown method"
+}
+
+func (obj *ActivationV1) Interface() string {
+	return ActivationV1Interface
+}
+
+func (obj *ActivationV1) Version() uint32 {
+	return ActivationV1Version
+}
+
+// Notify the compositor that the xdg_activation object will no longer be
+// used.
+//
+// The child objects created via this interface are unaffected and should
+// be destroyed separately.
+func (obj *ActivationV1) Destroy() {
+	obj.state.Enqueue(ActivationV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// Creates an xdg_activation_token_v1 object that will provide
+// the initiating client with a unique token for this activation. This
+// token should be offered to the clients to be activated.
+func (obj *ActivationV1) GetActivationToken() (id *ActivationTokenV1) {
+	id = NewActivationTokenV1(obj.state)
+	obj.state.Add(id)
+	obj.state.Enqueue(ActivationV1GetActivationTokenRequest{
+		Id: id,
+	}.Encode(obj))
+	return id
+}
+
+// Requests surface activation. It's up to the compositor to display
+// this information as desired, for example by placing the surface above
+// the rest.
+//
+// The compositor may know who requested this by checking the activation
+// token and might decide not to follow through with the activation if it's
+// considered unwanted.
+//
+// Compositors can ignore unknown activation tokens when an invalid
+// token is passed.
+func (obj *ActivationV1) Activate(token string, surface *wl.Surface) {
+	obj.state.Enqueue(ActivationV1ActivateRequest{
+		Token:   token,
+		Surface: surface,
+	}.Encode(obj))
+	return
+}
+
+// ActivationV1DestroyRequest holds the arguments of the destroy request of
+// the xdg_activation_v1 interface.
+type ActivationV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1DestroyRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationV1GetActivationTokenRequest holds the arguments of the get_activation_token request of
+// the xdg_activation_v1 interface.
+type ActivationV1GetActivationTokenRequest struct {
+	Id *ActivationTokenV1
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationV1GetActivationTokenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewActivationTokenV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ActivationTokenV1Interface, msg.ReadNewObject(ActivationTokenV1Interface), false))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1GetActivationTokenRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_activation_token"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ActivationTokenV1Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// ActivationV1ActivateRequest holds the arguments of the activate request of
+// the xdg_activation_v1 interface.
+type ActivationV1ActivateRequest struct {
+	Token   string
+	Surface *wl.Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationV1ActivateRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Token = msg.ReadString()
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1ActivateRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteString(m.Token)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "activate"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Token, m.Surface}
+	}
+	return builder
+}
+
+const (
+	ActivationTokenV1Interface = shared.ActivationTokenV1Interface
+
+	// ActivationTokenV1Version is the version of the interface that this
+	// package implements.
+	ActivationTokenV1Version = 1
+)
+
+// The versions of the xdg_activation_token_v1 interface that its messages were
+// added in.
+const (
+	ActivationTokenV1SetSerialRequestSince  = 1
+	ActivationTokenV1SetAppIdRequestSince   = 1
+	ActivationTokenV1SetSurfaceRequestSince = 1
+	ActivationTokenV1CommitRequestSince     = 1
+	ActivationTokenV1DestroyRequestSince    = 1
+	ActivationTokenV1DoneEventSince         = 1
+)
+
+// ActivationTokenV1Desc describes the xdg_activation_token_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ActivationTokenV1Desc = &wire.InterfaceDesc{
+	Name:    ActivationTokenV1Interface,
+	Version: ActivationTokenV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_serial",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+			},
+		},
+		{
+			Name:  "set_app_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "app_id",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "set_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "commit",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "token",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "already_used", Value: 0},
+			},
+		},
+	},
+}
+
+// ActivationTokenV1Listener is a type that can respond to incoming
+// messages for a ActivationTokenV1 object.
+type ActivationTokenV1Listener interface {
+	// The 'done' event contains the unique token of this activation request
+	// and notifies that the provider is done.
+	Done(token string)
+}
+
+// ActivationTokenV1ListenerFuncs implements ActivationTokenV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ActivationTokenV1ListenerFuncs struct {
+	OnDone func(token string)
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) Done(token string) {
+	if lis.OnDone != nil {
+		lis.OnDone(token)
+	}
+}
+
+// ActivationTokenV1Event is implemented by the message types of all
+// of the events that a ActivationTokenV1 can receive.
+type ActivationTokenV1Event interface {
+	activationTokenV1Event()
+}
+
+// ActivationTokenV1EventFunc implements ActivationTokenV1Listener by calling
+// itself with each incoming message.
+type ActivationTokenV1EventFunc func(ActivationTokenV1Event)
+
+func (f ActivationTokenV1EventFunc) Done(token string) {
+	f(ActivationTokenV1DoneEvent{
+		Token: token,
+	})
+}
+
+// An object for setting up a token and receiving a token handle that can
+// be passed as an activation token to another client.
+//
+// The object is created using the xdg_activation_v1.get_activation_token
+// request. This object should then be populated with the app_id, surface
+// and serial information and committed. The compositor shall then issue a
+// done event with the token. In case the request's parameters are invalid,
+// the compositor will provide an invalid token.
+type ActivationTokenV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ActivationTokenV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewActivationTokenV1 returns a newly instantiated ActivationTokenV1. It is
+// primarily intended for use by generated code.
+func NewActivationTokenV1(state wire.State) *ActivationTokenV1 {
+	return &ActivationTokenV1{state: state}
+}
+
+func (obj *ActivationTokenV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *ActivationTokenV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ActivationTokenV1DoneEvent
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Done(
+			m.Token,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ActivationTokenV1Interface, true); ok {
+		if m, ok := desc.Event(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_activation_token_v1",
+		Type:      "event",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *ActivationTokenV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *ActivationTokenV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *ActivationTokenV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *ActivationTokenV1) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_activation_token_v1", obj.id)
+}
+
+func (obj *ActivationTokenV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "done"
+	}
+
+	return "unknown method"
+}
+
+func (obj *ActivationTokenV1) Interface() string {
+	return ActivationTokenV1Interface
+}
+
+// OnDone sets the function that is called when the
+// done event is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnDone(f func(token string)) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDone = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ActivationTokenV1) Subscribe() *wire.Messages[ActivationTokenV1Event] {
+	q := new(wire.Messages[ActivationTokenV1Event])
+	obj.Listener = ActivationTokenV1EventFunc(q.Push)
+	return q
+}
+
+func (obj *ActivationTokenV1) Version() uint32 {
+	return ActivationTokenV1Version
+}
+
+// Provides information about the seat and serial event that requested the
+// token.
+//
+// The serial can come from an input or focus event. For instance, if a
+// click triggers the launch of a third-party client, the launcher client
+// should send a set_serial request with the serial and seat from the
+// wl_pointer.button event.
+//
+// Some compositors might refuse to activate toplevels when the token
+// doesn't have a valid and recent enough event serial.
+//
+// Must be sent before commit. This information is optional.
+func (obj *ActivationTokenV1) SetSerial(serial uint32, seat *wl.Seat) {
+	obj.state.Enqueue(ActivationTokenV1SetSerialRequest{
+		Serial: serial,
+		Seat:   seat,
+	}.Encode(obj))
+	return
+}
+
+// The requesting client can specify an app_id to associate the token
+// being created with it.
+//
+// Must be sent before commit. This information is optional.
+func (obj *ActivationTokenV1) SetAppId(appId string) {
+	obj.state.Enqueue(ActivationTokenV1SetAppIdRequest{
+		AppId: appId,
+	}.Encode(obj))
+	return
+}
+
+// This request sets the surface requesting the activation. Note, this is
+// different from the surface that will be activated.
+//
+// Some compositors might refuse to activate toplevels when the token
+// doesn't have a requesting surface.
+//
+// Must be sent before commit. This information is optional.
+func (obj *ActivationTokenV1) SetSurface(surface *wl.Surface) {
+	obj.state.Enqueue(ActivationTokenV1SetSurfaceRequest{
+		Surface: surface,
+	}.Encode(obj))
+	return
+}
+
+// Requests an activation token based on the different parameters that
+// have been offered through set_serial, set_surface and set_app_id.
+func (obj *ActivationTokenV1) Commit() {
+	obj.state.Enqueue(ActivationTokenV1CommitRequest{}.Encode(obj))
+	return
+}
+
+// Notify the compositor that the xdg_activation_token_v1 object will no
+// longer be used. The received token stays valid.
+func (obj *ActivationTokenV1) Destroy() {
+	obj.state.Enqueue(ActivationTokenV1DestroyRequest{}.Encode(obj))
+	return
+}
+
+// ActivationTokenV1DoneEvent holds the arguments of the done event of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1DoneEvent struct {
+	Token string
+}
+
+func (ActivationTokenV1DoneEvent) activationTokenV1Event() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Token = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1DoneEvent) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.Token)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Token}
+	}
+	return builder
+}
+
+// ActivationTokenV1SetSerialRequest holds the arguments of the set_serial request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetSerialRequest struct {
+	Serial uint32
+	Seat   *wl.Seat
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1SetSerialRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetSerialRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Seat)
+
+	builder.Method = "set_serial"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Seat}
+	}
+	return builder
+}
+
+// ActivationTokenV1SetAppIdRequest holds the arguments of the set_app_id request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetAppIdRequest struct {
+	AppId string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1SetAppIdRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.AppId = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetAppIdRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.AppId)
+
+	builder.Method = "set_app_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.AppId}
+	}
+	return builder
+}
+
+// ActivationTokenV1SetSurfaceRequest holds the arguments of the set_surface request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetSurfaceRequest struct {
+	Surface *wl.Surface
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1SetSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetSurfaceRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "set_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Surface}
+	}
+	return builder
+}
+
+// ActivationTokenV1CommitRequest holds the arguments of the commit request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1CommitRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1CommitRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1CommitRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "commit"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationTokenV1DestroyRequest holds the arguments of the destroy request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1DestroyRequest struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID.
+func (m *ActivationTokenV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1DestroyRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationTokenV1Error is an alias for [shared.ActivationTokenV1Error].
+type ActivationTokenV1Error = shared.ActivationTokenV1Error
+
+const (
+	ActivationTokenV1ErrorAlreadyUsed = shared.ActivationTokenV1ErrorAlreadyUsed
+)
+
+func init() {
+	wire.RegisterInterface(ActivationV1Desc, true)
+	wire.RegisterInterface(ActivationTokenV1Desc, true)
+}
@@ -0,0 +1,40 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package activation
+
+const (
+	ActivationV1Interface = "xdg_activation_v1"
+	ActivationV1Version   = 1
+)
+
+const (
+	ActivationTokenV1Interface = "xdg_activation_token_v1"
+	ActivationTokenV1Version   = 1
+)
+
+type ActivationTokenV1Error int64
+
+const (
+	// The token has already been used previously
+	ActivationTokenV1ErrorAlreadyUsed ActivationTokenV1Error = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ActivationTokenV1Error) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum ActivationTokenV1Error) String() string {
+	switch enum {
+	case 0:
+		return "ActivationTokenV1ErrorAlreadyUsed"
+	}
+
+	return "<invalid ActivationTokenV1Error>"
+}
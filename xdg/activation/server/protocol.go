@@ -0,0 +1,1106 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package activation
+
+import (
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/xdg/activation"
+	"fmt"
+)
+
+const (
+	ActivationV1Interface = shared.ActivationV1Interface
+
+	// ActivationV1Version is the version of the interface that this
+	// package implements.
+	ActivationV1Version = 1
+)
+
+// The versions of the xdg_activation_v1 interface that its messages were
+// added in.
+const (
+	ActivationV1DestroyRequestSince            = 1
+	ActivationV1GetActivationTokenRequestSince = 1
+	ActivationV1ActivateRequestSince           = 1
+)
+
+// ActivationV1Desc describes the xdg_activation_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ActivationV1Desc = &wire.InterfaceDesc{
+	Name:    ActivationV1Interface,
+	Version: ActivationV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_activation_token",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_activation_token_v1",
+				},
+			},
+		},
+		{
+			Name:  "activate",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "token",
+					Type: wire.ArgString,
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// ActivationV1Listener is a type that can respond to incoming
+// messages for a ActivationV1 object.
+type ActivationV1Listener interface {
+	// Notify the compositor that the xdg_activation object will no longer be
+	// used.
+	//
+	// The child objects created via this interface are unaffected and should
+	// be destroyed separately.
+	Destroy()
+
+	// Creates an xdg_activation_token_v1 object that will provide
+	// the initiating client with a unique token for this activation. This
+	// token should be offered to the clients to be activated.
+	GetActivationToken(id *ActivationTokenV1)
+
+	// Requests surface activation. It's up to the compositor to display
+	// this information as desired, for example by placing the surface above
+	// the rest.
+	//
+	// The compositor may know who requested this by checking the activation
+	// token and might decide not to follow through with the activation if it's
+	// considered unwanted.
+	//
+	// Compositors can ignore unknown activation tokens when an invalid
+	// token is passed.
+	Activate(token string, surface *wl.Surface)
+}
+
+// ActivationV1ListenerFuncs implements ActivationV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ActivationV1ListenerFuncs struct {
+	OnDestroy            func()
+	OnGetActivationToken func(id *ActivationTokenV1)
+	OnActivate           func(token string, surface *wl.Surface)
+}
+
+func (lis *ActivationV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ActivationV1ListenerFuncs) GetActivationToken(id *ActivationTokenV1) {
+	if lis.OnGetActivationToken != nil {
+		lis.OnGetActivationToken(id)
+	}
+}
+
+func (lis *ActivationV1ListenerFuncs) Activate(token string, surface *wl.Surface) {
+	if lis.OnActivate != nil {
+		lis.OnActivate(token, surface)
+	}
+}
+
+// ActivationV1Request is implemented by the message types of all
+// of the requests that a ActivationV1 can receive.
+type ActivationV1Request interface {
+	activationV1Request()
+}
+
+// ActivationV1RequestFunc implements ActivationV1Listener by calling
+// itself with each incoming message.
+type ActivationV1RequestFunc func(ActivationV1Request)
+
+func (f ActivationV1RequestFunc) Destroy() {
+	f(ActivationV1DestroyRequest{})
+}
+
+func (f ActivationV1RequestFunc) GetActivationToken(id *ActivationTokenV1) {
+	f(ActivationV1GetActivationTokenRequest{
+		Id: id,
+	})
+}
+
+func (f ActivationV1RequestFunc) Activate(token string, surface *wl.Surface) {
+	f(ActivationV1ActivateRequest{
+		Token:   token,
+		Surface: surface,
+	})
+}
+
+// A global interface used for informing the compositor about applications
+// being activated or started, or for applications to request to be
+// activated.
+type ActivationV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ActivationV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewActivationV1 returns a newly instantiated ActivationV1. It is
+// primarily intended for use by generated code.
+func NewActivationV1(state wire.State) *ActivationV1 {
+	return &ActivationV1{state: state}
+}
+
+func BindActivationV1(state wire.State, id wire.NewID) *ActivationV1 {
+	obj := NewActivationV1(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *ActivationV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *ActivationV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ActivationV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m ActivationV1GetActivationTokenRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetActivationToken(
+			m.Id,
+		)
+		return nil
+
+	case 2:
+		var m ActivationV1ActivateRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Activate(
+			m.Token,
+			m.Surface,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ActivationV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_activation_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *ActivationV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *ActivationV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *ActivationV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *ActivationV1) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_activation_v1", obj.id)
+}
+
+func (obj *ActivationV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "get_activation_token"
+
+	case 2:
+		return "activate"
+	}
+
+	return "unknown method"
+}
+
+func (obj *ActivationV1) Interface() string {
+	return ActivationV1Interface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ActivationV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ActivationV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetActivationToken sets the function that is called when the
+// get_activation_token request is received. If obj's Listener is not a
+// *ActivationV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationV1) OnGetActivationToken(f func(id *ActivationTokenV1)) {
+	lis, ok := obj.Listener.(*ActivationV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetActivationToken = f
+}
+
+// OnActivate sets the function that is called when the
+// activate request is received. If obj's Listener is not a
+// *ActivationV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationV1) OnActivate(f func(token string, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*ActivationV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnActivate = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ActivationV1) Subscribe() *wire.Messages[ActivationV1Request] {
+	q := new(wire.Messages[ActivationV1Request])
+	obj.Listener = ActivationV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *ActivationV1) Version() uint32 {
+	return ActivationV1Version
+}
+
+// ActivationV1DestroyRequest holds the arguments of the destroy request of
+// the xdg_activation_v1 interface.
+type ActivationV1DestroyRequest struct {
+}
+
+func (ActivationV1DestroyRequest) activationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1DestroyRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationV1GetActivationTokenRequest holds the arguments of the get_activation_token request of
+// the xdg_activation_v1 interface.
+type ActivationV1GetActivationTokenRequest struct {
+	Id *ActivationTokenV1
+}
+
+func (ActivationV1GetActivationTokenRequest) activationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationV1GetActivationTokenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewActivationTokenV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ActivationTokenV1Interface, msg.ReadNewObject(ActivationTokenV1Interface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1GetActivationTokenRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_activation_token"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ActivationTokenV1Interface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// ActivationV1ActivateRequest holds the arguments of the activate request of
+// the xdg_activation_v1 interface.
+type ActivationV1ActivateRequest struct {
+	Token   string
+	Surface *wl.Surface
+}
+
+func (ActivationV1ActivateRequest) activationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationV1ActivateRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Token = msg.ReadString()
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationV1ActivateRequest) Encode(obj *ActivationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteString(m.Token)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "activate"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Token, m.Surface}
+	}
+	return builder
+}
+
+const (
+	ActivationTokenV1Interface = shared.ActivationTokenV1Interface
+
+	// ActivationTokenV1Version is the version of the interface that this
+	// package implements.
+	ActivationTokenV1Version = 1
+)
+
+// The versions of the xdg_activation_token_v1 interface that its messages were
+// added in.
+const (
+	ActivationTokenV1SetSerialRequestSince  = 1
+	ActivationTokenV1SetAppIdRequestSince   = 1
+	ActivationTokenV1SetSurfaceRequestSince = 1
+	ActivationTokenV1CommitRequestSince     = 1
+	ActivationTokenV1DestroyRequestSince    = 1
+	ActivationTokenV1DoneEventSince         = 1
+)
+
+// ActivationTokenV1Desc describes the xdg_activation_token_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ActivationTokenV1Desc = &wire.InterfaceDesc{
+	Name:    ActivationTokenV1Interface,
+	Version: ActivationTokenV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "set_serial",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+			},
+		},
+		{
+			Name:  "set_app_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "app_id",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "set_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "commit",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "token",
+					Type: wire.ArgString,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "already_used", Value: 0},
+			},
+		},
+	},
+}
+
+// ActivationTokenV1Listener is a type that can respond to incoming
+// messages for a ActivationTokenV1 object.
+type ActivationTokenV1Listener interface {
+	// Provides information about the seat and serial event that requested the
+	// token.
+	//
+	// The serial can come from an input or focus event. For instance, if a
+	// click triggers the launch of a third-party client, the launcher client
+	// should send a set_serial request with the serial and seat from the
+	// wl_pointer.button event.
+	//
+	// Some compositors might refuse to activate toplevels when the token
+	// doesn't have a valid and recent enough event serial.
+	//
+	// Must be sent before commit. This information is optional.
+	SetSerial(serial uint32, seat *wl.Seat)
+
+	// The requesting client can specify an app_id to associate the token
+	// being created with it.
+	//
+	// Must be sent before commit. This information is optional.
+	SetAppId(appId string)
+
+	// This request sets the surface requesting the activation. Note, this is
+	// different from the surface that will be activated.
+	//
+	// Some compositors might refuse to activate toplevels when the token
+	// doesn't have a requesting surface.
+	//
+	// Must be sent before commit. This information is optional.
+	SetSurface(surface *wl.Surface)
+
+	// Requests an activation token based on the different parameters that
+	// have been offered through set_serial, set_surface and set_app_id.
+	Commit()
+
+	// Notify the compositor that the xdg_activation_token_v1 object will no
+	// longer be used. The received token stays valid.
+	Destroy()
+}
+
+// ActivationTokenV1ListenerFuncs implements ActivationTokenV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ActivationTokenV1ListenerFuncs struct {
+	OnSetSerial  func(serial uint32, seat *wl.Seat)
+	OnSetAppId   func(appId string)
+	OnSetSurface func(surface *wl.Surface)
+	OnCommit     func()
+	OnDestroy    func()
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) SetSerial(serial uint32, seat *wl.Seat) {
+	if lis.OnSetSerial != nil {
+		lis.OnSetSerial(serial, seat)
+	}
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) SetAppId(appId string) {
+	if lis.OnSetAppId != nil {
+		lis.OnSetAppId(appId)
+	}
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) SetSurface(surface *wl.Surface) {
+	if lis.OnSetSurface != nil {
+		lis.OnSetSurface(surface)
+	}
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) Commit() {
+	if lis.OnCommit != nil {
+		lis.OnCommit()
+	}
+}
+
+func (lis *ActivationTokenV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+// ActivationTokenV1Request is implemented by the message types of all
+// of the requests that a ActivationTokenV1 can receive.
+type ActivationTokenV1Request interface {
+	activationTokenV1Request()
+}
+
+// ActivationTokenV1RequestFunc implements ActivationTokenV1Listener by calling
+// itself with each incoming message.
+type ActivationTokenV1RequestFunc func(ActivationTokenV1Request)
+
+func (f ActivationTokenV1RequestFunc) SetSerial(serial uint32, seat *wl.Seat) {
+	f(ActivationTokenV1SetSerialRequest{
+		Serial: serial,
+		Seat:   seat,
+	})
+}
+
+func (f ActivationTokenV1RequestFunc) SetAppId(appId string) {
+	f(ActivationTokenV1SetAppIdRequest{
+		AppId: appId,
+	})
+}
+
+func (f ActivationTokenV1RequestFunc) SetSurface(surface *wl.Surface) {
+	f(ActivationTokenV1SetSurfaceRequest{
+		Surface: surface,
+	})
+}
+
+func (f ActivationTokenV1RequestFunc) Commit() {
+	f(ActivationTokenV1CommitRequest{})
+}
+
+func (f ActivationTokenV1RequestFunc) Destroy() {
+	f(ActivationTokenV1DestroyRequest{})
+}
+
+// An object for setting up a token and receiving a token handle that can
+// be passed as an activation token to another client.
+//
+// The object is created using the xdg_activation_v1.get_activation_token
+// request. This object should then be populated with the app_id, surface
+// and serial information and committed. The compositor shall then issue a
+// done event with the token. In case the request's parameters are invalid,
+// the compositor will provide an invalid token.
+type ActivationTokenV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ActivationTokenV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewActivationTokenV1 returns a newly instantiated ActivationTokenV1. It is
+// primarily intended for use by generated code.
+func NewActivationTokenV1(state wire.State) *ActivationTokenV1 {
+	return &ActivationTokenV1{state: state}
+}
+
+func (obj *ActivationTokenV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *ActivationTokenV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ActivationTokenV1SetSerialRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetSerial(
+			m.Serial,
+			m.Seat,
+		)
+		return nil
+
+	case 1:
+		var m ActivationTokenV1SetAppIdRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetAppId(
+			m.AppId,
+		)
+		return nil
+
+	case 2:
+		var m ActivationTokenV1SetSurfaceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetSurface(
+			m.Surface,
+		)
+		return nil
+
+	case 3:
+		var m ActivationTokenV1CommitRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Commit()
+		return nil
+
+	case 4:
+		var m ActivationTokenV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ActivationTokenV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_activation_token_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *ActivationTokenV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *ActivationTokenV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *ActivationTokenV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *ActivationTokenV1) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_activation_token_v1", obj.id)
+}
+
+func (obj *ActivationTokenV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "set_serial"
+
+	case 1:
+		return "set_app_id"
+
+	case 2:
+		return "set_surface"
+
+	case 3:
+		return "commit"
+
+	case 4:
+		return "destroy"
+	}
+
+	return "unknown method"
+}
+
+func (obj *ActivationTokenV1) Interface() string {
+	return ActivationTokenV1Interface
+}
+
+// OnSetSerial sets the function that is called when the
+// set_serial request is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnSetSerial(f func(serial uint32, seat *wl.Seat)) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSerial = f
+}
+
+// OnSetAppId sets the function that is called when the
+// set_app_id request is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnSetAppId(f func(appId string)) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetAppId = f
+}
+
+// OnSetSurface sets the function that is called when the
+// set_surface request is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnSetSurface(f func(surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSurface = f
+}
+
+// OnCommit sets the function that is called when the
+// commit request is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnCommit(f func()) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCommit = f
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ActivationTokenV1ListenerFuncs, it is replaced with one.
+func (obj *ActivationTokenV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ActivationTokenV1ListenerFuncs)
+	if !ok {
+		lis = new(ActivationTokenV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ActivationTokenV1) Subscribe() *wire.Messages[ActivationTokenV1Request] {
+	q := new(wire.Messages[ActivationTokenV1Request])
+	obj.Listener = ActivationTokenV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *ActivationTokenV1) Version() uint32 {
+	return ActivationTokenV1Version
+}
+
+// The 'done' event contains the unique token of this activation request
+// and notifies that the provider is done.
+func (obj *ActivationTokenV1) Done(token string) {
+	obj.state.Enqueue(ActivationTokenV1DoneEvent{
+		Token: token,
+	}.Encode(obj))
+	return
+}
+
+// ActivationTokenV1SetSerialRequest holds the arguments of the set_serial request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetSerialRequest struct {
+	Serial uint32
+	Seat   *wl.Seat
+}
+
+func (ActivationTokenV1SetSerialRequest) activationTokenV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1SetSerialRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetSerialRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+	builder.WriteObject(m.Seat)
+
+	builder.Method = "set_serial"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial, m.Seat}
+	}
+	return builder
+}
+
+// ActivationTokenV1SetAppIdRequest holds the arguments of the set_app_id request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetAppIdRequest struct {
+	AppId string
+}
+
+func (ActivationTokenV1SetAppIdRequest) activationTokenV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1SetAppIdRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.AppId = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetAppIdRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteString(m.AppId)
+
+	builder.Method = "set_app_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.AppId}
+	}
+	return builder
+}
+
+// ActivationTokenV1SetSurfaceRequest holds the arguments of the set_surface request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1SetSurfaceRequest struct {
+	Surface *wl.Surface
+}
+
+func (ActivationTokenV1SetSurfaceRequest) activationTokenV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1SetSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1SetSurfaceRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "set_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Surface}
+	}
+	return builder
+}
+
+// ActivationTokenV1CommitRequest holds the arguments of the commit request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1CommitRequest struct {
+}
+
+func (ActivationTokenV1CommitRequest) activationTokenV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1CommitRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1CommitRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+
+	builder.Method = "commit"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationTokenV1DestroyRequest holds the arguments of the destroy request of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1DestroyRequest struct {
+}
+
+func (ActivationTokenV1DestroyRequest) activationTokenV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1DestroyRequest) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ActivationTokenV1DoneEvent holds the arguments of the done event of
+// the xdg_activation_token_v1 interface.
+type ActivationTokenV1DoneEvent struct {
+	Token string
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ActivationTokenV1DoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Token = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ActivationTokenV1DoneEvent) Encode(obj *ActivationTokenV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteString(m.Token)
+
+	builder.Method = "done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Token}
+	}
+	return builder
+}
+
+// ActivationTokenV1Error is an alias for [shared.ActivationTokenV1Error].
+type ActivationTokenV1Error = shared.ActivationTokenV1Error
+
+const (
+	ActivationTokenV1ErrorAlreadyUsed = shared.ActivationTokenV1ErrorAlreadyUsed
+)
+
+func init() {
+	wire.RegisterInterface(ActivationV1Desc, false)
+	wire.RegisterInterface(ActivationTokenV1Desc, false)
+}
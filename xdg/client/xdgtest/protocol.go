@@ -0,0 +1,101 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+// Package xdgtest provides mock listeners for testing code that uses
+// the xdg_shell protocol bindings in package xdg. The listeners
+// record the messages that they receive so that they can be checked
+// against expectations.
+package xdgtest
+
+import (
+	xdg "deedles.dev/wl/xdg/client"
+
+	"deedles.dev/wl/wire/wiretest"
+)
+
+// WmBaseListener is a [xdg.WmBaseListener] that records the
+// events that it receives.
+type WmBaseListener struct {
+	wiretest.Recorder[xdg.WmBaseEvent]
+}
+
+var _ xdg.WmBaseListener = (*WmBaseListener)(nil)
+
+func (lis *WmBaseListener) Ping(serial uint32) {
+	lis.Record(xdg.WmBasePingEvent{
+		Serial: serial,
+	})
+}
+
+// SurfaceListener is a [xdg.SurfaceListener] that records the
+// events that it receives.
+type SurfaceListener struct {
+	wiretest.Recorder[xdg.SurfaceEvent]
+}
+
+var _ xdg.SurfaceListener = (*SurfaceListener)(nil)
+
+func (lis *SurfaceListener) Configure(serial uint32) {
+	lis.Record(xdg.SurfaceConfigureEvent{
+		Serial: serial,
+	})
+}
+
+// ToplevelListener is a [xdg.ToplevelListener] that records the
+// events that it receives.
+type ToplevelListener struct {
+	wiretest.Recorder[xdg.ToplevelEvent]
+}
+
+var _ xdg.ToplevelListener = (*ToplevelListener)(nil)
+
+func (lis *ToplevelListener) Configure(width int32, height int32, states []byte) {
+	lis.Record(xdg.ToplevelConfigureEvent{
+		Width:  width,
+		Height: height,
+		States: states,
+	})
+}
+
+func (lis *ToplevelListener) Close() {
+	lis.Record(xdg.ToplevelCloseEvent{})
+}
+
+func (lis *ToplevelListener) ConfigureBounds(width int32, height int32) {
+	lis.Record(xdg.ToplevelConfigureBoundsEvent{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *ToplevelListener) WmCapabilities(capabilities []byte) {
+	lis.Record(xdg.ToplevelWmCapabilitiesEvent{
+		Capabilities: capabilities,
+	})
+}
+
+// PopupListener is a [xdg.PopupListener] that records the
+// events that it receives.
+type PopupListener struct {
+	wiretest.Recorder[xdg.PopupEvent]
+}
+
+var _ xdg.PopupListener = (*PopupListener)(nil)
+
+func (lis *PopupListener) Configure(x int32, y int32, width int32, height int32) {
+	lis.Record(xdg.PopupConfigureEvent{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *PopupListener) PopupDone() {
+	lis.Record(xdg.PopupPopupDoneEvent{})
+}
+
+func (lis *PopupListener) Repositioned(token uint32) {
+	lis.Record(xdg.PopupRepositionedEvent{
+		Token: token,
+	})
+}
@@ -0,0 +1,86 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package decoration
+
+const (
+	DecorationManagerV1Interface = "zxdg_decoration_manager_v1"
+	DecorationManagerV1Version   = 1
+)
+
+const (
+	ToplevelDecorationV1Interface = "zxdg_toplevel_decoration_v1"
+	ToplevelDecorationV1Version   = 1
+)
+
+type ToplevelDecorationV1Error int64
+
+const (
+	// xdg_toplevel has a buffer attached before configure
+	ToplevelDecorationV1ErrorUnconfiguredBuffer ToplevelDecorationV1Error = 0
+
+	// xdg_toplevel already has a decoration object
+	ToplevelDecorationV1ErrorAlreadyConstructed ToplevelDecorationV1Error = 1
+
+	// xdg_toplevel destroyed before the decoration object
+	ToplevelDecorationV1ErrorOrphaned ToplevelDecorationV1Error = 2
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelDecorationV1Error) Valid() bool {
+	switch enum {
+	case 0, 1, 2:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelDecorationV1Error) String() string {
+	switch enum {
+	case 0:
+		return "ToplevelDecorationV1ErrorUnconfiguredBuffer"
+
+	case 1:
+		return "ToplevelDecorationV1ErrorAlreadyConstructed"
+
+	case 2:
+		return "ToplevelDecorationV1ErrorOrphaned"
+	}
+
+	return "<invalid ToplevelDecorationV1Error>"
+}
+
+// These values describe window decoration modes.
+type ToplevelDecorationV1Mode int64
+
+const (
+	// no server-side window decoration
+	ToplevelDecorationV1ModeClientSide ToplevelDecorationV1Mode = 1
+
+	// server-side window decoration
+	ToplevelDecorationV1ModeServerSide ToplevelDecorationV1Mode = 2
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelDecorationV1Mode) Valid() bool {
+	switch enum {
+	case 1, 2:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelDecorationV1Mode) String() string {
+	switch enum {
+	case 1:
+		return "ToplevelDecorationV1ModeClientSide"
+
+	case 2:
+		return "ToplevelDecorationV1ModeServerSide"
+	}
+
+	return "<invalid ToplevelDecorationV1Mode>"
+}
@@ -0,0 +1,872 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package decoration
+
+import (
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/xdg/decoration"
+	xdg "deedles.dev/wl/xdg/server"
+	"fmt"
+)
+
+const (
+	DecorationManagerV1Interface = shared.DecorationManagerV1Interface
+
+	// DecorationManagerV1Version is the version of the interface that this
+	// package implements.
+	DecorationManagerV1Version = 1
+)
+
+// The versions of the zxdg_decoration_manager_v1 interface that its messages were
+// added in.
+const (
+	DecorationManagerV1DestroyRequestSince               = 1
+	DecorationManagerV1GetToplevelDecorationRequestSince = 1
+)
+
+// DecorationManagerV1Desc describes the zxdg_decoration_manager_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var DecorationManagerV1Desc = &wire.InterfaceDesc{
+	Name:    DecorationManagerV1Interface,
+	Version: DecorationManagerV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_toplevel_decoration",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "zxdg_toplevel_decoration_v1",
+				},
+				{
+					Name:      "toplevel",
+					Type:      wire.ArgObject,
+					Interface: "xdg_toplevel",
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums:  []wire.EnumDesc{},
+}
+
+// DecorationManagerV1Listener is a type that can respond to incoming
+// messages for a DecorationManagerV1 object.
+type DecorationManagerV1Listener interface {
+	// Destroy the decoration manager. This doesn't destroy objects created
+	// with the manager.
+	Destroy()
+
+	// Create a new decoration object associated with the given toplevel.
+	//
+	// Creating an xdg_toplevel_decoration from an xdg_toplevel which has a
+	// buffer attached or committed is a client error, and any attempts by a
+	// client to attach or manipulate a buffer prior to the first
+	// xdg_toplevel_decoration.configure event must also be treated as
+	// errors.
+	GetToplevelDecoration(id *ToplevelDecorationV1, toplevel *xdg.Toplevel)
+}
+
+// DecorationManagerV1ListenerFuncs implements DecorationManagerV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type DecorationManagerV1ListenerFuncs struct {
+	OnDestroy               func()
+	OnGetToplevelDecoration func(id *ToplevelDecorationV1, toplevel *xdg.Toplevel)
+}
+
+func (lis *DecorationManagerV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *DecorationManagerV1ListenerFuncs) GetToplevelDecoration(id *ToplevelDecorationV1, toplevel *xdg.Toplevel) {
+	if lis.OnGetToplevelDecoration != nil {
+		lis.OnGetToplevelDecoration(id, toplevel)
+	}
+}
+
+// DecorationManagerV1Request is implemented by the message types of all
+// of the requests that a DecorationManagerV1 can receive.
+type DecorationManagerV1Request interface {
+	decorationManagerV1Request()
+}
+
+// DecorationManagerV1RequestFunc implements DecorationManagerV1Listener by calling
+// itself with each incoming message.
+type DecorationManagerV1RequestFunc func(DecorationManagerV1Request)
+
+func (f DecorationManagerV1RequestFunc) Destroy() {
+	f(DecorationManagerV1DestroyRequest{})
+}
+
+func (f DecorationManagerV1RequestFunc) GetToplevelDecoration(id *ToplevelDecorationV1, toplevel *xdg.Toplevel) {
+	f(DecorationManagerV1GetToplevelDecorationRequest{
+		Id:       id,
+		Toplevel: toplevel,
+	})
+}
+
+// This interface allows a compositor to announce support for server-side
+// decorations.
+//
+// A window decoration is a set of window controls as deemed appropriate by
+// the party managing them, such as user interface components used to move,
+// resize and change a window's state.
+//
+// A client can use this protocol to request being decorated by a supporting
+// compositor.
+//
+// If compositor and client do not negotiate the use of a server-side
+// decoration using this protocol, clients continue to self-decorate as they
+// see fit.
+//
+// Warning! The protocol described in this file is experimental and
+// backward incompatible changes may be made. Backward compatible changes
+// may be added together with the corresponding interface version bump.
+// Backward incompatible changes are done by bumping the version number in
+// the protocol and interface names and resetting the interface version.
+// Once the protocol is to be declared stable, the 'z' prefix and the
+// version number in the protocol and interface names are removed and the
+// interface version number is reset.
+type DecorationManagerV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener DecorationManagerV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewDecorationManagerV1 returns a newly instantiated DecorationManagerV1. It is
+// primarily intended for use by generated code.
+func NewDecorationManagerV1(state wire.State) *DecorationManagerV1 {
+	return &DecorationManagerV1{state: state}
+}
+
+func BindDecorationManagerV1(state wire.State, id wire.NewID) *DecorationManagerV1 {
+	obj := NewDecorationManagerV1(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *DecorationManagerV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *DecorationManagerV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m DecorationManagerV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m DecorationManagerV1GetToplevelDecorationRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetToplevelDecoration(
+			m.Id,
+			m.Toplevel,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(DecorationManagerV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zxdg_decoration_manager_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *DecorationManagerV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *DecorationManagerV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *DecorationManagerV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *DecorationManagerV1) String() string {
+	return fmt.Sprintf("%v(%v)", "zxdg_decoration_manager_v1", obj.id)
+}
+
+func (obj *DecorationManagerV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "get_toplevel_decoration"
+	}
+
+	return "unknown method"
+}
+
+func (obj *DecorationManagerV1) Interface() string {
+	return DecorationManagerV1Interface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *DecorationManagerV1ListenerFuncs, it is replaced with one.
+func (obj *DecorationManagerV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*DecorationManagerV1ListenerFuncs)
+	if !ok {
+		lis = new(DecorationManagerV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetToplevelDecoration sets the function that is called when the
+// get_toplevel_decoration request is received. If obj's Listener is not a
+// *DecorationManagerV1ListenerFuncs, it is replaced with one.
+func (obj *DecorationManagerV1) OnGetToplevelDecoration(f func(id *ToplevelDecorationV1, toplevel *xdg.Toplevel)) {
+	lis, ok := obj.Listener.(*DecorationManagerV1ListenerFuncs)
+	if !ok {
+		lis = new(DecorationManagerV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetToplevelDecoration = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *DecorationManagerV1) Subscribe() *wire.Messages[DecorationManagerV1Request] {
+	q := new(wire.Messages[DecorationManagerV1Request])
+	obj.Listener = DecorationManagerV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *DecorationManagerV1) Version() uint32 {
+	return DecorationManagerV1Version
+}
+
+// DecorationManagerV1DestroyRequest holds the arguments of the destroy request of
+// the zxdg_decoration_manager_v1 interface.
+type DecorationManagerV1DestroyRequest struct {
+}
+
+func (DecorationManagerV1DestroyRequest) decorationManagerV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DecorationManagerV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DecorationManagerV1DestroyRequest) Encode(obj *DecorationManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// DecorationManagerV1GetToplevelDecorationRequest holds the arguments of the get_toplevel_decoration request of
+// the zxdg_decoration_manager_v1 interface.
+type DecorationManagerV1GetToplevelDecorationRequest struct {
+	Id       *ToplevelDecorationV1
+	Toplevel *xdg.Toplevel
+}
+
+func (DecorationManagerV1GetToplevelDecorationRequest) decorationManagerV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *DecorationManagerV1GetToplevelDecorationRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewToplevelDecorationV1(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ToplevelDecorationV1Interface, msg.ReadNewObject(ToplevelDecorationV1Interface), true))
+	m.Toplevel = wire.ResolveObject[*xdg.Toplevel](msg, state, xdg.ToplevelInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m DecorationManagerV1GetToplevelDecorationRequest) Encode(obj *DecorationManagerV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Toplevel)
+
+	builder.Method = "get_toplevel_decoration"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ToplevelDecorationV1Interface, ID: m.Id.ID()}, m.Toplevel}
+	}
+	return builder
+}
+
+const (
+	ToplevelDecorationV1Interface = shared.ToplevelDecorationV1Interface
+
+	// ToplevelDecorationV1Version is the version of the interface that this
+	// package implements.
+	ToplevelDecorationV1Version = 1
+)
+
+// The versions of the zxdg_toplevel_decoration_v1 interface that its messages were
+// added in.
+const (
+	ToplevelDecorationV1DestroyRequestSince   = 1
+	ToplevelDecorationV1SetModeRequestSince   = 1
+	ToplevelDecorationV1UnsetModeRequestSince = 1
+	ToplevelDecorationV1ConfigureEventSince   = 1
+)
+
+// ToplevelDecorationV1Desc describes the zxdg_toplevel_decoration_v1 interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ToplevelDecorationV1Desc = &wire.InterfaceDesc{
+	Name:    ToplevelDecorationV1Interface,
+	Version: ToplevelDecorationV1Version,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_mode",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mode",
+					Type: wire.ArgUint,
+					Enum: "mode",
+				},
+			},
+		},
+		{
+			Name:  "unset_mode",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "mode",
+					Type: wire.ArgUint,
+					Enum: "mode",
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "unconfigured_buffer", Value: 0},
+				{Name: "already_constructed", Value: 1},
+				{Name: "orphaned", Value: 2},
+			},
+		},
+		{
+			Name: "mode",
+			Entries: []wire.EntryDesc{
+				{Name: "client_side", Value: 1},
+				{Name: "server_side", Value: 2},
+			},
+		},
+	},
+}
+
+// ToplevelDecorationV1Listener is a type that can respond to incoming
+// messages for a ToplevelDecorationV1 object.
+type ToplevelDecorationV1Listener interface {
+	// Switch back to a mode without any server-side decorations at the next
+	// commit.
+	Destroy()
+
+	// Set the toplevel surface decoration mode. This informs the compositor
+	// that the client prefers the provided decoration mode.
+	//
+	// After requesting a decoration mode, the compositor will respond by
+	// emitting an xdg_surface.configure event. The client should then update
+	// its content, drawing it without decorations if the received mode is
+	// server-side decorations. The client must also acknowledge the configure
+	// when committing the new content (see xdg_surface.ack_configure).
+	//
+	// The compositor can decide not to use the client's mode and enforce a
+	// different mode instead.
+	//
+	// Clients whose decoration mode depend on the xdg_toplevel state may send
+	// a set_mode request in response to an xdg_surface.configure event and wait
+	// for the next xdg_surface.configure event to prevent unwanted state.
+	// Such clients are responsible for preventing configure loops and must
+	// make sure not to send multiple successive set_mode requests with the
+	// same decoration mode.
+	SetMode(mode ToplevelDecorationV1Mode)
+
+	// Unset the toplevel surface decoration mode. This informs the compositor
+	// that the client doesn't prefer a particular decoration mode.
+	//
+	// This request has the same semantics as set_mode.
+	UnsetMode()
+}
+
+// ToplevelDecorationV1ListenerFuncs implements ToplevelDecorationV1Listener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ToplevelDecorationV1ListenerFuncs struct {
+	OnDestroy   func()
+	OnSetMode   func(mode ToplevelDecorationV1Mode)
+	OnUnsetMode func()
+}
+
+func (lis *ToplevelDecorationV1ListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ToplevelDecorationV1ListenerFuncs) SetMode(mode ToplevelDecorationV1Mode) {
+	if lis.OnSetMode != nil {
+		lis.OnSetMode(mode)
+	}
+}
+
+func (lis *ToplevelDecorationV1ListenerFuncs) UnsetMode() {
+	if lis.OnUnsetMode != nil {
+		lis.OnUnsetMode()
+	}
+}
+
+// ToplevelDecorationV1Request is implemented by the message types of all
+// of the requests that a ToplevelDecorationV1 can receive.
+type ToplevelDecorationV1Request interface {
+	toplevelDecorationV1Request()
+}
+
+// ToplevelDecorationV1RequestFunc implements ToplevelDecorationV1Listener by calling
+// itself with each incoming message.
+type ToplevelDecorationV1RequestFunc func(ToplevelDecorationV1Request)
+
+func (f ToplevelDecorationV1RequestFunc) Destroy() {
+	f(ToplevelDecorationV1DestroyRequest{})
+}
+
+func (f ToplevelDecorationV1RequestFunc) SetMode(mode ToplevelDecorationV1Mode) {
+	f(ToplevelDecorationV1SetModeRequest{
+		Mode: mode,
+	})
+}
+
+func (f ToplevelDecorationV1RequestFunc) UnsetMode() {
+	f(ToplevelDecorationV1UnsetModeRequest{})
+}
+
+// The decoration object allows the compositor to toggle server-side window
+// decorations for a toplevel surface. The client can request to switch to
+// another mode.
+//
+// The xdg_toplevel_decoration object must be destroyed before its
+// xdg_toplevel.
+type ToplevelDecorationV1 struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ToplevelDecorationV1Listener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewToplevelDecorationV1 returns a newly instantiated ToplevelDecorationV1. It is
+// primarily intended for use by generated code.
+func NewToplevelDecorationV1(state wire.State) *ToplevelDecorationV1 {
+	return &ToplevelDecorationV1{state: state}
+}
+
+func (obj *ToplevelDecorationV1) State() wire.State {
+	return obj.state
+}
+
+func (obj *ToplevelDecorationV1) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ToplevelDecorationV1DestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m ToplevelDecorationV1SetModeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetMode(
+			m.Mode,
+		)
+		return nil
+
+	case 2:
+		var m ToplevelDecorationV1UnsetModeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.UnsetMode()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ToplevelDecorationV1Interface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "zxdg_toplevel_decoration_v1",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *ToplevelDecorationV1) ID() uint32 {
+	return obj.id
+}
+
+func (obj *ToplevelDecorationV1) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *ToplevelDecorationV1) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *ToplevelDecorationV1) String() string {
+	return fmt.Sprintf("%v(%v)", "zxdg_toplevel_decoration_v1", obj.id)
+}
+
+func (obj *ToplevelDecorationV1) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "set_mode"
+
+	case 2:
+		return "unset_mode"
+	}
+
+	return "unknown method"
+}
+
+func (obj *ToplevelDecorationV1) Interface() string {
+	return ToplevelDecorationV1Interface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ToplevelDecorationV1ListenerFuncs, it is replaced with one.
+func (obj *ToplevelDecorationV1) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ToplevelDecorationV1ListenerFuncs)
+	if !ok {
+		lis = new(ToplevelDecorationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetMode sets the function that is called when the
+// set_mode request is received. If obj's Listener is not a
+// *ToplevelDecorationV1ListenerFuncs, it is replaced with one.
+func (obj *ToplevelDecorationV1) OnSetMode(f func(mode ToplevelDecorationV1Mode)) {
+	lis, ok := obj.Listener.(*ToplevelDecorationV1ListenerFuncs)
+	if !ok {
+		lis = new(ToplevelDecorationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMode = f
+}
+
+// OnUnsetMode sets the function that is called when the
+// unset_mode request is received. If obj's Listener is not a
+// *ToplevelDecorationV1ListenerFuncs, it is replaced with one.
+func (obj *ToplevelDecorationV1) OnUnsetMode(f func()) {
+	lis, ok := obj.Listener.(*ToplevelDecorationV1ListenerFuncs)
+	if !ok {
+		lis = new(ToplevelDecorationV1ListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnUnsetMode = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *ToplevelDecorationV1) Subscribe() *wire.Messages[ToplevelDecorationV1Request] {
+	q := new(wire.Messages[ToplevelDecorationV1Request])
+	obj.Listener = ToplevelDecorationV1RequestFunc(q.Push)
+	return q
+}
+
+func (obj *ToplevelDecorationV1) Version() uint32 {
+	return ToplevelDecorationV1Version
+}
+
+// The configure event configures the effective decoration mode. The
+// configured state should not be applied immediately. Clients must send an
+// ack_configure in response to this event. See xdg_surface.configure and
+// xdg_surface.ack_configure for details.
+//
+// A configure event can be sent at any time. The specified mode must be
+// obeyed by the client.
+func (obj *ToplevelDecorationV1) Configure(mode ToplevelDecorationV1Mode) {
+	obj.state.Enqueue(ToplevelDecorationV1ConfigureEvent{
+		Mode: mode,
+	}.Encode(obj))
+	return
+}
+
+// ToplevelDecorationV1DestroyRequest holds the arguments of the destroy request of
+// the zxdg_toplevel_decoration_v1 interface.
+type ToplevelDecorationV1DestroyRequest struct {
+}
+
+func (ToplevelDecorationV1DestroyRequest) toplevelDecorationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelDecorationV1DestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelDecorationV1DestroyRequest) Encode(obj *ToplevelDecorationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelDecorationV1SetModeRequest holds the arguments of the set_mode request of
+// the zxdg_toplevel_decoration_v1 interface.
+type ToplevelDecorationV1SetModeRequest struct {
+	Mode ToplevelDecorationV1Mode
+}
+
+func (ToplevelDecorationV1SetModeRequest) toplevelDecorationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelDecorationV1SetModeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Mode = ToplevelDecorationV1Mode(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Mode.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "zxdg_toplevel_decoration_v1",
+			Method:    "set_mode",
+			Arg:       "mode",
+			Value:     int64(m.Mode),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelDecorationV1SetModeRequest) Encode(obj *ToplevelDecorationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteUint(uint32(m.Mode))
+
+	builder.Method = "set_mode"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Mode}
+	}
+	return builder
+}
+
+// ToplevelDecorationV1UnsetModeRequest holds the arguments of the unset_mode request of
+// the zxdg_toplevel_decoration_v1 interface.
+type ToplevelDecorationV1UnsetModeRequest struct {
+}
+
+func (ToplevelDecorationV1UnsetModeRequest) toplevelDecorationV1Request() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelDecorationV1UnsetModeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelDecorationV1UnsetModeRequest) Encode(obj *ToplevelDecorationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+
+	builder.Method = "unset_mode"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelDecorationV1ConfigureEvent holds the arguments of the configure event of
+// the zxdg_toplevel_decoration_v1 interface.
+type ToplevelDecorationV1ConfigureEvent struct {
+	Mode ToplevelDecorationV1Mode
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelDecorationV1ConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Mode = ToplevelDecorationV1Mode(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Mode.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "zxdg_toplevel_decoration_v1",
+			Method:    "configure",
+			Arg:       "mode",
+			Value:     int64(m.Mode),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelDecorationV1ConfigureEvent) Encode(obj *ToplevelDecorationV1) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(uint32(m.Mode))
+
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Mode}
+	}
+	return builder
+}
+
+// ToplevelDecorationV1Error is an alias for [shared.ToplevelDecorationV1Error].
+type ToplevelDecorationV1Error = shared.ToplevelDecorationV1Error
+
+const (
+	ToplevelDecorationV1ErrorUnconfiguredBuffer = shared.ToplevelDecorationV1ErrorUnconfiguredBuffer
+	ToplevelDecorationV1ErrorAlreadyConstructed = shared.ToplevelDecorationV1ErrorAlreadyConstructed
+	ToplevelDecorationV1ErrorOrphaned           = shared.ToplevelDecorationV1ErrorOrphaned
+)
+
+// ToplevelDecorationV1Mode is an alias for [shared.ToplevelDecorationV1Mode].
+type ToplevelDecorationV1Mode = shared.ToplevelDecorationV1Mode
+
+const (
+	ToplevelDecorationV1ModeClientSide = shared.ToplevelDecorationV1ModeClientSide
+	ToplevelDecorationV1ModeServerSide = shared.ToplevelDecorationV1ModeServerSide
+)
+
+func init() {
+	wire.RegisterInterface(DecorationManagerV1Desc, false)
+	wire.RegisterInterface(ToplevelDecorationV1Desc, false)
+}
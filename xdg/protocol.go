@@ -0,0 +1,682 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package xdg
+
+import "fmt"
+
+const (
+	WmBaseInterface = "xdg_wm_base"
+	WmBaseVersion   = 7
+)
+
+type WmBaseError int64
+
+const (
+	// given wl_surface has another role
+	WmBaseErrorRole WmBaseError = 0
+
+	// xdg_wm_base was destroyed before children
+	WmBaseErrorDefunctSurfaces WmBaseError = 1
+
+	// the client tried to map or destroy a non-topmost popup
+	WmBaseErrorNotTheTopmostPopup WmBaseError = 2
+
+	// the client specified an invalid popup parent surface
+	WmBaseErrorInvalidPopupParent WmBaseError = 3
+
+	// the client provided an invalid surface state
+	WmBaseErrorInvalidSurfaceState WmBaseError = 4
+
+	// the client provided an invalid positioner
+	WmBaseErrorInvalidPositioner WmBaseError = 5
+
+	// the client didn’t respond to a ping event in time
+	WmBaseErrorUnresponsive WmBaseError = 6
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum WmBaseError) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5, 6:
+		return true
+	}
+
+	return false
+}
+
+func (enum WmBaseError) String() string {
+	switch enum {
+	case 0:
+		return "WmBaseErrorRole"
+
+	case 1:
+		return "WmBaseErrorDefunctSurfaces"
+
+	case 2:
+		return "WmBaseErrorNotTheTopmostPopup"
+
+	case 3:
+		return "WmBaseErrorInvalidPopupParent"
+
+	case 4:
+		return "WmBaseErrorInvalidSurfaceState"
+
+	case 5:
+		return "WmBaseErrorInvalidPositioner"
+
+	case 6:
+		return "WmBaseErrorUnresponsive"
+	}
+
+	return "<invalid WmBaseError>"
+}
+
+const (
+	PositionerInterface = "xdg_positioner"
+	PositionerVersion   = 7
+)
+
+type PositionerError int64
+
+const (
+	// invalid input provided
+	PositionerErrorInvalidInput PositionerError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PositionerError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum PositionerError) String() string {
+	switch enum {
+	case 0:
+		return "PositionerErrorInvalidInput"
+	}
+
+	return "<invalid PositionerError>"
+}
+
+type PositionerAnchor int64
+
+const (
+	PositionerAnchorNone PositionerAnchor = 0
+
+	PositionerAnchorTop PositionerAnchor = 1
+
+	PositionerAnchorBottom PositionerAnchor = 2
+
+	PositionerAnchorLeft PositionerAnchor = 3
+
+	PositionerAnchorRight PositionerAnchor = 4
+
+	PositionerAnchorTopLeft PositionerAnchor = 5
+
+	PositionerAnchorBottomLeft PositionerAnchor = 6
+
+	PositionerAnchorTopRight PositionerAnchor = 7
+
+	PositionerAnchorBottomRight PositionerAnchor = 8
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PositionerAnchor) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5, 6, 7, 8:
+		return true
+	}
+
+	return false
+}
+
+func (enum PositionerAnchor) String() string {
+	switch enum {
+	case 0:
+		return "PositionerAnchorNone"
+
+	case 1:
+		return "PositionerAnchorTop"
+
+	case 2:
+		return "PositionerAnchorBottom"
+
+	case 3:
+		return "PositionerAnchorLeft"
+
+	case 4:
+		return "PositionerAnchorRight"
+
+	case 5:
+		return "PositionerAnchorTopLeft"
+
+	case 6:
+		return "PositionerAnchorBottomLeft"
+
+	case 7:
+		return "PositionerAnchorTopRight"
+
+	case 8:
+		return "PositionerAnchorBottomRight"
+	}
+
+	return "<invalid PositionerAnchor>"
+}
+
+type PositionerGravity int64
+
+const (
+	PositionerGravityNone PositionerGravity = 0
+
+	PositionerGravityTop PositionerGravity = 1
+
+	PositionerGravityBottom PositionerGravity = 2
+
+	PositionerGravityLeft PositionerGravity = 3
+
+	PositionerGravityRight PositionerGravity = 4
+
+	PositionerGravityTopLeft PositionerGravity = 5
+
+	PositionerGravityBottomLeft PositionerGravity = 6
+
+	PositionerGravityTopRight PositionerGravity = 7
+
+	PositionerGravityBottomRight PositionerGravity = 8
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PositionerGravity) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 3, 4, 5, 6, 7, 8:
+		return true
+	}
+
+	return false
+}
+
+func (enum PositionerGravity) String() string {
+	switch enum {
+	case 0:
+		return "PositionerGravityNone"
+
+	case 1:
+		return "PositionerGravityTop"
+
+	case 2:
+		return "PositionerGravityBottom"
+
+	case 3:
+		return "PositionerGravityLeft"
+
+	case 4:
+		return "PositionerGravityRight"
+
+	case 5:
+		return "PositionerGravityTopLeft"
+
+	case 6:
+		return "PositionerGravityBottomLeft"
+
+	case 7:
+		return "PositionerGravityTopRight"
+
+	case 8:
+		return "PositionerGravityBottomRight"
+	}
+
+	return "<invalid PositionerGravity>"
+}
+
+// The constraint adjustment value define ways the compositor will adjust
+// the position of the surface, if the unadjusted position would result
+// in the surface being partly constrained.
+//
+// Whether a surface is considered 'constrained' is left to the compositor
+// to determine. For example, the surface may be partly outside the
+// compositor's defined 'work area', thus necessitating the child surface's
+// position be adjusted until it is entirely inside the work area.
+//
+// The adjustments can be combined, according to a defined precedence: 1)
+// Flip, 2) Slide, 3) Resize.
+type PositionerConstraintAdjustment int64
+
+const (
+	PositionerConstraintAdjustmentNone PositionerConstraintAdjustment = 0
+
+	PositionerConstraintAdjustmentSlideX PositionerConstraintAdjustment = 1
+
+	PositionerConstraintAdjustmentSlideY PositionerConstraintAdjustment = 2
+
+	PositionerConstraintAdjustmentFlipX PositionerConstraintAdjustment = 4
+
+	PositionerConstraintAdjustmentFlipY PositionerConstraintAdjustment = 8
+
+	PositionerConstraintAdjustmentResizeX PositionerConstraintAdjustment = 16
+
+	PositionerConstraintAdjustmentResizeY PositionerConstraintAdjustment = 32
+)
+
+// Has reports whether all of the bits in flags are set in enum.
+func (enum PositionerConstraintAdjustment) Has(flags PositionerConstraintAdjustment) bool {
+	return enum&flags == flags
+}
+
+// With returns enum with all of the bits in flags set.
+func (enum PositionerConstraintAdjustment) With(flags PositionerConstraintAdjustment) PositionerConstraintAdjustment {
+	return enum | flags
+}
+
+// Without returns enum with all of the bits in flags cleared.
+func (enum PositionerConstraintAdjustment) Without(flags PositionerConstraintAdjustment) PositionerConstraintAdjustment {
+	return enum &^ flags
+}
+
+// Valid reports whether enum consists only of bits defined by
+// the protocol.
+func (enum PositionerConstraintAdjustment) Valid() bool {
+	const mask = 0 | PositionerConstraintAdjustmentNone | PositionerConstraintAdjustmentSlideX | PositionerConstraintAdjustmentSlideY | PositionerConstraintAdjustmentFlipX | PositionerConstraintAdjustmentFlipY | PositionerConstraintAdjustmentResizeX | PositionerConstraintAdjustmentResizeY
+	return enum.Without(mask) == 0
+}
+
+func (enum PositionerConstraintAdjustment) String() string {
+	if enum == 0 {
+		return "PositionerConstraintAdjustmentNone"
+	}
+
+	var str string
+	if enum.Has(PositionerConstraintAdjustmentSlideX) {
+		str += "|PositionerConstraintAdjustmentSlideX"
+		enum = enum.Without(PositionerConstraintAdjustmentSlideX)
+	}
+	if enum.Has(PositionerConstraintAdjustmentSlideY) {
+		str += "|PositionerConstraintAdjustmentSlideY"
+		enum = enum.Without(PositionerConstraintAdjustmentSlideY)
+	}
+	if enum.Has(PositionerConstraintAdjustmentFlipX) {
+		str += "|PositionerConstraintAdjustmentFlipX"
+		enum = enum.Without(PositionerConstraintAdjustmentFlipX)
+	}
+	if enum.Has(PositionerConstraintAdjustmentFlipY) {
+		str += "|PositionerConstraintAdjustmentFlipY"
+		enum = enum.Without(PositionerConstraintAdjustmentFlipY)
+	}
+	if enum.Has(PositionerConstraintAdjustmentResizeX) {
+		str += "|PositionerConstraintAdjustmentResizeX"
+		enum = enum.Without(PositionerConstraintAdjustmentResizeX)
+	}
+	if enum.Has(PositionerConstraintAdjustmentResizeY) {
+		str += "|PositionerConstraintAdjustmentResizeY"
+		enum = enum.Without(PositionerConstraintAdjustmentResizeY)
+	}
+	if enum != 0 {
+		str += fmt.Sprintf("|%#x", int64(enum))
+	}
+	return str[1:]
+}
+
+const (
+	SurfaceInterface = "xdg_surface"
+	SurfaceVersion   = 7
+)
+
+type SurfaceError int64
+
+const (
+	// Surface was not fully constructed
+	SurfaceErrorNotConstructed SurfaceError = 1
+
+	// Surface was already constructed
+	SurfaceErrorAlreadyConstructed SurfaceError = 2
+
+	// Attaching a buffer to an unconfigured surface
+	SurfaceErrorUnconfiguredBuffer SurfaceError = 3
+
+	// Invalid serial number when acking a configure event
+	SurfaceErrorInvalidSerial SurfaceError = 4
+
+	// Width or height was zero or negative
+	SurfaceErrorInvalidSize SurfaceError = 5
+
+	// Surface was destroyed before its role object
+	SurfaceErrorDefunctRoleObject SurfaceError = 6
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum SurfaceError) Valid() bool {
+	switch enum {
+	case 1, 2, 3, 4, 5, 6:
+		return true
+	}
+
+	return false
+}
+
+func (enum SurfaceError) String() string {
+	switch enum {
+	case 1:
+		return "SurfaceErrorNotConstructed"
+
+	case 2:
+		return "SurfaceErrorAlreadyConstructed"
+
+	case 3:
+		return "SurfaceErrorUnconfiguredBuffer"
+
+	case 4:
+		return "SurfaceErrorInvalidSerial"
+
+	case 5:
+		return "SurfaceErrorInvalidSize"
+
+	case 6:
+		return "SurfaceErrorDefunctRoleObject"
+	}
+
+	return "<invalid SurfaceError>"
+}
+
+const (
+	ToplevelInterface = "xdg_toplevel"
+	ToplevelVersion   = 7
+)
+
+type ToplevelError int64
+
+const (
+	// provided value is
+	// not a valid variant of the resize_edge enum
+	ToplevelErrorInvalidResizeEdge ToplevelError = 0
+
+	// invalid parent toplevel
+	ToplevelErrorInvalidParent ToplevelError = 1
+
+	// client provided an invalid min or max size
+	ToplevelErrorInvalidSize ToplevelError = 2
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelError) Valid() bool {
+	switch enum {
+	case 0, 1, 2:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelError) String() string {
+	switch enum {
+	case 0:
+		return "ToplevelErrorInvalidResizeEdge"
+
+	case 1:
+		return "ToplevelErrorInvalidParent"
+
+	case 2:
+		return "ToplevelErrorInvalidSize"
+	}
+
+	return "<invalid ToplevelError>"
+}
+
+// These values are used to indicate which edge of a surface
+// is being dragged in a resize operation.
+type ToplevelResizeEdge int64
+
+const (
+	ToplevelResizeEdgeNone ToplevelResizeEdge = 0
+
+	ToplevelResizeEdgeTop ToplevelResizeEdge = 1
+
+	ToplevelResizeEdgeBottom ToplevelResizeEdge = 2
+
+	ToplevelResizeEdgeLeft ToplevelResizeEdge = 4
+
+	ToplevelResizeEdgeTopLeft ToplevelResizeEdge = 5
+
+	ToplevelResizeEdgeBottomLeft ToplevelResizeEdge = 6
+
+	ToplevelResizeEdgeRight ToplevelResizeEdge = 8
+
+	ToplevelResizeEdgeTopRight ToplevelResizeEdge = 9
+
+	ToplevelResizeEdgeBottomRight ToplevelResizeEdge = 10
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelResizeEdge) Valid() bool {
+	switch enum {
+	case 0, 1, 2, 4, 5, 6, 8, 9, 10:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelResizeEdge) String() string {
+	switch enum {
+	case 0:
+		return "ToplevelResizeEdgeNone"
+
+	case 1:
+		return "ToplevelResizeEdgeTop"
+
+	case 2:
+		return "ToplevelResizeEdgeBottom"
+
+	case 4:
+		return "ToplevelResizeEdgeLeft"
+
+	case 5:
+		return "ToplevelResizeEdgeTopLeft"
+
+	case 6:
+		return "ToplevelResizeEdgeBottomLeft"
+
+	case 8:
+		return "ToplevelResizeEdgeRight"
+
+	case 9:
+		return "ToplevelResizeEdgeTopRight"
+
+	case 10:
+		return "ToplevelResizeEdgeBottomRight"
+	}
+
+	return "<invalid ToplevelResizeEdge>"
+}
+
+// The different state values used on the surface. This is designed for
+// state values like maximized, fullscreen. It is paired with the
+// configure event to ensure that both the client and the compositor
+// setting the state can be synchronized.
+//
+// States set in this way are double-buffered, see wl_surface.commit.
+type ToplevelState int64
+
+const (
+	// the surface is maximized
+	ToplevelStateMaximized ToplevelState = 1
+
+	// the surface is fullscreen
+	ToplevelStateFullscreen ToplevelState = 2
+
+	// the surface is being resized
+	ToplevelStateResizing ToplevelState = 3
+
+	// the surface is now activated
+	ToplevelStateActivated ToplevelState = 4
+
+	// Available since version 2.
+	ToplevelStateTiledLeft ToplevelState = 5
+
+	// Available since version 2.
+	ToplevelStateTiledRight ToplevelState = 6
+
+	// Available since version 2.
+	ToplevelStateTiledTop ToplevelState = 7
+
+	// Available since version 2.
+	ToplevelStateTiledBottom ToplevelState = 8
+
+	// Available since version 6.
+	ToplevelStateSuspended ToplevelState = 9
+
+	// Available since version 7.
+	ToplevelStateConstrainedLeft ToplevelState = 10
+
+	// Available since version 7.
+	ToplevelStateConstrainedRight ToplevelState = 11
+
+	// Available since version 7.
+	ToplevelStateConstrainedTop ToplevelState = 12
+
+	// Available since version 7.
+	ToplevelStateConstrainedBottom ToplevelState = 13
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelState) Valid() bool {
+	switch enum {
+	case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelState) String() string {
+	switch enum {
+	case 1:
+		return "ToplevelStateMaximized"
+
+	case 2:
+		return "ToplevelStateFullscreen"
+
+	case 3:
+		return "ToplevelStateResizing"
+
+	case 4:
+		return "ToplevelStateActivated"
+
+	case 5:
+		return "ToplevelStateTiledLeft"
+
+	case 6:
+		return "ToplevelStateTiledRight"
+
+	case 7:
+		return "ToplevelStateTiledTop"
+
+	case 8:
+		return "ToplevelStateTiledBottom"
+
+	case 9:
+		return "ToplevelStateSuspended"
+
+	case 10:
+		return "ToplevelStateConstrainedLeft"
+
+	case 11:
+		return "ToplevelStateConstrainedRight"
+
+	case 12:
+		return "ToplevelStateConstrainedTop"
+
+	case 13:
+		return "ToplevelStateConstrainedBottom"
+	}
+
+	return "<invalid ToplevelState>"
+}
+
+// Available since version 5.
+type ToplevelWmCapabilities int64
+
+const (
+	// show_window_menu is available
+	ToplevelWmCapabilitiesWindowMenu ToplevelWmCapabilities = 1
+
+	// set_maximized and unset_maximized are available
+	ToplevelWmCapabilitiesMaximize ToplevelWmCapabilities = 2
+
+	// set_fullscreen and unset_fullscreen are available
+	ToplevelWmCapabilitiesFullscreen ToplevelWmCapabilities = 3
+
+	// set_minimized is available
+	ToplevelWmCapabilitiesMinimize ToplevelWmCapabilities = 4
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum ToplevelWmCapabilities) Valid() bool {
+	switch enum {
+	case 1, 2, 3, 4:
+		return true
+	}
+
+	return false
+}
+
+func (enum ToplevelWmCapabilities) String() string {
+	switch enum {
+	case 1:
+		return "ToplevelWmCapabilitiesWindowMenu"
+
+	case 2:
+		return "ToplevelWmCapabilitiesMaximize"
+
+	case 3:
+		return "ToplevelWmCapabilitiesFullscreen"
+
+	case 4:
+		return "ToplevelWmCapabilitiesMinimize"
+	}
+
+	return "<invalid ToplevelWmCapabilities>"
+}
+
+const (
+	PopupInterface = "xdg_popup"
+	PopupVersion   = 7
+)
+
+type PopupError int64
+
+const (
+	// tried to grab after being mapped
+	PopupErrorInvalidGrab PopupError = 0
+)
+
+// Valid reports whether enum is one of the values defined by the
+// protocol.
+func (enum PopupError) Valid() bool {
+	switch enum {
+	case 0:
+		return true
+	}
+
+	return false
+}
+
+func (enum PopupError) String() string {
+	switch enum {
+	case 0:
+		return "PopupErrorInvalidGrab"
+	}
+
+	return "<invalid PopupError>"
+}
@@ -0,0 +1,5599 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+package xdg
+
+import (
+	wl "deedles.dev/wl/server"
+	"deedles.dev/wl/wire"
+	shared "deedles.dev/wl/xdg"
+	"fmt"
+)
+
+const (
+	WmBaseInterface = shared.WmBaseInterface
+
+	// WmBaseVersion is the version of the interface that this
+	// package implements.
+	WmBaseVersion = 7
+)
+
+// The versions of the xdg_wm_base interface that its messages were
+// added in.
+const (
+	WmBaseDestroyRequestSince          = 1
+	WmBaseCreatePositionerRequestSince = 1
+	WmBaseGetXdgSurfaceRequestSince    = 1
+	WmBasePongRequestSince             = 1
+	WmBasePingEventSince               = 1
+)
+
+// WmBaseDesc describes the xdg_wm_base interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var WmBaseDesc = &wire.InterfaceDesc{
+	Name:    WmBaseInterface,
+	Version: WmBaseVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "create_positioner",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_positioner",
+				},
+			},
+		},
+		{
+			Name:  "get_xdg_surface",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_surface",
+				},
+				{
+					Name:      "surface",
+					Type:      wire.ArgObject,
+					Interface: "wl_surface",
+				},
+			},
+		},
+		{
+			Name:  "pong",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "ping",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "role", Value: 0},
+				{Name: "defunct_surfaces", Value: 1},
+				{Name: "not_the_topmost_popup", Value: 2},
+				{Name: "invalid_popup_parent", Value: 3},
+				{Name: "invalid_surface_state", Value: 4},
+				{Name: "invalid_positioner", Value: 5},
+				{Name: "unresponsive", Value: 6},
+			},
+		},
+	},
+}
+
+// WmBaseListener is a type that can respond to incoming
+// messages for a WmBase object.
+type WmBaseListener interface {
+	// Destroy this xdg_wm_base object.
+	//
+	// Destroying a bound xdg_wm_base object while there are surfaces
+	// still alive created by this xdg_wm_base object instance is illegal
+	// and will result in a defunct_surfaces error.
+	Destroy()
+
+	// Create a positioner object. A positioner object is used to position
+	// surfaces relative to some parent surface. See the interface description
+	// and xdg_surface.get_popup for details.
+	CreatePositioner(id *Positioner)
+
+	// This creates an xdg_surface for the given surface. While xdg_surface
+	// itself is not a role, the corresponding surface may only be assigned
+	// a role extending xdg_surface, such as xdg_toplevel or xdg_popup. It is
+	// illegal to create an xdg_surface for a wl_surface which already has an
+	// assigned role and this will result in a role error.
+	//
+	// This creates an xdg_surface for the given surface. An xdg_surface is
+	// used as basis to define a role to a given surface, such as xdg_toplevel
+	// or xdg_popup. It also manages functionality shared between xdg_surface
+	// based surface roles.
+	//
+	// See the documentation of xdg_surface for more details about what an
+	// xdg_surface is and how it is used.
+	GetXdgSurface(id *Surface, surface *wl.Surface)
+
+	// A client must respond to a ping event with a pong request or
+	// the client may be deemed unresponsive. See xdg_wm_base.ping
+	// and xdg_wm_base.error.unresponsive.
+	Pong(serial uint32)
+}
+
+// WmBaseListenerFuncs implements WmBaseListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type WmBaseListenerFuncs struct {
+	OnDestroy          func()
+	OnCreatePositioner func(id *Positioner)
+	OnGetXdgSurface    func(id *Surface, surface *wl.Surface)
+	OnPong             func(serial uint32)
+}
+
+func (lis *WmBaseListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *WmBaseListenerFuncs) CreatePositioner(id *Positioner) {
+	if lis.OnCreatePositioner != nil {
+		lis.OnCreatePositioner(id)
+	}
+}
+
+func (lis *WmBaseListenerFuncs) GetXdgSurface(id *Surface, surface *wl.Surface) {
+	if lis.OnGetXdgSurface != nil {
+		lis.OnGetXdgSurface(id, surface)
+	}
+}
+
+func (lis *WmBaseListenerFuncs) Pong(serial uint32) {
+	if lis.OnPong != nil {
+		lis.OnPong(serial)
+	}
+}
+
+// WmBaseRequest is implemented by the message types of all
+// of the requests that a WmBase can receive.
+type WmBaseRequest interface {
+	wmBaseRequest()
+}
+
+// WmBaseRequestFunc implements WmBaseListener by calling
+// itself with each incoming message.
+type WmBaseRequestFunc func(WmBaseRequest)
+
+func (f WmBaseRequestFunc) Destroy() {
+	f(WmBaseDestroyRequest{})
+}
+
+func (f WmBaseRequestFunc) CreatePositioner(id *Positioner) {
+	f(WmBaseCreatePositionerRequest{
+		Id: id,
+	})
+}
+
+func (f WmBaseRequestFunc) GetXdgSurface(id *Surface, surface *wl.Surface) {
+	f(WmBaseGetXdgSurfaceRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
+func (f WmBaseRequestFunc) Pong(serial uint32) {
+	f(WmBasePongRequest{
+		Serial: serial,
+	})
+}
+
+// The xdg_wm_base interface is exposed as a global object enabling clients
+// to turn their wl_surfaces into windows in a desktop environment. It
+// defines the basic functionality needed for clients and the compositor to
+// create windows that can be dragged, resized, maximized, etc, as well as
+// creating transient windows such as popup menus.
+type WmBase struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener WmBaseListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewWmBase returns a newly instantiated WmBase. It is
+// primarily intended for use by generated code.
+func NewWmBase(state wire.State) *WmBase {
+	return &WmBase{state: state}
+}
+
+func BindWmBase(state wire.State, id wire.NewID) *WmBase {
+	obj := NewWmBase(state)
+	obj.SetID(id.ID)
+	state.Add(obj)
+	return obj
+}
+
+func (obj *WmBase) State() wire.State {
+	return obj.state
+}
+
+func (obj *WmBase) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m WmBaseDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m WmBaseCreatePositionerRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.CreatePositioner(
+			m.Id,
+		)
+		return nil
+
+	case 2:
+		var m WmBaseGetXdgSurfaceRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetXdgSurface(
+			m.Id,
+			m.Surface,
+		)
+		return nil
+
+	case 3:
+		var m WmBasePongRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Pong(
+			m.Serial,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(WmBaseInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_wm_base",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *WmBase) ID() uint32 {
+	return obj.id
+}
+
+func (obj *WmBase) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *WmBase) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *WmBase) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_wm_base", obj.id)
+}
+
+func (obj *WmBase) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "create_positioner"
+
+	case 2:
+		return "get_xdg_surface"
+
+	case 3:
+		return "pong"
+	}
+
+	return "unknown method"
+}
+
+func (obj *WmBase) Interface() string {
+	return WmBaseInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *WmBaseListenerFuncs, it is replaced with one.
+func (obj *WmBase) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*WmBaseListenerFuncs)
+	if !ok {
+		lis = new(WmBaseListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnCreatePositioner sets the function that is called when the
+// create_positioner request is received. If obj's Listener is not a
+// *WmBaseListenerFuncs, it is replaced with one.
+func (obj *WmBase) OnCreatePositioner(f func(id *Positioner)) {
+	lis, ok := obj.Listener.(*WmBaseListenerFuncs)
+	if !ok {
+		lis = new(WmBaseListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnCreatePositioner = f
+}
+
+// OnGetXdgSurface sets the function that is called when the
+// get_xdg_surface request is received. If obj's Listener is not a
+// *WmBaseListenerFuncs, it is replaced with one.
+func (obj *WmBase) OnGetXdgSurface(f func(id *Surface, surface *wl.Surface)) {
+	lis, ok := obj.Listener.(*WmBaseListenerFuncs)
+	if !ok {
+		lis = new(WmBaseListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetXdgSurface = f
+}
+
+// OnPong sets the function that is called when the
+// pong request is received. If obj's Listener is not a
+// *WmBaseListenerFuncs, it is replaced with one.
+func (obj *WmBase) OnPong(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*WmBaseListenerFuncs)
+	if !ok {
+		lis = new(WmBaseListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnPong = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *WmBase) Subscribe() *wire.Messages[WmBaseRequest] {
+	q := new(wire.Messages[WmBaseRequest])
+	obj.Listener = WmBaseRequestFunc(q.Push)
+	return q
+}
+
+func (obj *WmBase) Version() uint32 {
+	return WmBaseVersion
+}
+
+// The ping event asks the client if it's still alive. Pass the
+// serial specified in the event back to the compositor by sending
+// a "pong" request back with the specified serial. See xdg_wm_base.pong.
+//
+// Compositors can use this to determine if the client is still
+// alive. It's unspecified what will happen if the client doesn't
+// respond to the ping request, or in what timeframe. Clients should
+// try to respond in a reasonable amount of time. The “unresponsive”
+// error is provided for compositors that wish to disconnect unresponsive
+// clients.
+//
+// A compositor is free to ping in any way it wants, but a client must
+// always respond to any xdg_wm_base object it created.
+func (obj *WmBase) Ping(serial uint32) {
+	obj.state.Enqueue(WmBasePingEvent{
+		Serial: serial,
+	}.Encode(obj))
+	return
+}
+
+// WmBaseDestroyRequest holds the arguments of the destroy request of
+// the xdg_wm_base interface.
+type WmBaseDestroyRequest struct {
+}
+
+func (WmBaseDestroyRequest) wmBaseRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *WmBaseDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m WmBaseDestroyRequest) Encode(obj *WmBase) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// WmBaseCreatePositionerRequest holds the arguments of the create_positioner request of
+// the xdg_wm_base interface.
+type WmBaseCreatePositionerRequest struct {
+	Id *Positioner
+}
+
+func (WmBaseCreatePositionerRequest) wmBaseRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *WmBaseCreatePositionerRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewPositioner(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, PositionerInterface, msg.ReadNewObject(PositionerInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m WmBaseCreatePositionerRequest) Encode(obj *WmBase) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "create_positioner"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: PositionerInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// WmBaseGetXdgSurfaceRequest holds the arguments of the get_xdg_surface request of
+// the xdg_wm_base interface.
+type WmBaseGetXdgSurfaceRequest struct {
+	Id      *Surface
+	Surface *wl.Surface
+}
+
+func (WmBaseGetXdgSurfaceRequest) wmBaseRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *WmBaseGetXdgSurfaceRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewSurface(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, SurfaceInterface, msg.ReadNewObject(SurfaceInterface), true))
+	m.Surface = wire.ResolveObject[*wl.Surface](msg, state, wl.SurfaceInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m WmBaseGetXdgSurfaceRequest) Encode(obj *WmBase) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+	builder.WriteObject(m.Surface)
+
+	builder.Method = "get_xdg_surface"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: SurfaceInterface, ID: m.Id.ID()}, m.Surface}
+	}
+	return builder
+}
+
+// WmBasePongRequest holds the arguments of the pong request of
+// the xdg_wm_base interface.
+type WmBasePongRequest struct {
+	Serial uint32
+}
+
+func (WmBasePongRequest) wmBaseRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *WmBasePongRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m WmBasePongRequest) Encode(obj *WmBase) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "pong"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// WmBasePingEvent holds the arguments of the ping event of
+// the xdg_wm_base interface.
+type WmBasePingEvent struct {
+	Serial uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *WmBasePingEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m WmBasePingEvent) Encode(obj *WmBase) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "ping"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// WmBaseError is an alias for [shared.WmBaseError].
+type WmBaseError = shared.WmBaseError
+
+const (
+	WmBaseErrorRole                = shared.WmBaseErrorRole
+	WmBaseErrorDefunctSurfaces     = shared.WmBaseErrorDefunctSurfaces
+	WmBaseErrorNotTheTopmostPopup  = shared.WmBaseErrorNotTheTopmostPopup
+	WmBaseErrorInvalidPopupParent  = shared.WmBaseErrorInvalidPopupParent
+	WmBaseErrorInvalidSurfaceState = shared.WmBaseErrorInvalidSurfaceState
+	WmBaseErrorInvalidPositioner   = shared.WmBaseErrorInvalidPositioner
+	WmBaseErrorUnresponsive        = shared.WmBaseErrorUnresponsive
+)
+
+const (
+	PositionerInterface = shared.PositionerInterface
+
+	// PositionerVersion is the version of the interface that this
+	// package implements.
+	PositionerVersion = 7
+)
+
+// The versions of the xdg_positioner interface that its messages were
+// added in.
+const (
+	PositionerDestroyRequestSince                 = 1
+	PositionerSetSizeRequestSince                 = 1
+	PositionerSetAnchorRectRequestSince           = 1
+	PositionerSetAnchorRequestSince               = 1
+	PositionerSetGravityRequestSince              = 1
+	PositionerSetConstraintAdjustmentRequestSince = 1
+	PositionerSetOffsetRequestSince               = 1
+	PositionerSetReactiveRequestSince             = 3
+	PositionerSetParentSizeRequestSince           = 3
+	PositionerSetParentConfigureRequestSince      = 3
+)
+
+// PositionerDesc describes the xdg_positioner interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PositionerDesc = &wire.InterfaceDesc{
+	Name:    PositionerInterface,
+	Version: PositionerVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_size",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_anchor_rect",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_anchor",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "anchor",
+					Type: wire.ArgUint,
+					Enum: "anchor",
+				},
+			},
+		},
+		{
+			Name:  "set_gravity",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "gravity",
+					Type: wire.ArgUint,
+					Enum: "gravity",
+				},
+			},
+		},
+		{
+			Name:  "set_constraint_adjustment",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "constraint_adjustment",
+					Type: wire.ArgUint,
+					Enum: "constraint_adjustment",
+				},
+			},
+		},
+		{
+			Name:  "set_offset",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_reactive",
+			Since: 3,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_parent_size",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "parent_width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "parent_height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_parent_configure",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_input", Value: 0},
+			},
+		},
+		{
+			Name: "anchor",
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "top", Value: 1},
+				{Name: "bottom", Value: 2},
+				{Name: "left", Value: 3},
+				{Name: "right", Value: 4},
+				{Name: "top_left", Value: 5},
+				{Name: "bottom_left", Value: 6},
+				{Name: "top_right", Value: 7},
+				{Name: "bottom_right", Value: 8},
+			},
+		},
+		{
+			Name: "gravity",
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "top", Value: 1},
+				{Name: "bottom", Value: 2},
+				{Name: "left", Value: 3},
+				{Name: "right", Value: 4},
+				{Name: "top_left", Value: 5},
+				{Name: "bottom_left", Value: 6},
+				{Name: "top_right", Value: 7},
+				{Name: "bottom_right", Value: 8},
+			},
+		},
+		{
+			Name:     "constraint_adjustment",
+			Bitfield: true,
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "slide_x", Value: 1},
+				{Name: "slide_y", Value: 2},
+				{Name: "flip_x", Value: 4},
+				{Name: "flip_y", Value: 8},
+				{Name: "resize_x", Value: 16},
+				{Name: "resize_y", Value: 32},
+			},
+		},
+	},
+}
+
+// PositionerListener is a type that can respond to incoming
+// messages for a Positioner object.
+type PositionerListener interface {
+	// Notify the compositor that the xdg_positioner will no longer be used.
+	Destroy()
+
+	// Set the size of the surface that is to be positioned with the positioner
+	// object. The size is in surface-local coordinates and corresponds to the
+	// window geometry. See xdg_surface.set_window_geometry.
+	//
+	// If a zero or negative size is set the invalid_input error is raised.
+	SetSize(width int32, height int32)
+
+	// Specify the anchor rectangle within the parent surface that the child
+	// surface will be placed relative to. The rectangle is relative to the
+	// window geometry as defined by xdg_surface.set_window_geometry of the
+	// parent surface.
+	//
+	// When the xdg_positioner object is used to position a child surface, the
+	// anchor rectangle may not extend outside the window geometry of the
+	// positioned child's parent surface.
+	//
+	// If a negative size is set the invalid_input error is raised.
+	SetAnchorRect(x int32, y int32, width int32, height int32)
+
+	// Defines the anchor point for the anchor rectangle. The specified anchor
+	// is used derive an anchor point that the child surface will be
+	// positioned relative to. If a corner anchor is set (e.g. 'top_left' or
+	// 'bottom_right'), the anchor point will be at the specified corner;
+	// otherwise, the derived anchor point will be centered on the specified
+	// edge, or in the center of the anchor rectangle if no edge is specified.
+	SetAnchor(anchor PositionerAnchor)
+
+	// Defines in what direction a surface should be positioned, relative to
+	// the anchor point of the parent surface. If a corner gravity is
+	// specified (e.g. 'bottom_right' or 'top_left'), then the child surface
+	// will be placed towards the specified gravity; otherwise, the child
+	// surface will be centered over the anchor point on any axis that had no
+	// gravity specified. If the gravity is not in the ‘gravity’ enum, an
+	// invalid_input error is raised.
+	SetGravity(gravity PositionerGravity)
+
+	// Specify how the window should be positioned if the originally intended
+	// position caused the surface to be constrained, meaning at least
+	// partially outside positioning boundaries set by the compositor. The
+	// adjustment is set by constructing a bitmask describing the adjustment to
+	// be made when the surface is constrained on that axis.
+	//
+	// If no bit for one axis is set, the compositor will assume that the child
+	// surface should not change its position on that axis when constrained.
+	//
+	// If more than one bit for one axis is set, the order of how adjustments
+	// are applied is specified in the corresponding adjustment descriptions.
+	//
+	// The default adjustment is none.
+	SetConstraintAdjustment(constraintAdjustment PositionerConstraintAdjustment)
+
+	// Specify the surface position offset relative to the position of the
+	// anchor on the anchor rectangle and the anchor on the surface. For
+	// example if the anchor of the anchor rectangle is at (x, y), the surface
+	// has the gravity bottom|right, and the offset is (ox, oy), the calculated
+	// surface position will be (x + ox, y + oy). The offset position of the
+	// surface is the one used for constraint testing. See
+	// set_constraint_adjustment.
+	//
+	// An example use case is placing a popup menu on top of a user interface
+	// element, while aligning the user interface element of the parent surface
+	// with some user interface element placed somewhere in the popup surface.
+	SetOffset(x int32, y int32)
+
+	// When set reactive, the surface is reconstrained if the conditions used
+	// for constraining changed, e.g. the parent window moved.
+	//
+	// If the conditions changed and the popup was reconstrained, an
+	// xdg_popup.configure event is sent with updated geometry, followed by an
+	// xdg_surface.configure event.
+	//
+	// Available since version 3.
+	SetReactive()
+
+	// Set the parent window geometry the compositor should use when
+	// positioning the popup. The compositor may use this information to
+	// determine the future state the popup should be constrained using. If
+	// this doesn't match the dimension of the parent the popup is eventually
+	// positioned against, the behavior is undefined.
+	//
+	// The arguments are given in the surface-local coordinate space.
+	//
+	// Available since version 3.
+	SetParentSize(parentWidth int32, parentHeight int32)
+
+	// Set the serial of an xdg_surface.configure event this positioner will be
+	// used in response to. The compositor may use this information together
+	// with set_parent_size to determine what future state the popup should be
+	// constrained using.
+	//
+	// Available since version 3.
+	SetParentConfigure(serial uint32)
+}
+
+// PositionerListenerFuncs implements PositionerListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PositionerListenerFuncs struct {
+	OnDestroy                 func()
+	OnSetSize                 func(width int32, height int32)
+	OnSetAnchorRect           func(x int32, y int32, width int32, height int32)
+	OnSetAnchor               func(anchor PositionerAnchor)
+	OnSetGravity              func(gravity PositionerGravity)
+	OnSetConstraintAdjustment func(constraintAdjustment PositionerConstraintAdjustment)
+	OnSetOffset               func(x int32, y int32)
+	// Available since version 3.
+	OnSetReactive func()
+	// Available since version 3.
+	OnSetParentSize func(parentWidth int32, parentHeight int32)
+	// Available since version 3.
+	OnSetParentConfigure func(serial uint32)
+}
+
+func (lis *PositionerListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetSize(width int32, height int32) {
+	if lis.OnSetSize != nil {
+		lis.OnSetSize(width, height)
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetAnchorRect(x int32, y int32, width int32, height int32) {
+	if lis.OnSetAnchorRect != nil {
+		lis.OnSetAnchorRect(x, y, width, height)
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetAnchor(anchor PositionerAnchor) {
+	if lis.OnSetAnchor != nil {
+		lis.OnSetAnchor(anchor)
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetGravity(gravity PositionerGravity) {
+	if lis.OnSetGravity != nil {
+		lis.OnSetGravity(gravity)
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetConstraintAdjustment(constraintAdjustment PositionerConstraintAdjustment) {
+	if lis.OnSetConstraintAdjustment != nil {
+		lis.OnSetConstraintAdjustment(constraintAdjustment)
+	}
+}
+
+func (lis *PositionerListenerFuncs) SetOffset(x int32, y int32) {
+	if lis.OnSetOffset != nil {
+		lis.OnSetOffset(x, y)
+	}
+}
+
+// Available since version 3.
+func (lis *PositionerListenerFuncs) SetReactive() {
+	if lis.OnSetReactive != nil {
+		lis.OnSetReactive()
+	}
+}
+
+// Available since version 3.
+func (lis *PositionerListenerFuncs) SetParentSize(parentWidth int32, parentHeight int32) {
+	if lis.OnSetParentSize != nil {
+		lis.OnSetParentSize(parentWidth, parentHeight)
+	}
+}
+
+// Available since version 3.
+func (lis *PositionerListenerFuncs) SetParentConfigure(serial uint32) {
+	if lis.OnSetParentConfigure != nil {
+		lis.OnSetParentConfigure(serial)
+	}
+}
+
+// PositionerRequest is implemented by the message types of all
+// of the requests that a Positioner can receive.
+type PositionerRequest interface {
+	positionerRequest()
+}
+
+// PositionerRequestFunc implements PositionerListener by calling
+// itself with each incoming message.
+type PositionerRequestFunc func(PositionerRequest)
+
+func (f PositionerRequestFunc) Destroy() {
+	f(PositionerDestroyRequest{})
+}
+
+func (f PositionerRequestFunc) SetSize(width int32, height int32) {
+	f(PositionerSetSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f PositionerRequestFunc) SetAnchorRect(x int32, y int32, width int32, height int32) {
+	f(PositionerSetAnchorRectRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f PositionerRequestFunc) SetAnchor(anchor PositionerAnchor) {
+	f(PositionerSetAnchorRequest{
+		Anchor: anchor,
+	})
+}
+
+func (f PositionerRequestFunc) SetGravity(gravity PositionerGravity) {
+	f(PositionerSetGravityRequest{
+		Gravity: gravity,
+	})
+}
+
+func (f PositionerRequestFunc) SetConstraintAdjustment(constraintAdjustment PositionerConstraintAdjustment) {
+	f(PositionerSetConstraintAdjustmentRequest{
+		ConstraintAdjustment: constraintAdjustment,
+	})
+}
+
+func (f PositionerRequestFunc) SetOffset(x int32, y int32) {
+	f(PositionerSetOffsetRequest{
+		X: x,
+		Y: y,
+	})
+}
+
+// Available since version 3.
+func (f PositionerRequestFunc) SetReactive() {
+	f(PositionerSetReactiveRequest{})
+}
+
+// Available since version 3.
+func (f PositionerRequestFunc) SetParentSize(parentWidth int32, parentHeight int32) {
+	f(PositionerSetParentSizeRequest{
+		ParentWidth:  parentWidth,
+		ParentHeight: parentHeight,
+	})
+}
+
+// Available since version 3.
+func (f PositionerRequestFunc) SetParentConfigure(serial uint32) {
+	f(PositionerSetParentConfigureRequest{
+		Serial: serial,
+	})
+}
+
+// The xdg_positioner provides a collection of rules for the placement of a
+// child surface relative to a parent surface. Rules can be defined to ensure
+// the child surface remains within the visible area's borders, and to
+// specify how the child surface changes its position, such as sliding along
+// an axis, or flipping around a rectangle. These positioner-created rules are
+// constrained by the requirement that a child surface must intersect with or
+// be at least partially adjacent to its parent surface.
+//
+// See the various requests for details about possible rules.
+//
+// At the time of the request, the compositor makes a copy of the rules
+// specified by the xdg_positioner. Thus, after the request is complete the
+// xdg_positioner object can be destroyed or reused; further changes to the
+// object will have no effect on previous usages.
+//
+// For an xdg_positioner object to be considered complete, it must have a
+// non-zero size set by set_size, and a non-zero anchor rectangle set by
+// set_anchor_rect. Passing an incomplete xdg_positioner object when
+// positioning a surface raises an invalid_positioner error.
+type Positioner struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener PositionerListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPositioner returns a newly instantiated Positioner. It is
+// primarily intended for use by generated code.
+func NewPositioner(state wire.State) *Positioner {
+	return &Positioner{state: state}
+}
+
+func (obj *Positioner) State() wire.State {
+	return obj.state
+}
+
+func (obj *Positioner) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m PositionerDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m PositionerSetSizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetSize(
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 2:
+		var m PositionerSetAnchorRectRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetAnchorRect(
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 3:
+		var m PositionerSetAnchorRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetAnchor(
+			m.Anchor,
+		)
+		return nil
+
+	case 4:
+		var m PositionerSetGravityRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetGravity(
+			m.Gravity,
+		)
+		return nil
+
+	case 5:
+		var m PositionerSetConstraintAdjustmentRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetConstraintAdjustment(
+			m.ConstraintAdjustment,
+		)
+		return nil
+
+	case 6:
+		var m PositionerSetOffsetRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetOffset(
+			m.X,
+			m.Y,
+		)
+		return nil
+
+	case 7:
+		var m PositionerSetReactiveRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetReactive()
+		return nil
+
+	case 8:
+		var m PositionerSetParentSizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetParentSize(
+			m.ParentWidth,
+			m.ParentHeight,
+		)
+		return nil
+
+	case 9:
+		var m PositionerSetParentConfigureRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetParentConfigure(
+			m.Serial,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PositionerInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_positioner",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Positioner) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Positioner) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Positioner) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Positioner) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_positioner", obj.id)
+}
+
+func (obj *Positioner) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "set_size"
+
+	case 2:
+		return "set_anchor_rect"
+
+	case 3:
+		return "set_anchor"
+
+	case 4:
+		return "set_gravity"
+
+	case 5:
+		return "set_constraint_adjustment"
+
+	case 6:
+		return "set_offset"
+
+	case 7:
+		return "set_reactive"
+
+	case 8:
+		return "set_parent_size"
+
+	case 9:
+		return "set_parent_configure"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Positioner) Interface() string {
+	return PositionerInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetSize sets the function that is called when the
+// set_size request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetSize(f func(width int32, height int32)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetSize = f
+}
+
+// OnSetAnchorRect sets the function that is called when the
+// set_anchor_rect request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetAnchorRect(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetAnchorRect = f
+}
+
+// OnSetAnchor sets the function that is called when the
+// set_anchor request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetAnchor(f func(anchor PositionerAnchor)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetAnchor = f
+}
+
+// OnSetGravity sets the function that is called when the
+// set_gravity request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetGravity(f func(gravity PositionerGravity)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetGravity = f
+}
+
+// OnSetConstraintAdjustment sets the function that is called when the
+// set_constraint_adjustment request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetConstraintAdjustment(f func(constraintAdjustment PositionerConstraintAdjustment)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetConstraintAdjustment = f
+}
+
+// OnSetOffset sets the function that is called when the
+// set_offset request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+func (obj *Positioner) OnSetOffset(f func(x int32, y int32)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetOffset = f
+}
+
+// OnSetReactive sets the function that is called when the
+// set_reactive request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Positioner) OnSetReactive(f func()) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetReactive = f
+}
+
+// OnSetParentSize sets the function that is called when the
+// set_parent_size request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Positioner) OnSetParentSize(f func(parentWidth int32, parentHeight int32)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetParentSize = f
+}
+
+// OnSetParentConfigure sets the function that is called when the
+// set_parent_configure request is received. If obj's Listener is not a
+// *PositionerListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Positioner) OnSetParentConfigure(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*PositionerListenerFuncs)
+	if !ok {
+		lis = new(PositionerListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetParentConfigure = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Positioner) Subscribe() *wire.Messages[PositionerRequest] {
+	q := new(wire.Messages[PositionerRequest])
+	obj.Listener = PositionerRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Positioner) Version() uint32 {
+	return PositionerVersion
+}
+
+// PositionerDestroyRequest holds the arguments of the destroy request of
+// the xdg_positioner interface.
+type PositionerDestroyRequest struct {
+}
+
+func (PositionerDestroyRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerDestroyRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PositionerSetSizeRequest holds the arguments of the set_size request of
+// the xdg_positioner interface.
+type PositionerSetSizeRequest struct {
+	Width  int32
+	Height int32
+}
+
+func (PositionerSetSizeRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetSizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetSizeRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_size"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height}
+	}
+	return builder
+}
+
+// PositionerSetAnchorRectRequest holds the arguments of the set_anchor_rect request of
+// the xdg_positioner interface.
+type PositionerSetAnchorRectRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (PositionerSetAnchorRectRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetAnchorRectRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetAnchorRectRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_anchor_rect"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// PositionerSetAnchorRequest holds the arguments of the set_anchor request of
+// the xdg_positioner interface.
+type PositionerSetAnchorRequest struct {
+	Anchor PositionerAnchor
+}
+
+func (PositionerSetAnchorRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetAnchorRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Anchor = PositionerAnchor(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Anchor.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "xdg_positioner",
+			Method:    "set_anchor",
+			Arg:       "anchor",
+			Value:     int64(m.Anchor),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetAnchorRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteUint(uint32(m.Anchor))
+
+	builder.Method = "set_anchor"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Anchor}
+	}
+	return builder
+}
+
+// PositionerSetGravityRequest holds the arguments of the set_gravity request of
+// the xdg_positioner interface.
+type PositionerSetGravityRequest struct {
+	Gravity PositionerGravity
+}
+
+func (PositionerSetGravityRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetGravityRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Gravity = PositionerGravity(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Gravity.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "xdg_positioner",
+			Method:    "set_gravity",
+			Arg:       "gravity",
+			Value:     int64(m.Gravity),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetGravityRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(uint32(m.Gravity))
+
+	builder.Method = "set_gravity"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Gravity}
+	}
+	return builder
+}
+
+// PositionerSetConstraintAdjustmentRequest holds the arguments of the set_constraint_adjustment request of
+// the xdg_positioner interface.
+type PositionerSetConstraintAdjustmentRequest struct {
+	ConstraintAdjustment PositionerConstraintAdjustment
+}
+
+func (PositionerSetConstraintAdjustmentRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetConstraintAdjustmentRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ConstraintAdjustment = PositionerConstraintAdjustment(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.ConstraintAdjustment.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "xdg_positioner",
+			Method:    "set_constraint_adjustment",
+			Arg:       "constraint_adjustment",
+			Value:     int64(m.ConstraintAdjustment),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetConstraintAdjustmentRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteUint(uint32(m.ConstraintAdjustment))
+
+	builder.Method = "set_constraint_adjustment"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ConstraintAdjustment}
+	}
+	return builder
+}
+
+// PositionerSetOffsetRequest holds the arguments of the set_offset request of
+// the xdg_positioner interface.
+type PositionerSetOffsetRequest struct {
+	X int32
+	Y int32
+}
+
+func (PositionerSetOffsetRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetOffsetRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetOffsetRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "set_offset"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y}
+	}
+	return builder
+}
+
+// PositionerSetReactiveRequest holds the arguments of the set_reactive request of
+// the xdg_positioner interface.
+//
+// Available since version 3.
+type PositionerSetReactiveRequest struct {
+}
+
+func (PositionerSetReactiveRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetReactiveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetReactiveRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+
+	builder.Method = "set_reactive"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PositionerSetParentSizeRequest holds the arguments of the set_parent_size request of
+// the xdg_positioner interface.
+//
+// Available since version 3.
+type PositionerSetParentSizeRequest struct {
+	ParentWidth  int32
+	ParentHeight int32
+}
+
+func (PositionerSetParentSizeRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetParentSizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.ParentWidth = msg.ReadInt()
+	m.ParentHeight = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetParentSizeRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteInt(m.ParentWidth)
+	builder.WriteInt(m.ParentHeight)
+
+	builder.Method = "set_parent_size"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.ParentWidth, m.ParentHeight}
+	}
+	return builder
+}
+
+// PositionerSetParentConfigureRequest holds the arguments of the set_parent_configure request of
+// the xdg_positioner interface.
+//
+// Available since version 3.
+type PositionerSetParentConfigureRequest struct {
+	Serial uint32
+}
+
+func (PositionerSetParentConfigureRequest) positionerRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PositionerSetParentConfigureRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PositionerSetParentConfigureRequest) Encode(obj *Positioner) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "set_parent_configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// PositionerError is an alias for [shared.PositionerError].
+type PositionerError = shared.PositionerError
+
+const (
+	PositionerErrorInvalidInput = shared.PositionerErrorInvalidInput
+)
+
+// PositionerAnchor is an alias for [shared.PositionerAnchor].
+type PositionerAnchor = shared.PositionerAnchor
+
+const (
+	PositionerAnchorNone        = shared.PositionerAnchorNone
+	PositionerAnchorTop         = shared.PositionerAnchorTop
+	PositionerAnchorBottom      = shared.PositionerAnchorBottom
+	PositionerAnchorLeft        = shared.PositionerAnchorLeft
+	PositionerAnchorRight       = shared.PositionerAnchorRight
+	PositionerAnchorTopLeft     = shared.PositionerAnchorTopLeft
+	PositionerAnchorBottomLeft  = shared.PositionerAnchorBottomLeft
+	PositionerAnchorTopRight    = shared.PositionerAnchorTopRight
+	PositionerAnchorBottomRight = shared.PositionerAnchorBottomRight
+)
+
+// PositionerGravity is an alias for [shared.PositionerGravity].
+type PositionerGravity = shared.PositionerGravity
+
+const (
+	PositionerGravityNone        = shared.PositionerGravityNone
+	PositionerGravityTop         = shared.PositionerGravityTop
+	PositionerGravityBottom      = shared.PositionerGravityBottom
+	PositionerGravityLeft        = shared.PositionerGravityLeft
+	PositionerGravityRight       = shared.PositionerGravityRight
+	PositionerGravityTopLeft     = shared.PositionerGravityTopLeft
+	PositionerGravityBottomLeft  = shared.PositionerGravityBottomLeft
+	PositionerGravityTopRight    = shared.PositionerGravityTopRight
+	PositionerGravityBottomRight = shared.PositionerGravityBottomRight
+)
+
+// PositionerConstraintAdjustment is an alias for [shared.PositionerConstraintAdjustment].
+type PositionerConstraintAdjustment = shared.PositionerConstraintAdjustment
+
+const (
+	PositionerConstraintAdjustmentNone    = shared.PositionerConstraintAdjustmentNone
+	PositionerConstraintAdjustmentSlideX  = shared.PositionerConstraintAdjustmentSlideX
+	PositionerConstraintAdjustmentSlideY  = shared.PositionerConstraintAdjustmentSlideY
+	PositionerConstraintAdjustmentFlipX   = shared.PositionerConstraintAdjustmentFlipX
+	PositionerConstraintAdjustmentFlipY   = shared.PositionerConstraintAdjustmentFlipY
+	PositionerConstraintAdjustmentResizeX = shared.PositionerConstraintAdjustmentResizeX
+	PositionerConstraintAdjustmentResizeY = shared.PositionerConstraintAdjustmentResizeY
+)
+
+const (
+	SurfaceInterface = shared.SurfaceInterface
+
+	// SurfaceVersion is the version of the interface that this
+	// package implements.
+	SurfaceVersion = 7
+)
+
+// The versions of the xdg_surface interface that its messages were
+// added in.
+const (
+	SurfaceDestroyRequestSince           = 1
+	SurfaceGetToplevelRequestSince       = 1
+	SurfaceGetPopupRequestSince          = 1
+	SurfaceSetWindowGeometryRequestSince = 1
+	SurfaceAckConfigureRequestSince      = 1
+	SurfaceConfigureEventSince           = 1
+)
+
+// SurfaceDesc describes the xdg_surface interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var SurfaceDesc = &wire.InterfaceDesc{
+	Name:    SurfaceInterface,
+	Version: SurfaceVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "get_toplevel",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_toplevel",
+				},
+			},
+		},
+		{
+			Name:  "get_popup",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "id",
+					Type:      wire.ArgNewID,
+					Interface: "xdg_popup",
+				},
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "xdg_surface",
+					AllowNull: true,
+				},
+				{
+					Name:      "positioner",
+					Type:      wire.ArgObject,
+					Interface: "xdg_positioner",
+				},
+			},
+		},
+		{
+			Name:  "set_window_geometry",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "ack_configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "not_constructed", Value: 1},
+				{Name: "already_constructed", Value: 2},
+				{Name: "unconfigured_buffer", Value: 3},
+				{Name: "invalid_serial", Value: 4},
+				{Name: "invalid_size", Value: 5},
+				{Name: "defunct_role_object", Value: 6},
+			},
+		},
+	},
+}
+
+// SurfaceListener is a type that can respond to incoming
+// messages for a Surface object.
+type SurfaceListener interface {
+	// Destroy the xdg_surface object. An xdg_surface must only be destroyed
+	// after its role object has been destroyed, otherwise
+	// a defunct_role_object error is raised.
+	Destroy()
+
+	// This creates an xdg_toplevel object for the given xdg_surface and gives
+	// the associated wl_surface the xdg_toplevel role.
+	//
+	// See the documentation of xdg_toplevel for more details about what an
+	// xdg_toplevel is and how it is used.
+	GetToplevel(id *Toplevel)
+
+	// This creates an xdg_popup object for the given xdg_surface and gives
+	// the associated wl_surface the xdg_popup role.
+	//
+	// If null is passed as a parent, a parent surface must be specified using
+	// some other protocol, before committing the initial state.
+	//
+	// See the documentation of xdg_popup for more details about what an
+	// xdg_popup is and how it is used.
+	GetPopup(id *Popup, parent *Surface, positioner *Positioner)
+
+	// The window geometry of a surface is its "visible bounds" from the
+	// user's perspective. Client-side decorations often have invisible
+	// portions like drop-shadows which should be ignored for the
+	// purposes of aligning, placing and constraining windows. Note that
+	// in some situations, compositors may clip rendering to the window
+	// geometry, so the client should avoid putting functional elements
+	// outside of it.
+	//
+	// The window geometry is double-buffered state, see wl_surface.commit.
+	//
+	// When maintaining a position, the compositor should treat the (x, y)
+	// coordinate of the window geometry as the top left corner of the window.
+	// A client changing the (x, y) window geometry coordinate should in
+	// general not alter the position of the window.
+	//
+	// Once the window geometry of the surface is set, it is not possible to
+	// unset it, and it will remain the same until set_window_geometry is
+	// called again, even if a new subsurface or buffer is attached.
+	//
+	// If never set, the value is the full bounds of the surface,
+	// including any subsurfaces. This updates dynamically on every
+	// commit. This unset is meant for extremely simple clients.
+	//
+	// The arguments are given in the surface-local coordinate space of
+	// the wl_surface associated with this xdg_surface, and may extend outside
+	// of the wl_surface itself to mark parts of the subsurface tree as part of
+	// the window geometry.
+	//
+	// When applied, the effective window geometry will be the set window
+	// geometry clamped to the bounding rectangle of the combined
+	// geometry of the surface of the xdg_surface and the associated
+	// subsurfaces.
+	//
+	// The effective geometry will not be recalculated unless a new call to
+	// set_window_geometry is done and the new pending surface state is
+	// subsequently applied.
+	//
+	// The width and height of the effective window geometry must be
+	// greater than zero. Setting an invalid size will raise an
+	// invalid_size error.
+	SetWindowGeometry(x int32, y int32, width int32, height int32)
+
+	// When a configure event is received, if a client commits the
+	// surface in response to the configure event, then the client
+	// must make an ack_configure request sometime before the commit
+	// request, passing along the serial of the configure event.
+	//
+	// For instance, for toplevel surfaces the compositor might use this
+	// information to move a surface to the top left only when the client has
+	// drawn itself for the maximized or fullscreen state.
+	//
+	// If the client receives multiple configure events before it
+	// can respond to one, it only has to ack the last configure event.
+	// Acking a configure event that was never sent raises an invalid_serial
+	// error.
+	//
+	// A client is not required to commit immediately after sending
+	// an ack_configure request - it may even ack_configure several times
+	// before its next surface commit.
+	//
+	// A client may send multiple ack_configure requests before committing, but
+	// only the last request sent before a commit indicates which configure
+	// event the client really is responding to.
+	//
+	// Sending an ack_configure request consumes the serial number sent with
+	// the request, as well as serial numbers sent by all configure events
+	// sent on this xdg_surface prior to the configure event referenced by
+	// the committed serial.
+	//
+	// It is an error to issue multiple ack_configure requests referencing a
+	// serial from the same configure event, or to issue an ack_configure
+	// request referencing a serial from a configure event issued before the
+	// event identified by the last ack_configure request for the same
+	// xdg_surface. Doing so will raise an invalid_serial error.
+	AckConfigure(serial uint32)
+}
+
+// SurfaceListenerFuncs implements SurfaceListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type SurfaceListenerFuncs struct {
+	OnDestroy           func()
+	OnGetToplevel       func(id *Toplevel)
+	OnGetPopup          func(id *Popup, parent *Surface, positioner *Positioner)
+	OnSetWindowGeometry func(x int32, y int32, width int32, height int32)
+	OnAckConfigure      func(serial uint32)
+}
+
+func (lis *SurfaceListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *SurfaceListenerFuncs) GetToplevel(id *Toplevel) {
+	if lis.OnGetToplevel != nil {
+		lis.OnGetToplevel(id)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) GetPopup(id *Popup, parent *Surface, positioner *Positioner) {
+	if lis.OnGetPopup != nil {
+		lis.OnGetPopup(id, parent, positioner)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) SetWindowGeometry(x int32, y int32, width int32, height int32) {
+	if lis.OnSetWindowGeometry != nil {
+		lis.OnSetWindowGeometry(x, y, width, height)
+	}
+}
+
+func (lis *SurfaceListenerFuncs) AckConfigure(serial uint32) {
+	if lis.OnAckConfigure != nil {
+		lis.OnAckConfigure(serial)
+	}
+}
+
+// SurfaceRequest is implemented by the message types of all
+// of the requests that a Surface can receive.
+type SurfaceRequest interface {
+	surfaceRequest()
+}
+
+// SurfaceRequestFunc implements SurfaceListener by calling
+// itself with each incoming message.
+type SurfaceRequestFunc func(SurfaceRequest)
+
+func (f SurfaceRequestFunc) Destroy() {
+	f(SurfaceDestroyRequest{})
+}
+
+func (f SurfaceRequestFunc) GetToplevel(id *Toplevel) {
+	f(SurfaceGetToplevelRequest{
+		Id: id,
+	})
+}
+
+func (f SurfaceRequestFunc) GetPopup(id *Popup, parent *Surface, positioner *Positioner) {
+	f(SurfaceGetPopupRequest{
+		Id:         id,
+		Parent:     parent,
+		Positioner: positioner,
+	})
+}
+
+func (f SurfaceRequestFunc) SetWindowGeometry(x int32, y int32, width int32, height int32) {
+	f(SurfaceSetWindowGeometryRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f SurfaceRequestFunc) AckConfigure(serial uint32) {
+	f(SurfaceAckConfigureRequest{
+		Serial: serial,
+	})
+}
+
+// An interface that may be implemented by a wl_surface, for
+// implementations that provide a desktop-style user interface.
+//
+// It provides a base set of functionality required to construct user
+// interface elements requiring management by the compositor, such as
+// toplevel windows, menus, etc. The types of functionality are split into
+// xdg_surface roles.
+//
+// Creating an xdg_surface does not set the role for a wl_surface. In order
+// to map an xdg_surface, the client must create a role-specific object
+// using, e.g., get_toplevel, get_popup. The wl_surface for any given
+// xdg_surface can have at most one role, and may not be assigned any role
+// not based on xdg_surface.
+//
+// A role must be assigned before any other requests are made to the
+// xdg_surface object.
+//
+// The client must call wl_surface.commit on the corresponding wl_surface
+// for the xdg_surface state to take effect.
+//
+// Creating an xdg_surface from a wl_surface which has a buffer attached or
+// committed is a client error, and any attempts by a client to attach or
+// manipulate a buffer prior to the first xdg_surface.configure call must
+// also be treated as errors.
+//
+// After creating a role-specific object and setting it up (e.g. by sending
+// the title, app ID, size constraints, parent, etc), the client must
+// perform an initial commit without any buffer attached. The compositor
+// will reply with initial wl_surface state such as
+// wl_surface.preferred_buffer_scale followed by an xdg_surface.configure
+// event. The client must acknowledge it and is then allowed to attach a
+// buffer to map the surface.
+//
+// Mapping an xdg_surface-based role surface is defined as making it
+// possible for the surface to be shown by the compositor. Note that
+// a mapped surface is not guaranteed to be visible once it is mapped.
+//
+// For an xdg_surface to be mapped by the compositor, the following
+// conditions must be met:
+// (1) the client has assigned an xdg_surface-based role to the surface
+// (2) the client has set and committed the xdg_surface state and the
+// role-dependent state to the surface
+// (3) the client has committed a buffer to the surface
+//
+// A newly-unmapped surface is considered to have met condition (1) out
+// of the 3 required conditions for mapping a surface if its role surface
+// has not been destroyed, i.e. the client must perform the initial commit
+// again before attaching a buffer.
+type Surface struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener SurfaceListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewSurface returns a newly instantiated Surface. It is
+// primarily intended for use by generated code.
+func NewSurface(state wire.State) *Surface {
+	return &Surface{state: state}
+}
+
+func (obj *Surface) State() wire.State {
+	return obj.state
+}
+
+func (obj *Surface) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m SurfaceDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m SurfaceGetToplevelRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetToplevel(
+			m.Id,
+		)
+		return nil
+
+	case 2:
+		var m SurfaceGetPopupRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.GetPopup(
+			m.Id,
+			m.Parent,
+			m.Positioner,
+		)
+		return nil
+
+	case 3:
+		var m SurfaceSetWindowGeometryRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetWindowGeometry(
+			m.X,
+			m.Y,
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 4:
+		var m SurfaceAckConfigureRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.AckConfigure(
+			m.Serial,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(SurfaceInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_surface",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Surface) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Surface) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Surface) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Surface) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_surface", obj.id)
+}
+
+func (obj *Surface) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "get_toplevel"
+
+	case 2:
+		return "get_popup"
+
+	case 3:
+		return "set_window_geometry"
+
+	case 4:
+		return "ack_configure"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Surface) Interface() string {
+	return SurfaceInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGetToplevel sets the function that is called when the
+// get_toplevel request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnGetToplevel(f func(id *Toplevel)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetToplevel = f
+}
+
+// OnGetPopup sets the function that is called when the
+// get_popup request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnGetPopup(f func(id *Popup, parent *Surface, positioner *Positioner)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGetPopup = f
+}
+
+// OnSetWindowGeometry sets the function that is called when the
+// set_window_geometry request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnSetWindowGeometry(f func(x int32, y int32, width int32, height int32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetWindowGeometry = f
+}
+
+// OnAckConfigure sets the function that is called when the
+// ack_configure request is received. If obj's Listener is not a
+// *SurfaceListenerFuncs, it is replaced with one.
+func (obj *Surface) OnAckConfigure(f func(serial uint32)) {
+	lis, ok := obj.Listener.(*SurfaceListenerFuncs)
+	if !ok {
+		lis = new(SurfaceListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnAckConfigure = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Surface) Subscribe() *wire.Messages[SurfaceRequest] {
+	q := new(wire.Messages[SurfaceRequest])
+	obj.Listener = SurfaceRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Surface) Version() uint32 {
+	return SurfaceVersion
+}
+
+// The configure event marks the end of a configure sequence. A configure
+// sequence is a set of one or more events configuring the state of the
+// xdg_surface, including the final xdg_surface.configure event.
+//
+// Where applicable, xdg_surface surface roles will during a configure
+// sequence extend this event as a latched state sent as events before the
+// xdg_surface.configure event. Such events should be considered to make up
+// a set of atomically applied configuration states, where the
+// xdg_surface.configure commits the accumulated state.
+//
+// Clients should arrange their surface for the new states, and then send
+// an ack_configure request with the serial sent in this configure event at
+// some point before committing the new surface.
+//
+// If the client receives multiple configure events before it can respond
+// to one, it is free to discard all but the last event it received.
+func (obj *Surface) Configure(serial uint32) {
+	obj.state.Enqueue(SurfaceConfigureEvent{
+		Serial: serial,
+	}.Encode(obj))
+	return
+}
+
+// SurfaceDestroyRequest holds the arguments of the destroy request of
+// the xdg_surface interface.
+type SurfaceDestroyRequest struct {
+}
+
+func (SurfaceDestroyRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceDestroyRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// SurfaceGetToplevelRequest holds the arguments of the get_toplevel request of
+// the xdg_surface interface.
+type SurfaceGetToplevelRequest struct {
+	Id *Toplevel
+}
+
+func (SurfaceGetToplevelRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceGetToplevelRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewToplevel(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, ToplevelInterface, msg.ReadNewObject(ToplevelInterface), true))
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceGetToplevelRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Id)
+
+	builder.Method = "get_toplevel"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: ToplevelInterface, ID: m.Id.ID()}}
+	}
+	return builder
+}
+
+// SurfaceGetPopupRequest holds the arguments of the get_popup request of
+// the xdg_surface interface.
+type SurfaceGetPopupRequest struct {
+	Id         *Popup
+	Parent     *Surface
+	Positioner *Positioner
+}
+
+func (SurfaceGetPopupRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceGetPopupRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Id = NewPopup(state)
+	m.Id.SetID(wire.CheckNewID(msg, state, PopupInterface, msg.ReadNewObject(PopupInterface), true))
+	m.Parent = wire.ResolveObject[*Surface](msg, state, SurfaceInterface, msg.ReadNullableObject())
+	m.Positioner = wire.ResolveObject[*Positioner](msg, state, PositionerInterface, msg.ReadObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	state.Add(m.Id)
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceGetPopupRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Id)
+	builder.WriteNullableObject(m.Parent)
+	builder.WriteObject(m.Positioner)
+
+	builder.Method = "get_popup"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{wire.NewID{Interface: PopupInterface, ID: m.Id.ID()}, m.Parent, m.Positioner}
+	}
+	return builder
+}
+
+// SurfaceSetWindowGeometryRequest holds the arguments of the set_window_geometry request of
+// the xdg_surface interface.
+type SurfaceSetWindowGeometryRequest struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+func (SurfaceSetWindowGeometryRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceSetWindowGeometryRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceSetWindowGeometryRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_window_geometry"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// SurfaceAckConfigureRequest holds the arguments of the ack_configure request of
+// the xdg_surface interface.
+type SurfaceAckConfigureRequest struct {
+	Serial uint32
+}
+
+func (SurfaceAckConfigureRequest) surfaceRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceAckConfigureRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceAckConfigureRequest) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "ack_configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// SurfaceConfigureEvent holds the arguments of the configure event of
+// the xdg_surface interface.
+type SurfaceConfigureEvent struct {
+	Serial uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *SurfaceConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m SurfaceConfigureEvent) Encode(obj *Surface) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Serial}
+	}
+	return builder
+}
+
+// SurfaceError is an alias for [shared.SurfaceError].
+type SurfaceError = shared.SurfaceError
+
+const (
+	SurfaceErrorNotConstructed     = shared.SurfaceErrorNotConstructed
+	SurfaceErrorAlreadyConstructed = shared.SurfaceErrorAlreadyConstructed
+	SurfaceErrorUnconfiguredBuffer = shared.SurfaceErrorUnconfiguredBuffer
+	SurfaceErrorInvalidSerial      = shared.SurfaceErrorInvalidSerial
+	SurfaceErrorInvalidSize        = shared.SurfaceErrorInvalidSize
+	SurfaceErrorDefunctRoleObject  = shared.SurfaceErrorDefunctRoleObject
+)
+
+const (
+	ToplevelInterface = shared.ToplevelInterface
+
+	// ToplevelVersion is the version of the interface that this
+	// package implements.
+	ToplevelVersion = 7
+)
+
+// The versions of the xdg_toplevel interface that its messages were
+// added in.
+const (
+	ToplevelDestroyRequestSince         = 1
+	ToplevelSetParentRequestSince       = 1
+	ToplevelSetTitleRequestSince        = 1
+	ToplevelSetAppIdRequestSince        = 1
+	ToplevelShowWindowMenuRequestSince  = 1
+	ToplevelMoveRequestSince            = 1
+	ToplevelResizeRequestSince          = 1
+	ToplevelSetMaxSizeRequestSince      = 1
+	ToplevelSetMinSizeRequestSince      = 1
+	ToplevelSetMaximizedRequestSince    = 1
+	ToplevelUnsetMaximizedRequestSince  = 1
+	ToplevelSetFullscreenRequestSince   = 1
+	ToplevelUnsetFullscreenRequestSince = 1
+	ToplevelSetMinimizedRequestSince    = 1
+	ToplevelConfigureEventSince         = 1
+	ToplevelCloseEventSince             = 1
+	ToplevelConfigureBoundsEventSince   = 4
+	ToplevelWmCapabilitiesEventSince    = 5
+)
+
+// ToplevelDesc describes the xdg_toplevel interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var ToplevelDesc = &wire.InterfaceDesc{
+	Name:    ToplevelInterface,
+	Version: ToplevelVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_parent",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "parent",
+					Type:      wire.ArgObject,
+					Interface: "xdg_toplevel",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "set_title",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "title",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "set_app_id",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "app_id",
+					Type: wire.ArgString,
+				},
+			},
+		},
+		{
+			Name:  "show_window_menu",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "move",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+				{
+					Name: "edges",
+					Type: wire.ArgUint,
+					Enum: "resize_edge",
+				},
+			},
+		},
+		{
+			Name:  "set_max_size",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_min_size",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "set_maximized",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "unset_maximized",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "output",
+					Type:      wire.ArgObject,
+					Interface: "wl_output",
+					AllowNull: true,
+				},
+			},
+		},
+		{
+			Name:  "unset_fullscreen",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "set_minimized",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "states",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+		{
+			Name:  "close",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "configure_bounds",
+			Since: 4,
+			Args: []wire.ArgDesc{
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "wm_capabilities",
+			Since: 5,
+			Args: []wire.ArgDesc{
+				{
+					Name: "capabilities",
+					Type: wire.ArgArray,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_resize_edge", Value: 0},
+				{Name: "invalid_parent", Value: 1},
+				{Name: "invalid_size", Value: 2},
+			},
+		},
+		{
+			Name: "resize_edge",
+			Entries: []wire.EntryDesc{
+				{Name: "none", Value: 0},
+				{Name: "top", Value: 1},
+				{Name: "bottom", Value: 2},
+				{Name: "left", Value: 4},
+				{Name: "top_left", Value: 5},
+				{Name: "bottom_left", Value: 6},
+				{Name: "right", Value: 8},
+				{Name: "top_right", Value: 9},
+				{Name: "bottom_right", Value: 10},
+			},
+		},
+		{
+			Name: "state",
+			Entries: []wire.EntryDesc{
+				{Name: "maximized", Value: 1},
+				{Name: "fullscreen", Value: 2},
+				{Name: "resizing", Value: 3},
+				{Name: "activated", Value: 4},
+				{Name: "tiled_left", Value: 5},
+				{Name: "tiled_right", Value: 6},
+				{Name: "tiled_top", Value: 7},
+				{Name: "tiled_bottom", Value: 8},
+				{Name: "suspended", Value: 9},
+				{Name: "constrained_left", Value: 10},
+				{Name: "constrained_right", Value: 11},
+				{Name: "constrained_top", Value: 12},
+				{Name: "constrained_bottom", Value: 13},
+			},
+		},
+		{
+			Name: "wm_capabilities",
+			Entries: []wire.EntryDesc{
+				{Name: "window_menu", Value: 1},
+				{Name: "maximize", Value: 2},
+				{Name: "fullscreen", Value: 3},
+				{Name: "minimize", Value: 4},
+			},
+		},
+	},
+}
+
+// ToplevelListener is a type that can respond to incoming
+// messages for a Toplevel object.
+type ToplevelListener interface {
+	// This request destroys the role surface and unmaps the surface;
+	// see "Unmapping" behavior in interface section for details.
+	Destroy()
+
+	// Set the "parent" of this surface. This surface should be stacked
+	// above the parent surface and all other ancestor surfaces.
+	//
+	// Parent surfaces should be set on dialogs, toolboxes, or other
+	// "auxiliary" surfaces, so that the parent is raised when the dialog
+	// is raised.
+	//
+	// Setting a null parent for a child surface unsets its parent. Setting
+	// a null parent for a surface which currently has no parent is a no-op.
+	//
+	// Only mapped surfaces can have child surfaces. Setting a parent which
+	// is not mapped is equivalent to setting a null parent. If a surface
+	// becomes unmapped, its children's parent is set to the parent of
+	// the now-unmapped surface. If the now-unmapped surface has no parent,
+	// its children's parent is unset. If the now-unmapped surface becomes
+	// mapped again, its parent-child relationship is not restored.
+	//
+	// The parent toplevel must not be one of the child toplevel's
+	// descendants, and the parent must be different from the child toplevel,
+	// otherwise the invalid_parent protocol error is raised.
+	SetParent(parent *Toplevel)
+
+	// Set a short title for the surface.
+	//
+	// This string may be used to identify the surface in a task bar,
+	// window list, or other user interface elements provided by the
+	// compositor.
+	//
+	// The string must be encoded in UTF-8.
+	SetTitle(title string)
+
+	// Set an application identifier for the surface.
+	//
+	// The app ID identifies the general class of applications to which
+	// the surface belongs. The compositor can use this to group multiple
+	// surfaces together, or to determine how to launch a new application.
+	//
+	// For D-Bus activatable applications, the app ID is used as the D-Bus
+	// service name.
+	//
+	// The compositor shell will try to group application surfaces together
+	// by their app ID. As a best practice, it is suggested to select app
+	// ID's that match the basename of the application's .desktop file.
+	// For example, "org.freedesktop.FooViewer" where the .desktop file is
+	// "org.freedesktop.FooViewer.desktop".
+	//
+	// Like other properties, a set_app_id request can be sent after the
+	// xdg_toplevel has been mapped to update the property.
+	//
+	// See the desktop-entry specification [0] for more details on
+	// application identifiers and how they relate to well-known D-Bus
+	// names and .desktop files.
+	//
+	// [0] https://standards.freedesktop.org/desktop-entry-spec/
+	SetAppId(appId string)
+
+	// Clients implementing client-side decorations might want to show
+	// a context menu when right-clicking on the decorations, giving the
+	// user a menu that they can use to maximize or minimize the window.
+	//
+	// This request asks the compositor to pop up such a window menu at
+	// the given position, relative to the local surface coordinates of
+	// the parent surface. There are no guarantees as to what menu items
+	// the window menu contains, or even if a window menu will be drawn
+	// at all.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event.
+	ShowWindowMenu(seat *wl.Seat, serial uint32, x int32, y int32)
+
+	// Start an interactive, user-driven move of the surface.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event. The passed
+	// serial is used to determine the type of interactive move (touch,
+	// pointer, etc).
+	//
+	// The server may ignore move requests depending on the state of
+	// the surface (e.g. fullscreen or maximized), or if the passed serial
+	// is no longer valid.
+	//
+	// If triggered, the surface will lose the focus of the device
+	// (wl_pointer, wl_touch, etc) used for the move. It is up to the
+	// compositor to visually indicate that the move is taking place, such as
+	// updating a pointer cursor, during the move. There is no guarantee
+	// that the device focus will return when the move is completed.
+	Move(seat *wl.Seat, serial uint32)
+
+	// Start a user-driven, interactive resize of the surface.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event. The passed
+	// serial is used to determine the type of interactive resize (touch,
+	// pointer, etc).
+	//
+	// The server may ignore resize requests depending on the state of
+	// the surface (e.g. fullscreen or maximized).
+	//
+	// If triggered, the client will receive configure events with the
+	// "resize" state enum value and the expected sizes. See the "resize"
+	// enum value for more details about what is required. The client
+	// must also acknowledge configure events using "ack_configure". After
+	// the resize is completed, the client will receive another "configure"
+	// event without the resize state.
+	//
+	// If triggered, the surface also will lose the focus of the device
+	// (wl_pointer, wl_touch, etc) used for the resize. It is up to the
+	// compositor to visually indicate that the resize is taking place,
+	// such as updating a pointer cursor, during the resize. There is no
+	// guarantee that the device focus will return when the resize is
+	// completed.
+	//
+	// The edges parameter specifies how the surface should be resized, and
+	// is one of the values of the resize_edge enum. Values not matching
+	// a variant of the enum will cause the invalid_resize_edge protocol error.
+	// The compositor may use this information to update the surface position
+	// for example when dragging the top left corner. The compositor may also
+	// use this information to adapt its behavior, e.g. choose an appropriate
+	// cursor image.
+	Resize(seat *wl.Seat, serial uint32, edges ToplevelResizeEdge)
+
+	// Set a maximum size for the window.
+	//
+	// The client can specify a maximum size so that the compositor does
+	// not try to configure the window beyond this size.
+	//
+	// The width and height arguments are in window geometry coordinates.
+	// See xdg_surface.set_window_geometry.
+	//
+	// Values set in this way are double-buffered, see wl_surface.commit.
+	//
+	// The compositor can use this information to allow or disallow
+	// different states like maximize or fullscreen and draw accurate
+	// animations.
+	//
+	// Similarly, a tiling window manager may use this information to
+	// place and resize client windows in a more effective way.
+	//
+	// The client should not rely on the compositor to obey the maximum
+	// size. The compositor may decide to ignore the values set by the
+	// client and request a larger size.
+	//
+	// If never set, or a value of zero in the request, means that the
+	// client has no expected maximum size in the given dimension.
+	// As a result, a client wishing to reset the maximum size
+	// to an unspecified state can use zero for width and height in the
+	// request.
+	//
+	// Requesting a maximum size to be smaller than the minimum size of
+	// a surface is illegal and will result in an invalid_size error.
+	//
+	// The width and height must be greater than or equal to zero. Using
+	// strictly negative values for width or height will result in a
+	// invalid_size error.
+	SetMaxSize(width int32, height int32)
+
+	// Set a minimum size for the window.
+	//
+	// The client can specify a minimum size so that the compositor does
+	// not try to configure the window below this size.
+	//
+	// The width and height arguments are in window geometry coordinates.
+	// See xdg_surface.set_window_geometry.
+	//
+	// Values set in this way are double-buffered, see wl_surface.commit.
+	//
+	// The compositor can use this information to allow or disallow
+	// different states like maximize or fullscreen and draw accurate
+	// animations.
+	//
+	// Similarly, a tiling window manager may use this information to
+	// place and resize client windows in a more effective way.
+	//
+	// The client should not rely on the compositor to obey the minimum
+	// size. The compositor may decide to ignore the values set by the
+	// client and request a smaller size.
+	//
+	// If never set, or a value of zero in the request, means that the
+	// client has no expected minimum size in the given dimension.
+	// As a result, a client wishing to reset the minimum size
+	// to an unspecified state can use zero for width and height in the
+	// request.
+	//
+	// Requesting a minimum size to be larger than the maximum size of
+	// a surface is illegal and will result in an invalid_size error.
+	//
+	// The width and height must be greater than or equal to zero. Using
+	// strictly negative values for width and height will result in a
+	// invalid_size error.
+	SetMinSize(width int32, height int32)
+
+	// Maximize the surface.
+	//
+	// After requesting that the surface should be maximized, the compositor
+	// will respond by emitting a configure event. Whether this configure
+	// actually sets the window maximized is subject to compositor policies.
+	// The client must then update its content, drawing in the configured
+	// state. The client must also acknowledge the configure when committing
+	// the new content (see ack_configure).
+	//
+	// It is up to the compositor to decide how and where to maximize the
+	// surface, for example which output and what region of the screen should
+	// be used.
+	//
+	// If the surface was already maximized, the compositor will still emit
+	// a configure event with the "maximized" state.
+	//
+	// If the surface is in a fullscreen state, this request has no direct
+	// effect. It may alter the state the surface is returned to when
+	// unmaximized unless overridden by the compositor.
+	SetMaximized()
+
+	// Unmaximize the surface.
+	//
+	// After requesting that the surface should be unmaximized, the compositor
+	// will respond by emitting a configure event. Whether this actually
+	// un-maximizes the window is subject to compositor policies.
+	// If available and applicable, the compositor will include the window
+	// geometry dimensions the window had prior to being maximized in the
+	// configure event. The client must then update its content, drawing it in
+	// the configured state. The client must also acknowledge the configure
+	// when committing the new content (see ack_configure).
+	//
+	// It is up to the compositor to position the surface after it was
+	// unmaximized; usually the position the surface had before maximizing, if
+	// applicable.
+	//
+	// If the surface was already not maximized, the compositor will still
+	// emit a configure event without the "maximized" state.
+	//
+	// If the surface is in a fullscreen state, this request has no direct
+	// effect. It may alter the state the surface is returned to when
+	// unmaximized unless overridden by the compositor.
+	UnsetMaximized()
+
+	// Make the surface fullscreen.
+	//
+	// After requesting that the surface should be fullscreened, the
+	// compositor will respond by emitting a configure event. Whether the
+	// client is actually put into a fullscreen state is subject to compositor
+	// policies. The client must also acknowledge the configure when
+	// committing the new content (see ack_configure).
+	//
+	// The output passed by the request indicates the client's preference as
+	// to which display it should be set fullscreen on. If this value is NULL,
+	// it's up to the compositor to choose which display will be used to map
+	// this surface.
+	//
+	// If the surface doesn't cover the whole output, the compositor will
+	// position the surface in the center of the output and compensate with
+	// with border fill covering the rest of the output. The content of the
+	// border fill is undefined, but should be assumed to be in some way that
+	// attempts to blend into the surrounding area (e.g. solid black).
+	//
+	// If the fullscreened surface is not opaque, the compositor must make
+	// sure that other screen content not part of the same surface tree (made
+	// up of subsurfaces, popups or similarly coupled surfaces) are not
+	// visible below the fullscreened surface.
+	SetFullscreen(output *wl.Output)
+
+	// Make the surface no longer fullscreen.
+	//
+	// After requesting that the surface should be unfullscreened, the
+	// compositor will respond by emitting a configure event.
+	// Whether this actually removes the fullscreen state of the client is
+	// subject to compositor policies.
+	//
+	// Making a surface unfullscreen sets states for the surface based on the following:
+	// * the state(s) it may have had before becoming fullscreen
+	// * any state(s) decided by the compositor
+	// * any state(s) requested by the client while the surface was fullscreen
+	//
+	// The compositor may include the previous window geometry dimensions in
+	// the configure event, if applicable.
+	//
+	// The client must also acknowledge the configure when committing the new
+	// content (see ack_configure).
+	UnsetFullscreen()
+
+	// Request that the compositor minimize your surface. There is no
+	// way to know if the surface is currently minimized, nor is there
+	// any way to unset minimization on this surface.
+	//
+	// If you are looking to throttle redrawing when minimized, please
+	// instead use the wl_surface.frame event for this, as this will
+	// also work with live previews on windows in Alt-Tab, Expose or
+	// similar compositor features.
+	SetMinimized()
+}
+
+// ToplevelListenerFuncs implements ToplevelListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type ToplevelListenerFuncs struct {
+	OnDestroy         func()
+	OnSetParent       func(parent *Toplevel)
+	OnSetTitle        func(title string)
+	OnSetAppId        func(appId string)
+	OnShowWindowMenu  func(seat *wl.Seat, serial uint32, x int32, y int32)
+	OnMove            func(seat *wl.Seat, serial uint32)
+	OnResize          func(seat *wl.Seat, serial uint32, edges ToplevelResizeEdge)
+	OnSetMaxSize      func(width int32, height int32)
+	OnSetMinSize      func(width int32, height int32)
+	OnSetMaximized    func()
+	OnUnsetMaximized  func()
+	OnSetFullscreen   func(output *wl.Output)
+	OnUnsetFullscreen func()
+	OnSetMinimized    func()
+}
+
+func (lis *ToplevelListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetParent(parent *Toplevel) {
+	if lis.OnSetParent != nil {
+		lis.OnSetParent(parent)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetTitle(title string) {
+	if lis.OnSetTitle != nil {
+		lis.OnSetTitle(title)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetAppId(appId string) {
+	if lis.OnSetAppId != nil {
+		lis.OnSetAppId(appId)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) ShowWindowMenu(seat *wl.Seat, serial uint32, x int32, y int32) {
+	if lis.OnShowWindowMenu != nil {
+		lis.OnShowWindowMenu(seat, serial, x, y)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) Move(seat *wl.Seat, serial uint32) {
+	if lis.OnMove != nil {
+		lis.OnMove(seat, serial)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) Resize(seat *wl.Seat, serial uint32, edges ToplevelResizeEdge) {
+	if lis.OnResize != nil {
+		lis.OnResize(seat, serial, edges)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetMaxSize(width int32, height int32) {
+	if lis.OnSetMaxSize != nil {
+		lis.OnSetMaxSize(width, height)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetMinSize(width int32, height int32) {
+	if lis.OnSetMinSize != nil {
+		lis.OnSetMinSize(width, height)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetMaximized() {
+	if lis.OnSetMaximized != nil {
+		lis.OnSetMaximized()
+	}
+}
+
+func (lis *ToplevelListenerFuncs) UnsetMaximized() {
+	if lis.OnUnsetMaximized != nil {
+		lis.OnUnsetMaximized()
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetFullscreen(output *wl.Output) {
+	if lis.OnSetFullscreen != nil {
+		lis.OnSetFullscreen(output)
+	}
+}
+
+func (lis *ToplevelListenerFuncs) UnsetFullscreen() {
+	if lis.OnUnsetFullscreen != nil {
+		lis.OnUnsetFullscreen()
+	}
+}
+
+func (lis *ToplevelListenerFuncs) SetMinimized() {
+	if lis.OnSetMinimized != nil {
+		lis.OnSetMinimized()
+	}
+}
+
+// ToplevelRequest is implemented by the message types of all
+// of the requests that a Toplevel can receive.
+type ToplevelRequest interface {
+	toplevelRequest()
+}
+
+// ToplevelRequestFunc implements ToplevelListener by calling
+// itself with each incoming message.
+type ToplevelRequestFunc func(ToplevelRequest)
+
+func (f ToplevelRequestFunc) Destroy() {
+	f(ToplevelDestroyRequest{})
+}
+
+func (f ToplevelRequestFunc) SetParent(parent *Toplevel) {
+	f(ToplevelSetParentRequest{
+		Parent: parent,
+	})
+}
+
+func (f ToplevelRequestFunc) SetTitle(title string) {
+	f(ToplevelSetTitleRequest{
+		Title: title,
+	})
+}
+
+func (f ToplevelRequestFunc) SetAppId(appId string) {
+	f(ToplevelSetAppIdRequest{
+		AppId: appId,
+	})
+}
+
+func (f ToplevelRequestFunc) ShowWindowMenu(seat *wl.Seat, serial uint32, x int32, y int32) {
+	f(ToplevelShowWindowMenuRequest{
+		Seat:   seat,
+		Serial: serial,
+		X:      x,
+		Y:      y,
+	})
+}
+
+func (f ToplevelRequestFunc) Move(seat *wl.Seat, serial uint32) {
+	f(ToplevelMoveRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+func (f ToplevelRequestFunc) Resize(seat *wl.Seat, serial uint32, edges ToplevelResizeEdge) {
+	f(ToplevelResizeRequest{
+		Seat:   seat,
+		Serial: serial,
+		Edges:  edges,
+	})
+}
+
+func (f ToplevelRequestFunc) SetMaxSize(width int32, height int32) {
+	f(ToplevelSetMaxSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f ToplevelRequestFunc) SetMinSize(width int32, height int32) {
+	f(ToplevelSetMinSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (f ToplevelRequestFunc) SetMaximized() {
+	f(ToplevelSetMaximizedRequest{})
+}
+
+func (f ToplevelRequestFunc) UnsetMaximized() {
+	f(ToplevelUnsetMaximizedRequest{})
+}
+
+func (f ToplevelRequestFunc) SetFullscreen(output *wl.Output) {
+	f(ToplevelSetFullscreenRequest{
+		Output: output,
+	})
+}
+
+func (f ToplevelRequestFunc) UnsetFullscreen() {
+	f(ToplevelUnsetFullscreenRequest{})
+}
+
+func (f ToplevelRequestFunc) SetMinimized() {
+	f(ToplevelSetMinimizedRequest{})
+}
+
+// This interface defines an xdg_surface role which allows a surface to,
+// among other things, set window-like properties such as maximize,
+// fullscreen, and minimize, set application-specific metadata like title and
+// id, and well as trigger user interactive operations such as interactive
+// resize and move.
+//
+// A xdg_toplevel by default is responsible for providing the full intended
+// visual representation of the toplevel, which depending on the window
+// state, may mean things like a title bar, window controls and drop shadow.
+//
+// Unmapping an xdg_toplevel means that the surface cannot be shown
+// by the compositor until it is explicitly mapped again.
+// All active operations (e.g., move, resize) are canceled and all
+// attributes (e.g. title, state, stacking, ...) are discarded for
+// an xdg_toplevel surface when it is unmapped. The xdg_toplevel returns to
+// the state it had right after xdg_surface.get_toplevel. The client
+// can re-map the toplevel by performing a commit without any buffer
+// attached, waiting for a configure event and handling it as usual (see
+// xdg_surface description).
+//
+// Attaching a null buffer to a toplevel unmaps the surface.
+type Toplevel struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener ToplevelListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewToplevel returns a newly instantiated Toplevel. It is
+// primarily intended for use by generated code.
+func NewToplevel(state wire.State) *Toplevel {
+	return &Toplevel{state: state}
+}
+
+func (obj *Toplevel) State() wire.State {
+	return obj.state
+}
+
+func (obj *Toplevel) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m ToplevelDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m ToplevelSetParentRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetParent(
+			m.Parent,
+		)
+		return nil
+
+	case 2:
+		var m ToplevelSetTitleRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetTitle(
+			m.Title,
+		)
+		return nil
+
+	case 3:
+		var m ToplevelSetAppIdRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetAppId(
+			m.AppId,
+		)
+		return nil
+
+	case 4:
+		var m ToplevelShowWindowMenuRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.ShowWindowMenu(
+			m.Seat,
+			m.Serial,
+			m.X,
+			m.Y,
+		)
+		return nil
+
+	case 5:
+		var m ToplevelMoveRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Move(
+			m.Seat,
+			m.Serial,
+		)
+		return nil
+
+	case 6:
+		var m ToplevelResizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Resize(
+			m.Seat,
+			m.Serial,
+			m.Edges,
+		)
+		return nil
+
+	case 7:
+		var m ToplevelSetMaxSizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetMaxSize(
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 8:
+		var m ToplevelSetMinSizeRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetMinSize(
+			m.Width,
+			m.Height,
+		)
+		return nil
+
+	case 9:
+		var m ToplevelSetMaximizedRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetMaximized()
+		return nil
+
+	case 10:
+		var m ToplevelUnsetMaximizedRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.UnsetMaximized()
+		return nil
+
+	case 11:
+		var m ToplevelSetFullscreenRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetFullscreen(
+			m.Output,
+		)
+		return nil
+
+	case 12:
+		var m ToplevelUnsetFullscreenRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.UnsetFullscreen()
+		return nil
+
+	case 13:
+		var m ToplevelSetMinimizedRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.SetMinimized()
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(ToplevelInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_toplevel",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Toplevel) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Toplevel) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Toplevel) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Toplevel) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_toplevel", obj.id)
+}
+
+func (obj *Toplevel) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "set_parent"
+
+	case 2:
+		return "set_title"
+
+	case 3:
+		return "set_app_id"
+
+	case 4:
+		return "show_window_menu"
+
+	case 5:
+		return "move"
+
+	case 6:
+		return "resize"
+
+	case 7:
+		return "set_max_size"
+
+	case 8:
+		return "set_min_size"
+
+	case 9:
+		return "set_maximized"
+
+	case 10:
+		return "unset_maximized"
+
+	case 11:
+		return "set_fullscreen"
+
+	case 12:
+		return "unset_fullscreen"
+
+	case 13:
+		return "set_minimized"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Toplevel) Interface() string {
+	return ToplevelInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnSetParent sets the function that is called when the
+// set_parent request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetParent(f func(parent *Toplevel)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetParent = f
+}
+
+// OnSetTitle sets the function that is called when the
+// set_title request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetTitle(f func(title string)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetTitle = f
+}
+
+// OnSetAppId sets the function that is called when the
+// set_app_id request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetAppId(f func(appId string)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetAppId = f
+}
+
+// OnShowWindowMenu sets the function that is called when the
+// show_window_menu request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnShowWindowMenu(f func(seat *wl.Seat, serial uint32, x int32, y int32)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnShowWindowMenu = f
+}
+
+// OnMove sets the function that is called when the
+// move request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnMove(f func(seat *wl.Seat, serial uint32)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnMove = f
+}
+
+// OnResize sets the function that is called when the
+// resize request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnResize(f func(seat *wl.Seat, serial uint32, edges ToplevelResizeEdge)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnResize = f
+}
+
+// OnSetMaxSize sets the function that is called when the
+// set_max_size request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetMaxSize(f func(width int32, height int32)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMaxSize = f
+}
+
+// OnSetMinSize sets the function that is called when the
+// set_min_size request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetMinSize(f func(width int32, height int32)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMinSize = f
+}
+
+// OnSetMaximized sets the function that is called when the
+// set_maximized request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetMaximized(f func()) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMaximized = f
+}
+
+// OnUnsetMaximized sets the function that is called when the
+// unset_maximized request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnUnsetMaximized(f func()) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnUnsetMaximized = f
+}
+
+// OnSetFullscreen sets the function that is called when the
+// set_fullscreen request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetFullscreen(f func(output *wl.Output)) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetFullscreen = f
+}
+
+// OnUnsetFullscreen sets the function that is called when the
+// unset_fullscreen request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnUnsetFullscreen(f func()) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnUnsetFullscreen = f
+}
+
+// OnSetMinimized sets the function that is called when the
+// set_minimized request is received. If obj's Listener is not a
+// *ToplevelListenerFuncs, it is replaced with one.
+func (obj *Toplevel) OnSetMinimized(f func()) {
+	lis, ok := obj.Listener.(*ToplevelListenerFuncs)
+	if !ok {
+		lis = new(ToplevelListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnSetMinimized = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Toplevel) Subscribe() *wire.Messages[ToplevelRequest] {
+	q := new(wire.Messages[ToplevelRequest])
+	obj.Listener = ToplevelRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Toplevel) Version() uint32 {
+	return ToplevelVersion
+}
+
+// This configure event asks the client to resize its toplevel surface or
+// to change its state. The configured state should not be applied
+// immediately. See xdg_surface.configure for details.
+//
+// The width and height arguments specify a hint to the window
+// about how its surface should be resized in window geometry
+// coordinates. See set_window_geometry.
+//
+// If the width or height arguments are zero, it means the client
+// should decide its own window dimension. This may happen when the
+// compositor needs to configure the state of the surface but doesn't
+// have any information about any previous or expected dimension.
+//
+// The states listed in the event specify how the width/height
+// arguments should be interpreted, and possibly how it should be
+// drawn.
+//
+// Clients must send an ack_configure in response to this event. See
+// xdg_surface.configure and xdg_surface.ack_configure for details.
+func (obj *Toplevel) Configure(width int32, height int32, states []byte) {
+	obj.state.Enqueue(ToplevelConfigureEvent{
+		Width:  width,
+		Height: height,
+		States: states,
+	}.Encode(obj))
+	return
+}
+
+// The close event is sent by the compositor when the user
+// wants the surface to be closed. This should be equivalent to
+// the user clicking the close button in client-side decorations,
+// if your application has any.
+//
+// This is only a request that the user intends to close the
+// window. The client may choose to ignore this request, or show
+// a dialog to ask the user to save their data, etc.
+func (obj *Toplevel) Close() {
+	obj.state.Enqueue(ToplevelCloseEvent{}.Encode(obj))
+	return
+}
+
+// The configure_bounds event may be sent prior to a xdg_toplevel.configure
+// event to communicate the bounds a window geometry size is recommended
+// to constrain to.
+//
+// The passed width and height are in surface coordinate space. If width
+// and height are 0, it means bounds is unknown and equivalent to as if no
+// configure_bounds event was ever sent for this surface.
+//
+// The bounds can for example correspond to the size of a monitor excluding
+// any panels or other shell components, so that a surface isn't created in
+// a way that it cannot fit.
+//
+// The bounds may change at any point, and in such a case, a new
+// xdg_toplevel.configure_bounds will be sent, followed by
+// xdg_toplevel.configure and xdg_surface.configure.
+//
+// Available since version 4.
+func (obj *Toplevel) ConfigureBounds(width int32, height int32) {
+	obj.state.Enqueue(ToplevelConfigureBoundsEvent{
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
+	return
+}
+
+// This event advertises the capabilities supported by the compositor. If
+// a capability isn't supported, clients should hide or disable the UI
+// elements that expose this functionality. For instance, if the
+// compositor doesn't advertise support for minimized toplevels, a button
+// triggering the set_minimized request should not be displayed.
+//
+// The compositor will ignore requests it doesn't support. For instance,
+// a compositor which doesn't advertise support for minimized will ignore
+// set_minimized requests.
+//
+// Compositors must send this event once before the first
+// xdg_surface.configure event. When the capabilities change, compositors
+// must send this event again and then send an xdg_surface.configure
+// event.
+//
+// The configured state should not be applied immediately. See
+// xdg_surface.configure for details.
+//
+// The capabilities are sent as an array of 32-bit unsigned integers in
+// native endianness.
+//
+// Available since version 5.
+func (obj *Toplevel) WmCapabilities(capabilities []byte) {
+	obj.state.Enqueue(ToplevelWmCapabilitiesEvent{
+		Capabilities: capabilities,
+	}.Encode(obj))
+	return
+}
+
+// ToplevelDestroyRequest holds the arguments of the destroy request of
+// the xdg_toplevel interface.
+type ToplevelDestroyRequest struct {
+}
+
+func (ToplevelDestroyRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelDestroyRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelSetParentRequest holds the arguments of the set_parent request of
+// the xdg_toplevel interface.
+type ToplevelSetParentRequest struct {
+	Parent *Toplevel
+}
+
+func (ToplevelSetParentRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetParentRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Parent = wire.ResolveObject[*Toplevel](msg, state, ToplevelInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetParentRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteNullableObject(m.Parent)
+
+	builder.Method = "set_parent"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Parent}
+	}
+	return builder
+}
+
+// ToplevelSetTitleRequest holds the arguments of the set_title request of
+// the xdg_toplevel interface.
+type ToplevelSetTitleRequest struct {
+	Title string
+}
+
+func (ToplevelSetTitleRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetTitleRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Title = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetTitleRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteString(m.Title)
+
+	builder.Method = "set_title"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Title}
+	}
+	return builder
+}
+
+// ToplevelSetAppIdRequest holds the arguments of the set_app_id request of
+// the xdg_toplevel interface.
+type ToplevelSetAppIdRequest struct {
+	AppId string
+}
+
+func (ToplevelSetAppIdRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetAppIdRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.AppId = msg.ReadString()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetAppIdRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteString(m.AppId)
+
+	builder.Method = "set_app_id"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.AppId}
+	}
+	return builder
+}
+
+// ToplevelShowWindowMenuRequest holds the arguments of the show_window_menu request of
+// the xdg_toplevel interface.
+type ToplevelShowWindowMenuRequest struct {
+	Seat   *wl.Seat
+	Serial uint32
+	X      int32
+	Y      int32
+}
+
+func (ToplevelShowWindowMenuRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelShowWindowMenuRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelShowWindowMenuRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 4)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+
+	builder.Method = "show_window_menu"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.X, m.Y}
+	}
+	return builder
+}
+
+// ToplevelMoveRequest holds the arguments of the move request of
+// the xdg_toplevel interface.
+type ToplevelMoveRequest struct {
+	Seat   *wl.Seat
+	Serial uint32
+}
+
+func (ToplevelMoveRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelMoveRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelMoveRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 5)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "move"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial}
+	}
+	return builder
+}
+
+// ToplevelResizeRequest holds the arguments of the resize request of
+// the xdg_toplevel interface.
+type ToplevelResizeRequest struct {
+	Seat   *wl.Seat
+	Serial uint32
+	Edges  ToplevelResizeEdge
+}
+
+func (ToplevelResizeRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelResizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	m.Edges = ToplevelResizeEdge(msg.ReadUint())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	if !m.Edges.Valid() {
+		return wire.InvalidEnumError{
+			Interface: "xdg_toplevel",
+			Method:    "resize",
+			Arg:       "edges",
+			Value:     int64(m.Edges),
+		}
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelResizeRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 6)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+	builder.WriteUint(uint32(m.Edges))
+
+	builder.Method = "resize"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial, m.Edges}
+	}
+	return builder
+}
+
+// ToplevelSetMaxSizeRequest holds the arguments of the set_max_size request of
+// the xdg_toplevel interface.
+type ToplevelSetMaxSizeRequest struct {
+	Width  int32
+	Height int32
+}
+
+func (ToplevelSetMaxSizeRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetMaxSizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetMaxSizeRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 7)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_max_size"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height}
+	}
+	return builder
+}
+
+// ToplevelSetMinSizeRequest holds the arguments of the set_min_size request of
+// the xdg_toplevel interface.
+type ToplevelSetMinSizeRequest struct {
+	Width  int32
+	Height int32
+}
+
+func (ToplevelSetMinSizeRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetMinSizeRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetMinSizeRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 8)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "set_min_size"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height}
+	}
+	return builder
+}
+
+// ToplevelSetMaximizedRequest holds the arguments of the set_maximized request of
+// the xdg_toplevel interface.
+type ToplevelSetMaximizedRequest struct {
+}
+
+func (ToplevelSetMaximizedRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetMaximizedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetMaximizedRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 9)
+
+	builder.Method = "set_maximized"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelUnsetMaximizedRequest holds the arguments of the unset_maximized request of
+// the xdg_toplevel interface.
+type ToplevelUnsetMaximizedRequest struct {
+}
+
+func (ToplevelUnsetMaximizedRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelUnsetMaximizedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelUnsetMaximizedRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 10)
+
+	builder.Method = "unset_maximized"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelSetFullscreenRequest holds the arguments of the set_fullscreen request of
+// the xdg_toplevel interface.
+type ToplevelSetFullscreenRequest struct {
+	Output *wl.Output
+}
+
+func (ToplevelSetFullscreenRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetFullscreenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Output = wire.ResolveObject[*wl.Output](msg, state, wl.OutputInterface, msg.ReadNullableObject())
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetFullscreenRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 11)
+	builder.WriteNullableObject(m.Output)
+
+	builder.Method = "set_fullscreen"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Output}
+	}
+	return builder
+}
+
+// ToplevelUnsetFullscreenRequest holds the arguments of the unset_fullscreen request of
+// the xdg_toplevel interface.
+type ToplevelUnsetFullscreenRequest struct {
+}
+
+func (ToplevelUnsetFullscreenRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelUnsetFullscreenRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelUnsetFullscreenRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 12)
+
+	builder.Method = "unset_fullscreen"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelSetMinimizedRequest holds the arguments of the set_minimized request of
+// the xdg_toplevel interface.
+type ToplevelSetMinimizedRequest struct {
+}
+
+func (ToplevelSetMinimizedRequest) toplevelRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelSetMinimizedRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelSetMinimizedRequest) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 13)
+
+	builder.Method = "set_minimized"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelConfigureEvent holds the arguments of the configure event of
+// the xdg_toplevel interface.
+type ToplevelConfigureEvent struct {
+	Width  int32
+	Height int32
+	States []byte
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	m.States = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelConfigureEvent) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+	builder.WriteArray(m.States)
+
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height, m.States}
+	}
+	return builder
+}
+
+// ToplevelCloseEvent holds the arguments of the close event of
+// the xdg_toplevel interface.
+type ToplevelCloseEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelCloseEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelCloseEvent) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "close"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// ToplevelConfigureBoundsEvent holds the arguments of the configure_bounds event of
+// the xdg_toplevel interface.
+//
+// Available since version 4.
+type ToplevelConfigureBoundsEvent struct {
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelConfigureBoundsEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelConfigureBoundsEvent) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "configure_bounds"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Width, m.Height}
+	}
+	return builder
+}
+
+// ToplevelWmCapabilitiesEvent holds the arguments of the wm_capabilities event of
+// the xdg_toplevel interface.
+//
+// Available since version 5.
+type ToplevelWmCapabilitiesEvent struct {
+	Capabilities []byte
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *ToplevelWmCapabilitiesEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Capabilities = msg.ReadArray()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m ToplevelWmCapabilitiesEvent) Encode(obj *Toplevel) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 3)
+	builder.WriteArray(m.Capabilities)
+
+	builder.Method = "wm_capabilities"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Capabilities}
+	}
+	return builder
+}
+
+// ToplevelError is an alias for [shared.ToplevelError].
+type ToplevelError = shared.ToplevelError
+
+const (
+	ToplevelErrorInvalidResizeEdge = shared.ToplevelErrorInvalidResizeEdge
+	ToplevelErrorInvalidParent     = shared.ToplevelErrorInvalidParent
+	ToplevelErrorInvalidSize       = shared.ToplevelErrorInvalidSize
+)
+
+// ToplevelResizeEdge is an alias for [shared.ToplevelResizeEdge].
+type ToplevelResizeEdge = shared.ToplevelResizeEdge
+
+const (
+	ToplevelResizeEdgeNone        = shared.ToplevelResizeEdgeNone
+	ToplevelResizeEdgeTop         = shared.ToplevelResizeEdgeTop
+	ToplevelResizeEdgeBottom      = shared.ToplevelResizeEdgeBottom
+	ToplevelResizeEdgeLeft        = shared.ToplevelResizeEdgeLeft
+	ToplevelResizeEdgeTopLeft     = shared.ToplevelResizeEdgeTopLeft
+	ToplevelResizeEdgeBottomLeft  = shared.ToplevelResizeEdgeBottomLeft
+	ToplevelResizeEdgeRight       = shared.ToplevelResizeEdgeRight
+	ToplevelResizeEdgeTopRight    = shared.ToplevelResizeEdgeTopRight
+	ToplevelResizeEdgeBottomRight = shared.ToplevelResizeEdgeBottomRight
+)
+
+// ToplevelState is an alias for [shared.ToplevelState].
+type ToplevelState = shared.ToplevelState
+
+const (
+	ToplevelStateMaximized  = shared.ToplevelStateMaximized
+	ToplevelStateFullscreen = shared.ToplevelStateFullscreen
+	ToplevelStateResizing   = shared.ToplevelStateResizing
+	ToplevelStateActivated  = shared.ToplevelStateActivated
+	// Available since version 2.
+	ToplevelStateTiledLeft = shared.ToplevelStateTiledLeft
+	// Available since version 2.
+	ToplevelStateTiledRight = shared.ToplevelStateTiledRight
+	// Available since version 2.
+	ToplevelStateTiledTop = shared.ToplevelStateTiledTop
+	// Available since version 2.
+	ToplevelStateTiledBottom = shared.ToplevelStateTiledBottom
+	// Available since version 6.
+	ToplevelStateSuspended = shared.ToplevelStateSuspended
+	// Available since version 7.
+	ToplevelStateConstrainedLeft = shared.ToplevelStateConstrainedLeft
+	// Available since version 7.
+	ToplevelStateConstrainedRight = shared.ToplevelStateConstrainedRight
+	// Available since version 7.
+	ToplevelStateConstrainedTop = shared.ToplevelStateConstrainedTop
+	// Available since version 7.
+	ToplevelStateConstrainedBottom = shared.ToplevelStateConstrainedBottom
+)
+
+// ToplevelWmCapabilities is an alias for [shared.ToplevelWmCapabilities].
+//
+// Available since version 5.
+type ToplevelWmCapabilities = shared.ToplevelWmCapabilities
+
+const (
+	ToplevelWmCapabilitiesWindowMenu = shared.ToplevelWmCapabilitiesWindowMenu
+	ToplevelWmCapabilitiesMaximize   = shared.ToplevelWmCapabilitiesMaximize
+	ToplevelWmCapabilitiesFullscreen = shared.ToplevelWmCapabilitiesFullscreen
+	ToplevelWmCapabilitiesMinimize   = shared.ToplevelWmCapabilitiesMinimize
+)
+
+const (
+	PopupInterface = shared.PopupInterface
+
+	// PopupVersion is the version of the interface that this
+	// package implements.
+	PopupVersion = 7
+)
+
+// The versions of the xdg_popup interface that its messages were
+// added in.
+const (
+	PopupDestroyRequestSince    = 1
+	PopupGrabRequestSince       = 1
+	PopupRepositionRequestSince = 3
+	PopupConfigureEventSince    = 1
+	PopupPopupDoneEventSince    = 1
+	PopupRepositionedEventSince = 3
+)
+
+// PopupDesc describes the xdg_popup interface at runtime. It is
+// registered with [wire.RegisterInterface] during initialization.
+var PopupDesc = &wire.InterfaceDesc{
+	Name:    PopupInterface,
+	Version: PopupVersion,
+	Requests: []wire.MessageDesc{
+		{
+			Name:  "destroy",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "grab",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "seat",
+					Type:      wire.ArgObject,
+					Interface: "wl_seat",
+				},
+				{
+					Name: "serial",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+		{
+			Name:  "reposition",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name:      "positioner",
+					Type:      wire.ArgObject,
+					Interface: "xdg_positioner",
+				},
+				{
+					Name: "token",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Events: []wire.MessageDesc{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []wire.ArgDesc{
+				{
+					Name: "x",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "y",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "width",
+					Type: wire.ArgInt,
+				},
+				{
+					Name: "height",
+					Type: wire.ArgInt,
+				},
+			},
+		},
+		{
+			Name:  "popup_done",
+			Since: 1,
+			Args:  []wire.ArgDesc{},
+		},
+		{
+			Name:  "repositioned",
+			Since: 3,
+			Args: []wire.ArgDesc{
+				{
+					Name: "token",
+					Type: wire.ArgUint,
+				},
+			},
+		},
+	},
+	Enums: []wire.EnumDesc{
+		{
+			Name: "error",
+			Entries: []wire.EntryDesc{
+				{Name: "invalid_grab", Value: 0},
+			},
+		},
+	},
+}
+
+// PopupListener is a type that can respond to incoming
+// messages for a Popup object.
+type PopupListener interface {
+	// This destroys the popup. Explicitly destroying the xdg_popup
+	// object will also dismiss the popup, and unmap the surface.
+	//
+	// If this xdg_popup is not the "topmost" popup, the
+	// xdg_wm_base.not_the_topmost_popup protocol error will be sent.
+	Destroy()
+
+	// This request makes the created popup take an explicit grab. An explicit
+	// grab will be dismissed when the user dismisses the popup, or when the
+	// client destroys the xdg_popup. This can be done by the user clicking
+	// outside the surface, using the keyboard, or even locking the screen
+	// through closing the lid or a timeout.
+	//
+	// If the compositor denies the grab, the popup will be immediately
+	// dismissed.
+	//
+	// This request must be used in response to some sort of user action like a
+	// button press, key press, or touch down event. The serial number of the
+	// event should be passed as 'serial'.
+	//
+	// The parent of a grabbing popup must either be an xdg_toplevel surface or
+	// another xdg_popup with an explicit grab. If the parent is another
+	// xdg_popup it means that the popups are nested, with this popup now being
+	// the topmost popup.
+	//
+	// Nested popups must be destroyed in the reverse order they were created
+	// in, e.g. the only popup you are allowed to destroy at all times is the
+	// topmost one.
+	//
+	// When compositors choose to dismiss a popup, they may dismiss every
+	// nested grabbing popup as well. When a compositor dismisses popups, it
+	// will follow the same dismissing order as required from the client.
+	//
+	// If the topmost grabbing popup is destroyed, the grab will be returned to
+	// the parent of the popup, if that parent previously had an explicit grab.
+	//
+	// If the parent is a grabbing popup which has already been dismissed, this
+	// popup will be immediately dismissed. If the parent is a popup that did
+	// not take an explicit grab, an error will be raised.
+	//
+	// During a popup grab, the client owning the grab will receive pointer
+	// and touch events for all their surfaces as normal (similar to an
+	// "owner-events" grab in X11 parlance), while the top most grabbing popup
+	// will always have keyboard focus.
+	Grab(seat *wl.Seat, serial uint32)
+
+	// Reposition an already-mapped popup. The popup will be placed given the
+	// details in the passed xdg_positioner object, and a
+	// xdg_popup.repositioned followed by xdg_popup.configure and
+	// xdg_surface.configure will be emitted in response. Any parameters set
+	// by the previous positioner will be discarded.
+	//
+	// The passed token will be sent in the corresponding
+	// xdg_popup.repositioned event. The new popup position will not take
+	// effect until the corresponding configure event is acknowledged by the
+	// client. See xdg_popup.repositioned for details. The token itself is
+	// opaque, and has no other special meaning.
+	//
+	// If multiple reposition requests are sent, the compositor may skip all
+	// but the last one.
+	//
+	// If the popup is repositioned in response to a configure event for its
+	// parent, the client should send an xdg_positioner.set_parent_configure
+	// and possibly an xdg_positioner.set_parent_size request to allow the
+	// compositor to properly constrain the popup.
+	//
+	// If the popup is repositioned together with a parent that is being
+	// resized, but not in response to a configure event, the client should
+	// send an xdg_positioner.set_parent_size request.
+	//
+	// Available since version 3.
+	Reposition(positioner *Positioner, token uint32)
+}
+
+// PopupListenerFuncs implements PopupListener by calling
+// its fields. Messages whose corresponding field is nil are
+// ignored.
+type PopupListenerFuncs struct {
+	OnDestroy func()
+	OnGrab    func(seat *wl.Seat, serial uint32)
+	// Available since version 3.
+	OnReposition func(positioner *Positioner, token uint32)
+}
+
+func (lis *PopupListenerFuncs) Destroy() {
+	if lis.OnDestroy != nil {
+		lis.OnDestroy()
+	}
+}
+
+func (lis *PopupListenerFuncs) Grab(seat *wl.Seat, serial uint32) {
+	if lis.OnGrab != nil {
+		lis.OnGrab(seat, serial)
+	}
+}
+
+// Available since version 3.
+func (lis *PopupListenerFuncs) Reposition(positioner *Positioner, token uint32) {
+	if lis.OnReposition != nil {
+		lis.OnReposition(positioner, token)
+	}
+}
+
+// PopupRequest is implemented by the message types of all
+// of the requests that a Popup can receive.
+type PopupRequest interface {
+	popupRequest()
+}
+
+// PopupRequestFunc implements PopupListener by calling
+// itself with each incoming message.
+type PopupRequestFunc func(PopupRequest)
+
+func (f PopupRequestFunc) Destroy() {
+	f(PopupDestroyRequest{})
+}
+
+func (f PopupRequestFunc) Grab(seat *wl.Seat, serial uint32) {
+	f(PopupGrabRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+// Available since version 3.
+func (f PopupRequestFunc) Reposition(positioner *Positioner, token uint32) {
+	f(PopupRepositionRequest{
+		Positioner: positioner,
+		Token:      token,
+	})
+}
+
+// A popup surface is a short-lived, temporary surface. It can be used to
+// implement for example menus, popovers, tooltips and other similar user
+// interface concepts.
+//
+// A popup can be made to take an explicit grab. See xdg_popup.grab for
+// details.
+//
+// When the popup is dismissed, a popup_done event will be sent out, and at
+// the same time the surface will be unmapped. See the xdg_popup.popup_done
+// event for details.
+//
+// Explicitly destroying the xdg_popup object will also dismiss the popup and
+// unmap the surface. Clients that want to dismiss the popup when another
+// surface of their own is clicked should dismiss the popup using the destroy
+// request.
+//
+// A newly created xdg_popup will be stacked on top of all previously created
+// xdg_popup surfaces associated with the same xdg_toplevel.
+//
+// The parent of an xdg_popup must be mapped (see the xdg_surface
+// description) before the xdg_popup itself.
+//
+// The client must call wl_surface.commit on the corresponding wl_surface
+// for the xdg_popup state to take effect.
+type Popup struct {
+	// Listener's methods are called by incoming messages from the
+	// remote end via Dispatch. If it is nil, messages are silently
+	// ignored.
+	Listener PopupListener
+
+	// OnDelete is called when the object is removed from the tracking
+	// system.
+	OnDelete func()
+
+	state wire.State
+	id    uint32
+}
+
+// NewPopup returns a newly instantiated Popup. It is
+// primarily intended for use by generated code.
+func NewPopup(state wire.State) *Popup {
+	return &Popup{state: state}
+}
+
+func (obj *Popup) State() wire.State {
+	return obj.state
+}
+
+func (obj *Popup) Dispatch(msg *wire.MessageBuffer) error {
+	switch msg.Op() {
+	case 0:
+		var m PopupDestroyRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Destroy()
+		return nil
+
+	case 1:
+		var m PopupGrabRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Grab(
+			m.Seat,
+			m.Serial,
+		)
+		return nil
+
+	case 2:
+		var m PopupRepositionRequest
+		if err := m.Decode(obj.state, msg); err != nil {
+			return err
+		}
+
+		if obj.Listener == nil {
+			return nil
+		}
+		obj.Listener.Reposition(
+			m.Positioner,
+			m.Token,
+		)
+		return nil
+	}
+
+	// The registered description of the interface may have been
+	// replaced by a newer one that knows how many file descriptors
+	// the message has, so that they aren't claimed by the next one.
+	if desc, ok := wire.LookupInterface(PopupInterface, false); ok {
+		if m, ok := desc.Request(msg.Op()); ok {
+			msg.ClaimFDs(m.FDCount())
+		}
+	}
+	return wire.UnknownOpError{
+		Interface: "xdg_popup",
+		Type:      "request",
+		Op:        msg.Op(),
+	}
+}
+
+func (obj *Popup) ID() uint32 {
+	return obj.id
+}
+
+func (obj *Popup) SetID(id uint32) {
+	obj.id = id
+}
+
+func (obj *Popup) Delete() {
+	if obj.OnDelete != nil {
+		obj.OnDelete()
+	}
+}
+
+func (obj *Popup) String() string {
+	return fmt.Sprintf("%v(%v)", "xdg_popup", obj.id)
+}
+
+func (obj *Popup) MethodName(op uint16) string {
+	switch op {
+	case 0:
+		return "destroy"
+
+	case 1:
+		return "grab"
+
+	case 2:
+		return "reposition"
+	}
+
+	return "unknown method"
+}
+
+func (obj *Popup) Interface() string {
+	return PopupInterface
+}
+
+// OnDestroy sets the function that is called when the
+// destroy request is received. If obj's Listener is not a
+// *PopupListenerFuncs, it is replaced with one.
+func (obj *Popup) OnDestroy(f func()) {
+	lis, ok := obj.Listener.(*PopupListenerFuncs)
+	if !ok {
+		lis = new(PopupListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnDestroy = f
+}
+
+// OnGrab sets the function that is called when the
+// grab request is received. If obj's Listener is not a
+// *PopupListenerFuncs, it is replaced with one.
+func (obj *Popup) OnGrab(f func(seat *wl.Seat, serial uint32)) {
+	lis, ok := obj.Listener.(*PopupListenerFuncs)
+	if !ok {
+		lis = new(PopupListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnGrab = f
+}
+
+// OnReposition sets the function that is called when the
+// reposition request is received. If obj's Listener is not a
+// *PopupListenerFuncs, it is replaced with one.
+//
+// Available since version 3.
+func (obj *Popup) OnReposition(f func(positioner *Positioner, token uint32)) {
+	lis, ok := obj.Listener.(*PopupListenerFuncs)
+	if !ok {
+		lis = new(PopupListenerFuncs)
+		obj.Listener = lis
+	}
+	lis.OnReposition = f
+}
+
+// Subscribe replaces obj's Listener with one that adds each
+// incoming message to the returned queue.
+func (obj *Popup) Subscribe() *wire.Messages[PopupRequest] {
+	q := new(wire.Messages[PopupRequest])
+	obj.Listener = PopupRequestFunc(q.Push)
+	return q
+}
+
+func (obj *Popup) Version() uint32 {
+	return PopupVersion
+}
+
+// This event asks the popup surface to configure itself given the
+// configuration. The configured state should not be applied immediately.
+// See xdg_surface.configure for details.
+//
+// The x and y arguments represent the position the popup was placed at
+// given the xdg_positioner rule, relative to the upper left corner of the
+// window geometry of the parent surface.
+//
+// For version 2 or older, the configure event for an xdg_popup is only
+// ever sent once for the initial configuration. Starting with version 3,
+// it may be sent again if the popup is setup with an xdg_positioner with
+// set_reactive requested, or in response to xdg_popup.reposition requests.
+func (obj *Popup) Configure(x int32, y int32, width int32, height int32) {
+	obj.state.Enqueue(PopupConfigureEvent{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}.Encode(obj))
+	return
+}
+
+// The popup_done event is sent out when a popup is dismissed by the
+// compositor. The client should destroy the xdg_popup object at this
+// point.
+func (obj *Popup) PopupDone() {
+	obj.state.Enqueue(PopupPopupDoneEvent{}.Encode(obj))
+	return
+}
+
+// The repositioned event is sent as part of a popup configuration
+// sequence, together with xdg_popup.configure and lastly
+// xdg_surface.configure to notify the completion of a reposition request.
+//
+// The repositioned event is to notify about the completion of a
+// xdg_popup.reposition request. The token argument is the token passed
+// in the xdg_popup.reposition request.
+//
+// Immediately after this event is emitted, xdg_popup.configure and
+// xdg_surface.configure will be sent with the updated size and position,
+// as well as a new configure serial.
+//
+// The client should optionally update the content of the popup, but must
+// acknowledge the new popup configuration for the new position to take
+// effect. See xdg_surface.ack_configure for details.
+//
+// Available since version 3.
+func (obj *Popup) Repositioned(token uint32) {
+	obj.state.Enqueue(PopupRepositionedEvent{
+		Token: token,
+	}.Encode(obj))
+	return
+}
+
+// PopupDestroyRequest holds the arguments of the destroy request of
+// the xdg_popup interface.
+type PopupDestroyRequest struct {
+}
+
+func (PopupDestroyRequest) popupRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupDestroyRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupDestroyRequest) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+
+	builder.Method = "destroy"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PopupGrabRequest holds the arguments of the grab request of
+// the xdg_popup interface.
+type PopupGrabRequest struct {
+	Seat   *wl.Seat
+	Serial uint32
+}
+
+func (PopupGrabRequest) popupRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupGrabRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Seat = wire.ResolveObject[*wl.Seat](msg, state, wl.SeatInterface, msg.ReadObject())
+	m.Serial = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupGrabRequest) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+	builder.WriteObject(m.Seat)
+	builder.WriteUint(m.Serial)
+
+	builder.Method = "grab"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Seat, m.Serial}
+	}
+	return builder
+}
+
+// PopupRepositionRequest holds the arguments of the reposition request of
+// the xdg_popup interface.
+//
+// Available since version 3.
+type PopupRepositionRequest struct {
+	Positioner *Positioner
+	Token      uint32
+}
+
+func (PopupRepositionRequest) popupRequest() {}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupRepositionRequest) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Positioner = wire.ResolveObject[*Positioner](msg, state, PositionerInterface, msg.ReadObject())
+	m.Token = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupRepositionRequest) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteObject(m.Positioner)
+	builder.WriteUint(m.Token)
+
+	builder.Method = "reposition"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Positioner, m.Token}
+	}
+	return builder
+}
+
+// PopupConfigureEvent holds the arguments of the configure event of
+// the xdg_popup interface.
+type PopupConfigureEvent struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupConfigureEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.X = msg.ReadInt()
+	m.Y = msg.ReadInt()
+	m.Width = msg.ReadInt()
+	m.Height = msg.ReadInt()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupConfigureEvent) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 0)
+	builder.WriteInt(m.X)
+	builder.WriteInt(m.Y)
+	builder.WriteInt(m.Width)
+	builder.WriteInt(m.Height)
+
+	builder.Method = "configure"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.X, m.Y, m.Width, m.Height}
+	}
+	return builder
+}
+
+// PopupPopupDoneEvent holds the arguments of the popup_done event of
+// the xdg_popup interface.
+type PopupPopupDoneEvent struct {
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupPopupDoneEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupPopupDoneEvent) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 1)
+
+	builder.Method = "popup_done"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{}
+	}
+	return builder
+}
+
+// PopupRepositionedEvent holds the arguments of the repositioned event of
+// the xdg_popup interface.
+//
+// Available since version 3.
+type PopupRepositionedEvent struct {
+	Token uint32
+}
+
+// Decode reads the arguments of m from msg. Objects created by
+// the message are added to state, and referenced objects are
+// looked up in it. The IDs of created objects are checked with
+// wire.CheckNewID. Invalid enum arguments result in a
+// wire.InvalidEnumError, which is returned before any objects
+// are added.
+func (m *PopupRepositionedEvent) Decode(state wire.State, msg *wire.MessageBuffer) error {
+	m.Token = msg.ReadUint()
+	if err := msg.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Encode returns a message that sends m from obj. Objects created
+// by the message must already have been added to obj's state.
+func (m PopupRepositionedEvent) Encode(obj *Popup) *wire.MessageBuilder {
+	builder := wire.NewMessage(obj, 2)
+	builder.WriteUint(m.Token)
+
+	builder.Method = "repositioned"
+	if wire.Tracing(obj.state) {
+		builder.Args = []any{m.Token}
+	}
+	return builder
+}
+
+// PopupError is an alias for [shared.PopupError].
+type PopupError = shared.PopupError
+
+const (
+	PopupErrorInvalidGrab = shared.PopupErrorInvalidGrab
+)
+
+func init() {
+	wire.RegisterInterface(WmBaseDesc, false)
+	wire.RegisterInterface(PositionerDesc, false)
+	wire.RegisterInterface(SurfaceDesc, false)
+	wire.RegisterInterface(ToplevelDesc, false)
+	wire.RegisterInterface(PopupDesc, false)
+}
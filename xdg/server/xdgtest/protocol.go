@@ -0,0 +1,272 @@
+// Code generated by wlgen. DO NOT EDIT.
+
+// Package xdgtest provides mock listeners for testing code that uses
+// the xdg_shell protocol bindings in package xdg. The listeners
+// record the messages that they receive so that they can be checked
+// against expectations.
+package xdgtest
+
+import (
+	wl "deedles.dev/wl/server"
+	xdg "deedles.dev/wl/xdg/server"
+
+	"deedles.dev/wl/wire/wiretest"
+)
+
+// WmBaseListener is a [xdg.WmBaseListener] that records the
+// requests that it receives.
+type WmBaseListener struct {
+	wiretest.Recorder[xdg.WmBaseRequest]
+}
+
+var _ xdg.WmBaseListener = (*WmBaseListener)(nil)
+
+func (lis *WmBaseListener) Destroy() {
+	lis.Record(xdg.WmBaseDestroyRequest{})
+}
+
+func (lis *WmBaseListener) CreatePositioner(id *xdg.Positioner) {
+	lis.Record(xdg.WmBaseCreatePositionerRequest{
+		Id: id,
+	})
+}
+
+func (lis *WmBaseListener) GetXdgSurface(id *xdg.Surface, surface *wl.Surface) {
+	lis.Record(xdg.WmBaseGetXdgSurfaceRequest{
+		Id:      id,
+		Surface: surface,
+	})
+}
+
+func (lis *WmBaseListener) Pong(serial uint32) {
+	lis.Record(xdg.WmBasePongRequest{
+		Serial: serial,
+	})
+}
+
+// PositionerListener is a [xdg.PositionerListener] that records the
+// requests that it receives.
+type PositionerListener struct {
+	wiretest.Recorder[xdg.PositionerRequest]
+}
+
+var _ xdg.PositionerListener = (*PositionerListener)(nil)
+
+func (lis *PositionerListener) Destroy() {
+	lis.Record(xdg.PositionerDestroyRequest{})
+}
+
+func (lis *PositionerListener) SetSize(width int32, height int32) {
+	lis.Record(xdg.PositionerSetSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *PositionerListener) SetAnchorRect(x int32, y int32, width int32, height int32) {
+	lis.Record(xdg.PositionerSetAnchorRectRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *PositionerListener) SetAnchor(anchor xdg.PositionerAnchor) {
+	lis.Record(xdg.PositionerSetAnchorRequest{
+		Anchor: anchor,
+	})
+}
+
+func (lis *PositionerListener) SetGravity(gravity xdg.PositionerGravity) {
+	lis.Record(xdg.PositionerSetGravityRequest{
+		Gravity: gravity,
+	})
+}
+
+func (lis *PositionerListener) SetConstraintAdjustment(constraintAdjustment xdg.PositionerConstraintAdjustment) {
+	lis.Record(xdg.PositionerSetConstraintAdjustmentRequest{
+		ConstraintAdjustment: constraintAdjustment,
+	})
+}
+
+func (lis *PositionerListener) SetOffset(x int32, y int32) {
+	lis.Record(xdg.PositionerSetOffsetRequest{
+		X: x,
+		Y: y,
+	})
+}
+
+func (lis *PositionerListener) SetReactive() {
+	lis.Record(xdg.PositionerSetReactiveRequest{})
+}
+
+func (lis *PositionerListener) SetParentSize(parentWidth int32, parentHeight int32) {
+	lis.Record(xdg.PositionerSetParentSizeRequest{
+		ParentWidth:  parentWidth,
+		ParentHeight: parentHeight,
+	})
+}
+
+func (lis *PositionerListener) SetParentConfigure(serial uint32) {
+	lis.Record(xdg.PositionerSetParentConfigureRequest{
+		Serial: serial,
+	})
+}
+
+// SurfaceListener is a [xdg.SurfaceListener] that records the
+// requests that it receives.
+type SurfaceListener struct {
+	wiretest.Recorder[xdg.SurfaceRequest]
+}
+
+var _ xdg.SurfaceListener = (*SurfaceListener)(nil)
+
+func (lis *SurfaceListener) Destroy() {
+	lis.Record(xdg.SurfaceDestroyRequest{})
+}
+
+func (lis *SurfaceListener) GetToplevel(id *xdg.Toplevel) {
+	lis.Record(xdg.SurfaceGetToplevelRequest{
+		Id: id,
+	})
+}
+
+func (lis *SurfaceListener) GetPopup(id *xdg.Popup, parent *xdg.Surface, positioner *xdg.Positioner) {
+	lis.Record(xdg.SurfaceGetPopupRequest{
+		Id:         id,
+		Parent:     parent,
+		Positioner: positioner,
+	})
+}
+
+func (lis *SurfaceListener) SetWindowGeometry(x int32, y int32, width int32, height int32) {
+	lis.Record(xdg.SurfaceSetWindowGeometryRequest{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *SurfaceListener) AckConfigure(serial uint32) {
+	lis.Record(xdg.SurfaceAckConfigureRequest{
+		Serial: serial,
+	})
+}
+
+// ToplevelListener is a [xdg.ToplevelListener] that records the
+// requests that it receives.
+type ToplevelListener struct {
+	wiretest.Recorder[xdg.ToplevelRequest]
+}
+
+var _ xdg.ToplevelListener = (*ToplevelListener)(nil)
+
+func (lis *ToplevelListener) Destroy() {
+	lis.Record(xdg.ToplevelDestroyRequest{})
+}
+
+func (lis *ToplevelListener) SetParent(parent *xdg.Toplevel) {
+	lis.Record(xdg.ToplevelSetParentRequest{
+		Parent: parent,
+	})
+}
+
+func (lis *ToplevelListener) SetTitle(title string) {
+	lis.Record(xdg.ToplevelSetTitleRequest{
+		Title: title,
+	})
+}
+
+func (lis *ToplevelListener) SetAppId(appId string) {
+	lis.Record(xdg.ToplevelSetAppIdRequest{
+		AppId: appId,
+	})
+}
+
+func (lis *ToplevelListener) ShowWindowMenu(seat *wl.Seat, serial uint32, x int32, y int32) {
+	lis.Record(xdg.ToplevelShowWindowMenuRequest{
+		Seat:   seat,
+		Serial: serial,
+		X:      x,
+		Y:      y,
+	})
+}
+
+func (lis *ToplevelListener) Move(seat *wl.Seat, serial uint32) {
+	lis.Record(xdg.ToplevelMoveRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+func (lis *ToplevelListener) Resize(seat *wl.Seat, serial uint32, edges xdg.ToplevelResizeEdge) {
+	lis.Record(xdg.ToplevelResizeRequest{
+		Seat:   seat,
+		Serial: serial,
+		Edges:  edges,
+	})
+}
+
+func (lis *ToplevelListener) SetMaxSize(width int32, height int32) {
+	lis.Record(xdg.ToplevelSetMaxSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *ToplevelListener) SetMinSize(width int32, height int32) {
+	lis.Record(xdg.ToplevelSetMinSizeRequest{
+		Width:  width,
+		Height: height,
+	})
+}
+
+func (lis *ToplevelListener) SetMaximized() {
+	lis.Record(xdg.ToplevelSetMaximizedRequest{})
+}
+
+func (lis *ToplevelListener) UnsetMaximized() {
+	lis.Record(xdg.ToplevelUnsetMaximizedRequest{})
+}
+
+func (lis *ToplevelListener) SetFullscreen(output *wl.Output) {
+	lis.Record(xdg.ToplevelSetFullscreenRequest{
+		Output: output,
+	})
+}
+
+func (lis *ToplevelListener) UnsetFullscreen() {
+	lis.Record(xdg.ToplevelUnsetFullscreenRequest{})
+}
+
+func (lis *ToplevelListener) SetMinimized() {
+	lis.Record(xdg.ToplevelSetMinimizedRequest{})
+}
+
+// PopupListener is a [xdg.PopupListener] that records the
+// requests that it receives.
+type PopupListener struct {
+	wiretest.Recorder[xdg.PopupRequest]
+}
+
+var _ xdg.PopupListener = (*PopupListener)(nil)
+
+func (lis *PopupListener) Destroy() {
+	lis.Record(xdg.PopupDestroyRequest{})
+}
+
+func (lis *PopupListener) Grab(seat *wl.Seat, serial uint32) {
+	lis.Record(xdg.PopupGrabRequest{
+		Seat:   seat,
+		Serial: serial,
+	})
+}
+
+func (lis *PopupListener) Reposition(positioner *xdg.Positioner, token uint32) {
+	lis.Record(xdg.PopupRepositionRequest{
+		Positioner: positioner,
+		Token:      token,
+	})
+}